@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
@@ -13,6 +14,8 @@ import (
 	"npan/internal/httpx"
 	"npan/internal/logx"
 	"npan/internal/metrics"
+	"npan/internal/notify"
+	"npan/internal/npan"
 	"npan/internal/search"
 	"npan/internal/service"
 	"npan/internal/storage"
@@ -36,6 +39,7 @@ func main() {
 	promReg := metrics.NewRegistry()
 	syncMetrics := metrics.NewSyncMetrics(promReg)
 	searchMetrics := metrics.NewSearchMetrics(promReg)
+	upstreamMetrics := metrics.NewUpstreamMetrics(promReg)
 
 	index, backendInfo, err := search.NewIndexOperator(search.BackendConfig{
 		Backend:             cfg.SearchBackend,
@@ -72,8 +76,19 @@ func main() {
 	}
 	defer stateStores.DB.Close()
 
+	tokenManager, err := cfg.NewTokenManager(stateStores.OAuthTokenStore)
+	if err != nil {
+		logger.Error("初始化 token 管理器失败", "error", err)
+		os.Exit(1)
+	}
+
 	syncReporter := metrics.NewPrometheusSyncReporter(syncMetrics)
-	syncManager := service.NewSyncManager(service.SyncManagerArgs{
+	circuitBreaker := cfg.NewCircuitBreaker(upstreamMetrics.ObserveCircuitTransition)
+	notifier := notify.NewDispatcher(cfg.NotifyOptions())
+	if names := notifier.SinkNames(); len(names) > 0 {
+		logger.Info("同步通知已启用", "sinks", names)
+	}
+	syncArgs := service.SyncManagerArgs{
 		Index:              index,
 		ProgressStore:      stateStores.ProgressStore,
 		SyncStateStore:     stateStores.SyncStateStore,
@@ -82,6 +97,10 @@ func main() {
 		MeiliIndex:         backendInfo.Index,
 		CheckpointTemplate: cfg.CheckpointTemplate,
 		RootWorkers:        cfg.SyncRootWorkers,
+		FolderWorkers:      cfg.SyncFolderWorkers,
+		IndexBatchDocs:     cfg.IndexBatchDocs,
+		IndexBatchBytes:    cfg.IndexBatchBytes,
+		IndexMaxInFlight:   cfg.IndexMaxInFlight,
 		ProgressEvery:      cfg.SyncProgressEvery,
 		Retry:              cfg.Retry,
 		MaxConcurrent:      cfg.SyncMaxConcurrent,
@@ -90,9 +109,95 @@ func main() {
 		IncrementalQuery:   cfg.IncrementalQuery,
 		WindowOverlapMS:    cfg.SyncWindowOverlapMS,
 		MetricsReporter:    syncReporter,
-	})
+		RunStore:           stateStores.SyncRunStore,
+		DeadLetterStore:    stateStores.DeadLetterStore,
+		PathRewriteLimit:   cfg.PathRewriteMaxFolders,
+		Notifier:           notifier,
+
+		IndexChangeStore:     stateStores.IndexChangeStore,
+		IndexChangeRetention: cfg.IndexChangeRetention,
+
+		CircuitBreaker: circuitBreaker,
+
+		ReconciliationStore: stateStores.ReconciliationStore,
+
+		IncrementalWindowMaxPages: cfg.IncrementalWindowMaxPages,
+		IncrementalWindowMaxItems: cfg.IncrementalWindowMaxItems,
+
+		SyncLeaseStore: stateStores.SyncLeaseStore,
+		SyncLeaseOwner: service.SyncLeaseOwner("server"),
+		SyncLeaseTTL:   cfg.SyncLeaseTTL,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	var (
+		syncManager *service.SyncManager
+		apiFactory  func(ctx context.Context) (npan.API, error)
+		tenants     []*httpx.Tenant
+	)
+	if cfg.MultiTenant() {
+		tenants, err = newTenants(ctx, cfg, index, stateStores, syncReporter, upstreamMetrics, syncArgs)
+		if err != nil {
+			logger.Error("初始化租户失败", "error", err)
+			os.Exit(1)
+		}
+		// 未指定租户的请求落到默认租户。
+		syncManager = tenants[0].SyncManager
+		logger.Info("多租户已启用", "tenants", len(tenants), "default", tenants[0].Config.ID)
+	} else {
+		syncManager = service.NewSyncManager(syncArgs)
+		apiFactory = newAPIFactory(cfg.BaseURL, npan.AuthResolverOptions{
+			Token:        cfg.Token,
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			SubID:        cfg.SubID,
+			SubType:      cfg.SubType,
+			OAuthHost:    cfg.OAuthHost,
+		}, tokenManager)
+	}
 
 	handlers := httpx.NewHandlers(cfg, instrSearch, syncManager)
+	handlers.SetNotifier(notifier)
+	handlers.SetCircuitBreaker(circuitBreaker)
+	handlers.SetTokenManager(tokenManager)
+	handlers.SetTenants(tenants)
+
+	if tokenManager != nil {
+		go tokenManager.Run(ctx)
+		logger.Info("token 管理器已启动", "refresh_before", cfg.TokenRefreshBefore)
+	}
+
+	if cfg.SchedulerEnabled {
+		location, _ := cfg.SchedulerLocation()
+		if len(tenants) > 0 {
+			// 每个租户有自己的计划与调度器，计划同步使用租户凭据与租户配置的同步范围。
+			for _, tenant := range tenants {
+				tenant.Scheduler = service.NewSyncScheduler(service.SyncSchedulerArgs{
+					Store:          stateStores.ForTenant(tenant.Config.ID).ScheduleStore,
+					SyncManager:    tenant.SyncManager,
+					APIFactory:     tenant.APIFactory,
+					PrepareRequest: tenant.ApplySyncDefaults,
+					TickInterval:   cfg.SchedulerTickInterval,
+					Location:       location,
+				})
+				go tenant.Scheduler.Run(ctx)
+			}
+		} else {
+			scheduler := service.NewSyncScheduler(service.SyncSchedulerArgs{
+				Store:        stateStores.ScheduleStore,
+				SyncManager:  syncManager,
+				APIFactory:   apiFactory,
+				TickInterval: cfg.SchedulerTickInterval,
+				Location:     location,
+			})
+			handlers.SetSyncScheduler(scheduler)
+			go scheduler.Run(ctx)
+		}
+		logger.Info("同步调度器已启动", "tick_interval", cfg.SchedulerTickInterval, "timezone", location.String(), "tenants", len(tenants))
+	}
+
 	distFS := echo.MustSubFS(web.DistFS, "dist")
 	e := httpx.NewServer(handlers, cfg.AdminAPIKey, distFS, promReg)
 
@@ -117,9 +222,6 @@ func main() {
 		}()
 	}
 
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
-	defer stop()
-
 	go func() {
 		logger.Info("服务启动", "addr", cfg.ServerAddr)
 		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -146,4 +248,81 @@ func main() {
 			slog.Error("指标服务优雅关闭失败", "error", err)
 		}
 	}
+
+	notifier.Wait()
+}
+
+// newAPIFactory 使用服务端配置的凭据创建 API 客户端；配置了 token 管理器时复用其缓存的 token。
+func newAPIFactory(baseURL string, authOptions npan.AuthResolverOptions, tokenManager *npan.TokenManager) func(ctx context.Context) (npan.API, error) {
+	return func(ctx context.Context) (npan.API, error) {
+		if tokenManager != nil {
+			if _, err := tokenManager.Token(ctx); err != nil {
+				return nil, err
+			}
+			return npan.NewHTTPClient(npan.HTTPClientOptions{
+				BaseURL:     baseURL,
+				TokenSource: tokenManager,
+			}), nil
+		}
+		token, err := npan.ResolveBearerToken(ctx, nil, authOptions)
+		if err != nil {
+			return nil, err
+		}
+		return npan.NewHTTPClient(npan.HTTPClientOptions{
+			BaseURL:        baseURL,
+			Token:          token,
+			TokenRefresher: npan.NewTokenRefresher(nil, authOptions),
+		}), nil
+	}
+}
+
+// newTenants 为每个租户创建独立的同步管理器：索引读写限定在租户内，进度、游标、checkpoint、运行历史、死信、
+// 变更日志、对账报告与同步租约都按租户分开保存，同步指标共享计数器。
+// 熔断器按上游地址区分，一个租户的上游故障不会拒绝其他上游的请求；使用全局地址的租户共享全局熔断器。
+func newTenants(ctx context.Context, cfg config.Config, index search.IndexOperator, stateStores *storage.SQLiteStateStores, syncReporter *metrics.PrometheusSyncReporter, upstreamMetrics *metrics.UpstreamMetrics, base service.SyncManagerArgs) ([]*httpx.Tenant, error) {
+	tenants := make([]*httpx.Tenant, 0, len(cfg.Tenants))
+	breakers := map[string]*npan.CircuitBreaker{cfg.BaseURL: base.CircuitBreaker}
+	for _, tenantCfg := range cfg.Tenants {
+		tenantIndex, err := search.ForTenant(index, tenantCfg.ID)
+		if err != nil {
+			return nil, err
+		}
+		tokenManager, err := cfg.NewTenantTokenManager(tenantCfg, stateStores.OAuthTokenStore)
+		if err != nil {
+			return nil, fmt.Errorf("租户 %s: %w", tenantCfg.ID, err)
+		}
+		if tokenManager != nil {
+			go tokenManager.Run(ctx)
+		}
+
+		baseURL := cfg.TenantBaseURL(tenantCfg)
+		breaker, ok := breakers[baseURL]
+		if !ok {
+			breaker = cfg.NewCircuitBreaker(upstreamMetrics.CircuitObserver(baseURL))
+			breakers[baseURL] = breaker
+		}
+
+		stores := stateStores.ForTenant(tenantCfg.ID)
+		args := base
+		args.Index = tenantIndex
+		args.ProgressStore = stores.ProgressStore
+		args.SyncStateStore = stores.SyncStateStore
+		args.CheckpointStores = stores.CheckpointStoreFactory
+		args.RunStore = stores.SyncRunStore
+		args.DeadLetterStore = stores.DeadLetterStore
+		args.IndexChangeStore = stores.IndexChangeStore
+		args.ReconciliationStore = stores.ReconciliationStore
+		args.MetricsReporter = syncReporter.ForTenant(tenantCfg.ID)
+		args.SyncLeaseKey = storage.SyncLeaseKey(tenantCfg.ID)
+		args.CircuitBreaker = breaker
+		args.TenantID = tenantCfg.ID
+
+		tenants = append(tenants, &httpx.Tenant{
+			Config:         tenantCfg,
+			SyncManager:    service.NewSyncManager(args),
+			APIFactory:     newAPIFactory(baseURL, cfg.TenantAuthOptions(tenantCfg), tokenManager),
+			CircuitBreaker: breaker,
+		})
+	}
+	return tenants, nil
 }
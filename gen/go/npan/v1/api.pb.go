@@ -82,6 +82,7 @@ const (
 	SyncStatus_SYNC_STATUS_ERROR       SyncStatus = 4
 	SyncStatus_SYNC_STATUS_CANCELLED   SyncStatus = 5
 	SyncStatus_SYNC_STATUS_INTERRUPTED SyncStatus = 6
+	SyncStatus_SYNC_STATUS_PAUSED      SyncStatus = 7
 )
 
 // Enum value maps for SyncStatus.
@@ -94,6 +95,7 @@ var (
 		4: "SYNC_STATUS_ERROR",
 		5: "SYNC_STATUS_CANCELLED",
 		6: "SYNC_STATUS_INTERRUPTED",
+		7: "SYNC_STATUS_PAUSED",
 	}
 	SyncStatus_value = map[string]int32{
 		"SYNC_STATUS_UNSPECIFIED": 0,
@@ -103,6 +105,7 @@ var (
 		"SYNC_STATUS_ERROR":       4,
 		"SYNC_STATUS_CANCELLED":   5,
 		"SYNC_STATUS_INTERRUPTED": 6,
+		"SYNC_STATUS_PAUSED":      7,
 	}
 )
 
@@ -292,6 +295,162 @@ func (ReadyStatus) EnumDescriptor() ([]byte, []int) {
 	return file_npan_v1_api_proto_rawDescGZIP(), []int{4}
 }
 
+type IndexRebuildStatus int32
+
+const (
+	IndexRebuildStatus_INDEX_REBUILD_STATUS_UNSPECIFIED IndexRebuildStatus = 0
+	IndexRebuildStatus_INDEX_REBUILD_STATUS_BUILDING    IndexRebuildStatus = 1
+	IndexRebuildStatus_INDEX_REBUILD_STATUS_SWAPPED     IndexRebuildStatus = 2
+	IndexRebuildStatus_INDEX_REBUILD_STATUS_ROLLED_BACK IndexRebuildStatus = 3
+)
+
+// Enum value maps for IndexRebuildStatus.
+var (
+	IndexRebuildStatus_name = map[int32]string{
+		0: "INDEX_REBUILD_STATUS_UNSPECIFIED",
+		1: "INDEX_REBUILD_STATUS_BUILDING",
+		2: "INDEX_REBUILD_STATUS_SWAPPED",
+		3: "INDEX_REBUILD_STATUS_ROLLED_BACK",
+	}
+	IndexRebuildStatus_value = map[string]int32{
+		"INDEX_REBUILD_STATUS_UNSPECIFIED": 0,
+		"INDEX_REBUILD_STATUS_BUILDING":    1,
+		"INDEX_REBUILD_STATUS_SWAPPED":     2,
+		"INDEX_REBUILD_STATUS_ROLLED_BACK": 3,
+	}
+)
+
+func (x IndexRebuildStatus) Enum() *IndexRebuildStatus {
+	p := new(IndexRebuildStatus)
+	*p = x
+	return p
+}
+
+func (x IndexRebuildStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IndexRebuildStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_npan_v1_api_proto_enumTypes[5].Descriptor()
+}
+
+func (IndexRebuildStatus) Type() protoreflect.EnumType {
+	return &file_npan_v1_api_proto_enumTypes[5]
+}
+
+func (x IndexRebuildStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IndexRebuildStatus.Descriptor instead.
+func (IndexRebuildStatus) EnumDescriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{5}
+}
+
+type FolderResyncMode int32
+
+const (
+	FolderResyncMode_FOLDER_RESYNC_MODE_UNSPECIFIED FolderResyncMode = 0
+	FolderResyncMode_FOLDER_RESYNC_MODE_MERGE       FolderResyncMode = 1
+	FolderResyncMode_FOLDER_RESYNC_MODE_REBUILD     FolderResyncMode = 2
+)
+
+// Enum value maps for FolderResyncMode.
+var (
+	FolderResyncMode_name = map[int32]string{
+		0: "FOLDER_RESYNC_MODE_UNSPECIFIED",
+		1: "FOLDER_RESYNC_MODE_MERGE",
+		2: "FOLDER_RESYNC_MODE_REBUILD",
+	}
+	FolderResyncMode_value = map[string]int32{
+		"FOLDER_RESYNC_MODE_UNSPECIFIED": 0,
+		"FOLDER_RESYNC_MODE_MERGE":       1,
+		"FOLDER_RESYNC_MODE_REBUILD":     2,
+	}
+)
+
+func (x FolderResyncMode) Enum() *FolderResyncMode {
+	p := new(FolderResyncMode)
+	*p = x
+	return p
+}
+
+func (x FolderResyncMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FolderResyncMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_npan_v1_api_proto_enumTypes[6].Descriptor()
+}
+
+func (FolderResyncMode) Type() protoreflect.EnumType {
+	return &file_npan_v1_api_proto_enumTypes[6]
+}
+
+func (x FolderResyncMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FolderResyncMode.Descriptor instead.
+func (FolderResyncMode) EnumDescriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{6}
+}
+
+type IndexChangeOp int32
+
+const (
+	IndexChangeOp_INDEX_CHANGE_OP_UNSPECIFIED IndexChangeOp = 0
+	IndexChangeOp_INDEX_CHANGE_OP_UPSERT      IndexChangeOp = 1
+	IndexChangeOp_INDEX_CHANGE_OP_DELETE      IndexChangeOp = 2
+	IndexChangeOp_INDEX_CHANGE_OP_SWEEP       IndexChangeOp = 3
+	IndexChangeOp_INDEX_CHANGE_OP_RESET       IndexChangeOp = 4
+)
+
+// Enum value maps for IndexChangeOp.
+var (
+	IndexChangeOp_name = map[int32]string{
+		0: "INDEX_CHANGE_OP_UNSPECIFIED",
+		1: "INDEX_CHANGE_OP_UPSERT",
+		2: "INDEX_CHANGE_OP_DELETE",
+		3: "INDEX_CHANGE_OP_SWEEP",
+		4: "INDEX_CHANGE_OP_RESET",
+	}
+	IndexChangeOp_value = map[string]int32{
+		"INDEX_CHANGE_OP_UNSPECIFIED": 0,
+		"INDEX_CHANGE_OP_UPSERT":      1,
+		"INDEX_CHANGE_OP_DELETE":      2,
+		"INDEX_CHANGE_OP_SWEEP":       3,
+		"INDEX_CHANGE_OP_RESET":       4,
+	}
+)
+
+func (x IndexChangeOp) Enum() *IndexChangeOp {
+	p := new(IndexChangeOp)
+	*p = x
+	return p
+}
+
+func (x IndexChangeOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IndexChangeOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_npan_v1_api_proto_enumTypes[7].Descriptor()
+}
+
+func (IndexChangeOp) Type() protoreflect.EnumType {
+	return &file_npan_v1_api_proto_enumTypes[7]
+}
+
+func (x IndexChangeOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IndexChangeOp.Descriptor instead.
+func (IndexChangeOp) EnumDescriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{7}
+}
+
 type IndexDocument struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	DocId           string                 `protobuf:"bytes,1,opt,name=doc_id,json=docId,proto3" json:"doc_id,omitempty"`
@@ -307,6 +466,8 @@ type IndexDocument struct {
 	InTrash         bool                   `protobuf:"varint,11,opt,name=in_trash,json=inTrash,proto3" json:"in_trash,omitempty"`
 	IsDeleted       bool                   `protobuf:"varint,12,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
 	HighlightedName *string                `protobuf:"bytes,13,opt,name=highlighted_name,json=highlightedName,proto3,oneof" json:"highlighted_name,omitempty"`
+	AncestorIds     []int64                `protobuf:"varint,14,rep,packed,name=ancestor_ids,json=ancestorIds,proto3" json:"ancestor_ids,omitempty"`
+	TenantId        *string                `protobuf:"bytes,15,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -432,6 +593,20 @@ func (x *IndexDocument) GetHighlightedName() string {
 	return ""
 }
 
+func (x *IndexDocument) GetAncestorIds() []int64 {
+	if x != nil {
+		return x.AncestorIds
+	}
+	return nil
+}
+
+func (x *IndexDocument) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
 type QueryResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*IndexDocument       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -613,6 +788,7 @@ type RootSyncProgress struct {
 	CurrentPageCount   *int64                 `protobuf:"varint,9,opt,name=current_page_count,json=currentPageCount,proto3,oneof" json:"current_page_count,omitempty"`
 	QueueLength        *int64                 `protobuf:"varint,10,opt,name=queue_length,json=queueLength,proto3,oneof" json:"queue_length,omitempty"`
 	Error              *string                `protobuf:"bytes,11,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	StaleRemoved       int64                  `protobuf:"varint,12,opt,name=stale_removed,json=staleRemoved,proto3" json:"stale_removed,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -724,17 +900,34 @@ func (x *RootSyncProgress) GetError() string {
 	return ""
 }
 
+func (x *RootSyncProgress) GetStaleRemoved() int64 {
+	if x != nil {
+		return x.StaleRemoved
+	}
+	return 0
+}
+
 type IncrementalSyncStats struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ChangesFetched int64                  `protobuf:"varint,1,opt,name=changes_fetched,json=changesFetched,proto3" json:"changes_fetched,omitempty"`
-	Upserted       int64                  `protobuf:"varint,2,opt,name=upserted,proto3" json:"upserted,omitempty"`
-	Deleted        int64                  `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	SkippedUpserts int64                  `protobuf:"varint,4,opt,name=skipped_upserts,json=skippedUpserts,proto3" json:"skipped_upserts,omitempty"`
-	SkippedDeletes int64                  `protobuf:"varint,5,opt,name=skipped_deletes,json=skippedDeletes,proto3" json:"skipped_deletes,omitempty"`
-	CursorBefore   int64                  `protobuf:"varint,6,opt,name=cursor_before,json=cursorBefore,proto3" json:"cursor_before,omitempty"`
-	CursorAfter    int64                  `protobuf:"varint,7,opt,name=cursor_after,json=cursorAfter,proto3" json:"cursor_after,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ChangesFetched      int64                  `protobuf:"varint,1,opt,name=changes_fetched,json=changesFetched,proto3" json:"changes_fetched,omitempty"`
+	Upserted            int64                  `protobuf:"varint,2,opt,name=upserted,proto3" json:"upserted,omitempty"`
+	Deleted             int64                  `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	SkippedUpserts      int64                  `protobuf:"varint,4,opt,name=skipped_upserts,json=skippedUpserts,proto3" json:"skipped_upserts,omitempty"`
+	SkippedDeletes      int64                  `protobuf:"varint,5,opt,name=skipped_deletes,json=skippedDeletes,proto3" json:"skipped_deletes,omitempty"`
+	CursorBefore        int64                  `protobuf:"varint,6,opt,name=cursor_before,json=cursorBefore,proto3" json:"cursor_before,omitempty"`
+	CursorAfter         int64                  `protobuf:"varint,7,opt,name=cursor_after,json=cursorAfter,proto3" json:"cursor_after,omitempty"`
+	FoldersMoved        int64                  `protobuf:"varint,8,opt,name=folders_moved,json=foldersMoved,proto3" json:"folders_moved,omitempty"`
+	PathsRewritten      int64                  `protobuf:"varint,9,opt,name=paths_rewritten,json=pathsRewritten,proto3" json:"paths_rewritten,omitempty"`
+	PathRewritesPending int64                  `protobuf:"varint,10,opt,name=path_rewrites_pending,json=pathRewritesPending,proto3" json:"path_rewrites_pending,omitempty"`
+	CascadeDeleted      int64                  `protobuf:"varint,11,opt,name=cascade_deleted,json=cascadeDeleted,proto3" json:"cascade_deleted,omitempty"`
+	FoldersRestored     int64                  `protobuf:"varint,12,opt,name=folders_restored,json=foldersRestored,proto3" json:"folders_restored,omitempty"`
+	RestoredDocs        int64                  `protobuf:"varint,13,opt,name=restored_docs,json=restoredDocs,proto3" json:"restored_docs,omitempty"`
+	RecrawlsPending     int64                  `protobuf:"varint,14,opt,name=recrawls_pending,json=recrawlsPending,proto3" json:"recrawls_pending,omitempty"`
+	WindowsFetched      int64                  `protobuf:"varint,15,opt,name=windows_fetched,json=windowsFetched,proto3" json:"windows_fetched,omitempty"`
+	WindowsSplit        int64                  `protobuf:"varint,16,opt,name=windows_split,json=windowsSplit,proto3" json:"windows_split,omitempty"`
+	WindowsPending      int64                  `protobuf:"varint,17,opt,name=windows_pending,json=windowsPending,proto3" json:"windows_pending,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *IncrementalSyncStats) Reset() {
@@ -816,6 +1009,76 @@ func (x *IncrementalSyncStats) GetCursorAfter() int64 {
 	return 0
 }
 
+func (x *IncrementalSyncStats) GetFoldersMoved() int64 {
+	if x != nil {
+		return x.FoldersMoved
+	}
+	return 0
+}
+
+func (x *IncrementalSyncStats) GetPathsRewritten() int64 {
+	if x != nil {
+		return x.PathsRewritten
+	}
+	return 0
+}
+
+func (x *IncrementalSyncStats) GetPathRewritesPending() int64 {
+	if x != nil {
+		return x.PathRewritesPending
+	}
+	return 0
+}
+
+func (x *IncrementalSyncStats) GetCascadeDeleted() int64 {
+	if x != nil {
+		return x.CascadeDeleted
+	}
+	return 0
+}
+
+func (x *IncrementalSyncStats) GetFoldersRestored() int64 {
+	if x != nil {
+		return x.FoldersRestored
+	}
+	return 0
+}
+
+func (x *IncrementalSyncStats) GetRestoredDocs() int64 {
+	if x != nil {
+		return x.RestoredDocs
+	}
+	return 0
+}
+
+func (x *IncrementalSyncStats) GetRecrawlsPending() int64 {
+	if x != nil {
+		return x.RecrawlsPending
+	}
+	return 0
+}
+
+func (x *IncrementalSyncStats) GetWindowsFetched() int64 {
+	if x != nil {
+		return x.WindowsFetched
+	}
+	return 0
+}
+
+func (x *IncrementalSyncStats) GetWindowsSplit() int64 {
+	if x != nil {
+		return x.WindowsSplit
+	}
+	return 0
+}
+
+func (x *IncrementalSyncStats) GetWindowsPending() int64 {
+	if x != nil {
+		return x.WindowsPending
+	}
+	return 0
+}
+
 type SyncVerification struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	MeiliDocCount      int64                  `protobuf:"varint,1,opt,name=meili_doc_count,json=meiliDocCount,proto3" json:"meili_doc_count,omitempty"`
@@ -824,6 +1087,8 @@ type SyncVerification struct {
 	SkippedCount       int64                  `protobuf:"varint,4,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
 	Verified           bool                   `protobuf:"varint,5,opt,name=verified,proto3" json:"verified,omitempty"`
 	Warnings           []string               `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	StaleRemoved       int64                  `protobuf:"varint,7,opt,name=stale_removed,json=staleRemoved,proto3" json:"stale_removed,omitempty"`
+	DeadLetterCount    int64                  `protobuf:"varint,8,opt,name=dead_letter_count,json=deadLetterCount,proto3" json:"dead_letter_count,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -900,6 +1165,20 @@ func (x *SyncVerification) GetWarnings() []string {
 	return nil
 }
 
+func (x *SyncVerification) GetStaleRemoved() int64 {
+	if x != nil {
+		return x.StaleRemoved
+	}
+	return 0
+}
+
+func (x *SyncVerification) GetDeadLetterCount() int64 {
+	if x != nil {
+		return x.DeadLetterCount
+	}
+	return 0
+}
+
 type SyncProgressState struct {
 	state               protoimpl.MessageState       `protogen:"open.v1"`
 	Status              SyncStatus                   `protobuf:"varint,1,opt,name=status,proto3,enum=npan.v1.SyncStatus" json:"status,omitempty"`
@@ -920,6 +1199,11 @@ type SyncProgressState struct {
 	Verification        *SyncVerification            `protobuf:"bytes,16,opt,name=verification,proto3,oneof" json:"verification,omitempty"`
 	StartedAtTs         *timestamppb.Timestamp       `protobuf:"bytes,17,opt,name=started_at_ts,json=startedAtTs,proto3" json:"started_at_ts,omitempty"`
 	UpdatedAtTs         *timestamppb.Timestamp       `protobuf:"bytes,18,opt,name=updated_at_ts,json=updatedAtTs,proto3" json:"updated_at_ts,omitempty"`
+	StaleRemoved        int64                        `protobuf:"varint,19,opt,name=stale_removed,json=staleRemoved,proto3" json:"stale_removed,omitempty"`
+	Rebuild             *IndexRebuildState           `protobuf:"bytes,20,opt,name=rebuild,proto3,oneof" json:"rebuild,omitempty"`
+	DryRun              *DryRunReport                `protobuf:"bytes,21,opt,name=dry_run,json=dryRun,proto3,oneof" json:"dry_run,omitempty"`
+	RateControl         *RateControlState            `protobuf:"bytes,22,opt,name=rate_control,json=rateControl,proto3,oneof" json:"rate_control,omitempty"`
+	PausedAt            *int64                       `protobuf:"varint,23,opt,name=paused_at,json=pausedAt,proto3,oneof" json:"paused_at,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -1080,29 +1364,68 @@ func (x *SyncProgressState) GetUpdatedAtTs() *timestamppb.Timestamp {
 	return nil
 }
 
-type ErrorResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          ErrorCode              `protobuf:"varint,1,opt,name=code,proto3,enum=npan.v1.ErrorCode" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	RequestId     *string                `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SyncProgressState) GetStaleRemoved() int64 {
+	if x != nil {
+		return x.StaleRemoved
+	}
+	return 0
 }
 
-func (x *ErrorResponse) Reset() {
-	*x = ErrorResponse{}
+func (x *SyncProgressState) GetRebuild() *IndexRebuildState {
+	if x != nil {
+		return x.Rebuild
+	}
+	return nil
+}
+
+func (x *SyncProgressState) GetDryRun() *DryRunReport {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+func (x *SyncProgressState) GetRateControl() *RateControlState {
+	if x != nil {
+		return x.RateControl
+	}
+	return nil
+}
+
+func (x *SyncProgressState) GetPausedAt() int64 {
+	if x != nil && x.PausedAt != nil {
+		return *x.PausedAt
+	}
+	return 0
+}
+
+type RateControlState struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BaseRate           float64                `protobuf:"fixed64,1,opt,name=base_rate,json=baseRate,proto3" json:"base_rate,omitempty"`
+	EffectiveRate      float64                `protobuf:"fixed64,2,opt,name=effective_rate,json=effectiveRate,proto3" json:"effective_rate,omitempty"`
+	Backoff            bool                   `protobuf:"varint,3,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	PausedUntil        int64                  `protobuf:"varint,4,opt,name=paused_until,json=pausedUntil,proto3" json:"paused_until,omitempty"`
+	ThrottleEvents     int64                  `protobuf:"varint,5,opt,name=throttle_events,json=throttleEvents,proto3" json:"throttle_events,omitempty"`
+	LastThrottleAt     int64                  `protobuf:"varint,6,opt,name=last_throttle_at,json=lastThrottleAt,proto3" json:"last_throttle_at,omitempty"`
+	LastThrottleStatus int32                  `protobuf:"varint,7,opt,name=last_throttle_status,json=lastThrottleStatus,proto3" json:"last_throttle_status,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RateControlState) Reset() {
+	*x = RateControlState{}
 	mi := &file_npan_v1_api_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ErrorResponse) String() string {
+func (x *RateControlState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ErrorResponse) ProtoMessage() {}
+func (*RateControlState) ProtoMessage() {}
 
-func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
+func (x *RateControlState) ProtoReflect() protoreflect.Message {
 	mi := &file_npan_v1_api_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1114,54 +1437,85 @@ func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
-func (*ErrorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RateControlState.ProtoReflect.Descriptor instead.
+func (*RateControlState) Descriptor() ([]byte, []int) {
 	return file_npan_v1_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ErrorResponse) GetCode() ErrorCode {
+func (x *RateControlState) GetBaseRate() float64 {
 	if x != nil {
-		return x.Code
+		return x.BaseRate
 	}
-	return ErrorCode_ERROR_CODE_UNSPECIFIED
+	return 0
 }
 
-func (x *ErrorResponse) GetMessage() string {
+func (x *RateControlState) GetEffectiveRate() float64 {
 	if x != nil {
-		return x.Message
+		return x.EffectiveRate
 	}
-	return ""
+	return 0
 }
 
-func (x *ErrorResponse) GetRequestId() string {
-	if x != nil && x.RequestId != nil {
-		return *x.RequestId
+func (x *RateControlState) GetBackoff() bool {
+	if x != nil {
+		return x.Backoff
 	}
-	return ""
+	return false
 }
 
-type DownloadURLResult struct {
+func (x *RateControlState) GetPausedUntil() int64 {
+	if x != nil {
+		return x.PausedUntil
+	}
+	return 0
+}
+
+func (x *RateControlState) GetThrottleEvents() int64 {
+	if x != nil {
+		return x.ThrottleEvents
+	}
+	return 0
+}
+
+func (x *RateControlState) GetLastThrottleAt() int64 {
+	if x != nil {
+		return x.LastThrottleAt
+	}
+	return 0
+}
+
+func (x *RateControlState) GetLastThrottleStatus() int32 {
+	if x != nil {
+		return x.LastThrottleStatus
+	}
+	return 0
+}
+
+type DryRunSample struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileId        int64                  `protobuf:"varint,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
-	DownloadUrl   string                 `protobuf:"bytes,2,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	DocId         string                 `protobuf:"bytes,1,opt,name=doc_id,json=docId,proto3" json:"doc_id,omitempty"`
+	SourceId      int64                  `protobuf:"varint,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Type          ItemType               `protobuf:"varint,3,opt,name=type,proto3,enum=npan.v1.ItemType" json:"type,omitempty"`
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	ChangedFields []string               `protobuf:"bytes,5,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadURLResult) Reset() {
-	*x = DownloadURLResult{}
+func (x *DryRunSample) Reset() {
+	*x = DryRunSample{}
 	mi := &file_npan_v1_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadURLResult) String() string {
+func (x *DryRunSample) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadURLResult) ProtoMessage() {}
+func (*DryRunSample) ProtoMessage() {}
 
-func (x *DownloadURLResult) ProtoReflect() protoreflect.Message {
+func (x *DryRunSample) ProtoReflect() protoreflect.Message {
 	mi := &file_npan_v1_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1173,48 +1527,75 @@ func (x *DownloadURLResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadURLResult.ProtoReflect.Descriptor instead.
-func (*DownloadURLResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use DryRunSample.ProtoReflect.Descriptor instead.
+func (*DryRunSample) Descriptor() ([]byte, []int) {
 	return file_npan_v1_api_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DownloadURLResult) GetFileId() int64 {
+func (x *DryRunSample) GetDocId() string {
 	if x != nil {
-		return x.FileId
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *DryRunSample) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
 	}
 	return 0
 }
 
-func (x *DownloadURLResult) GetDownloadUrl() string {
+func (x *DryRunSample) GetType() ItemType {
 	if x != nil {
-		return x.DownloadUrl
+		return x.Type
 	}
-	return ""
+	return ItemType_ITEM_TYPE_UNSPECIFIED
 }
 
-type RemoteSearchItem struct {
+func (x *DryRunSample) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DryRunSample) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+type DryRunRootDiff struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	RootFolderId  int64                  `protobuf:"varint,1,opt,name=root_folder_id,json=rootFolderId,proto3" json:"root_folder_id,omitempty"`
+	RootName      string                 `protobuf:"bytes,2,opt,name=root_name,json=rootName,proto3" json:"root_name,omitempty"`
+	Adds          int64                  `protobuf:"varint,3,opt,name=adds,proto3" json:"adds,omitempty"`
+	Updates       int64                  `protobuf:"varint,4,opt,name=updates,proto3" json:"updates,omitempty"`
+	Deletes       int64                  `protobuf:"varint,5,opt,name=deletes,proto3" json:"deletes,omitempty"`
+	Unchanged     int64                  `protobuf:"varint,6,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	SampleAdds    []*DryRunSample        `protobuf:"bytes,7,rep,name=sample_adds,json=sampleAdds,proto3" json:"sample_adds,omitempty"`
+	SampleUpdates []*DryRunSample        `protobuf:"bytes,8,rep,name=sample_updates,json=sampleUpdates,proto3" json:"sample_updates,omitempty"`
+	SampleDeletes []*DryRunSample        `protobuf:"bytes,9,rep,name=sample_deletes,json=sampleDeletes,proto3" json:"sample_deletes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoteSearchItem) Reset() {
-	*x = RemoteSearchItem{}
+func (x *DryRunRootDiff) Reset() {
+	*x = DryRunRootDiff{}
 	mi := &file_npan_v1_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoteSearchItem) String() string {
+func (x *DryRunRootDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoteSearchItem) ProtoMessage() {}
+func (*DryRunRootDiff) ProtoMessage() {}
 
-func (x *RemoteSearchItem) ProtoReflect() protoreflect.Message {
+func (x *DryRunRootDiff) ProtoReflect() protoreflect.Message {
 	mi := &file_npan_v1_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1226,58 +1607,104 @@ func (x *RemoteSearchItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoteSearchItem.ProtoReflect.Descriptor instead.
-func (*RemoteSearchItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use DryRunRootDiff.ProtoReflect.Descriptor instead.
+func (*DryRunRootDiff) Descriptor() ([]byte, []int) {
 	return file_npan_v1_api_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RemoteSearchItem) GetId() int64 {
+func (x *DryRunRootDiff) GetRootFolderId() int64 {
 	if x != nil {
-		return x.Id
+		return x.RootFolderId
 	}
 	return 0
 }
 
-func (x *RemoteSearchItem) GetName() string {
+func (x *DryRunRootDiff) GetRootName() string {
 	if x != nil {
-		return x.Name
+		return x.RootName
 	}
 	return ""
 }
 
-func (x *RemoteSearchItem) GetType() string {
+func (x *DryRunRootDiff) GetAdds() int64 {
 	if x != nil {
-		return x.Type
+		return x.Adds
 	}
-	return ""
+	return 0
 }
 
-type RemoteSearchResponse struct {
+func (x *DryRunRootDiff) GetUpdates() int64 {
+	if x != nil {
+		return x.Updates
+	}
+	return 0
+}
+
+func (x *DryRunRootDiff) GetDeletes() int64 {
+	if x != nil {
+		return x.Deletes
+	}
+	return 0
+}
+
+func (x *DryRunRootDiff) GetUnchanged() int64 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *DryRunRootDiff) GetSampleAdds() []*DryRunSample {
+	if x != nil {
+		return x.SampleAdds
+	}
+	return nil
+}
+
+func (x *DryRunRootDiff) GetSampleUpdates() []*DryRunSample {
+	if x != nil {
+		return x.SampleUpdates
+	}
+	return nil
+}
+
+func (x *DryRunRootDiff) GetSampleDeletes() []*DryRunSample {
+	if x != nil {
+		return x.SampleDeletes
+	}
+	return nil
+}
+
+type DryRunReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Files         []*RemoteSearchItem    `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
-	Folders       []*RemoteSearchItem    `protobuf:"bytes,2,rep,name=folders,proto3" json:"folders,omitempty"`
-	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	PageId        int64                  `protobuf:"varint,4,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
-	PageCapacity  int64                  `protobuf:"varint,5,opt,name=page_capacity,json=pageCapacity,proto3" json:"page_capacity,omitempty"`
-	PageCount     int64                  `protobuf:"varint,6,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	Mode          *SyncMode              `protobuf:"varint,1,opt,name=mode,proto3,enum=npan.v1.SyncMode,oneof" json:"mode,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *int64                 `protobuf:"varint,3,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`
+	Roots         []*DryRunRootDiff      `protobuf:"bytes,4,rep,name=roots,proto3" json:"roots,omitempty"`
+	Adds          int64                  `protobuf:"varint,5,opt,name=adds,proto3" json:"adds,omitempty"`
+	Updates       int64                  `protobuf:"varint,6,opt,name=updates,proto3" json:"updates,omitempty"`
+	Deletes       int64                  `protobuf:"varint,7,opt,name=deletes,proto3" json:"deletes,omitempty"`
+	Status        SyncStatus             `protobuf:"varint,8,opt,name=status,proto3,enum=npan.v1.SyncStatus" json:"status,omitempty"`
+	LastError     *string                `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	ActiveRoot    *int64                 `protobuf:"varint,10,opt,name=active_root,json=activeRoot,proto3,oneof" json:"active_root,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoteSearchResponse) Reset() {
-	*x = RemoteSearchResponse{}
+func (x *DryRunReport) Reset() {
+	*x = DryRunReport{}
 	mi := &file_npan_v1_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoteSearchResponse) String() string {
+func (x *DryRunReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoteSearchResponse) ProtoMessage() {}
+func (*DryRunReport) ProtoMessage() {}
 
-func (x *RemoteSearchResponse) ProtoReflect() protoreflect.Message {
+func (x *DryRunReport) ProtoReflect() protoreflect.Message {
 	mi := &file_npan_v1_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1289,77 +1716,108 @@ func (x *RemoteSearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoteSearchResponse.ProtoReflect.Descriptor instead.
-func (*RemoteSearchResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DryRunReport.ProtoReflect.Descriptor instead.
+func (*DryRunReport) Descriptor() ([]byte, []int) {
 	return file_npan_v1_api_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RemoteSearchResponse) GetFiles() []*RemoteSearchItem {
+func (x *DryRunReport) GetMode() SyncMode {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return SyncMode_SYNC_MODE_UNSPECIFIED
+}
+
+func (x *DryRunReport) GetStartedAt() int64 {
 	if x != nil {
-		return x.Files
+		return x.StartedAt
 	}
-	return nil
+	return 0
 }
 
-func (x *RemoteSearchResponse) GetFolders() []*RemoteSearchItem {
+func (x *DryRunReport) GetFinishedAt() int64 {
+	if x != nil && x.FinishedAt != nil {
+		return *x.FinishedAt
+	}
+	return 0
+}
+
+func (x *DryRunReport) GetRoots() []*DryRunRootDiff {
 	if x != nil {
-		return x.Folders
+		return x.Roots
 	}
 	return nil
 }
 
-func (x *RemoteSearchResponse) GetTotalCount() int64 {
+func (x *DryRunReport) GetAdds() int64 {
 	if x != nil {
-		return x.TotalCount
+		return x.Adds
 	}
 	return 0
 }
 
-func (x *RemoteSearchResponse) GetPageId() int64 {
+func (x *DryRunReport) GetUpdates() int64 {
 	if x != nil {
-		return x.PageId
+		return x.Updates
 	}
 	return 0
 }
 
-func (x *RemoteSearchResponse) GetPageCapacity() int64 {
+func (x *DryRunReport) GetDeletes() int64 {
 	if x != nil {
-		return x.PageCapacity
+		return x.Deletes
 	}
 	return 0
 }
 
-func (x *RemoteSearchResponse) GetPageCount() int64 {
+func (x *DryRunReport) GetStatus() SyncStatus {
 	if x != nil {
-		return x.PageCount
+		return x.Status
+	}
+	return SyncStatus_SYNC_STATUS_UNSPECIFIED
+}
+
+func (x *DryRunReport) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *DryRunReport) GetActiveRoot() int64 {
+	if x != nil && x.ActiveRoot != nil {
+		return *x.ActiveRoot
 	}
 	return 0
 }
 
-type InspectRootItem struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	FolderId           int64                  `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
-	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ItemCount          int64                  `protobuf:"varint,3,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
-	EstimatedTotalDocs int64                  `protobuf:"varint,4,opt,name=estimated_total_docs,json=estimatedTotalDocs,proto3" json:"estimated_total_docs,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type IndexRebuildState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        IndexRebuildStatus     `protobuf:"varint,1,opt,name=status,proto3,enum=npan.v1.IndexRebuildStatus" json:"status,omitempty"`
+	LiveIndex     string                 `protobuf:"bytes,2,opt,name=live_index,json=liveIndex,proto3" json:"live_index,omitempty"`
+	ShadowIndex   string                 `protobuf:"bytes,3,opt,name=shadow_index,json=shadowIndex,proto3" json:"shadow_index,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	SwappedAt     *int64                 `protobuf:"varint,5,opt,name=swapped_at,json=swappedAt,proto3,oneof" json:"swapped_at,omitempty"`
+	RolledBackAt  *int64                 `protobuf:"varint,6,opt,name=rolled_back_at,json=rolledBackAt,proto3,oneof" json:"rolled_back_at,omitempty"`
+	LastError     *string                `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InspectRootItem) Reset() {
-	*x = InspectRootItem{}
+func (x *IndexRebuildState) Reset() {
+	*x = IndexRebuildState{}
 	mi := &file_npan_v1_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InspectRootItem) String() string {
+func (x *IndexRebuildState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InspectRootItem) ProtoMessage() {}
+func (*IndexRebuildState) ProtoMessage() {}
 
-func (x *InspectRootItem) ProtoReflect() protoreflect.Message {
+func (x *IndexRebuildState) ProtoReflect() protoreflect.Message {
 	mi := &file_npan_v1_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1371,61 +1829,83 @@ func (x *InspectRootItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InspectRootItem.ProtoReflect.Descriptor instead.
-func (*InspectRootItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use IndexRebuildState.ProtoReflect.Descriptor instead.
+func (*IndexRebuildState) Descriptor() ([]byte, []int) {
 	return file_npan_v1_api_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *InspectRootItem) GetFolderId() int64 {
+func (x *IndexRebuildState) GetStatus() IndexRebuildStatus {
 	if x != nil {
-		return x.FolderId
+		return x.Status
 	}
-	return 0
+	return IndexRebuildStatus_INDEX_REBUILD_STATUS_UNSPECIFIED
 }
 
-func (x *InspectRootItem) GetName() string {
+func (x *IndexRebuildState) GetLiveIndex() string {
 	if x != nil {
-		return x.Name
+		return x.LiveIndex
 	}
 	return ""
 }
 
-func (x *InspectRootItem) GetItemCount() int64 {
+func (x *IndexRebuildState) GetShadowIndex() string {
 	if x != nil {
-		return x.ItemCount
+		return x.ShadowIndex
 	}
-	return 0
+	return ""
 }
 
-func (x *InspectRootItem) GetEstimatedTotalDocs() int64 {
+func (x *IndexRebuildState) GetStartedAt() int64 {
 	if x != nil {
-		return x.EstimatedTotalDocs
+		return x.StartedAt
 	}
 	return 0
 }
 
-type InspectRootError struct {
+func (x *IndexRebuildState) GetSwappedAt() int64 {
+	if x != nil && x.SwappedAt != nil {
+		return *x.SwappedAt
+	}
+	return 0
+}
+
+func (x *IndexRebuildState) GetRolledBackAt() int64 {
+	if x != nil && x.RolledBackAt != nil {
+		return *x.RolledBackAt
+	}
+	return 0
+}
+
+func (x *IndexRebuildState) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+type ErrorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FolderId      int64                  `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Code          ErrorCode              `protobuf:"varint,1,opt,name=code,proto3,enum=npan.v1.ErrorCode" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequestId     *string                `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InspectRootError) Reset() {
-	*x = InspectRootError{}
+func (x *ErrorResponse) Reset() {
+	*x = ErrorResponse{}
 	mi := &file_npan_v1_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InspectRootError) String() string {
+func (x *ErrorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InspectRootError) ProtoMessage() {}
+func (*ErrorResponse) ProtoMessage() {}
 
-func (x *InspectRootError) ProtoReflect() protoreflect.Message {
+func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_npan_v1_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1437,45 +1917,54 @@ func (x *InspectRootError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InspectRootError.ProtoReflect.Descriptor instead.
-func (*InspectRootError) Descriptor() ([]byte, []int) {
+// Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
+func (*ErrorResponse) Descriptor() ([]byte, []int) {
 	return file_npan_v1_api_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *InspectRootError) GetFolderId() int64 {
+func (x *ErrorResponse) GetCode() ErrorCode {
 	if x != nil {
-		return x.FolderId
+		return x.Code
 	}
-	return 0
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
 }
 
-func (x *InspectRootError) GetMessage() string {
+func (x *ErrorResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type HealthRequest struct {
+func (x *ErrorResponse) GetRequestId() string {
+	if x != nil && x.RequestId != nil {
+		return *x.RequestId
+	}
+	return ""
+}
+
+type DownloadURLResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        int64                  `protobuf:"varint,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	DownloadUrl   string                 `protobuf:"bytes,2,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HealthRequest) Reset() {
-	*x = HealthRequest{}
+func (x *DownloadURLResult) Reset() {
+	*x = DownloadURLResult{}
 	mi := &file_npan_v1_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthRequest) String() string {
+func (x *DownloadURLResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthRequest) ProtoMessage() {}
+func (*DownloadURLResult) ProtoMessage() {}
 
-func (x *HealthRequest) ProtoReflect() protoreflect.Message {
+func (x *DownloadURLResult) ProtoReflect() protoreflect.Message {
 	mi := &file_npan_v1_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1487,33 +1976,48 @@ func (x *HealthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
-func (*HealthRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DownloadURLResult.ProtoReflect.Descriptor instead.
+func (*DownloadURLResult) Descriptor() ([]byte, []int) {
 	return file_npan_v1_api_proto_rawDescGZIP(), []int{13}
 }
 
-type HealthResponse struct {
+func (x *DownloadURLResult) GetFileId() int64 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+func (x *DownloadURLResult) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+type RemoteSearchItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	RunningSync   bool                   `protobuf:"varint,2,opt,name=running_sync,json=runningSync,proto3" json:"running_sync,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HealthResponse) Reset() {
-	*x = HealthResponse{}
+func (x *RemoteSearchItem) Reset() {
+	*x = RemoteSearchItem{}
 	mi := &file_npan_v1_api_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthResponse) String() string {
+func (x *RemoteSearchItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthResponse) ProtoMessage() {}
+func (*RemoteSearchItem) ProtoMessage() {}
 
-func (x *HealthResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoteSearchItem) ProtoReflect() protoreflect.Message {
 	mi := &file_npan_v1_api_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1525,23 +2029,322 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
-func (*HealthResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoteSearchItem.ProtoReflect.Descriptor instead.
+func (*RemoteSearchItem) Descriptor() ([]byte, []int) {
 	return file_npan_v1_api_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *HealthResponse) GetStatus() string {
+func (x *RemoteSearchItem) GetId() int64 {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *HealthResponse) GetRunningSync() bool {
+func (x *RemoteSearchItem) GetName() string {
 	if x != nil {
-		return x.RunningSync
+		return x.Name
 	}
-	return false
+	return ""
+}
+
+func (x *RemoteSearchItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type RemoteSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*RemoteSearchItem    `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Folders       []*RemoteSearchItem    `protobuf:"bytes,2,rep,name=folders,proto3" json:"folders,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PageId        int64                  `protobuf:"varint,4,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageCapacity  int64                  `protobuf:"varint,5,opt,name=page_capacity,json=pageCapacity,proto3" json:"page_capacity,omitempty"`
+	PageCount     int64                  `protobuf:"varint,6,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteSearchResponse) Reset() {
+	*x = RemoteSearchResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteSearchResponse) ProtoMessage() {}
+
+func (x *RemoteSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteSearchResponse.ProtoReflect.Descriptor instead.
+func (*RemoteSearchResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemoteSearchResponse) GetFiles() []*RemoteSearchItem {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *RemoteSearchResponse) GetFolders() []*RemoteSearchItem {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+func (x *RemoteSearchResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *RemoteSearchResponse) GetPageId() int64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *RemoteSearchResponse) GetPageCapacity() int64 {
+	if x != nil {
+		return x.PageCapacity
+	}
+	return 0
+}
+
+func (x *RemoteSearchResponse) GetPageCount() int64 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+type InspectRootItem struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FolderId           int64                  `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ItemCount          int64                  `protobuf:"varint,3,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	EstimatedTotalDocs int64                  `protobuf:"varint,4,opt,name=estimated_total_docs,json=estimatedTotalDocs,proto3" json:"estimated_total_docs,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *InspectRootItem) Reset() {
+	*x = InspectRootItem{}
+	mi := &file_npan_v1_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectRootItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectRootItem) ProtoMessage() {}
+
+func (x *InspectRootItem) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectRootItem.ProtoReflect.Descriptor instead.
+func (*InspectRootItem) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InspectRootItem) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *InspectRootItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InspectRootItem) GetItemCount() int64 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *InspectRootItem) GetEstimatedTotalDocs() int64 {
+	if x != nil {
+		return x.EstimatedTotalDocs
+	}
+	return 0
+}
+
+type InspectRootError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      int64                  `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectRootError) Reset() {
+	*x = InspectRootError{}
+	mi := &file_npan_v1_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectRootError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectRootError) ProtoMessage() {}
+
+func (x *InspectRootError) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectRootError.ProtoReflect.Descriptor instead.
+func (*InspectRootError) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InspectRootError) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *InspectRootError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type HealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthRequest) Reset() {
+	*x = HealthRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthRequest) ProtoMessage() {}
+
+func (x *HealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
+func (*HealthRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{18}
+}
+
+type HealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RunningSync   bool                   `protobuf:"varint,2,opt,name=running_sync,json=runningSync,proto3" json:"running_sync,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthResponse) Reset() {
+	*x = HealthResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthResponse) ProtoMessage() {}
+
+func (x *HealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HealthResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetRunningSync() bool {
+	if x != nil {
+		return x.RunningSync
+	}
+	return false
 }
 
 type ReadyzRequest struct {
@@ -1550,21 +2353,3734 @@ type ReadyzRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadyzRequest) Reset() {
-	*x = ReadyzRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[15]
+func (x *ReadyzRequest) Reset() {
+	*x = ReadyzRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadyzRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyzRequest) ProtoMessage() {}
+
+func (x *ReadyzRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyzRequest.ProtoReflect.Descriptor instead.
+func (*ReadyzRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{20}
+}
+
+type ReadyzResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ReadyStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=npan.v1.ReadyStatus" json:"status,omitempty"`
+	Meili         *string                `protobuf:"bytes,2,opt,name=meili,proto3,oneof" json:"meili,omitempty"`
+	NpanApi       *string                `protobuf:"bytes,3,opt,name=npan_api,json=npanApi,proto3,oneof" json:"npan_api,omitempty"`
+	NpanToken     *string                `protobuf:"bytes,4,opt,name=npan_token,json=npanToken,proto3,oneof" json:"npan_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadyzResponse) Reset() {
+	*x = ReadyzResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadyzResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyzResponse) ProtoMessage() {}
+
+func (x *ReadyzResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyzResponse.ProtoReflect.Descriptor instead.
+func (*ReadyzResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReadyzResponse) GetStatus() ReadyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReadyStatus_READY_STATUS_UNSPECIFIED
+}
+
+func (x *ReadyzResponse) GetMeili() string {
+	if x != nil && x.Meili != nil {
+		return *x.Meili
+	}
+	return ""
+}
+
+func (x *ReadyzResponse) GetNpanApi() string {
+	if x != nil && x.NpanApi != nil {
+		return *x.NpanApi
+	}
+	return ""
+}
+
+func (x *ReadyzResponse) GetNpanToken() string {
+	if x != nil && x.NpanToken != nil {
+		return *x.NpanToken
+	}
+	return ""
+}
+
+type GetSearchConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchConfigRequest) Reset() {
+	*x = GetSearchConfigRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchConfigRequest) ProtoMessage() {}
+
+func (x *GetSearchConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchConfigRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetSearchConfigRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type GetSearchConfigResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Host                 string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	IndexName            string                 `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	SearchApiKey         string                 `protobuf:"bytes,3,opt,name=search_api_key,json=searchApiKey,proto3" json:"search_api_key,omitempty"`
+	InstantsearchEnabled bool                   `protobuf:"varint,4,opt,name=instantsearch_enabled,json=instantsearchEnabled,proto3" json:"instantsearch_enabled,omitempty"`
+	Provider             string                 `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
+	TenantId             string                 `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetSearchConfigResponse) Reset() {
+	*x = GetSearchConfigResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchConfigResponse) ProtoMessage() {}
+
+func (x *GetSearchConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchConfigResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetSearchConfigResponse) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GetSearchConfigResponse) GetIndexName() string {
+	if x != nil {
+		return x.IndexName
+	}
+	return ""
+}
+
+func (x *GetSearchConfigResponse) GetSearchApiKey() string {
+	if x != nil {
+		return x.SearchApiKey
+	}
+	return ""
+}
+
+func (x *GetSearchConfigResponse) GetInstantsearchEnabled() bool {
+	if x != nil {
+		return x.InstantsearchEnabled
+	}
+	return false
+}
+
+func (x *GetSearchConfigResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GetSearchConfigResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type AppSearchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *int64                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize       *int64                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	WithinFolderId *int64                 `protobuf:"varint,4,opt,name=within_folder_id,json=withinFolderId,proto3,oneof" json:"within_folder_id,omitempty"`
+	TenantId       *string                `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AppSearchRequest) Reset() {
+	*x = AppSearchRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppSearchRequest) ProtoMessage() {}
+
+func (x *AppSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppSearchRequest.ProtoReflect.Descriptor instead.
+func (*AppSearchRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AppSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *AppSearchRequest) GetPage() int64 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *AppSearchRequest) GetPageSize() int64 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *AppSearchRequest) GetWithinFolderId() int64 {
+	if x != nil && x.WithinFolderId != nil {
+		return *x.WithinFolderId
+	}
+	return 0
+}
+
+func (x *AppSearchRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type AppSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *QueryResult           `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppSearchResponse) Reset() {
+	*x = AppSearchResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppSearchResponse) ProtoMessage() {}
+
+func (x *AppSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppSearchResponse.ProtoReflect.Descriptor instead.
+func (*AppSearchResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AppSearchResponse) GetResult() *QueryResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type AppDownloadURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        int64                  `protobuf:"varint,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	ValidPeriod   *int64                 `protobuf:"varint,2,opt,name=valid_period,json=validPeriod,proto3,oneof" json:"valid_period,omitempty"`
+	TenantId      *string                `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppDownloadURLRequest) Reset() {
+	*x = AppDownloadURLRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppDownloadURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDownloadURLRequest) ProtoMessage() {}
+
+func (x *AppDownloadURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDownloadURLRequest.ProtoReflect.Descriptor instead.
+func (*AppDownloadURLRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AppDownloadURLRequest) GetFileId() int64 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+func (x *AppDownloadURLRequest) GetValidPeriod() int64 {
+	if x != nil && x.ValidPeriod != nil {
+		return *x.ValidPeriod
+	}
+	return 0
+}
+
+func (x *AppDownloadURLRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type AppDownloadURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *DownloadURLResult     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppDownloadURLResponse) Reset() {
+	*x = AppDownloadURLResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppDownloadURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDownloadURLResponse) ProtoMessage() {}
+
+func (x *AppDownloadURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDownloadURLResponse.ProtoReflect.Descriptor instead.
+func (*AppDownloadURLResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AppDownloadURLResponse) GetResult() *DownloadURLResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CreateTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *string                `protobuf:"bytes,1,opt,name=token,proto3,oneof" json:"token,omitempty"`
+	ClientId      *string                `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ClientSecret  *string                `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3,oneof" json:"client_secret,omitempty"`
+	SubId         *int64                 `protobuf:"varint,4,opt,name=sub_id,json=subId,proto3,oneof" json:"sub_id,omitempty"`
+	SubType       *string                `protobuf:"bytes,5,opt,name=sub_type,json=subType,proto3,oneof" json:"sub_type,omitempty"`
+	OauthHost     *string                `protobuf:"bytes,6,opt,name=oauth_host,json=oauthHost,proto3,oneof" json:"oauth_host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTokenRequest) Reset() {
+	*x = CreateTokenRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenRequest) ProtoMessage() {}
+
+func (x *CreateTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateTokenRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateTokenRequest) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *CreateTokenRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *CreateTokenRequest) GetClientSecret() string {
+	if x != nil && x.ClientSecret != nil {
+		return *x.ClientSecret
+	}
+	return ""
+}
+
+func (x *CreateTokenRequest) GetSubId() int64 {
+	if x != nil && x.SubId != nil {
+		return *x.SubId
+	}
+	return 0
+}
+
+func (x *CreateTokenRequest) GetSubType() string {
+	if x != nil && x.SubType != nil {
+		return *x.SubType
+	}
+	return ""
+}
+
+func (x *CreateTokenRequest) GetOauthHost() string {
+	if x != nil && x.OauthHost != nil {
+		return *x.OauthHost
+	}
+	return ""
+}
+
+type CreateTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTokenResponse) Reset() {
+	*x = CreateTokenResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenResponse) ProtoMessage() {}
+
+func (x *CreateTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateTokenResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RemoteSearchRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Query            string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Type             *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	PageId           *int64                 `protobuf:"varint,3,opt,name=page_id,json=pageId,proto3,oneof" json:"page_id,omitempty"`
+	QueryFilter      *string                `protobuf:"bytes,4,opt,name=query_filter,json=queryFilter,proto3,oneof" json:"query_filter,omitempty"`
+	SearchInFolder   *int64                 `protobuf:"varint,5,opt,name=search_in_folder,json=searchInFolder,proto3,oneof" json:"search_in_folder,omitempty"`
+	UpdatedTimeRange *string                `protobuf:"bytes,6,opt,name=updated_time_range,json=updatedTimeRange,proto3,oneof" json:"updated_time_range,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RemoteSearchRequest) Reset() {
+	*x = RemoteSearchRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteSearchRequest) ProtoMessage() {}
+
+func (x *RemoteSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteSearchRequest.ProtoReflect.Descriptor instead.
+func (*RemoteSearchRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RemoteSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *RemoteSearchRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *RemoteSearchRequest) GetPageId() int64 {
+	if x != nil && x.PageId != nil {
+		return *x.PageId
+	}
+	return 0
+}
+
+func (x *RemoteSearchRequest) GetQueryFilter() string {
+	if x != nil && x.QueryFilter != nil {
+		return *x.QueryFilter
+	}
+	return ""
+}
+
+func (x *RemoteSearchRequest) GetSearchInFolder() int64 {
+	if x != nil && x.SearchInFolder != nil {
+		return *x.SearchInFolder
+	}
+	return 0
+}
+
+func (x *RemoteSearchRequest) GetUpdatedTimeRange() string {
+	if x != nil && x.UpdatedTimeRange != nil {
+		return *x.UpdatedTimeRange
+	}
+	return ""
+}
+
+type LocalSearchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *int64                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize       *int64                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Type           *string                `protobuf:"bytes,4,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	ParentId       *int64                 `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	UpdatedAfter   *int64                 `protobuf:"varint,6,opt,name=updated_after,json=updatedAfter,proto3,oneof" json:"updated_after,omitempty"`
+	UpdatedBefore  *int64                 `protobuf:"varint,7,opt,name=updated_before,json=updatedBefore,proto3,oneof" json:"updated_before,omitempty"`
+	IncludeDeleted *bool                  `protobuf:"varint,8,opt,name=include_deleted,json=includeDeleted,proto3,oneof" json:"include_deleted,omitempty"`
+	WithinFolderId *int64                 `protobuf:"varint,9,opt,name=within_folder_id,json=withinFolderId,proto3,oneof" json:"within_folder_id,omitempty"`
+	TenantId       *string                `protobuf:"bytes,10,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LocalSearchRequest) Reset() {
+	*x = LocalSearchRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalSearchRequest) ProtoMessage() {}
+
+func (x *LocalSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalSearchRequest.ProtoReflect.Descriptor instead.
+func (*LocalSearchRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LocalSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *LocalSearchRequest) GetPage() int64 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *LocalSearchRequest) GetPageSize() int64 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *LocalSearchRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *LocalSearchRequest) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *LocalSearchRequest) GetUpdatedAfter() int64 {
+	if x != nil && x.UpdatedAfter != nil {
+		return *x.UpdatedAfter
+	}
+	return 0
+}
+
+func (x *LocalSearchRequest) GetUpdatedBefore() int64 {
+	if x != nil && x.UpdatedBefore != nil {
+		return *x.UpdatedBefore
+	}
+	return 0
+}
+
+func (x *LocalSearchRequest) GetIncludeDeleted() bool {
+	if x != nil && x.IncludeDeleted != nil {
+		return *x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *LocalSearchRequest) GetWithinFolderId() int64 {
+	if x != nil && x.WithinFolderId != nil {
+		return *x.WithinFolderId
+	}
+	return 0
+}
+
+func (x *LocalSearchRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type LocalSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *QueryResult           `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalSearchResponse) Reset() {
+	*x = LocalSearchResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalSearchResponse) ProtoMessage() {}
+
+func (x *LocalSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalSearchResponse.ProtoReflect.Descriptor instead.
+func (*LocalSearchResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LocalSearchResponse) GetResult() *QueryResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DownloadURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        int64                  `protobuf:"varint,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	ValidPeriod   *int64                 `protobuf:"varint,2,opt,name=valid_period,json=validPeriod,proto3,oneof" json:"valid_period,omitempty"`
+	TenantId      *string                `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadURLRequest) Reset() {
+	*x = DownloadURLRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadURLRequest) ProtoMessage() {}
+
+func (x *DownloadURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadURLRequest.ProtoReflect.Descriptor instead.
+func (*DownloadURLRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DownloadURLRequest) GetFileId() int64 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+func (x *DownloadURLRequest) GetValidPeriod() int64 {
+	if x != nil && x.ValidPeriod != nil {
+		return *x.ValidPeriod
+	}
+	return 0
+}
+
+func (x *DownloadURLRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type DownloadURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *DownloadURLResult     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadURLResponse) Reset() {
+	*x = DownloadURLResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadURLResponse) ProtoMessage() {}
+
+func (x *DownloadURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadURLResponse.ProtoReflect.Descriptor instead.
+func (*DownloadURLResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DownloadURLResponse) GetResult() *DownloadURLResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type StartSyncRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Mode                *SyncMode              `protobuf:"varint,1,opt,name=mode,proto3,enum=npan.v1.SyncMode,oneof" json:"mode,omitempty"`
+	RootFolderIds       []int64                `protobuf:"varint,2,rep,packed,name=root_folder_ids,json=rootFolderIds,proto3" json:"root_folder_ids,omitempty"`
+	IncludeDepartments  *bool                  `protobuf:"varint,3,opt,name=include_departments,json=includeDepartments,proto3,oneof" json:"include_departments,omitempty"`
+	PreserveRootCatalog *bool                  `protobuf:"varint,4,opt,name=preserve_root_catalog,json=preserveRootCatalog,proto3,oneof" json:"preserve_root_catalog,omitempty"`
+	DepartmentIds       []int64                `protobuf:"varint,5,rep,packed,name=department_ids,json=departmentIds,proto3" json:"department_ids,omitempty"`
+	ResumeProgress      *bool                  `protobuf:"varint,6,opt,name=resume_progress,json=resumeProgress,proto3,oneof" json:"resume_progress,omitempty"`
+	ForceRebuild        *bool                  `protobuf:"varint,7,opt,name=force_rebuild,json=forceRebuild,proto3,oneof" json:"force_rebuild,omitempty"`
+	RootWorkers         *int64                 `protobuf:"varint,8,opt,name=root_workers,json=rootWorkers,proto3,oneof" json:"root_workers,omitempty"`
+	ProgressEvery       *int64                 `protobuf:"varint,9,opt,name=progress_every,json=progressEvery,proto3,oneof" json:"progress_every,omitempty"`
+	CheckpointTemplate  *string                `protobuf:"bytes,10,opt,name=checkpoint_template,json=checkpointTemplate,proto3,oneof" json:"checkpoint_template,omitempty"`
+	WindowOverlapMs     *int64                 `protobuf:"varint,11,opt,name=window_overlap_ms,json=windowOverlapMs,proto3,oneof" json:"window_overlap_ms,omitempty"`
+	IncrementalQuery    *string                `protobuf:"bytes,12,opt,name=incremental_query,json=incrementalQuery,proto3,oneof" json:"incremental_query,omitempty"`
+	FolderWorkers       *int64                 `protobuf:"varint,13,opt,name=folder_workers,json=folderWorkers,proto3,oneof" json:"folder_workers,omitempty"`
+	ShadowRebuild       *bool                  `protobuf:"varint,14,opt,name=shadow_rebuild,json=shadowRebuild,proto3,oneof" json:"shadow_rebuild,omitempty"`
+	DryRun              *bool                  `protobuf:"varint,15,opt,name=dry_run,json=dryRun,proto3,oneof" json:"dry_run,omitempty"`
+	TenantId            *string                `protobuf:"bytes,16,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *StartSyncRequest) Reset() {
+	*x = StartSyncRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSyncRequest) ProtoMessage() {}
+
+func (x *StartSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSyncRequest.ProtoReflect.Descriptor instead.
+func (*StartSyncRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StartSyncRequest) GetMode() SyncMode {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return SyncMode_SYNC_MODE_UNSPECIFIED
+}
+
+func (x *StartSyncRequest) GetRootFolderIds() []int64 {
+	if x != nil {
+		return x.RootFolderIds
+	}
+	return nil
+}
+
+func (x *StartSyncRequest) GetIncludeDepartments() bool {
+	if x != nil && x.IncludeDepartments != nil {
+		return *x.IncludeDepartments
+	}
+	return false
+}
+
+func (x *StartSyncRequest) GetPreserveRootCatalog() bool {
+	if x != nil && x.PreserveRootCatalog != nil {
+		return *x.PreserveRootCatalog
+	}
+	return false
+}
+
+func (x *StartSyncRequest) GetDepartmentIds() []int64 {
+	if x != nil {
+		return x.DepartmentIds
+	}
+	return nil
+}
+
+func (x *StartSyncRequest) GetResumeProgress() bool {
+	if x != nil && x.ResumeProgress != nil {
+		return *x.ResumeProgress
+	}
+	return false
+}
+
+func (x *StartSyncRequest) GetForceRebuild() bool {
+	if x != nil && x.ForceRebuild != nil {
+		return *x.ForceRebuild
+	}
+	return false
+}
+
+func (x *StartSyncRequest) GetRootWorkers() int64 {
+	if x != nil && x.RootWorkers != nil {
+		return *x.RootWorkers
+	}
+	return 0
+}
+
+func (x *StartSyncRequest) GetProgressEvery() int64 {
+	if x != nil && x.ProgressEvery != nil {
+		return *x.ProgressEvery
+	}
+	return 0
+}
+
+func (x *StartSyncRequest) GetCheckpointTemplate() string {
+	if x != nil && x.CheckpointTemplate != nil {
+		return *x.CheckpointTemplate
+	}
+	return ""
+}
+
+func (x *StartSyncRequest) GetWindowOverlapMs() int64 {
+	if x != nil && x.WindowOverlapMs != nil {
+		return *x.WindowOverlapMs
+	}
+	return 0
+}
+
+func (x *StartSyncRequest) GetIncrementalQuery() string {
+	if x != nil && x.IncrementalQuery != nil {
+		return *x.IncrementalQuery
+	}
+	return ""
+}
+
+func (x *StartSyncRequest) GetFolderWorkers() int64 {
+	if x != nil && x.FolderWorkers != nil {
+		return *x.FolderWorkers
+	}
+	return 0
+}
+
+func (x *StartSyncRequest) GetShadowRebuild() bool {
+	if x != nil && x.ShadowRebuild != nil {
+		return *x.ShadowRebuild
+	}
+	return false
+}
+
+func (x *StartSyncRequest) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+func (x *StartSyncRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type StartSyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartSyncResponse) Reset() {
+	*x = StartSyncResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSyncResponse) ProtoMessage() {}
+
+func (x *StartSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSyncResponse.ProtoReflect.Descriptor instead.
+func (*StartSyncResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StartSyncResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type InspectRootsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderIds     []int64                `protobuf:"varint,1,rep,packed,name=folder_ids,json=folderIds,proto3" json:"folder_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectRootsRequest) Reset() {
+	*x = InspectRootsRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectRootsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectRootsRequest) ProtoMessage() {}
+
+func (x *InspectRootsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectRootsRequest.ProtoReflect.Descriptor instead.
+func (*InspectRootsRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *InspectRootsRequest) GetFolderIds() []int64 {
+	if x != nil {
+		return x.FolderIds
+	}
+	return nil
+}
+
+type InspectRootsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*InspectRootItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Errors        []*InspectRootError    `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectRootsResponse) Reset() {
+	*x = InspectRootsResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectRootsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectRootsResponse) ProtoMessage() {}
+
+func (x *InspectRootsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectRootsResponse.ProtoReflect.Descriptor instead.
+func (*InspectRootsResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *InspectRootsResponse) GetItems() []*InspectRootItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *InspectRootsResponse) GetErrors() []*InspectRootError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type GetIndexStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIndexStatsRequest) Reset() {
+	*x = GetIndexStatsRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIndexStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIndexStatsRequest) ProtoMessage() {}
+
+func (x *GetIndexStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIndexStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetIndexStatsRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetIndexStatsRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type GetIndexStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentCount int64                  `protobuf:"varint,1,opt,name=document_count,json=documentCount,proto3" json:"document_count,omitempty"`
+	Token         *OAuthTokenStatus      `protobuf:"bytes,2,opt,name=token,proto3,oneof" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIndexStatsResponse) Reset() {
+	*x = GetIndexStatsResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIndexStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIndexStatsResponse) ProtoMessage() {}
+
+func (x *GetIndexStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIndexStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetIndexStatsResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetIndexStatsResponse) GetDocumentCount() int64 {
+	if x != nil {
+		return x.DocumentCount
+	}
+	return 0
+}
+
+func (x *GetIndexStatsResponse) GetToken() *OAuthTokenStatus {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type OAuthTokenStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RefreshedAt   int64                  `protobuf:"varint,3,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	RefreshCount  int64                  `protobuf:"varint,5,opt,name=refresh_count,json=refreshCount,proto3" json:"refresh_count,omitempty"`
+	LastError     string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorAt   int64                  `protobuf:"varint,7,opt,name=last_error_at,json=lastErrorAt,proto3" json:"last_error_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthTokenStatus) Reset() {
+	*x = OAuthTokenStatus{}
+	mi := &file_npan_v1_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthTokenStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthTokenStatus) ProtoMessage() {}
+
+func (x *OAuthTokenStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthTokenStatus.ProtoReflect.Descriptor instead.
+func (*OAuthTokenStatus) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *OAuthTokenStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OAuthTokenStatus) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *OAuthTokenStatus) GetRefreshedAt() int64 {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return 0
+}
+
+func (x *OAuthTokenStatus) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *OAuthTokenStatus) GetRefreshCount() int64 {
+	if x != nil {
+		return x.RefreshCount
+	}
+	return 0
+}
+
+func (x *OAuthTokenStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *OAuthTokenStatus) GetLastErrorAt() int64 {
+	if x != nil {
+		return x.LastErrorAt
+	}
+	return 0
+}
+
+type GetSyncProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncProgressRequest) Reset() {
+	*x = GetSyncProgressRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncProgressRequest) ProtoMessage() {}
+
+func (x *GetSyncProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncProgressRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetSyncProgressRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type GetSyncProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *SyncProgressState     `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncProgressResponse) Reset() {
+	*x = GetSyncProgressResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncProgressResponse) ProtoMessage() {}
+
+func (x *GetSyncProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncProgressResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetSyncProgressResponse) GetState() *SyncProgressState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type WatchSyncProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSyncProgressRequest) Reset() {
+	*x = WatchSyncProgressRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSyncProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSyncProgressRequest) ProtoMessage() {}
+
+func (x *WatchSyncProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSyncProgressRequest.ProtoReflect.Descriptor instead.
+func (*WatchSyncProgressRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *WatchSyncProgressRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type WatchSyncProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *SyncProgressState     `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSyncProgressResponse) Reset() {
+	*x = WatchSyncProgressResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSyncProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSyncProgressResponse) ProtoMessage() {}
+
+func (x *WatchSyncProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSyncProgressResponse.ProtoReflect.Descriptor instead.
+func (*WatchSyncProgressResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *WatchSyncProgressResponse) GetState() *SyncProgressState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type CancelSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSyncRequest) Reset() {
+	*x = CancelSyncRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSyncRequest) ProtoMessage() {}
+
+func (x *CancelSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSyncRequest.ProtoReflect.Descriptor instead.
+func (*CancelSyncRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CancelSyncRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type CancelSyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSyncResponse) Reset() {
+	*x = CancelSyncResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSyncResponse) ProtoMessage() {}
+
+func (x *CancelSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSyncResponse.ProtoReflect.Descriptor instead.
+func (*CancelSyncResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CancelSyncResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PauseSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSyncRequest) Reset() {
+	*x = PauseSyncRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSyncRequest) ProtoMessage() {}
+
+func (x *PauseSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSyncRequest.ProtoReflect.Descriptor instead.
+func (*PauseSyncRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PauseSyncRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type PauseSyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSyncResponse) Reset() {
+	*x = PauseSyncResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSyncResponse) ProtoMessage() {}
+
+func (x *PauseSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSyncResponse.ProtoReflect.Descriptor instead.
+func (*PauseSyncResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PauseSyncResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResumeSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSyncRequest) Reset() {
+	*x = ResumeSyncRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSyncRequest) ProtoMessage() {}
+
+func (x *ResumeSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSyncRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSyncRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ResumeSyncRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type ResumeSyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSyncResponse) Reset() {
+	*x = ResumeSyncResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSyncResponse) ProtoMessage() {}
+
+func (x *ResumeSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSyncResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSyncResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ResumeSyncResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SyncLease struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	AcquiredAt    int64                  `protobuf:"varint,3,opt,name=acquired_at,json=acquiredAt,proto3" json:"acquired_at,omitempty"`
+	HeartbeatAt   int64                  `protobuf:"varint,4,opt,name=heartbeat_at,json=heartbeatAt,proto3" json:"heartbeat_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Expired       bool                   `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncLease) Reset() {
+	*x = SyncLease{}
+	mi := &file_npan_v1_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncLease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncLease) ProtoMessage() {}
+
+func (x *SyncLease) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncLease.ProtoReflect.Descriptor instead.
+func (*SyncLease) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SyncLease) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SyncLease) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SyncLease) GetAcquiredAt() int64 {
+	if x != nil {
+		return x.AcquiredAt
+	}
+	return 0
+}
+
+func (x *SyncLease) GetHeartbeatAt() int64 {
+	if x != nil {
+		return x.HeartbeatAt
+	}
+	return 0
+}
+
+func (x *SyncLease) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *SyncLease) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+type GetSyncLeaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncLeaseRequest) Reset() {
+	*x = GetSyncLeaseRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncLeaseRequest) ProtoMessage() {}
+
+func (x *GetSyncLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncLeaseRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetSyncLeaseRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type GetSyncLeaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lease         *SyncLease             `protobuf:"bytes,1,opt,name=lease,proto3,oneof" json:"lease,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncLeaseResponse) Reset() {
+	*x = GetSyncLeaseResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncLeaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncLeaseResponse) ProtoMessage() {}
+
+func (x *GetSyncLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncLeaseResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetSyncLeaseResponse) GetLease() *SyncLease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type ForceReleaseSyncLeaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceReleaseSyncLeaseRequest) Reset() {
+	*x = ForceReleaseSyncLeaseRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceReleaseSyncLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceReleaseSyncLeaseRequest) ProtoMessage() {}
+
+func (x *ForceReleaseSyncLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceReleaseSyncLeaseRequest.ProtoReflect.Descriptor instead.
+func (*ForceReleaseSyncLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ForceReleaseSyncLeaseRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type ForceReleaseSyncLeaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Released      *SyncLease             `protobuf:"bytes,1,opt,name=released,proto3,oneof" json:"released,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceReleaseSyncLeaseResponse) Reset() {
+	*x = ForceReleaseSyncLeaseResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceReleaseSyncLeaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceReleaseSyncLeaseResponse) ProtoMessage() {}
+
+func (x *ForceReleaseSyncLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceReleaseSyncLeaseResponse.ProtoReflect.Descriptor instead.
+func (*ForceReleaseSyncLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ForceReleaseSyncLeaseResponse) GetReleased() *SyncLease {
+	if x != nil {
+		return x.Released
+	}
+	return nil
+}
+
+func (x *ForceReleaseSyncLeaseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type FolderResyncProgress struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FolderId        int64                  `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	FolderName      string                 `protobuf:"bytes,2,opt,name=folder_name,json=folderName,proto3" json:"folder_name,omitempty"`
+	Mode            FolderResyncMode       `protobuf:"varint,3,opt,name=mode,proto3,enum=npan.v1.FolderResyncMode" json:"mode,omitempty"`
+	Status          SyncStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=npan.v1.SyncStatus" json:"status,omitempty"`
+	StartedAt       int64                  `protobuf:"varint,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UpdatedAt       int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinishedAt      *int64                 `protobuf:"varint,7,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`
+	DocsDeleted     int64                  `protobuf:"varint,8,opt,name=docs_deleted,json=docsDeleted,proto3" json:"docs_deleted,omitempty"`
+	DocsWritten     int64                  `protobuf:"varint,9,opt,name=docs_written,json=docsWritten,proto3" json:"docs_written,omitempty"`
+	FoldersVisited  int64                  `protobuf:"varint,10,opt,name=folders_visited,json=foldersVisited,proto3" json:"folders_visited,omitempty"`
+	CurrentFolderId *int64                 `protobuf:"varint,11,opt,name=current_folder_id,json=currentFolderId,proto3,oneof" json:"current_folder_id,omitempty"`
+	LastError       *string                `protobuf:"bytes,12,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FolderResyncProgress) Reset() {
+	*x = FolderResyncProgress{}
+	mi := &file_npan_v1_api_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FolderResyncProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderResyncProgress) ProtoMessage() {}
+
+func (x *FolderResyncProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderResyncProgress.ProtoReflect.Descriptor instead.
+func (*FolderResyncProgress) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *FolderResyncProgress) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *FolderResyncProgress) GetFolderName() string {
+	if x != nil {
+		return x.FolderName
+	}
+	return ""
+}
+
+func (x *FolderResyncProgress) GetMode() FolderResyncMode {
+	if x != nil {
+		return x.Mode
+	}
+	return FolderResyncMode_FOLDER_RESYNC_MODE_UNSPECIFIED
+}
+
+func (x *FolderResyncProgress) GetStatus() SyncStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SyncStatus_SYNC_STATUS_UNSPECIFIED
+}
+
+func (x *FolderResyncProgress) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *FolderResyncProgress) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *FolderResyncProgress) GetFinishedAt() int64 {
+	if x != nil && x.FinishedAt != nil {
+		return *x.FinishedAt
+	}
+	return 0
+}
+
+func (x *FolderResyncProgress) GetDocsDeleted() int64 {
+	if x != nil {
+		return x.DocsDeleted
+	}
+	return 0
+}
+
+func (x *FolderResyncProgress) GetDocsWritten() int64 {
+	if x != nil {
+		return x.DocsWritten
+	}
+	return 0
+}
+
+func (x *FolderResyncProgress) GetFoldersVisited() int64 {
+	if x != nil {
+		return x.FoldersVisited
+	}
+	return 0
+}
+
+func (x *FolderResyncProgress) GetCurrentFolderId() int64 {
+	if x != nil && x.CurrentFolderId != nil {
+		return *x.CurrentFolderId
+	}
+	return 0
+}
+
+func (x *FolderResyncProgress) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+type ResyncFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      int64                  `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Mode          *FolderResyncMode      `protobuf:"varint,2,opt,name=mode,proto3,enum=npan.v1.FolderResyncMode,oneof" json:"mode,omitempty"`
+	TenantId      *string                `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncFolderRequest) Reset() {
+	*x = ResyncFolderRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncFolderRequest) ProtoMessage() {}
+
+func (x *ResyncFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncFolderRequest.ProtoReflect.Descriptor instead.
+func (*ResyncFolderRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ResyncFolderRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *ResyncFolderRequest) GetMode() FolderResyncMode {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return FolderResyncMode_FOLDER_RESYNC_MODE_UNSPECIFIED
+}
+
+func (x *ResyncFolderRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type ResyncFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncFolderResponse) Reset() {
+	*x = ResyncFolderResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncFolderResponse) ProtoMessage() {}
+
+func (x *ResyncFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncFolderResponse.ProtoReflect.Descriptor instead.
+func (*ResyncFolderResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ResyncFolderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetFolderResyncProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFolderResyncProgressRequest) Reset() {
+	*x = GetFolderResyncProgressRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFolderResyncProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFolderResyncProgressRequest) ProtoMessage() {}
+
+func (x *GetFolderResyncProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFolderResyncProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetFolderResyncProgressRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetFolderResyncProgressRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type GetFolderResyncProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Progress      *FolderResyncProgress  `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFolderResyncProgressResponse) Reset() {
+	*x = GetFolderResyncProgressResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFolderResyncProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFolderResyncProgressResponse) ProtoMessage() {}
+
+func (x *GetFolderResyncProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFolderResyncProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetFolderResyncProgressResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetFolderResyncProgressResponse) GetProgress() *FolderResyncProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type CancelFolderResyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFolderResyncRequest) Reset() {
+	*x = CancelFolderResyncRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFolderResyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFolderResyncRequest) ProtoMessage() {}
+
+func (x *CancelFolderResyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFolderResyncRequest.ProtoReflect.Descriptor instead.
+func (*CancelFolderResyncRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CancelFolderResyncRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type CancelFolderResyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFolderResyncResponse) Reset() {
+	*x = CancelFolderResyncResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFolderResyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFolderResyncResponse) ProtoMessage() {}
+
+func (x *CancelFolderResyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFolderResyncResponse.ProtoReflect.Descriptor instead.
+func (*CancelFolderResyncResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CancelFolderResyncResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RollbackIndexRebuildRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackIndexRebuildRequest) Reset() {
+	*x = RollbackIndexRebuildRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackIndexRebuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackIndexRebuildRequest) ProtoMessage() {}
+
+func (x *RollbackIndexRebuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackIndexRebuildRequest.ProtoReflect.Descriptor instead.
+func (*RollbackIndexRebuildRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RollbackIndexRebuildRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type RollbackIndexRebuildResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rebuild       *IndexRebuildState     `protobuf:"bytes,1,opt,name=rebuild,proto3" json:"rebuild,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackIndexRebuildResponse) Reset() {
+	*x = RollbackIndexRebuildResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackIndexRebuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackIndexRebuildResponse) ProtoMessage() {}
+
+func (x *RollbackIndexRebuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackIndexRebuildResponse.ProtoReflect.Descriptor instead.
+func (*RollbackIndexRebuildResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RollbackIndexRebuildResponse) GetRebuild() *IndexRebuildState {
+	if x != nil {
+		return x.Rebuild
+	}
+	return nil
+}
+
+type SyncRun struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mode             SyncMode               `protobuf:"varint,2,opt,name=mode,proto3,enum=npan.v1.SyncMode" json:"mode,omitempty"`
+	Status           SyncStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=npan.v1.SyncStatus" json:"status,omitempty"`
+	Roots            []int64                `protobuf:"varint,4,rep,packed,name=roots,proto3" json:"roots,omitempty"`
+	StartedAt        int64                  `protobuf:"varint,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	StartedAtTs      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at_ts,json=startedAtTs,proto3" json:"started_at_ts,omitempty"`
+	EndedAt          int64                  `protobuf:"varint,7,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	EndedAtTs        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ended_at_ts,json=endedAtTs,proto3" json:"ended_at_ts,omitempty"`
+	DurationMs       int64                  `protobuf:"varint,9,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Stats            *CrawlStats            `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
+	IncrementalStats *IncrementalSyncStats  `protobuf:"bytes,11,opt,name=incremental_stats,json=incrementalStats,proto3,oneof" json:"incremental_stats,omitempty"`
+	Verification     *SyncVerification      `protobuf:"bytes,12,opt,name=verification,proto3,oneof" json:"verification,omitempty"`
+	Error            *string                `protobuf:"bytes,13,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SyncRun) Reset() {
+	*x = SyncRun{}
+	mi := &file_npan_v1_api_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRun) ProtoMessage() {}
+
+func (x *SyncRun) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRun.ProtoReflect.Descriptor instead.
+func (*SyncRun) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SyncRun) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SyncRun) GetMode() SyncMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SyncMode_SYNC_MODE_UNSPECIFIED
+}
+
+func (x *SyncRun) GetStatus() SyncStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SyncStatus_SYNC_STATUS_UNSPECIFIED
+}
+
+func (x *SyncRun) GetRoots() []int64 {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+func (x *SyncRun) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *SyncRun) GetStartedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAtTs
+	}
+	return nil
+}
+
+func (x *SyncRun) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+func (x *SyncRun) GetEndedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAtTs
+	}
+	return nil
+}
+
+func (x *SyncRun) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *SyncRun) GetStats() *CrawlStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *SyncRun) GetIncrementalStats() *IncrementalSyncStats {
+	if x != nil {
+		return x.IncrementalStats
+	}
+	return nil
+}
+
+func (x *SyncRun) GetVerification() *SyncVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+func (x *SyncRun) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type ListSyncRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          *SyncMode              `protobuf:"varint,1,opt,name=mode,proto3,enum=npan.v1.SyncMode,oneof" json:"mode,omitempty"`
+	Limit         *int64                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	BeforeId      *int64                 `protobuf:"varint,3,opt,name=before_id,json=beforeId,proto3,oneof" json:"before_id,omitempty"`
+	TenantId      *string                `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyncRunsRequest) Reset() {
+	*x = ListSyncRunsRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyncRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncRunsRequest) ProtoMessage() {}
+
+func (x *ListSyncRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListSyncRunsRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListSyncRunsRequest) GetMode() SyncMode {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return SyncMode_SYNC_MODE_UNSPECIFIED
+}
+
+func (x *ListSyncRunsRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListSyncRunsRequest) GetBeforeId() int64 {
+	if x != nil && x.BeforeId != nil {
+		return *x.BeforeId
+	}
+	return 0
+}
+
+func (x *ListSyncRunsRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type ListSyncRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*SyncRun             `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	NextBeforeId  *int64                 `protobuf:"varint,2,opt,name=next_before_id,json=nextBeforeId,proto3,oneof" json:"next_before_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyncRunsResponse) Reset() {
+	*x = ListSyncRunsResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyncRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncRunsResponse) ProtoMessage() {}
+
+func (x *ListSyncRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListSyncRunsResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListSyncRunsResponse) GetRuns() []*SyncRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *ListSyncRunsResponse) GetNextBeforeId() int64 {
+	if x != nil && x.NextBeforeId != nil {
+		return *x.NextBeforeId
+	}
+	return 0
+}
+
+type GetSyncRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      *string                `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncRunRequest) Reset() {
+	*x = GetSyncRunRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncRunRequest) ProtoMessage() {}
+
+func (x *GetSyncRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncRunRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncRunRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetSyncRunRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetSyncRunRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type GetSyncRunResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *SyncRun               `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncRunResponse) Reset() {
+	*x = GetSyncRunResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncRunResponse) ProtoMessage() {}
+
+func (x *GetSyncRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncRunResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncRunResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetSyncRunResponse) GetRun() *SyncRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type DeadLetter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RunId         int64                  `protobuf:"varint,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	RootFolderId  int64                  `protobuf:"varint,3,opt,name=root_folder_id,json=rootFolderId,proto3" json:"root_folder_id,omitempty"`
+	DocCount      int64                  `protobuf:"varint,4,opt,name=doc_count,json=docCount,proto3" json:"doc_count,omitempty"`
+	DocIds        []string               `protobuf:"bytes,5,rep,name=doc_ids,json=docIds,proto3" json:"doc_ids,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Attempts      int64                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at_ts,json=updatedAtTs,proto3" json:"updated_at_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_npan_v1_api_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DeadLetter) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetRunId() int64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetRootFolderId() int64 {
+	if x != nil {
+		return x.RootFolderId
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetDocCount() int64 {
+	if x != nil {
+		return x.DocCount
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetDocIds() []string {
+	if x != nil {
+		return x.DocIds
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetCreatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTs
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetUpdatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAtTs
+	}
+	return nil
+}
+
+type ListDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RootFolderId  *int64                 `protobuf:"varint,1,opt,name=root_folder_id,json=rootFolderId,proto3,oneof" json:"root_folder_id,omitempty"`
+	Limit         *int64                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	BeforeId      *int64                 `protobuf:"varint,3,opt,name=before_id,json=beforeId,proto3,oneof" json:"before_id,omitempty"`
+	TenantId      *string                `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListDeadLettersRequest) GetRootFolderId() int64 {
+	if x != nil && x.RootFolderId != nil {
+		return *x.RootFolderId
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetBeforeId() int64 {
+	if x != nil && x.BeforeId != nil {
+		return *x.BeforeId
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type ListDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetters   []*DeadLetter          `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	NextBeforeId  *int64                 `protobuf:"varint,2,opt,name=next_before_id,json=nextBeforeId,proto3,oneof" json:"next_before_id,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+func (x *ListDeadLettersResponse) GetNextBeforeId() int64 {
+	if x != nil && x.NextBeforeId != nil {
+		return *x.NextBeforeId
+	}
+	return 0
+}
+
+func (x *ListDeadLettersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ReplayDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	All           bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	TenantId      *string                `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersRequest) Reset() {
+	*x = ReplayDeadLettersRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ReplayDeadLettersRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ReplayDeadLettersRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *ReplayDeadLettersRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type ReplayDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReplayedIds   []int64                `protobuf:"varint,1,rep,packed,name=replayed_ids,json=replayedIds,proto3" json:"replayed_ids,omitempty"`
+	FailedIds     []int64                `protobuf:"varint,2,rep,packed,name=failed_ids,json=failedIds,proto3" json:"failed_ids,omitempty"`
+	Remaining     int64                  `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	SupersededIds []int64                `protobuf:"varint,4,rep,packed,name=superseded_ids,json=supersededIds,proto3" json:"superseded_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersResponse) Reset() {
+	*x = ReplayDeadLettersResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ReplayDeadLettersResponse) GetReplayedIds() []int64 {
+	if x != nil {
+		return x.ReplayedIds
+	}
+	return nil
+}
+
+func (x *ReplayDeadLettersResponse) GetFailedIds() []int64 {
+	if x != nil {
+		return x.FailedIds
+	}
+	return nil
+}
+
+func (x *ReplayDeadLettersResponse) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *ReplayDeadLettersResponse) GetSupersededIds() []int64 {
+	if x != nil {
+		return x.SupersededIds
+	}
+	return nil
+}
+
+type DiscardDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	All           bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	TenantId      *string                `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDeadLettersRequest) Reset() {
+	*x = DiscardDeadLettersRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDeadLettersRequest) ProtoMessage() {}
+
+func (x *DiscardDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*DiscardDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DiscardDeadLettersRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DiscardDeadLettersRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *DiscardDeadLettersRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type DiscardDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discarded     int64                  `protobuf:"varint,1,opt,name=discarded,proto3" json:"discarded,omitempty"`
+	Remaining     int64                  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDeadLettersResponse) Reset() {
+	*x = DiscardDeadLettersResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDeadLettersResponse) ProtoMessage() {}
+
+func (x *DiscardDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*DiscardDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DiscardDeadLettersResponse) GetDiscarded() int64 {
+	if x != nil {
+		return x.Discarded
+	}
+	return 0
+}
+
+func (x *DiscardDeadLettersResponse) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+type DuplicateFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocId         string                 `protobuf:"bytes,1,opt,name=doc_id,json=docId,proto3" json:"doc_id,omitempty"`
+	SourceId      int64                  `protobuf:"varint,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateFile) Reset() {
+	*x = DuplicateFile{}
+	mi := &file_npan_v1_api_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateFile) ProtoMessage() {}
+
+func (x *DuplicateFile) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateFile.ProtoReflect.Descriptor instead.
+func (*DuplicateFile) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DuplicateFile) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *DuplicateFile) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *DuplicateFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DuplicateFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DuplicateFile) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type DuplicateGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sha1          string                 `protobuf:"bytes,1,opt,name=sha1,proto3" json:"sha1,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Copies        int64                  `protobuf:"varint,3,opt,name=copies,proto3" json:"copies,omitempty"`
+	WastedBytes   int64                  `protobuf:"varint,4,opt,name=wasted_bytes,json=wastedBytes,proto3" json:"wasted_bytes,omitempty"`
+	Files         []*DuplicateFile       `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateGroup) Reset() {
+	*x = DuplicateGroup{}
+	mi := &file_npan_v1_api_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateGroup) ProtoMessage() {}
+
+func (x *DuplicateGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateGroup.ProtoReflect.Descriptor instead.
+func (*DuplicateGroup) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DuplicateGroup) GetSha1() string {
+	if x != nil {
+		return x.Sha1
+	}
+	return ""
+}
+
+func (x *DuplicateGroup) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DuplicateGroup) GetCopies() int64 {
+	if x != nil {
+		return x.Copies
+	}
+	return 0
+}
+
+func (x *DuplicateGroup) GetWastedBytes() int64 {
+	if x != nil {
+		return x.WastedBytes
+	}
+	return 0
+}
+
+func (x *DuplicateGroup) GetFiles() []*DuplicateFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type FindDuplicatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RootFolderId  *int64                 `protobuf:"varint,1,opt,name=root_folder_id,json=rootFolderId,proto3,oneof" json:"root_folder_id,omitempty"`
+	MinSize       int64                  `protobuf:"varint,2,opt,name=min_size,json=minSize,proto3" json:"min_size,omitempty"`
+	Limit         *int64                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	ExportFormat  *string                `protobuf:"bytes,4,opt,name=export_format,json=exportFormat,proto3,oneof" json:"export_format,omitempty"`
+	TenantId      *string                `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesRequest) Reset() {
+	*x = FindDuplicatesRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesRequest) ProtoMessage() {}
+
+func (x *FindDuplicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicatesRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicatesRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *FindDuplicatesRequest) GetRootFolderId() int64 {
+	if x != nil && x.RootFolderId != nil {
+		return *x.RootFolderId
+	}
+	return 0
+}
+
+func (x *FindDuplicatesRequest) GetMinSize() int64 {
+	if x != nil {
+		return x.MinSize
+	}
+	return 0
+}
+
+func (x *FindDuplicatesRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *FindDuplicatesRequest) GetExportFormat() string {
+	if x != nil && x.ExportFormat != nil {
+		return *x.ExportFormat
+	}
+	return ""
+}
+
+func (x *FindDuplicatesRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type FindDuplicatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*DuplicateGroup      `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	WastedBytes   int64                  `protobuf:"varint,2,opt,name=wasted_bytes,json=wastedBytes,proto3" json:"wasted_bytes,omitempty"`
+	Export        string                 `protobuf:"bytes,3,opt,name=export,proto3" json:"export,omitempty"`
+	TotalGroups   int64                  `protobuf:"varint,4,opt,name=total_groups,json=totalGroups,proto3" json:"total_groups,omitempty"`
+	Truncated     bool                   `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesResponse) Reset() {
+	*x = FindDuplicatesResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadyzRequest) String() string {
+func (x *FindDuplicatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadyzRequest) ProtoMessage() {}
+func (*FindDuplicatesResponse) ProtoMessage() {}
 
-func (x *ReadyzRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[15]
+func (x *FindDuplicatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1575,34 +6091,74 @@ func (x *ReadyzRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadyzRequest.ProtoReflect.Descriptor instead.
-func (*ReadyzRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use FindDuplicatesResponse.ProtoReflect.Descriptor instead.
+func (*FindDuplicatesResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{81}
 }
 
-type ReadyzResponse struct {
+func (x *FindDuplicatesResponse) GetGroups() []*DuplicateGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *FindDuplicatesResponse) GetWastedBytes() int64 {
+	if x != nil {
+		return x.WastedBytes
+	}
+	return 0
+}
+
+func (x *FindDuplicatesResponse) GetExport() string {
+	if x != nil {
+		return x.Export
+	}
+	return ""
+}
+
+func (x *FindDuplicatesResponse) GetTotalGroups() int64 {
+	if x != nil {
+		return x.TotalGroups
+	}
+	return 0
+}
+
+func (x *FindDuplicatesResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type ReconciliationRow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        ReadyStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=npan.v1.ReadyStatus" json:"status,omitempty"`
-	Meili         *string                `protobuf:"bytes,2,opt,name=meili,proto3,oneof" json:"meili,omitempty"`
+	RootFolderId  int64                  `protobuf:"varint,1,opt,name=root_folder_id,json=rootFolderId,proto3" json:"root_folder_id,omitempty"`
+	FolderId      int64                  `protobuf:"varint,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	UpstreamItems int64                  `protobuf:"varint,4,opt,name=upstream_items,json=upstreamItems,proto3" json:"upstream_items,omitempty"`
+	IndexedItems  int64                  `protobuf:"varint,5,opt,name=indexed_items,json=indexedItems,proto3" json:"indexed_items,omitempty"`
+	Drift         int64                  `protobuf:"varint,6,opt,name=drift,proto3" json:"drift,omitempty"`
+	Error         *string                `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadyzResponse) Reset() {
-	*x = ReadyzResponse{}
-	mi := &file_npan_v1_api_proto_msgTypes[16]
+func (x *ReconciliationRow) Reset() {
+	*x = ReconciliationRow{}
+	mi := &file_npan_v1_api_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadyzResponse) String() string {
+func (x *ReconciliationRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadyzResponse) ProtoMessage() {}
+func (*ReconciliationRow) ProtoMessage() {}
 
-func (x *ReadyzResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[16]
+func (x *ReconciliationRow) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1613,87 +6169,90 @@ func (x *ReadyzResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadyzResponse.ProtoReflect.Descriptor instead.
-func (*ReadyzResponse) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ReconciliationRow.ProtoReflect.Descriptor instead.
+func (*ReconciliationRow) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *ReadyzResponse) GetStatus() ReadyStatus {
+func (x *ReconciliationRow) GetRootFolderId() int64 {
 	if x != nil {
-		return x.Status
+		return x.RootFolderId
 	}
-	return ReadyStatus_READY_STATUS_UNSPECIFIED
+	return 0
 }
 
-func (x *ReadyzResponse) GetMeili() string {
-	if x != nil && x.Meili != nil {
-		return *x.Meili
+func (x *ReconciliationRow) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
 	}
-	return ""
+	return 0
 }
 
-type GetSearchConfigRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ReconciliationRow) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
 }
 
-func (x *GetSearchConfigRequest) Reset() {
-	*x = GetSearchConfigRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ReconciliationRow) GetUpstreamItems() int64 {
+	if x != nil {
+		return x.UpstreamItems
+	}
+	return 0
 }
 
-func (x *GetSearchConfigRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReconciliationRow) GetIndexedItems() int64 {
+	if x != nil {
+		return x.IndexedItems
+	}
+	return 0
 }
 
-func (*GetSearchConfigRequest) ProtoMessage() {}
-
-func (x *GetSearchConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[17]
+func (x *ReconciliationRow) GetDrift() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Drift
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetSearchConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetSearchConfigRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{17}
+func (x *ReconciliationRow) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
 }
 
-type GetSearchConfigResponse struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Host                 string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	IndexName            string                 `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	SearchApiKey         string                 `protobuf:"bytes,3,opt,name=search_api_key,json=searchApiKey,proto3" json:"search_api_key,omitempty"`
-	InstantsearchEnabled bool                   `protobuf:"varint,4,opt,name=instantsearch_enabled,json=instantsearchEnabled,proto3" json:"instantsearch_enabled,omitempty"`
-	Provider             string                 `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type ReconciliationReport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status         SyncStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=npan.v1.SyncStatus" json:"status,omitempty"`
+	Roots          []int64                `protobuf:"varint,3,rep,packed,name=roots,proto3" json:"roots,omitempty"`
+	SampleSize     int64                  `protobuf:"varint,4,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	StartedAt      int64                  `protobuf:"varint,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt     *int64                 `protobuf:"varint,6,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`
+	FoldersChecked int64                  `protobuf:"varint,7,opt,name=folders_checked,json=foldersChecked,proto3" json:"folders_checked,omitempty"`
+	FoldersDrifted int64                  `protobuf:"varint,8,opt,name=folders_drifted,json=foldersDrifted,proto3" json:"folders_drifted,omitempty"`
+	Error          *string                `protobuf:"bytes,9,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetSearchConfigResponse) Reset() {
-	*x = GetSearchConfigResponse{}
-	mi := &file_npan_v1_api_proto_msgTypes[18]
+func (x *ReconciliationReport) Reset() {
+	*x = ReconciliationReport{}
+	mi := &file_npan_v1_api_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSearchConfigResponse) String() string {
+func (x *ReconciliationReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSearchConfigResponse) ProtoMessage() {}
+func (*ReconciliationReport) ProtoMessage() {}
 
-func (x *GetSearchConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[18]
+func (x *ReconciliationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1704,70 +6263,98 @@ func (x *GetSearchConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSearchConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetSearchConfigResponse) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ReconciliationReport.ProtoReflect.Descriptor instead.
+func (*ReconciliationReport) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetSearchConfigResponse) GetHost() string {
+func (x *ReconciliationReport) GetId() int64 {
 	if x != nil {
-		return x.Host
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *GetSearchConfigResponse) GetIndexName() string {
+func (x *ReconciliationReport) GetStatus() SyncStatus {
 	if x != nil {
-		return x.IndexName
+		return x.Status
 	}
-	return ""
+	return SyncStatus_SYNC_STATUS_UNSPECIFIED
 }
 
-func (x *GetSearchConfigResponse) GetSearchApiKey() string {
+func (x *ReconciliationReport) GetRoots() []int64 {
 	if x != nil {
-		return x.SearchApiKey
+		return x.Roots
 	}
-	return ""
+	return nil
 }
 
-func (x *GetSearchConfigResponse) GetInstantsearchEnabled() bool {
+func (x *ReconciliationReport) GetSampleSize() int64 {
 	if x != nil {
-		return x.InstantsearchEnabled
+		return x.SampleSize
 	}
-	return false
+	return 0
 }
 
-func (x *GetSearchConfigResponse) GetProvider() string {
+func (x *ReconciliationReport) GetStartedAt() int64 {
 	if x != nil {
-		return x.Provider
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *ReconciliationReport) GetFinishedAt() int64 {
+	if x != nil && x.FinishedAt != nil {
+		return *x.FinishedAt
+	}
+	return 0
+}
+
+func (x *ReconciliationReport) GetFoldersChecked() int64 {
+	if x != nil {
+		return x.FoldersChecked
+	}
+	return 0
+}
+
+func (x *ReconciliationReport) GetFoldersDrifted() int64 {
+	if x != nil {
+		return x.FoldersDrifted
+	}
+	return 0
+}
+
+func (x *ReconciliationReport) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
 	return ""
 }
 
-type AppSearchRequest struct {
+type StartReconciliationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Page          *int64                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize      *int64                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	RootFolderIds []int64                `protobuf:"varint,1,rep,packed,name=root_folder_ids,json=rootFolderIds,proto3" json:"root_folder_ids,omitempty"`
+	SampleSize    *int64                 `protobuf:"varint,2,opt,name=sample_size,json=sampleSize,proto3,oneof" json:"sample_size,omitempty"`
+	TenantId      *string                `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AppSearchRequest) Reset() {
-	*x = AppSearchRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[19]
+func (x *StartReconciliationRequest) Reset() {
+	*x = StartReconciliationRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AppSearchRequest) String() string {
+func (x *StartReconciliationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppSearchRequest) ProtoMessage() {}
+func (*StartReconciliationRequest) ProtoMessage() {}
 
-func (x *AppSearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[19]
+func (x *StartReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,54 +6365,54 @@ func (x *AppSearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppSearchRequest.ProtoReflect.Descriptor instead.
-func (*AppSearchRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use StartReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*StartReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *AppSearchRequest) GetQuery() string {
+func (x *StartReconciliationRequest) GetRootFolderIds() []int64 {
 	if x != nil {
-		return x.Query
+		return x.RootFolderIds
 	}
-	return ""
+	return nil
 }
 
-func (x *AppSearchRequest) GetPage() int64 {
-	if x != nil && x.Page != nil {
-		return *x.Page
+func (x *StartReconciliationRequest) GetSampleSize() int64 {
+	if x != nil && x.SampleSize != nil {
+		return *x.SampleSize
 	}
 	return 0
 }
 
-func (x *AppSearchRequest) GetPageSize() int64 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
+func (x *StartReconciliationRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
-	return 0
+	return ""
 }
 
-type AppSearchResponse struct {
+type StartReconciliationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        *QueryResult           `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Report        *ReconciliationReport  `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AppSearchResponse) Reset() {
-	*x = AppSearchResponse{}
-	mi := &file_npan_v1_api_proto_msgTypes[20]
+func (x *StartReconciliationResponse) Reset() {
+	*x = StartReconciliationResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AppSearchResponse) String() string {
+func (x *StartReconciliationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppSearchResponse) ProtoMessage() {}
+func (*StartReconciliationResponse) ProtoMessage() {}
 
-func (x *AppSearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[20]
+func (x *StartReconciliationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1836,41 +6423,43 @@ func (x *AppSearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppSearchResponse.ProtoReflect.Descriptor instead.
-func (*AppSearchResponse) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use StartReconciliationResponse.ProtoReflect.Descriptor instead.
+func (*StartReconciliationResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *AppSearchResponse) GetResult() *QueryResult {
+func (x *StartReconciliationResponse) GetReport() *ReconciliationReport {
 	if x != nil {
-		return x.Result
+		return x.Report
 	}
 	return nil
 }
 
-type AppDownloadURLRequest struct {
+type GetReconciliationReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileId        int64                  `protobuf:"varint,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
-	ValidPeriod   *int64                 `protobuf:"varint,2,opt,name=valid_period,json=validPeriod,proto3,oneof" json:"valid_period,omitempty"`
+	ReportId      *int64                 `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3,oneof" json:"report_id,omitempty"`
+	OnlyDrift     *bool                  `protobuf:"varint,2,opt,name=only_drift,json=onlyDrift,proto3,oneof" json:"only_drift,omitempty"`
+	Limit         *int64                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	TenantId      *string                `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AppDownloadURLRequest) Reset() {
-	*x = AppDownloadURLRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[21]
+func (x *GetReconciliationReportRequest) Reset() {
+	*x = GetReconciliationReportRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AppDownloadURLRequest) String() string {
+func (x *GetReconciliationReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppDownloadURLRequest) ProtoMessage() {}
+func (*GetReconciliationReportRequest) ProtoMessage() {}
 
-func (x *AppDownloadURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[21]
+func (x *GetReconciliationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1881,47 +6470,116 @@ func (x *AppDownloadURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppDownloadURLRequest.ProtoReflect.Descriptor instead.
-func (*AppDownloadURLRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetReconciliationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetReconciliationReportRequest) GetReportId() int64 {
+	if x != nil && x.ReportId != nil {
+		return *x.ReportId
+	}
+	return 0
+}
+
+func (x *GetReconciliationReportRequest) GetOnlyDrift() bool {
+	if x != nil && x.OnlyDrift != nil {
+		return *x.OnlyDrift
+	}
+	return false
+}
+
+func (x *GetReconciliationReportRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetReconciliationReportRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type GetReconciliationReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *ReconciliationReport  `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Rows          []*ReconciliationRow   `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportResponse) Reset() {
+	*x = GetReconciliationReportResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportResponse) ProtoMessage() {}
+
+func (x *GetReconciliationReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationReportResponse.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *AppDownloadURLRequest) GetFileId() int64 {
+func (x *GetReconciliationReportResponse) GetReport() *ReconciliationReport {
 	if x != nil {
-		return x.FileId
+		return x.Report
 	}
-	return 0
+	return nil
 }
 
-func (x *AppDownloadURLRequest) GetValidPeriod() int64 {
-	if x != nil && x.ValidPeriod != nil {
-		return *x.ValidPeriod
+func (x *GetReconciliationReportResponse) GetRows() []*ReconciliationRow {
+	if x != nil {
+		return x.Rows
 	}
-	return 0
+	return nil
 }
 
-type AppDownloadURLResponse struct {
+type ExportReconciliationReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        *DownloadURLResult     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ReportId      *int64                 `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3,oneof" json:"report_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	OnlyDrift     *bool                  `protobuf:"varint,3,opt,name=only_drift,json=onlyDrift,proto3,oneof" json:"only_drift,omitempty"`
+	TenantId      *string                `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AppDownloadURLResponse) Reset() {
-	*x = AppDownloadURLResponse{}
-	mi := &file_npan_v1_api_proto_msgTypes[22]
+func (x *ExportReconciliationReportRequest) Reset() {
+	*x = ExportReconciliationReportRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AppDownloadURLResponse) String() string {
+func (x *ExportReconciliationReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppDownloadURLResponse) ProtoMessage() {}
+func (*ExportReconciliationReportRequest) ProtoMessage() {}
 
-func (x *AppDownloadURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[22]
+func (x *ExportReconciliationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1932,45 +6590,62 @@ func (x *AppDownloadURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppDownloadURLResponse.ProtoReflect.Descriptor instead.
-func (*AppDownloadURLResponse) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ExportReconciliationReportRequest.ProtoReflect.Descriptor instead.
+func (*ExportReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *AppDownloadURLResponse) GetResult() *DownloadURLResult {
+func (x *ExportReconciliationReportRequest) GetReportId() int64 {
+	if x != nil && x.ReportId != nil {
+		return *x.ReportId
+	}
+	return 0
+}
+
+func (x *ExportReconciliationReportRequest) GetFormat() string {
 	if x != nil {
-		return x.Result
+		return x.Format
 	}
-	return nil
+	return ""
 }
 
-type CreateTokenRequest struct {
+func (x *ExportReconciliationReportRequest) GetOnlyDrift() bool {
+	if x != nil && x.OnlyDrift != nil {
+		return *x.OnlyDrift
+	}
+	return false
+}
+
+func (x *ExportReconciliationReportRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type ExportReconciliationReportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         *string                `protobuf:"bytes,1,opt,name=token,proto3,oneof" json:"token,omitempty"`
-	ClientId      *string                `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
-	ClientSecret  *string                `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3,oneof" json:"client_secret,omitempty"`
-	SubId         *int64                 `protobuf:"varint,4,opt,name=sub_id,json=subId,proto3,oneof" json:"sub_id,omitempty"`
-	SubType       *string                `protobuf:"bytes,5,opt,name=sub_type,json=subType,proto3,oneof" json:"sub_type,omitempty"`
-	OauthHost     *string                `protobuf:"bytes,6,opt,name=oauth_host,json=oauthHost,proto3,oneof" json:"oauth_host,omitempty"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Export        string                 `protobuf:"bytes,2,opt,name=export,proto3" json:"export,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTokenRequest) Reset() {
-	*x = CreateTokenRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[23]
+func (x *ExportReconciliationReportResponse) Reset() {
+	*x = ExportReconciliationReportResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTokenRequest) String() string {
+func (x *ExportReconciliationReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTokenRequest) ProtoMessage() {}
+func (*ExportReconciliationReportResponse) ProtoMessage() {}
 
-func (x *CreateTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[23]
+func (x *ExportReconciliationReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1981,75 +6656,91 @@ func (x *CreateTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTokenRequest.ProtoReflect.Descriptor instead.
-func (*CreateTokenRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ExportReconciliationReportResponse.ProtoReflect.Descriptor instead.
+func (*ExportReconciliationReportResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *CreateTokenRequest) GetToken() string {
-	if x != nil && x.Token != nil {
-		return *x.Token
+func (x *ExportReconciliationReportResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *CreateTokenRequest) GetClientId() string {
-	if x != nil && x.ClientId != nil {
-		return *x.ClientId
+func (x *ExportReconciliationReportResponse) GetExport() string {
+	if x != nil {
+		return x.Export
 	}
 	return ""
 }
 
-func (x *CreateTokenRequest) GetClientSecret() string {
-	if x != nil && x.ClientSecret != nil {
-		return *x.ClientSecret
-	}
-	return ""
+type CancelReconciliationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTokenRequest) GetSubId() int64 {
-	if x != nil && x.SubId != nil {
-		return *x.SubId
-	}
-	return 0
+func (x *CancelReconciliationRequest) Reset() {
+	*x = CancelReconciliationRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTokenRequest) GetSubType() string {
-	if x != nil && x.SubType != nil {
-		return *x.SubType
+func (x *CancelReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReconciliationRequest) ProtoMessage() {}
+
+func (x *CancelReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateTokenRequest) GetOauthHost() string {
-	if x != nil && x.OauthHost != nil {
-		return *x.OauthHost
+// Deprecated: Use CancelReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*CancelReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CancelReconciliationRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
 	return ""
 }
 
-type CreateTokenResponse struct {
+type CancelReconciliationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTokenResponse) Reset() {
-	*x = CreateTokenResponse{}
-	mi := &file_npan_v1_api_proto_msgTypes[24]
+func (x *CancelReconciliationResponse) Reset() {
+	*x = CancelReconciliationResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTokenResponse) String() string {
+func (x *CancelReconciliationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTokenResponse) ProtoMessage() {}
+func (*CancelReconciliationResponse) ProtoMessage() {}
 
-func (x *CreateTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[24]
+func (x *CancelReconciliationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2060,45 +6751,53 @@ func (x *CreateTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTokenResponse.ProtoReflect.Descriptor instead.
-func (*CreateTokenResponse) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CancelReconciliationResponse.ProtoReflect.Descriptor instead.
+func (*CancelReconciliationResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *CreateTokenResponse) GetToken() string {
+func (x *CancelReconciliationResponse) GetMessage() string {
 	if x != nil {
-		return x.Token
+		return x.Message
 	}
 	return ""
 }
 
-type RemoteSearchRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Query            string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Type             *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
-	PageId           *int64                 `protobuf:"varint,3,opt,name=page_id,json=pageId,proto3,oneof" json:"page_id,omitempty"`
-	QueryFilter      *string                `protobuf:"bytes,4,opt,name=query_filter,json=queryFilter,proto3,oneof" json:"query_filter,omitempty"`
-	SearchInFolder   *int64                 `protobuf:"varint,5,opt,name=search_in_folder,json=searchInFolder,proto3,oneof" json:"search_in_folder,omitempty"`
-	UpdatedTimeRange *string                `protobuf:"bytes,6,opt,name=updated_time_range,json=updatedTimeRange,proto3,oneof" json:"updated_time_range,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type SyncSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CronExpr      string                 `protobuf:"bytes,3,opt,name=cron_expr,json=cronExpr,proto3" json:"cron_expr,omitempty"`
+	Mode          SyncMode               `protobuf:"varint,4,opt,name=mode,proto3,enum=npan.v1.SyncMode" json:"mode,omitempty"`
+	JitterSeconds int64                  `protobuf:"varint,5,opt,name=jitter_seconds,json=jitterSeconds,proto3" json:"jitter_seconds,omitempty"`
+	Paused        bool                   `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"`
+	NextRunAt     int64                  `protobuf:"varint,7,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	NextRunAtTs   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_run_at_ts,json=nextRunAtTs,proto3" json:"next_run_at_ts,omitempty"`
+	LastRunAt     int64                  `protobuf:"varint,9,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	LastRunAtTs   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_run_at_ts,json=lastRunAtTs,proto3" json:"last_run_at_ts,omitempty"`
+	LastRunStatus *string                `protobuf:"bytes,11,opt,name=last_run_status,json=lastRunStatus,proto3,oneof" json:"last_run_status,omitempty"`
+	LastError     *string                `protobuf:"bytes,12,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoteSearchRequest) Reset() {
-	*x = RemoteSearchRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[25]
+func (x *SyncSchedule) Reset() {
+	*x = SyncSchedule{}
+	mi := &file_npan_v1_api_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoteSearchRequest) String() string {
+func (x *SyncSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoteSearchRequest) ProtoMessage() {}
+func (*SyncSchedule) ProtoMessage() {}
 
-func (x *RemoteSearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[25]
+func (x *SyncSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2109,175 +6808,175 @@ func (x *RemoteSearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoteSearchRequest.ProtoReflect.Descriptor instead.
-func (*RemoteSearchRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SyncSchedule.ProtoReflect.Descriptor instead.
+func (*SyncSchedule) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *RemoteSearchRequest) GetQuery() string {
+func (x *SyncSchedule) GetId() int64 {
 	if x != nil {
-		return x.Query
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *RemoteSearchRequest) GetType() string {
-	if x != nil && x.Type != nil {
-		return *x.Type
+func (x *SyncSchedule) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RemoteSearchRequest) GetPageId() int64 {
-	if x != nil && x.PageId != nil {
-		return *x.PageId
+func (x *SyncSchedule) GetCronExpr() string {
+	if x != nil {
+		return x.CronExpr
 	}
-	return 0
+	return ""
 }
 
-func (x *RemoteSearchRequest) GetQueryFilter() string {
-	if x != nil && x.QueryFilter != nil {
-		return *x.QueryFilter
+func (x *SyncSchedule) GetMode() SyncMode {
+	if x != nil {
+		return x.Mode
 	}
-	return ""
+	return SyncMode_SYNC_MODE_UNSPECIFIED
 }
 
-func (x *RemoteSearchRequest) GetSearchInFolder() int64 {
-	if x != nil && x.SearchInFolder != nil {
-		return *x.SearchInFolder
+func (x *SyncSchedule) GetJitterSeconds() int64 {
+	if x != nil {
+		return x.JitterSeconds
 	}
 	return 0
 }
 
-func (x *RemoteSearchRequest) GetUpdatedTimeRange() string {
-	if x != nil && x.UpdatedTimeRange != nil {
-		return *x.UpdatedTimeRange
+func (x *SyncSchedule) GetPaused() bool {
+	if x != nil {
+		return x.Paused
 	}
-	return ""
+	return false
 }
 
-type LocalSearchRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Page           *int64                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize       *int64                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	Type           *string                `protobuf:"bytes,4,opt,name=type,proto3,oneof" json:"type,omitempty"`
-	ParentId       *int64                 `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
-	UpdatedAfter   *int64                 `protobuf:"varint,6,opt,name=updated_after,json=updatedAfter,proto3,oneof" json:"updated_after,omitempty"`
-	UpdatedBefore  *int64                 `protobuf:"varint,7,opt,name=updated_before,json=updatedBefore,proto3,oneof" json:"updated_before,omitempty"`
-	IncludeDeleted *bool                  `protobuf:"varint,8,opt,name=include_deleted,json=includeDeleted,proto3,oneof" json:"include_deleted,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *SyncSchedule) GetNextRunAt() int64 {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return 0
 }
 
-func (x *LocalSearchRequest) Reset() {
-	*x = LocalSearchRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SyncSchedule) GetNextRunAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAtTs
+	}
+	return nil
 }
 
-func (x *LocalSearchRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SyncSchedule) GetLastRunAt() int64 {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return 0
 }
 
-func (*LocalSearchRequest) ProtoMessage() {}
-
-func (x *LocalSearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[26]
+func (x *SyncSchedule) GetLastRunAtTs() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LastRunAtTs
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use LocalSearchRequest.ProtoReflect.Descriptor instead.
-func (*LocalSearchRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{26}
+func (x *SyncSchedule) GetLastRunStatus() string {
+	if x != nil && x.LastRunStatus != nil {
+		return *x.LastRunStatus
+	}
+	return ""
 }
 
-func (x *LocalSearchRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
+func (x *SyncSchedule) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
 	}
 	return ""
 }
 
-func (x *LocalSearchRequest) GetPage() int64 {
-	if x != nil && x.Page != nil {
-		return *x.Page
+func (x *SyncSchedule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *LocalSearchRequest) GetPageSize() int64 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
+func (x *SyncSchedule) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return 0
 }
 
-func (x *LocalSearchRequest) GetType() string {
-	if x != nil && x.Type != nil {
-		return *x.Type
-	}
-	return ""
+type ListSyncSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *string                `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LocalSearchRequest) GetParentId() int64 {
-	if x != nil && x.ParentId != nil {
-		return *x.ParentId
-	}
-	return 0
+func (x *ListSyncSchedulesRequest) Reset() {
+	*x = ListSyncSchedulesRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *LocalSearchRequest) GetUpdatedAfter() int64 {
-	if x != nil && x.UpdatedAfter != nil {
-		return *x.UpdatedAfter
+func (x *ListSyncSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSyncSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *LocalSearchRequest) GetUpdatedBefore() int64 {
-	if x != nil && x.UpdatedBefore != nil {
-		return *x.UpdatedBefore
-	}
-	return 0
+// Deprecated: Use ListSyncSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSyncSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *LocalSearchRequest) GetIncludeDeleted() bool {
-	if x != nil && x.IncludeDeleted != nil {
-		return *x.IncludeDeleted
+func (x *ListSyncSchedulesRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
-	return false
+	return ""
 }
 
-type LocalSearchResponse struct {
+type ListSyncSchedulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        *QueryResult           `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Schedules     []*SyncSchedule        `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LocalSearchResponse) Reset() {
-	*x = LocalSearchResponse{}
-	mi := &file_npan_v1_api_proto_msgTypes[27]
+func (x *ListSyncSchedulesResponse) Reset() {
+	*x = ListSyncSchedulesResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LocalSearchResponse) String() string {
+func (x *ListSyncSchedulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LocalSearchResponse) ProtoMessage() {}
+func (*ListSyncSchedulesResponse) ProtoMessage() {}
 
-func (x *LocalSearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[27]
+func (x *ListSyncSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2288,41 +6987,45 @@ func (x *LocalSearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LocalSearchResponse.ProtoReflect.Descriptor instead.
-func (*LocalSearchResponse) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ListSyncSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSyncSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *LocalSearchResponse) GetResult() *QueryResult {
+func (x *ListSyncSchedulesResponse) GetSchedules() []*SyncSchedule {
 	if x != nil {
-		return x.Result
+		return x.Schedules
 	}
 	return nil
 }
 
-type DownloadURLRequest struct {
+type CreateSyncScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileId        int64                  `protobuf:"varint,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
-	ValidPeriod   *int64                 `protobuf:"varint,2,opt,name=valid_period,json=validPeriod,proto3,oneof" json:"valid_period,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CronExpr      string                 `protobuf:"bytes,2,opt,name=cron_expr,json=cronExpr,proto3" json:"cron_expr,omitempty"`
+	Mode          *SyncMode              `protobuf:"varint,3,opt,name=mode,proto3,enum=npan.v1.SyncMode,oneof" json:"mode,omitempty"`
+	JitterSeconds *int64                 `protobuf:"varint,4,opt,name=jitter_seconds,json=jitterSeconds,proto3,oneof" json:"jitter_seconds,omitempty"`
+	Paused        *bool                  `protobuf:"varint,5,opt,name=paused,proto3,oneof" json:"paused,omitempty"`
+	TenantId      *string                `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadURLRequest) Reset() {
-	*x = DownloadURLRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[28]
+func (x *CreateSyncScheduleRequest) Reset() {
+	*x = CreateSyncScheduleRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadURLRequest) String() string {
+func (x *CreateSyncScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadURLRequest) ProtoMessage() {}
+func (*CreateSyncScheduleRequest) ProtoMessage() {}
 
-func (x *DownloadURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[28]
+func (x *CreateSyncScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2333,47 +7036,75 @@ func (x *DownloadURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadURLRequest.ProtoReflect.Descriptor instead.
-func (*DownloadURLRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use CreateSyncScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateSyncScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *DownloadURLRequest) GetFileId() int64 {
+func (x *CreateSyncScheduleRequest) GetName() string {
 	if x != nil {
-		return x.FileId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *DownloadURLRequest) GetValidPeriod() int64 {
-	if x != nil && x.ValidPeriod != nil {
-		return *x.ValidPeriod
+func (x *CreateSyncScheduleRequest) GetCronExpr() string {
+	if x != nil {
+		return x.CronExpr
+	}
+	return ""
+}
+
+func (x *CreateSyncScheduleRequest) GetMode() SyncMode {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return SyncMode_SYNC_MODE_UNSPECIFIED
+}
+
+func (x *CreateSyncScheduleRequest) GetJitterSeconds() int64 {
+	if x != nil && x.JitterSeconds != nil {
+		return *x.JitterSeconds
 	}
 	return 0
 }
 
-type DownloadURLResponse struct {
+func (x *CreateSyncScheduleRequest) GetPaused() bool {
+	if x != nil && x.Paused != nil {
+		return *x.Paused
+	}
+	return false
+}
+
+func (x *CreateSyncScheduleRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type CreateSyncScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        *DownloadURLResult     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Schedule      *SyncSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadURLResponse) Reset() {
-	*x = DownloadURLResponse{}
-	mi := &file_npan_v1_api_proto_msgTypes[29]
+func (x *CreateSyncScheduleResponse) Reset() {
+	*x = CreateSyncScheduleResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadURLResponse) String() string {
+func (x *CreateSyncScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadURLResponse) ProtoMessage() {}
+func (*CreateSyncScheduleResponse) ProtoMessage() {}
 
-func (x *DownloadURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[29]
+func (x *CreateSyncScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2384,51 +7115,41 @@ func (x *DownloadURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadURLResponse.ProtoReflect.Descriptor instead.
-func (*DownloadURLResponse) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use CreateSyncScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CreateSyncScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *DownloadURLResponse) GetResult() *DownloadURLResult {
+func (x *CreateSyncScheduleResponse) GetSchedule() *SyncSchedule {
 	if x != nil {
-		return x.Result
+		return x.Schedule
 	}
 	return nil
 }
 
-type StartSyncRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Mode                *SyncMode              `protobuf:"varint,1,opt,name=mode,proto3,enum=npan.v1.SyncMode,oneof" json:"mode,omitempty"`
-	RootFolderIds       []int64                `protobuf:"varint,2,rep,packed,name=root_folder_ids,json=rootFolderIds,proto3" json:"root_folder_ids,omitempty"`
-	IncludeDepartments  *bool                  `protobuf:"varint,3,opt,name=include_departments,json=includeDepartments,proto3,oneof" json:"include_departments,omitempty"`
-	PreserveRootCatalog *bool                  `protobuf:"varint,4,opt,name=preserve_root_catalog,json=preserveRootCatalog,proto3,oneof" json:"preserve_root_catalog,omitempty"`
-	DepartmentIds       []int64                `protobuf:"varint,5,rep,packed,name=department_ids,json=departmentIds,proto3" json:"department_ids,omitempty"`
-	ResumeProgress      *bool                  `protobuf:"varint,6,opt,name=resume_progress,json=resumeProgress,proto3,oneof" json:"resume_progress,omitempty"`
-	ForceRebuild        *bool                  `protobuf:"varint,7,opt,name=force_rebuild,json=forceRebuild,proto3,oneof" json:"force_rebuild,omitempty"`
-	RootWorkers         *int64                 `protobuf:"varint,8,opt,name=root_workers,json=rootWorkers,proto3,oneof" json:"root_workers,omitempty"`
-	ProgressEvery       *int64                 `protobuf:"varint,9,opt,name=progress_every,json=progressEvery,proto3,oneof" json:"progress_every,omitempty"`
-	CheckpointTemplate  *string                `protobuf:"bytes,10,opt,name=checkpoint_template,json=checkpointTemplate,proto3,oneof" json:"checkpoint_template,omitempty"`
-	WindowOverlapMs     *int64                 `protobuf:"varint,11,opt,name=window_overlap_ms,json=windowOverlapMs,proto3,oneof" json:"window_overlap_ms,omitempty"`
-	IncrementalQuery    *string                `protobuf:"bytes,12,opt,name=incremental_query,json=incrementalQuery,proto3,oneof" json:"incremental_query,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type PauseSyncScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      *string                `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartSyncRequest) Reset() {
-	*x = StartSyncRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[30]
+func (x *PauseSyncScheduleRequest) Reset() {
+	*x = PauseSyncScheduleRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartSyncRequest) String() string {
+func (x *PauseSyncScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartSyncRequest) ProtoMessage() {}
+func (*PauseSyncScheduleRequest) ProtoMessage() {}
 
-func (x *StartSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[30]
+func (x *PauseSyncScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2439,117 +7160,92 @@ func (x *StartSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartSyncRequest.ProtoReflect.Descriptor instead.
-func (*StartSyncRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *StartSyncRequest) GetMode() SyncMode {
-	if x != nil && x.Mode != nil {
-		return *x.Mode
-	}
-	return SyncMode_SYNC_MODE_UNSPECIFIED
+// Deprecated: Use PauseSyncScheduleRequest.ProtoReflect.Descriptor instead.
+func (*PauseSyncScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *StartSyncRequest) GetRootFolderIds() []int64 {
+func (x *PauseSyncScheduleRequest) GetId() int64 {
 	if x != nil {
-		return x.RootFolderIds
-	}
-	return nil
-}
-
-func (x *StartSyncRequest) GetIncludeDepartments() bool {
-	if x != nil && x.IncludeDepartments != nil {
-		return *x.IncludeDepartments
-	}
-	return false
-}
-
-func (x *StartSyncRequest) GetPreserveRootCatalog() bool {
-	if x != nil && x.PreserveRootCatalog != nil {
-		return *x.PreserveRootCatalog
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-func (x *StartSyncRequest) GetDepartmentIds() []int64 {
-	if x != nil {
-		return x.DepartmentIds
+func (x *PauseSyncScheduleRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
-	return nil
+	return ""
 }
 
-func (x *StartSyncRequest) GetResumeProgress() bool {
-	if x != nil && x.ResumeProgress != nil {
-		return *x.ResumeProgress
-	}
-	return false
+type PauseSyncScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *SyncSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartSyncRequest) GetForceRebuild() bool {
-	if x != nil && x.ForceRebuild != nil {
-		return *x.ForceRebuild
-	}
-	return false
+func (x *PauseSyncScheduleResponse) Reset() {
+	*x = PauseSyncScheduleResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartSyncRequest) GetRootWorkers() int64 {
-	if x != nil && x.RootWorkers != nil {
-		return *x.RootWorkers
-	}
-	return 0
+func (x *PauseSyncScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StartSyncRequest) GetProgressEvery() int64 {
-	if x != nil && x.ProgressEvery != nil {
-		return *x.ProgressEvery
-	}
-	return 0
-}
+func (*PauseSyncScheduleResponse) ProtoMessage() {}
 
-func (x *StartSyncRequest) GetCheckpointTemplate() string {
-	if x != nil && x.CheckpointTemplate != nil {
-		return *x.CheckpointTemplate
+func (x *PauseSyncScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StartSyncRequest) GetWindowOverlapMs() int64 {
-	if x != nil && x.WindowOverlapMs != nil {
-		return *x.WindowOverlapMs
-	}
-	return 0
+// Deprecated: Use PauseSyncScheduleResponse.ProtoReflect.Descriptor instead.
+func (*PauseSyncScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *StartSyncRequest) GetIncrementalQuery() string {
-	if x != nil && x.IncrementalQuery != nil {
-		return *x.IncrementalQuery
+func (x *PauseSyncScheduleResponse) GetSchedule() *SyncSchedule {
+	if x != nil {
+		return x.Schedule
 	}
-	return ""
+	return nil
 }
 
-type StartSyncResponse struct {
+type ResumeSyncScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      *string                `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartSyncResponse) Reset() {
-	*x = StartSyncResponse{}
-	mi := &file_npan_v1_api_proto_msgTypes[31]
+func (x *ResumeSyncScheduleRequest) Reset() {
+	*x = ResumeSyncScheduleRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartSyncResponse) String() string {
+func (x *ResumeSyncScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartSyncResponse) ProtoMessage() {}
+func (*ResumeSyncScheduleRequest) ProtoMessage() {}
 
-func (x *StartSyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[31]
+func (x *ResumeSyncScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2560,40 +7256,47 @@ func (x *StartSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartSyncResponse.ProtoReflect.Descriptor instead.
-func (*StartSyncResponse) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ResumeSyncScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSyncScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *StartSyncResponse) GetMessage() string {
+func (x *ResumeSyncScheduleRequest) GetId() int64 {
 	if x != nil {
-		return x.Message
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResumeSyncScheduleRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
 	return ""
 }
 
-type InspectRootsRequest struct {
+type ResumeSyncScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FolderIds     []int64                `protobuf:"varint,1,rep,packed,name=folder_ids,json=folderIds,proto3" json:"folder_ids,omitempty"`
+	Schedule      *SyncSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InspectRootsRequest) Reset() {
-	*x = InspectRootsRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[32]
+func (x *ResumeSyncScheduleResponse) Reset() {
+	*x = ResumeSyncScheduleResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InspectRootsRequest) String() string {
+func (x *ResumeSyncScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InspectRootsRequest) ProtoMessage() {}
+func (*ResumeSyncScheduleResponse) ProtoMessage() {}
 
-func (x *InspectRootsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[32]
+func (x *ResumeSyncScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2604,41 +7307,41 @@ func (x *InspectRootsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InspectRootsRequest.ProtoReflect.Descriptor instead.
-func (*InspectRootsRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ResumeSyncScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSyncScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *InspectRootsRequest) GetFolderIds() []int64 {
+func (x *ResumeSyncScheduleResponse) GetSchedule() *SyncSchedule {
 	if x != nil {
-		return x.FolderIds
+		return x.Schedule
 	}
 	return nil
 }
 
-type InspectRootsResponse struct {
+type DeleteSyncScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*InspectRootItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Errors        []*InspectRootError    `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      *string                `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InspectRootsResponse) Reset() {
-	*x = InspectRootsResponse{}
-	mi := &file_npan_v1_api_proto_msgTypes[33]
+func (x *DeleteSyncScheduleRequest) Reset() {
+	*x = DeleteSyncScheduleRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InspectRootsResponse) String() string {
+func (x *DeleteSyncScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InspectRootsResponse) ProtoMessage() {}
+func (*DeleteSyncScheduleRequest) ProtoMessage() {}
 
-func (x *InspectRootsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[33]
+func (x *DeleteSyncScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2649,46 +7352,47 @@ func (x *InspectRootsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InspectRootsResponse.ProtoReflect.Descriptor instead.
-func (*InspectRootsResponse) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use DeleteSyncScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSyncScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *InspectRootsResponse) GetItems() []*InspectRootItem {
+func (x *DeleteSyncScheduleRequest) GetId() int64 {
 	if x != nil {
-		return x.Items
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *InspectRootsResponse) GetErrors() []*InspectRootError {
-	if x != nil {
-		return x.Errors
+func (x *DeleteSyncScheduleRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
-	return nil
+	return ""
 }
 
-type GetIndexStatsRequest struct {
+type DeleteSyncScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *GetIndexStatsRequest) Reset() {
-	*x = GetIndexStatsRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[34]
+
+func (x *DeleteSyncScheduleResponse) Reset() {
+	*x = DeleteSyncScheduleResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIndexStatsRequest) String() string {
+func (x *DeleteSyncScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIndexStatsRequest) ProtoMessage() {}
+func (*DeleteSyncScheduleResponse) ProtoMessage() {}
 
-func (x *GetIndexStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[34]
+func (x *DeleteSyncScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2699,33 +7403,40 @@ func (x *GetIndexStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIndexStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetIndexStatsRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use DeleteSyncScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSyncScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{102}
 }
 
-type GetIndexStatsResponse struct {
+func (x *DeleteSyncScheduleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TestNotificationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DocumentCount int64                  `protobuf:"varint,1,opt,name=document_count,json=documentCount,proto3" json:"document_count,omitempty"`
+	Sink          *string                `protobuf:"bytes,1,opt,name=sink,proto3,oneof" json:"sink,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIndexStatsResponse) Reset() {
-	*x = GetIndexStatsResponse{}
-	mi := &file_npan_v1_api_proto_msgTypes[35]
+func (x *TestNotificationRequest) Reset() {
+	*x = TestNotificationRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIndexStatsResponse) String() string {
+func (x *TestNotificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIndexStatsResponse) ProtoMessage() {}
+func (*TestNotificationRequest) ProtoMessage() {}
 
-func (x *GetIndexStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[35]
+func (x *TestNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2736,39 +7447,42 @@ func (x *GetIndexStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIndexStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetIndexStatsResponse) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use TestNotificationRequest.ProtoReflect.Descriptor instead.
+func (*TestNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetIndexStatsResponse) GetDocumentCount() int64 {
-	if x != nil {
-		return x.DocumentCount
+func (x *TestNotificationRequest) GetSink() string {
+	if x != nil && x.Sink != nil {
+		return *x.Sink
 	}
-	return 0
+	return ""
 }
 
-type GetSyncProgressRequest struct {
+type NotificationSinkResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sink          string                 `protobuf:"bytes,1,opt,name=sink,proto3" json:"sink,omitempty"`
+	Ok            bool                   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	Error         *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSyncProgressRequest) Reset() {
-	*x = GetSyncProgressRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[36]
+func (x *NotificationSinkResult) Reset() {
+	*x = NotificationSinkResult{}
+	mi := &file_npan_v1_api_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSyncProgressRequest) String() string {
+func (x *NotificationSinkResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSyncProgressRequest) ProtoMessage() {}
+func (*NotificationSinkResult) ProtoMessage() {}
 
-func (x *GetSyncProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[36]
+func (x *NotificationSinkResult) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2779,33 +7493,54 @@ func (x *GetSyncProgressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSyncProgressRequest.ProtoReflect.Descriptor instead.
-func (*GetSyncProgressRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use NotificationSinkResult.ProtoReflect.Descriptor instead.
+func (*NotificationSinkResult) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{104}
 }
 
-type GetSyncProgressResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         *SyncProgressState     `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+func (x *NotificationSinkResult) GetSink() string {
+	if x != nil {
+		return x.Sink
+	}
+	return ""
+}
+
+func (x *NotificationSinkResult) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *NotificationSinkResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type TestNotificationResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Results       []*NotificationSinkResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSyncProgressResponse) Reset() {
-	*x = GetSyncProgressResponse{}
-	mi := &file_npan_v1_api_proto_msgTypes[37]
+func (x *TestNotificationResponse) Reset() {
+	*x = TestNotificationResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSyncProgressResponse) String() string {
+func (x *TestNotificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSyncProgressResponse) ProtoMessage() {}
+func (*TestNotificationResponse) ProtoMessage() {}
 
-func (x *GetSyncProgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[37]
+func (x *TestNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2816,39 +7551,47 @@ func (x *GetSyncProgressResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSyncProgressResponse.ProtoReflect.Descriptor instead.
-func (*GetSyncProgressResponse) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use TestNotificationResponse.ProtoReflect.Descriptor instead.
+func (*TestNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GetSyncProgressResponse) GetState() *SyncProgressState {
+func (x *TestNotificationResponse) GetResults() []*NotificationSinkResult {
 	if x != nil {
-		return x.State
+		return x.Results
 	}
 	return nil
 }
 
-type WatchSyncProgressRequest struct {
+type IndexChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           int64                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Op            IndexChangeOp          `protobuf:"varint,2,opt,name=op,proto3,enum=npan.v1.IndexChangeOp" json:"op,omitempty"`
+	DocId         string                 `protobuf:"bytes,3,opt,name=doc_id,json=docId,proto3" json:"doc_id,omitempty"`
+	Document      *IndexDocument         `protobuf:"bytes,4,opt,name=document,proto3,oneof" json:"document,omitempty"`
+	RootFolderId  int64                  `protobuf:"varint,5,opt,name=root_folder_id,json=rootFolderId,proto3" json:"root_folder_id,omitempty"`
+	RunId         int64                  `protobuf:"varint,6,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Removed       int64                  `protobuf:"varint,7,opt,name=removed,proto3" json:"removed,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WatchSyncProgressRequest) Reset() {
-	*x = WatchSyncProgressRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[38]
+func (x *IndexChange) Reset() {
+	*x = IndexChange{}
+	mi := &file_npan_v1_api_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WatchSyncProgressRequest) String() string {
+func (x *IndexChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchSyncProgressRequest) ProtoMessage() {}
+func (*IndexChange) ProtoMessage() {}
 
-func (x *WatchSyncProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[38]
+func (x *IndexChange) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2859,76 +7602,91 @@ func (x *WatchSyncProgressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchSyncProgressRequest.ProtoReflect.Descriptor instead.
-func (*WatchSyncProgressRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use IndexChange.ProtoReflect.Descriptor instead.
+func (*IndexChange) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{106}
 }
 
-type WatchSyncProgressResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         *SyncProgressState     `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *IndexChange) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
 }
 
-func (x *WatchSyncProgressResponse) Reset() {
-	*x = WatchSyncProgressResponse{}
-	mi := &file_npan_v1_api_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *IndexChange) GetOp() IndexChangeOp {
+	if x != nil {
+		return x.Op
+	}
+	return IndexChangeOp_INDEX_CHANGE_OP_UNSPECIFIED
 }
 
-func (x *WatchSyncProgressResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *IndexChange) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
 }
 
-func (*WatchSyncProgressResponse) ProtoMessage() {}
+func (x *IndexChange) GetDocument() *IndexDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
 
-func (x *WatchSyncProgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[39]
+func (x *IndexChange) GetRootFolderId() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RootFolderId
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use WatchSyncProgressResponse.ProtoReflect.Descriptor instead.
-func (*WatchSyncProgressResponse) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{39}
+func (x *IndexChange) GetRunId() int64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
 }
 
-func (x *WatchSyncProgressResponse) GetState() *SyncProgressState {
+func (x *IndexChange) GetRemoved() int64 {
 	if x != nil {
-		return x.State
+		return x.Removed
 	}
-	return nil
+	return 0
 }
 
-type CancelSyncRequest struct {
+func (x *IndexChange) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+type WatchIndexChangesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSeq      int64                  `protobuf:"varint,1,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"`
+	FromLatest    *bool                  `protobuf:"varint,2,opt,name=from_latest,json=fromLatest,proto3,oneof" json:"from_latest,omitempty"`
+	TenantId      *string                `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelSyncRequest) Reset() {
-	*x = CancelSyncRequest{}
-	mi := &file_npan_v1_api_proto_msgTypes[40]
+func (x *WatchIndexChangesRequest) Reset() {
+	*x = WatchIndexChangesRequest{}
+	mi := &file_npan_v1_api_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelSyncRequest) String() string {
+func (x *WatchIndexChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelSyncRequest) ProtoMessage() {}
+func (*WatchIndexChangesRequest) ProtoMessage() {}
 
-func (x *CancelSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[40]
+func (x *WatchIndexChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2939,33 +7697,55 @@ func (x *CancelSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelSyncRequest.ProtoReflect.Descriptor instead.
-func (*CancelSyncRequest) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use WatchIndexChangesRequest.ProtoReflect.Descriptor instead.
+func (*WatchIndexChangesRequest) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{107}
 }
 
-type CancelSyncResponse struct {
+func (x *WatchIndexChangesRequest) GetAfterSeq() int64 {
+	if x != nil {
+		return x.AfterSeq
+	}
+	return 0
+}
+
+func (x *WatchIndexChangesRequest) GetFromLatest() bool {
+	if x != nil && x.FromLatest != nil {
+		return *x.FromLatest
+	}
+	return false
+}
+
+func (x *WatchIndexChangesRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+type WatchIndexChangesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Changes       []*IndexChange         `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	LatestSeq     int64                  `protobuf:"varint,2,opt,name=latest_seq,json=latestSeq,proto3" json:"latest_seq,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelSyncResponse) Reset() {
-	*x = CancelSyncResponse{}
-	mi := &file_npan_v1_api_proto_msgTypes[41]
+func (x *WatchIndexChangesResponse) Reset() {
+	*x = WatchIndexChangesResponse{}
+	mi := &file_npan_v1_api_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelSyncResponse) String() string {
+func (x *WatchIndexChangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelSyncResponse) ProtoMessage() {}
+func (*WatchIndexChangesResponse) ProtoMessage() {}
 
-func (x *CancelSyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_npan_v1_api_proto_msgTypes[41]
+func (x *WatchIndexChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_npan_v1_api_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2976,23 +7756,30 @@ func (x *CancelSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelSyncResponse.ProtoReflect.Descriptor instead.
-func (*CancelSyncResponse) Descriptor() ([]byte, []int) {
-	return file_npan_v1_api_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use WatchIndexChangesResponse.ProtoReflect.Descriptor instead.
+func (*WatchIndexChangesResponse) Descriptor() ([]byte, []int) {
+	return file_npan_v1_api_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *CancelSyncResponse) GetMessage() string {
+func (x *WatchIndexChangesResponse) GetChanges() []*IndexChange {
 	if x != nil {
-		return x.Message
+		return x.Changes
 	}
-	return ""
+	return nil
+}
+
+func (x *WatchIndexChangesResponse) GetLatestSeq() int64 {
+	if x != nil {
+		return x.LatestSeq
+	}
+	return 0
 }
 
 var File_npan_v1_api_proto protoreflect.FileDescriptor
 
 const file_npan_v1_api_proto_rawDesc = "" +
 	"\n" +
-	"\x11npan/v1/api.proto\x12\anpan.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9f\x03\n" +
+	"\x11npan/v1/api.proto\x12\anpan.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf2\x03\n" +
 	"\rIndexDocument\x12\x15\n" +
 	"\x06doc_id\x18\x01 \x01(\tR\x05docId\x12\x1b\n" +
 	"\tsource_id\x18\x02 \x01(\x03R\bsourceId\x12%\n" +
@@ -3010,8 +7797,12 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\bin_trash\x18\v \x01(\bR\ainTrash\x12\x1d\n" +
 	"\n" +
 	"is_deleted\x18\f \x01(\bR\tisDeleted\x12.\n" +
-	"\x10highlighted_name\x18\r \x01(\tH\x00R\x0fhighlightedName\x88\x01\x01B\x13\n" +
-	"\x11_highlighted_name\"Q\n" +
+	"\x10highlighted_name\x18\r \x01(\tH\x00R\x0fhighlightedName\x88\x01\x01\x12!\n" +
+	"\fancestor_ids\x18\x0e \x03(\x03R\vancestorIds\x12 \n" +
+	"\ttenant_id\x18\x0f \x01(\tH\x01R\btenantId\x88\x01\x01B\x13\n" +
+	"\x11_highlighted_nameB\f\n" +
+	"\n" +
+	"_tenant_id\"Q\n" +
 	"\vQueryResult\x12,\n" +
 	"\x05items\x18\x01 \x03(\v2\x16.npan.v1.IndexDocumentR\x05items\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\"\xae\x03\n" +
@@ -3028,7 +7819,7 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\bended_at\x18\b \x01(\x03R\aendedAt\x12>\n" +
 	"\rstarted_at_ts\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vstartedAtTs\x12:\n" +
 	"\vended_at_ts\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tendedAtTs\"\xda\x04\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tendedAtTs\"\xff\x04\n" +
 	"\x10RootSyncProgress\x12$\n" +
 	"\x0eroot_folder_id\x18\x01 \x01(\x03R\frootFolderId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x125\n" +
@@ -3042,13 +7833,14 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\x12current_page_count\x18\t \x01(\x03H\x03R\x10currentPageCount\x88\x01\x01\x12&\n" +
 	"\fqueue_length\x18\n" +
 	" \x01(\x03H\x04R\vqueueLength\x88\x01\x01\x12\x19\n" +
-	"\x05error\x18\v \x01(\tH\x05R\x05error\x88\x01\x01B\x17\n" +
+	"\x05error\x18\v \x01(\tH\x05R\x05error\x88\x01\x01\x12#\n" +
+	"\rstale_removed\x18\f \x01(\x03R\fstaleRemovedB\x17\n" +
 	"\x15_estimated_total_docsB\x14\n" +
 	"\x12_current_folder_idB\x12\n" +
 	"\x10_current_page_idB\x15\n" +
 	"\x13_current_page_countB\x0f\n" +
 	"\r_queue_lengthB\b\n" +
-	"\x06_error\"\x8f\x02\n" +
+	"\x06_error\"\xac\x05\n" +
 	"\x14IncrementalSyncStats\x12'\n" +
 	"\x0fchanges_fetched\x18\x01 \x01(\x03R\x0echangesFetched\x12\x1a\n" +
 	"\bupserted\x18\x02 \x01(\x03R\bupserted\x12\x18\n" +
@@ -3056,14 +7848,27 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\x0fskipped_upserts\x18\x04 \x01(\x03R\x0eskippedUpserts\x12'\n" +
 	"\x0fskipped_deletes\x18\x05 \x01(\x03R\x0eskippedDeletes\x12#\n" +
 	"\rcursor_before\x18\x06 \x01(\x03R\fcursorBefore\x12!\n" +
-	"\fcursor_after\x18\a \x01(\x03R\vcursorAfter\"\xf5\x01\n" +
+	"\fcursor_after\x18\a \x01(\x03R\vcursorAfter\x12#\n" +
+	"\rfolders_moved\x18\b \x01(\x03R\ffoldersMoved\x12'\n" +
+	"\x0fpaths_rewritten\x18\t \x01(\x03R\x0epathsRewritten\x122\n" +
+	"\x15path_rewrites_pending\x18\n" +
+	" \x01(\x03R\x13pathRewritesPending\x12'\n" +
+	"\x0fcascade_deleted\x18\v \x01(\x03R\x0ecascadeDeleted\x12)\n" +
+	"\x10folders_restored\x18\f \x01(\x03R\x0ffoldersRestored\x12#\n" +
+	"\rrestored_docs\x18\r \x01(\x03R\frestoredDocs\x12)\n" +
+	"\x10recrawls_pending\x18\x0e \x01(\x03R\x0frecrawlsPending\x12'\n" +
+	"\x0fwindows_fetched\x18\x0f \x01(\x03R\x0ewindowsFetched\x12#\n" +
+	"\rwindows_split\x18\x10 \x01(\x03R\fwindowsSplit\x12'\n" +
+	"\x0fwindows_pending\x18\x11 \x01(\x03R\x0ewindowsPending\"\xc6\x02\n" +
 	"\x10SyncVerification\x12&\n" +
 	"\x0fmeili_doc_count\x18\x01 \x01(\x03R\rmeiliDocCount\x12*\n" +
 	"\x11crawled_doc_count\x18\x02 \x01(\x03R\x0fcrawledDocCount\x120\n" +
 	"\x14discovered_doc_count\x18\x03 \x01(\x03R\x12discoveredDocCount\x12#\n" +
 	"\rskipped_count\x18\x04 \x01(\x03R\fskippedCount\x12\x1a\n" +
 	"\bverified\x18\x05 \x01(\bR\bverified\x12\x1a\n" +
-	"\bwarnings\x18\x06 \x03(\tR\bwarnings\"\xa2\v\n" +
+	"\bwarnings\x18\x06 \x03(\tR\bwarnings\x12#\n" +
+	"\rstale_removed\x18\a \x01(\x03R\fstaleRemoved\x12*\n" +
+	"\x11dead_letter_count\x18\b \x01(\x03R\x0fdeadLetterCount\"\xd3\r\n" +
 	"\x11SyncProgressState\x12+\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x13.npan.v1.SyncStatusR\x06status\x12*\n" +
 	"\x04mode\x18\x02 \x01(\x0e2\x11.npan.v1.SyncModeH\x00R\x04mode\x88\x01\x01\x12\x1d\n" +
@@ -3088,7 +7893,12 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"last_error\x18\x0f \x01(\tH\x03R\tlastError\x88\x01\x01\x12B\n" +
 	"\fverification\x18\x10 \x01(\v2\x19.npan.v1.SyncVerificationH\x04R\fverification\x88\x01\x01\x12>\n" +
 	"\rstarted_at_ts\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vstartedAtTs\x12>\n" +
-	"\rupdated_at_ts\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedAtTs\x1a<\n" +
+	"\rupdated_at_ts\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedAtTs\x12#\n" +
+	"\rstale_removed\x18\x13 \x01(\x03R\fstaleRemoved\x129\n" +
+	"\arebuild\x18\x14 \x01(\v2\x1a.npan.v1.IndexRebuildStateH\x05R\arebuild\x88\x01\x01\x123\n" +
+	"\adry_run\x18\x15 \x01(\v2\x15.npan.v1.DryRunReportH\x06R\x06dryRun\x88\x01\x01\x12A\n" +
+	"\frate_control\x18\x16 \x01(\v2\x19.npan.v1.RateControlStateH\aR\vrateControl\x88\x01\x01\x12 \n" +
+	"\tpaused_at\x18\x17 \x01(\x03H\bR\bpausedAt\x88\x01\x01\x1a<\n" +
 	"\x0eRootNamesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aZ\n" +
@@ -3105,7 +7915,74 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\f_active_rootB\x14\n" +
 	"\x12_incremental_statsB\r\n" +
 	"\v_last_errorB\x0f\n" +
-	"\r_verification\"\x84\x01\n" +
+	"\r_verificationB\n" +
+	"\n" +
+	"\b_rebuildB\n" +
+	"\n" +
+	"\b_dry_runB\x0f\n" +
+	"\r_rate_controlB\f\n" +
+	"\n" +
+	"_paused_at\"\x98\x02\n" +
+	"\x10RateControlState\x12\x1b\n" +
+	"\tbase_rate\x18\x01 \x01(\x01R\bbaseRate\x12%\n" +
+	"\x0eeffective_rate\x18\x02 \x01(\x01R\reffectiveRate\x12\x18\n" +
+	"\abackoff\x18\x03 \x01(\bR\abackoff\x12!\n" +
+	"\fpaused_until\x18\x04 \x01(\x03R\vpausedUntil\x12'\n" +
+	"\x0fthrottle_events\x18\x05 \x01(\x03R\x0ethrottleEvents\x12(\n" +
+	"\x10last_throttle_at\x18\x06 \x01(\x03R\x0elastThrottleAt\x120\n" +
+	"\x14last_throttle_status\x18\a \x01(\x05R\x12lastThrottleStatus\"\xa4\x01\n" +
+	"\fDryRunSample\x12\x15\n" +
+	"\x06doc_id\x18\x01 \x01(\tR\x05docId\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\x03R\bsourceId\x12%\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x11.npan.v1.ItemTypeR\x04type\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12%\n" +
+	"\x0echanged_fields\x18\x05 \x03(\tR\rchangedFields\"\xed\x02\n" +
+	"\x0eDryRunRootDiff\x12$\n" +
+	"\x0eroot_folder_id\x18\x01 \x01(\x03R\frootFolderId\x12\x1b\n" +
+	"\troot_name\x18\x02 \x01(\tR\brootName\x12\x12\n" +
+	"\x04adds\x18\x03 \x01(\x03R\x04adds\x12\x18\n" +
+	"\aupdates\x18\x04 \x01(\x03R\aupdates\x12\x18\n" +
+	"\adeletes\x18\x05 \x01(\x03R\adeletes\x12\x1c\n" +
+	"\tunchanged\x18\x06 \x01(\x03R\tunchanged\x126\n" +
+	"\vsample_adds\x18\a \x03(\v2\x15.npan.v1.DryRunSampleR\n" +
+	"sampleAdds\x12<\n" +
+	"\x0esample_updates\x18\b \x03(\v2\x15.npan.v1.DryRunSampleR\rsampleUpdates\x12<\n" +
+	"\x0esample_deletes\x18\t \x03(\v2\x15.npan.v1.DryRunSampleR\rsampleDeletes\"\xa5\x03\n" +
+	"\fDryRunReport\x12*\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x11.npan.v1.SyncModeH\x00R\x04mode\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\x03R\tstartedAt\x12$\n" +
+	"\vfinished_at\x18\x03 \x01(\x03H\x01R\n" +
+	"finishedAt\x88\x01\x01\x12-\n" +
+	"\x05roots\x18\x04 \x03(\v2\x17.npan.v1.DryRunRootDiffR\x05roots\x12\x12\n" +
+	"\x04adds\x18\x05 \x01(\x03R\x04adds\x12\x18\n" +
+	"\aupdates\x18\x06 \x01(\x03R\aupdates\x12\x18\n" +
+	"\adeletes\x18\a \x01(\x03R\adeletes\x12+\n" +
+	"\x06status\x18\b \x01(\x0e2\x13.npan.v1.SyncStatusR\x06status\x12\"\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tH\x02R\tlastError\x88\x01\x01\x12$\n" +
+	"\vactive_root\x18\n" +
+	" \x01(\x03H\x03R\n" +
+	"activeRoot\x88\x01\x01B\a\n" +
+	"\x05_modeB\x0e\n" +
+	"\f_finished_atB\r\n" +
+	"\v_last_errorB\x0e\n" +
+	"\f_active_root\"\xcd\x02\n" +
+	"\x11IndexRebuildState\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.npan.v1.IndexRebuildStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"live_index\x18\x02 \x01(\tR\tliveIndex\x12!\n" +
+	"\fshadow_index\x18\x03 \x01(\tR\vshadowIndex\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\x03R\tstartedAt\x12\"\n" +
+	"\n" +
+	"swapped_at\x18\x05 \x01(\x03H\x00R\tswappedAt\x88\x01\x01\x12)\n" +
+	"\x0erolled_back_at\x18\x06 \x01(\x03H\x01R\frolledBackAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tH\x02R\tlastError\x88\x01\x01B\r\n" +
+	"\v_swapped_atB\x11\n" +
+	"\x0f_rolled_back_atB\r\n" +
+	"\v_last_error\"\x84\x01\n" +
 	"\rErrorResponse\x12&\n" +
 	"\x04code\x18\x01 \x01(\x0e2\x12.npan.v1.ErrorCodeR\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
@@ -3141,32 +8018,49 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\x0eHealthResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
 	"\frunning_sync\x18\x02 \x01(\bR\vrunningSync\"\x0f\n" +
-	"\rReadyzRequest\"c\n" +
+	"\rReadyzRequest\"\xc3\x01\n" +
 	"\x0eReadyzResponse\x12,\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x14.npan.v1.ReadyStatusR\x06status\x12\x19\n" +
-	"\x05meili\x18\x02 \x01(\tH\x00R\x05meili\x88\x01\x01B\b\n" +
-	"\x06_meili\"\x18\n" +
-	"\x16GetSearchConfigRequest\"\xc3\x01\n" +
+	"\x05meili\x18\x02 \x01(\tH\x00R\x05meili\x88\x01\x01\x12\x1e\n" +
+	"\bnpan_api\x18\x03 \x01(\tH\x01R\anpanApi\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"npan_token\x18\x04 \x01(\tH\x02R\tnpanToken\x88\x01\x01B\b\n" +
+	"\x06_meiliB\v\n" +
+	"\t_npan_apiB\r\n" +
+	"\v_npan_token\"H\n" +
+	"\x16GetSearchConfigRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"\xe0\x01\n" +
 	"\x17GetSearchConfigResponse\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x1d\n" +
 	"\n" +
 	"index_name\x18\x02 \x01(\tR\tindexName\x12$\n" +
 	"\x0esearch_api_key\x18\x03 \x01(\tR\fsearchApiKey\x123\n" +
 	"\x15instantsearch_enabled\x18\x04 \x01(\bR\x14instantsearchEnabled\x12\x1a\n" +
-	"\bprovider\x18\x05 \x01(\tR\bprovider\"\x8e\x01\n" +
+	"\bprovider\x18\x05 \x01(\tR\bprovider\x12\x1b\n" +
+	"\ttenant_id\x18\x06 \x01(\tR\btenantId\"\x8b\x02\n" +
 	"\x10AppSearchRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12 \n" +
 	"\x04page\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\x04page\x88\x01\x01\x12+\n" +
-	"\tpage_size\x18\x03 \x01(\x03B\t\xbaH\x06\"\x04\x18d \x00H\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\tpage_size\x18\x03 \x01(\x03B\t\xbaH\x06\"\x04\x18d \x00H\x01R\bpageSize\x88\x01\x01\x126\n" +
+	"\x10within_folder_id\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x02R\x0ewithinFolderId\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x05 \x01(\tH\x03R\btenantId\x88\x01\x01B\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
-	"_page_size\"A\n" +
+	"_page_sizeB\x13\n" +
+	"\x11_within_folder_idB\f\n" +
+	"\n" +
+	"_tenant_id\"A\n" +
 	"\x11AppSearchResponse\x12,\n" +
-	"\x06result\x18\x01 \x01(\v2\x14.npan.v1.QueryResultR\x06result\"i\n" +
+	"\x06result\x18\x01 \x01(\v2\x14.npan.v1.QueryResultR\x06result\"\x99\x01\n" +
 	"\x15AppDownloadURLRequest\x12\x17\n" +
 	"\afile_id\x18\x01 \x01(\x03R\x06fileId\x12&\n" +
-	"\fvalid_period\x18\x02 \x01(\x03H\x00R\vvalidPeriod\x88\x01\x01B\x0f\n" +
-	"\r_valid_period\"L\n" +
+	"\fvalid_period\x18\x02 \x01(\x03H\x00R\vvalidPeriod\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x03 \x01(\tH\x01R\btenantId\x88\x01\x01B\x0f\n" +
+	"\r_valid_periodB\f\n" +
+	"\n" +
+	"_tenant_id\"L\n" +
 	"\x16AppDownloadURLResponse\x122\n" +
 	"\x06result\x18\x01 \x01(\v2\x1a.npan.v1.DownloadURLResultR\x06result\"\xac\x02\n" +
 	"\x12CreateTokenRequest\x12\x19\n" +
@@ -3198,7 +8092,7 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\b_page_idB\x0f\n" +
 	"\r_query_filterB\x13\n" +
 	"\x11_search_in_folderB\x15\n" +
-	"\x13_updated_time_range\"\x9f\x03\n" +
+	"\x13_updated_time_range\"\x9c\x04\n" +
 	"\x12LocalSearchRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12 \n" +
 	"\x04page\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\x04page\x88\x01\x01\x12+\n" +
@@ -3207,7 +8101,10 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\tparent_id\x18\x05 \x01(\x03H\x03R\bparentId\x88\x01\x01\x12(\n" +
 	"\rupdated_after\x18\x06 \x01(\x03H\x04R\fupdatedAfter\x88\x01\x01\x12*\n" +
 	"\x0eupdated_before\x18\a \x01(\x03H\x05R\rupdatedBefore\x88\x01\x01\x12,\n" +
-	"\x0finclude_deleted\x18\b \x01(\bH\x06R\x0eincludeDeleted\x88\x01\x01B\a\n" +
+	"\x0finclude_deleted\x18\b \x01(\bH\x06R\x0eincludeDeleted\x88\x01\x01\x126\n" +
+	"\x10within_folder_id\x18\t \x01(\x03B\a\xbaH\x04\"\x02(\x00H\aR\x0ewithinFolderId\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\n" +
+	" \x01(\tH\bR\btenantId\x88\x01\x01B\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
 	"_page_sizeB\a\n" +
@@ -3216,15 +8113,21 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"_parent_idB\x10\n" +
 	"\x0e_updated_afterB\x11\n" +
 	"\x0f_updated_beforeB\x12\n" +
-	"\x10_include_deleted\"C\n" +
+	"\x10_include_deletedB\x13\n" +
+	"\x11_within_folder_idB\f\n" +
+	"\n" +
+	"_tenant_id\"C\n" +
 	"\x13LocalSearchResponse\x12,\n" +
-	"\x06result\x18\x01 \x01(\v2\x14.npan.v1.QueryResultR\x06result\"f\n" +
+	"\x06result\x18\x01 \x01(\v2\x14.npan.v1.QueryResultR\x06result\"\x96\x01\n" +
 	"\x12DownloadURLRequest\x12\x17\n" +
 	"\afile_id\x18\x01 \x01(\x03R\x06fileId\x12&\n" +
-	"\fvalid_period\x18\x02 \x01(\x03H\x00R\vvalidPeriod\x88\x01\x01B\x0f\n" +
-	"\r_valid_period\"I\n" +
+	"\fvalid_period\x18\x02 \x01(\x03H\x00R\vvalidPeriod\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x03 \x01(\tH\x01R\btenantId\x88\x01\x01B\x0f\n" +
+	"\r_valid_periodB\f\n" +
+	"\n" +
+	"_tenant_id\"I\n" +
 	"\x13DownloadURLResponse\x122\n" +
-	"\x06result\x18\x01 \x01(\v2\x1a.npan.v1.DownloadURLResultR\x06result\"\xc1\x06\n" +
+	"\x06result\x18\x01 \x01(\v2\x1a.npan.v1.DownloadURLResultR\x06result\"\xa2\b\n" +
 	"\x10StartSyncRequest\x12*\n" +
 	"\x04mode\x18\x01 \x01(\x0e2\x11.npan.v1.SyncModeH\x00R\x04mode\x88\x01\x01\x124\n" +
 	"\x0froot_folder_ids\x18\x02 \x03(\x03B\f\xbaH\t\x92\x01\x06\"\x04\"\x02 \x00R\rrootFolderIds\x124\n" +
@@ -3238,7 +8141,12 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\x13checkpoint_template\x18\n" +
 	" \x01(\tH\aR\x12checkpointTemplate\x88\x01\x01\x128\n" +
 	"\x11window_overlap_ms\x18\v \x01(\x03B\a\xbaH\x04\"\x02(\x00H\bR\x0fwindowOverlapMs\x88\x01\x01\x120\n" +
-	"\x11incremental_query\x18\f \x01(\tH\tR\x10incrementalQuery\x88\x01\x01B\a\n" +
+	"\x11incremental_query\x18\f \x01(\tH\tR\x10incrementalQuery\x88\x01\x01\x123\n" +
+	"\x0efolder_workers\x18\r \x01(\x03B\a\xbaH\x04\"\x02 \x00H\n" +
+	"R\rfolderWorkers\x88\x01\x01\x12*\n" +
+	"\x0eshadow_rebuild\x18\x0e \x01(\bH\vR\rshadowRebuild\x88\x01\x01\x12\x1c\n" +
+	"\adry_run\x18\x0f \x01(\bH\fR\x06dryRun\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x10 \x01(\tH\rR\btenantId\x88\x01\x01B\a\n" +
 	"\x05_modeB\x16\n" +
 	"\x14_include_departmentsB\x18\n" +
 	"\x16_preserve_root_catalogB\x12\n" +
@@ -3248,7 +8156,13 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\x0f_progress_everyB\x16\n" +
 	"\x14_checkpoint_templateB\x14\n" +
 	"\x12_window_overlap_msB\x14\n" +
-	"\x12_incremental_query\"-\n" +
+	"\x12_incremental_queryB\x11\n" +
+	"\x0f_folder_workersB\x11\n" +
+	"\x0f_shadow_rebuildB\n" +
+	"\n" +
+	"\b_dry_runB\f\n" +
+	"\n" +
+	"_tenant_id\"-\n" +
 	"\x11StartSyncResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"D\n" +
 	"\x13InspectRootsRequest\x12-\n" +
@@ -3256,23 +8170,425 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"folder_ids\x18\x01 \x03(\x03B\x0e\xbaH\v\x92\x01\b\b\x01\"\x04\"\x02 \x00R\tfolderIds\"y\n" +
 	"\x14InspectRootsResponse\x12.\n" +
 	"\x05items\x18\x01 \x03(\v2\x18.npan.v1.InspectRootItemR\x05items\x121\n" +
-	"\x06errors\x18\x02 \x03(\v2\x19.npan.v1.InspectRootErrorR\x06errors\"\x16\n" +
-	"\x14GetIndexStatsRequest\">\n" +
+	"\x06errors\x18\x02 \x03(\v2\x19.npan.v1.InspectRootErrorR\x06errors\"F\n" +
+	"\x14GetIndexStatsRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"~\n" +
 	"\x15GetIndexStatsResponse\x12%\n" +
-	"\x0edocument_count\x18\x01 \x01(\x03R\rdocumentCount\"\x18\n" +
-	"\x16GetSyncProgressRequest\"K\n" +
+	"\x0edocument_count\x18\x01 \x01(\x03R\rdocumentCount\x124\n" +
+	"\x05token\x18\x02 \x01(\v2\x19.npan.v1.OAuthTokenStatusH\x00R\x05token\x88\x01\x01B\b\n" +
+	"\x06_token\"\xea\x01\n" +
+	"\x10OAuthTokenStatus\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\x12!\n" +
+	"\frefreshed_at\x18\x03 \x01(\x03R\vrefreshedAt\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12#\n" +
+	"\rrefresh_count\x18\x05 \x01(\x03R\frefreshCount\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x12\"\n" +
+	"\rlast_error_at\x18\a \x01(\x03R\vlastErrorAt\"H\n" +
+	"\x16GetSyncProgressRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"K\n" +
 	"\x17GetSyncProgressResponse\x120\n" +
-	"\x05state\x18\x01 \x01(\v2\x1a.npan.v1.SyncProgressStateR\x05state\"\x1a\n" +
-	"\x18WatchSyncProgressRequest\"M\n" +
+	"\x05state\x18\x01 \x01(\v2\x1a.npan.v1.SyncProgressStateR\x05state\"J\n" +
+	"\x18WatchSyncProgressRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"M\n" +
 	"\x19WatchSyncProgressResponse\x120\n" +
-	"\x05state\x18\x01 \x01(\v2\x1a.npan.v1.SyncProgressStateR\x05state\"\x13\n" +
-	"\x11CancelSyncRequest\".\n" +
+	"\x05state\x18\x01 \x01(\v2\x1a.npan.v1.SyncProgressStateR\x05state\"C\n" +
+	"\x11CancelSyncRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\".\n" +
 	"\x12CancelSyncResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage*O\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"B\n" +
+	"\x10PauseSyncRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"-\n" +
+	"\x11PauseSyncResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"C\n" +
+	"\x11ResumeSyncRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\".\n" +
+	"\x12ResumeSyncResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xb9\x01\n" +
+	"\tSyncLease\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x1f\n" +
+	"\vacquired_at\x18\x03 \x01(\x03R\n" +
+	"acquiredAt\x12!\n" +
+	"\fheartbeat_at\x18\x04 \x01(\x03R\vheartbeatAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\x12\x18\n" +
+	"\aexpired\x18\x06 \x01(\bR\aexpired\"E\n" +
+	"\x13GetSyncLeaseRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"O\n" +
+	"\x14GetSyncLeaseResponse\x12-\n" +
+	"\x05lease\x18\x01 \x01(\v2\x12.npan.v1.SyncLeaseH\x00R\x05lease\x88\x01\x01B\b\n" +
+	"\x06_lease\"N\n" +
+	"\x1cForceReleaseSyncLeaseRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"{\n" +
+	"\x1dForceReleaseSyncLeaseResponse\x123\n" +
+	"\breleased\x18\x01 \x01(\v2\x12.npan.v1.SyncLeaseH\x00R\breleased\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessageB\v\n" +
+	"\t_released\"\x8d\x04\n" +
+	"\x14FolderResyncProgress\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\x03R\bfolderId\x12\x1f\n" +
+	"\vfolder_name\x18\x02 \x01(\tR\n" +
+	"folderName\x12-\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x19.npan.v1.FolderResyncModeR\x04mode\x12+\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x13.npan.v1.SyncStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\x03R\tstartedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\x12$\n" +
+	"\vfinished_at\x18\a \x01(\x03H\x00R\n" +
+	"finishedAt\x88\x01\x01\x12!\n" +
+	"\fdocs_deleted\x18\b \x01(\x03R\vdocsDeleted\x12!\n" +
+	"\fdocs_written\x18\t \x01(\x03R\vdocsWritten\x12'\n" +
+	"\x0ffolders_visited\x18\n" +
+	" \x01(\x03R\x0efoldersVisited\x12/\n" +
+	"\x11current_folder_id\x18\v \x01(\x03H\x01R\x0fcurrentFolderId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\f \x01(\tH\x02R\tlastError\x88\x01\x01B\x0e\n" +
+	"\f_finished_atB\x14\n" +
+	"\x12_current_folder_idB\r\n" +
+	"\v_last_error\"\xa8\x01\n" +
+	"\x13ResyncFolderRequest\x12$\n" +
+	"\tfolder_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bfolderId\x122\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x19.npan.v1.FolderResyncModeH\x00R\x04mode\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x03 \x01(\tH\x01R\btenantId\x88\x01\x01B\a\n" +
+	"\x05_modeB\f\n" +
+	"\n" +
+	"_tenant_id\"0\n" +
+	"\x14ResyncFolderResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"P\n" +
+	"\x1eGetFolderResyncProgressRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"\\\n" +
+	"\x1fGetFolderResyncProgressResponse\x129\n" +
+	"\bprogress\x18\x01 \x01(\v2\x1d.npan.v1.FolderResyncProgressR\bprogress\"K\n" +
+	"\x19CancelFolderResyncRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"6\n" +
+	"\x1aCancelFolderResyncResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"M\n" +
+	"\x1bRollbackIndexRebuildRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"T\n" +
+	"\x1cRollbackIndexRebuildResponse\x124\n" +
+	"\arebuild\x18\x01 \x01(\v2\x1a.npan.v1.IndexRebuildStateR\arebuild\"\xe6\x04\n" +
+	"\aSyncRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x11.npan.v1.SyncModeR\x04mode\x12+\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.npan.v1.SyncStatusR\x06status\x12\x14\n" +
+	"\x05roots\x18\x04 \x03(\x03R\x05roots\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\x03R\tstartedAt\x12>\n" +
+	"\rstarted_at_ts\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vstartedAtTs\x12\x19\n" +
+	"\bended_at\x18\a \x01(\x03R\aendedAt\x12:\n" +
+	"\vended_at_ts\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tendedAtTs\x12\x1f\n" +
+	"\vduration_ms\x18\t \x01(\x03R\n" +
+	"durationMs\x12)\n" +
+	"\x05stats\x18\n" +
+	" \x01(\v2\x13.npan.v1.CrawlStatsR\x05stats\x12O\n" +
+	"\x11incremental_stats\x18\v \x01(\v2\x1d.npan.v1.IncrementalSyncStatsH\x00R\x10incrementalStats\x88\x01\x01\x12B\n" +
+	"\fverification\x18\f \x01(\v2\x19.npan.v1.SyncVerificationH\x01R\fverification\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\r \x01(\tH\x02R\x05error\x88\x01\x01B\x14\n" +
+	"\x12_incremental_statsB\x0f\n" +
+	"\r_verificationB\b\n" +
+	"\x06_error\"\xe4\x01\n" +
+	"\x13ListSyncRunsRequest\x12*\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x11.npan.v1.SyncModeH\x00R\x04mode\x88\x01\x01\x12%\n" +
+	"\x05limit\x18\x02 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xc8\x01 \x00H\x01R\x05limit\x88\x01\x01\x12)\n" +
+	"\tbefore_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x02R\bbeforeId\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x04 \x01(\tH\x03R\btenantId\x88\x01\x01B\a\n" +
+	"\x05_modeB\b\n" +
+	"\x06_limitB\f\n" +
+	"\n" +
+	"_before_idB\f\n" +
+	"\n" +
+	"_tenant_id\"z\n" +
+	"\x14ListSyncRunsResponse\x12$\n" +
+	"\x04runs\x18\x01 \x03(\v2\x10.npan.v1.SyncRunR\x04runs\x12)\n" +
+	"\x0enext_before_id\x18\x02 \x01(\x03H\x00R\fnextBeforeId\x88\x01\x01B\x11\n" +
+	"\x0f_next_before_id\"\\\n" +
+	"\x11GetSyncRunRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\ttenant_id\x18\x02 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"8\n" +
+	"\x12GetSyncRunResponse\x12\"\n" +
+	"\x03run\x18\x01 \x01(\v2\x10.npan.v1.SyncRunR\x03run\"\xff\x02\n" +
+	"\n" +
+	"DeadLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\x03R\x05runId\x12$\n" +
+	"\x0eroot_folder_id\x18\x03 \x01(\x03R\frootFolderId\x12\x1b\n" +
+	"\tdoc_count\x18\x04 \x01(\x03R\bdocCount\x12\x17\n" +
+	"\adoc_ids\x18\x05 \x03(\tR\x06docIds\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x03R\battempts\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12>\n" +
+	"\rcreated_at_ts\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\x12>\n" +
+	"\rupdated_at_ts\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedAtTs\"\xf9\x01\n" +
+	"\x16ListDeadLettersRequest\x122\n" +
+	"\x0eroot_folder_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\frootFolderId\x88\x01\x01\x12%\n" +
+	"\x05limit\x18\x02 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xc8\x01 \x00H\x01R\x05limit\x88\x01\x01\x12)\n" +
+	"\tbefore_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x02R\bbeforeId\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x04 \x01(\tH\x03R\btenantId\x88\x01\x01B\x11\n" +
+	"\x0f_root_folder_idB\b\n" +
+	"\x06_limitB\f\n" +
+	"\n" +
+	"_before_idB\f\n" +
+	"\n" +
+	"_tenant_id\"\xa5\x01\n" +
+	"\x17ListDeadLettersResponse\x126\n" +
+	"\fdead_letters\x18\x01 \x03(\v2\x13.npan.v1.DeadLetterR\vdeadLetters\x12)\n" +
+	"\x0enext_before_id\x18\x02 \x01(\x03H\x00R\fnextBeforeId\x88\x01\x01\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05totalB\x11\n" +
+	"\x0f_next_before_id\"\x7f\n" +
+	"\x18ReplayDeadLettersRequest\x12!\n" +
+	"\x03ids\x18\x01 \x03(\x03B\x0f\xbaH\f\x92\x01\t\x10\xf4\x03\"\x04\"\x02 \x00R\x03ids\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\x12 \n" +
+	"\ttenant_id\x18\x03 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"\xa2\x01\n" +
+	"\x19ReplayDeadLettersResponse\x12!\n" +
+	"\freplayed_ids\x18\x01 \x03(\x03R\vreplayedIds\x12\x1d\n" +
+	"\n" +
+	"failed_ids\x18\x02 \x03(\x03R\tfailedIds\x12\x1c\n" +
+	"\tremaining\x18\x03 \x01(\x03R\tremaining\x12%\n" +
+	"\x0esuperseded_ids\x18\x04 \x03(\x03R\rsupersededIds\"\x80\x01\n" +
+	"\x19DiscardDeadLettersRequest\x12!\n" +
+	"\x03ids\x18\x01 \x03(\x03B\x0f\xbaH\f\x92\x01\t\x10\xf4\x03\"\x04\"\x02 \x00R\x03ids\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\x12 \n" +
+	"\ttenant_id\x18\x03 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"X\n" +
+	"\x1aDiscardDeadLettersResponse\x12\x1c\n" +
+	"\tdiscarded\x18\x01 \x01(\x03R\tdiscarded\x12\x1c\n" +
+	"\tremaining\x18\x02 \x01(\x03R\tremaining\"\x7f\n" +
+	"\rDuplicateFile\x12\x15\n" +
+	"\x06doc_id\x18\x01 \x01(\tR\x05docId\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\x03R\bsourceId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\"\xa1\x01\n" +
+	"\x0eDuplicateGroup\x12\x12\n" +
+	"\x04sha1\x18\x01 \x01(\tR\x04sha1\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06copies\x18\x03 \x01(\x03R\x06copies\x12!\n" +
+	"\fwasted_bytes\x18\x04 \x01(\x03R\vwastedBytes\x12,\n" +
+	"\x05files\x18\x05 \x03(\v2\x16.npan.v1.DuplicateFileR\x05files\"\xb3\x02\n" +
+	"\x15FindDuplicatesRequest\x122\n" +
+	"\x0eroot_folder_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\frootFolderId\x88\x01\x01\x12\"\n" +
+	"\bmin_size\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\aminSize\x12%\n" +
+	"\x05limit\x18\x03 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xe8\a \x00H\x01R\x05limit\x88\x01\x01\x12<\n" +
+	"\rexport_format\x18\x04 \x01(\tB\x12\xbaH\x0fr\rR\x03csvR\x06ndjsonH\x02R\fexportFormat\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x05 \x01(\tH\x03R\btenantId\x88\x01\x01B\x11\n" +
+	"\x0f_root_folder_idB\b\n" +
+	"\x06_limitB\x10\n" +
+	"\x0e_export_formatB\f\n" +
+	"\n" +
+	"_tenant_id\"\xc5\x01\n" +
+	"\x16FindDuplicatesResponse\x12/\n" +
+	"\x06groups\x18\x01 \x03(\v2\x17.npan.v1.DuplicateGroupR\x06groups\x12!\n" +
+	"\fwasted_bytes\x18\x02 \x01(\x03R\vwastedBytes\x12\x16\n" +
+	"\x06export\x18\x03 \x01(\tR\x06export\x12!\n" +
+	"\ftotal_groups\x18\x04 \x01(\x03R\vtotalGroups\x12\x1c\n" +
+	"\ttruncated\x18\x05 \x01(\bR\ttruncated\"\xf1\x01\n" +
+	"\x11ReconciliationRow\x12$\n" +
+	"\x0eroot_folder_id\x18\x01 \x01(\x03R\frootFolderId\x12\x1b\n" +
+	"\tfolder_id\x18\x02 \x01(\x03R\bfolderId\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12%\n" +
+	"\x0eupstream_items\x18\x04 \x01(\x03R\rupstreamItems\x12#\n" +
+	"\rindexed_items\x18\x05 \x01(\x03R\findexedItems\x12\x14\n" +
+	"\x05drift\x18\x06 \x01(\x03R\x05drift\x12\x19\n" +
+	"\x05error\x18\a \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"\xd6\x02\n" +
+	"\x14ReconciliationReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12+\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x13.npan.v1.SyncStatusR\x06status\x12\x14\n" +
+	"\x05roots\x18\x03 \x03(\x03R\x05roots\x12\x1f\n" +
+	"\vsample_size\x18\x04 \x01(\x03R\n" +
+	"sampleSize\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\x03R\tstartedAt\x12$\n" +
+	"\vfinished_at\x18\x06 \x01(\x03H\x00R\n" +
+	"finishedAt\x88\x01\x01\x12'\n" +
+	"\x0ffolders_checked\x18\a \x01(\x03R\x0efoldersChecked\x12'\n" +
+	"\x0ffolders_drifted\x18\b \x01(\x03R\x0efoldersDrifted\x12\x19\n" +
+	"\x05error\x18\t \x01(\tH\x01R\x05error\x88\x01\x01B\x0e\n" +
+	"\f_finished_atB\b\n" +
+	"\x06_error\"\xc1\x01\n" +
+	"\x1aStartReconciliationRequest\x124\n" +
+	"\x0froot_folder_ids\x18\x01 \x03(\x03B\f\xbaH\t\x92\x01\x06\"\x04\"\x02 \x00R\rrootFolderIds\x12-\n" +
+	"\vsample_size\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\n" +
+	"sampleSize\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x03 \x01(\tH\x01R\btenantId\x88\x01\x01B\x0e\n" +
+	"\f_sample_sizeB\f\n" +
+	"\n" +
+	"_tenant_id\"T\n" +
+	"\x1bStartReconciliationResponse\x125\n" +
+	"\x06report\x18\x01 \x01(\v2\x1d.npan.v1.ReconciliationReportR\x06report\"\xed\x01\n" +
+	"\x1eGetReconciliationReportRequest\x12)\n" +
+	"\treport_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\breportId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"only_drift\x18\x02 \x01(\bH\x01R\tonlyDrift\x88\x01\x01\x12%\n" +
+	"\x05limit\x18\x03 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xe8\a \x00H\x02R\x05limit\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x04 \x01(\tH\x03R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_report_idB\r\n" +
+	"\v_only_driftB\b\n" +
+	"\x06_limitB\f\n" +
+	"\n" +
+	"_tenant_id\"\x88\x01\n" +
+	"\x1fGetReconciliationReportResponse\x125\n" +
+	"\x06report\x18\x01 \x01(\v2\x1d.npan.v1.ReconciliationReportR\x06report\x12.\n" +
+	"\x04rows\x18\x02 \x03(\v2\x1a.npan.v1.ReconciliationRowR\x04rows\"\xe9\x01\n" +
+	"!ExportReconciliationReportRequest\x12)\n" +
+	"\treport_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\breportId\x88\x01\x01\x12(\n" +
+	"\x06format\x18\x02 \x01(\tB\x10\xbaH\rr\vR\x03csvR\x04jsonR\x06format\x12\"\n" +
+	"\n" +
+	"only_drift\x18\x03 \x01(\bH\x01R\tonlyDrift\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x04 \x01(\tH\x02R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_report_idB\r\n" +
+	"\v_only_driftB\f\n" +
+	"\n" +
+	"_tenant_id\"X\n" +
+	"\"ExportReconciliationReportResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x16\n" +
+	"\x06export\x18\x02 \x01(\tR\x06export\"M\n" +
+	"\x1bCancelReconciliationRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"8\n" +
+	"\x1cCancelReconciliationResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xa9\x04\n" +
+	"\fSyncSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tcron_expr\x18\x03 \x01(\tR\bcronExpr\x12%\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x11.npan.v1.SyncModeR\x04mode\x12%\n" +
+	"\x0ejitter_seconds\x18\x05 \x01(\x03R\rjitterSeconds\x12\x16\n" +
+	"\x06paused\x18\x06 \x01(\bR\x06paused\x12\x1e\n" +
+	"\vnext_run_at\x18\a \x01(\x03R\tnextRunAt\x12?\n" +
+	"\x0enext_run_at_ts\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vnextRunAtTs\x12\x1e\n" +
+	"\vlast_run_at\x18\t \x01(\x03R\tlastRunAt\x12?\n" +
+	"\x0elast_run_at_ts\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vlastRunAtTs\x12+\n" +
+	"\x0flast_run_status\x18\v \x01(\tH\x00R\rlastRunStatus\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\f \x01(\tH\x01R\tlastError\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\x03R\tupdatedAtB\x12\n" +
+	"\x10_last_run_statusB\r\n" +
+	"\v_last_error\"J\n" +
+	"\x18ListSyncSchedulesRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"P\n" +
+	"\x19ListSyncSchedulesResponse\x123\n" +
+	"\tschedules\x18\x01 \x03(\v2\x15.npan.v1.SyncScheduleR\tschedules\"\xb6\x02\n" +
+	"\x19CreateSyncScheduleRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12$\n" +
+	"\tcron_expr\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bcronExpr\x12*\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x11.npan.v1.SyncModeH\x00R\x04mode\x88\x01\x01\x126\n" +
+	"\x0ejitter_seconds\x18\x04 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\x90\x1c(\x00H\x01R\rjitterSeconds\x88\x01\x01\x12\x1b\n" +
+	"\x06paused\x18\x05 \x01(\bH\x02R\x06paused\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x06 \x01(\tH\x03R\btenantId\x88\x01\x01B\a\n" +
+	"\x05_modeB\x11\n" +
+	"\x0f_jitter_secondsB\t\n" +
+	"\a_pausedB\f\n" +
+	"\n" +
+	"_tenant_id\"O\n" +
+	"\x1aCreateSyncScheduleResponse\x121\n" +
+	"\bschedule\x18\x01 \x01(\v2\x15.npan.v1.SyncScheduleR\bschedule\"c\n" +
+	"\x18PauseSyncScheduleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\ttenant_id\x18\x02 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"N\n" +
+	"\x19PauseSyncScheduleResponse\x121\n" +
+	"\bschedule\x18\x01 \x01(\v2\x15.npan.v1.SyncScheduleR\bschedule\"d\n" +
+	"\x19ResumeSyncScheduleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\ttenant_id\x18\x02 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"O\n" +
+	"\x1aResumeSyncScheduleResponse\x121\n" +
+	"\bschedule\x18\x01 \x01(\v2\x15.npan.v1.SyncScheduleR\bschedule\"d\n" +
+	"\x19DeleteSyncScheduleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\ttenant_id\x18\x02 \x01(\tH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"6\n" +
+	"\x1aDeleteSyncScheduleResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"W\n" +
+	"\x17TestNotificationRequest\x123\n" +
+	"\x04sink\x18\x01 \x01(\tB\x1a\xbaH\x17r\x15R\awebhookR\x04smtpR\x04fileH\x00R\x04sink\x88\x01\x01B\a\n" +
+	"\x05_sink\"a\n" +
+	"\x16NotificationSinkResult\x12\x12\n" +
+	"\x04sink\x18\x01 \x01(\tR\x04sink\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\x12\x19\n" +
+	"\x05error\x18\x03 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"U\n" +
+	"\x18TestNotificationResponse\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.npan.v1.NotificationSinkResultR\aresults\"\x9c\x02\n" +
+	"\vIndexChange\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12&\n" +
+	"\x02op\x18\x02 \x01(\x0e2\x16.npan.v1.IndexChangeOpR\x02op\x12\x15\n" +
+	"\x06doc_id\x18\x03 \x01(\tR\x05docId\x127\n" +
+	"\bdocument\x18\x04 \x01(\v2\x16.npan.v1.IndexDocumentH\x00R\bdocument\x88\x01\x01\x12$\n" +
+	"\x0eroot_folder_id\x18\x05 \x01(\x03R\frootFolderId\x12\x15\n" +
+	"\x06run_id\x18\x06 \x01(\x03R\x05runId\x12\x18\n" +
+	"\aremoved\x18\a \x01(\x03R\aremoved\x12\x1f\n" +
+	"\voccurred_at\x18\b \x01(\x03R\n" +
+	"occurredAtB\v\n" +
+	"\t_document\"\xa6\x01\n" +
+	"\x18WatchIndexChangesRequest\x12$\n" +
+	"\tafter_seq\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\bafterSeq\x12$\n" +
+	"\vfrom_latest\x18\x02 \x01(\bH\x00R\n" +
+	"fromLatest\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x03 \x01(\tH\x01R\btenantId\x88\x01\x01B\x0e\n" +
+	"\f_from_latestB\f\n" +
+	"\n" +
+	"_tenant_id\"j\n" +
+	"\x19WatchIndexChangesResponse\x12.\n" +
+	"\achanges\x18\x01 \x03(\v2\x14.npan.v1.IndexChangeR\achanges\x12\x1d\n" +
+	"\n" +
+	"latest_seq\x18\x02 \x01(\x03R\tlatestSeq*O\n" +
 	"\bItemType\x12\x19\n" +
 	"\x15ITEM_TYPE_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eITEM_TYPE_FILE\x10\x01\x12\x14\n" +
-	"\x10ITEM_TYPE_FOLDER\x10\x02*\xbd\x01\n" +
+	"\x10ITEM_TYPE_FOLDER\x10\x02*\xd5\x01\n" +
 	"\n" +
 	"SyncStatus\x12\x1b\n" +
 	"\x17SYNC_STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
@@ -3281,7 +8597,8 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\x10SYNC_STATUS_DONE\x10\x03\x12\x15\n" +
 	"\x11SYNC_STATUS_ERROR\x10\x04\x12\x19\n" +
 	"\x15SYNC_STATUS_CANCELLED\x10\x05\x12\x1b\n" +
-	"\x17SYNC_STATUS_INTERRUPTED\x10\x06*j\n" +
+	"\x17SYNC_STATUS_INTERRUPTED\x10\x06\x12\x16\n" +
+	"\x12SYNC_STATUS_PAUSED\x10\a*j\n" +
 	"\bSyncMode\x12\x19\n" +
 	"\x15SYNC_MODE_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eSYNC_MODE_FULL\x10\x02\x12\x19\n" +
@@ -3297,7 +8614,22 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\vReadyStatus\x12\x1c\n" +
 	"\x18READY_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12READY_STATUS_READY\x10\x01\x12\x1a\n" +
-	"\x16READY_STATUS_NOT_READY\x10\x022\x85\x01\n" +
+	"\x16READY_STATUS_NOT_READY\x10\x02*\xa5\x01\n" +
+	"\x12IndexRebuildStatus\x12$\n" +
+	" INDEX_REBUILD_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dINDEX_REBUILD_STATUS_BUILDING\x10\x01\x12 \n" +
+	"\x1cINDEX_REBUILD_STATUS_SWAPPED\x10\x02\x12$\n" +
+	" INDEX_REBUILD_STATUS_ROLLED_BACK\x10\x03*t\n" +
+	"\x10FolderResyncMode\x12\"\n" +
+	"\x1eFOLDER_RESYNC_MODE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18FOLDER_RESYNC_MODE_MERGE\x10\x01\x12\x1e\n" +
+	"\x1aFOLDER_RESYNC_MODE_REBUILD\x10\x02*\x9e\x01\n" +
+	"\rIndexChangeOp\x12\x1f\n" +
+	"\x1bINDEX_CHANGE_OP_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16INDEX_CHANGE_OP_UPSERT\x10\x01\x12\x1a\n" +
+	"\x16INDEX_CHANGE_OP_DELETE\x10\x02\x12\x19\n" +
+	"\x15INDEX_CHANGE_OP_SWEEP\x10\x03\x12\x19\n" +
+	"\x15INDEX_CHANGE_OP_RESET\x10\x042\x85\x01\n" +
 	"\rHealthService\x129\n" +
 	"\x06Health\x12\x16.npan.v1.HealthRequest\x1a\x17.npan.v1.HealthResponse\x129\n" +
 	"\x06Readyz\x12\x16.npan.v1.ReadyzRequest\x1a\x17.npan.v1.ReadyzResponse2\xf9\x01\n" +
@@ -3311,7 +8643,7 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\rSearchService\x12K\n" +
 	"\fRemoteSearch\x12\x1c.npan.v1.RemoteSearchRequest\x1a\x1d.npan.v1.RemoteSearchResponse\x12H\n" +
 	"\vLocalSearch\x12\x1b.npan.v1.LocalSearchRequest\x1a\x1c.npan.v1.LocalSearchResponse\x12H\n" +
-	"\vDownloadURL\x12\x1b.npan.v1.DownloadURLRequest\x1a\x1c.npan.v1.DownloadURLResponse2\xea\x03\n" +
+	"\vDownloadURL\x12\x1b.npan.v1.DownloadURLRequest\x1a\x1c.npan.v1.DownloadURLResponse2\xd9\x15\n" +
 	"\fAdminService\x12B\n" +
 	"\tStartSync\x12\x19.npan.v1.StartSyncRequest\x1a\x1a.npan.v1.StartSyncResponse\x12K\n" +
 	"\fInspectRoots\x12\x1c.npan.v1.InspectRootsRequest\x1a\x1d.npan.v1.InspectRootsResponse\x12N\n" +
@@ -3319,7 +8651,34 @@ const file_npan_v1_api_proto_rawDesc = "" +
 	"\x0fGetSyncProgress\x12\x1f.npan.v1.GetSyncProgressRequest\x1a .npan.v1.GetSyncProgressResponse\x12\\\n" +
 	"\x11WatchSyncProgress\x12!.npan.v1.WatchSyncProgressRequest\x1a\".npan.v1.WatchSyncProgressResponse0\x01\x12E\n" +
 	"\n" +
-	"CancelSync\x12\x1a.npan.v1.CancelSyncRequest\x1a\x1b.npan.v1.CancelSyncResponseB\x1cZ\x1anpan/gen/go/npan/v1;npanv1b\x06proto3"
+	"CancelSync\x12\x1a.npan.v1.CancelSyncRequest\x1a\x1b.npan.v1.CancelSyncResponse\x12B\n" +
+	"\tPauseSync\x12\x19.npan.v1.PauseSyncRequest\x1a\x1a.npan.v1.PauseSyncResponse\x12E\n" +
+	"\n" +
+	"ResumeSync\x12\x1a.npan.v1.ResumeSyncRequest\x1a\x1b.npan.v1.ResumeSyncResponse\x12K\n" +
+	"\fResyncFolder\x12\x1c.npan.v1.ResyncFolderRequest\x1a\x1d.npan.v1.ResyncFolderResponse\x12K\n" +
+	"\fGetSyncLease\x12\x1c.npan.v1.GetSyncLeaseRequest\x1a\x1d.npan.v1.GetSyncLeaseResponse\x12f\n" +
+	"\x15ForceReleaseSyncLease\x12%.npan.v1.ForceReleaseSyncLeaseRequest\x1a&.npan.v1.ForceReleaseSyncLeaseResponse\x12l\n" +
+	"\x17GetFolderResyncProgress\x12'.npan.v1.GetFolderResyncProgressRequest\x1a(.npan.v1.GetFolderResyncProgressResponse\x12]\n" +
+	"\x12CancelFolderResync\x12\".npan.v1.CancelFolderResyncRequest\x1a#.npan.v1.CancelFolderResyncResponse\x12c\n" +
+	"\x14RollbackIndexRebuild\x12$.npan.v1.RollbackIndexRebuildRequest\x1a%.npan.v1.RollbackIndexRebuildResponse\x12K\n" +
+	"\fListSyncRuns\x12\x1c.npan.v1.ListSyncRunsRequest\x1a\x1d.npan.v1.ListSyncRunsResponse\x12E\n" +
+	"\n" +
+	"GetSyncRun\x12\x1a.npan.v1.GetSyncRunRequest\x1a\x1b.npan.v1.GetSyncRunResponse\x12T\n" +
+	"\x0fListDeadLetters\x12\x1f.npan.v1.ListDeadLettersRequest\x1a .npan.v1.ListDeadLettersResponse\x12Z\n" +
+	"\x11ReplayDeadLetters\x12!.npan.v1.ReplayDeadLettersRequest\x1a\".npan.v1.ReplayDeadLettersResponse\x12]\n" +
+	"\x12DiscardDeadLetters\x12\".npan.v1.DiscardDeadLettersRequest\x1a#.npan.v1.DiscardDeadLettersResponse\x12Q\n" +
+	"\x0eFindDuplicates\x12\x1e.npan.v1.FindDuplicatesRequest\x1a\x1f.npan.v1.FindDuplicatesResponse\x12`\n" +
+	"\x13StartReconciliation\x12#.npan.v1.StartReconciliationRequest\x1a$.npan.v1.StartReconciliationResponse\x12l\n" +
+	"\x17GetReconciliationReport\x12'.npan.v1.GetReconciliationReportRequest\x1a(.npan.v1.GetReconciliationReportResponse\x12u\n" +
+	"\x1aExportReconciliationReport\x12*.npan.v1.ExportReconciliationReportRequest\x1a+.npan.v1.ExportReconciliationReportResponse\x12c\n" +
+	"\x14CancelReconciliation\x12$.npan.v1.CancelReconciliationRequest\x1a%.npan.v1.CancelReconciliationResponse\x12Z\n" +
+	"\x11ListSyncSchedules\x12!.npan.v1.ListSyncSchedulesRequest\x1a\".npan.v1.ListSyncSchedulesResponse\x12]\n" +
+	"\x12CreateSyncSchedule\x12\".npan.v1.CreateSyncScheduleRequest\x1a#.npan.v1.CreateSyncScheduleResponse\x12Z\n" +
+	"\x11PauseSyncSchedule\x12!.npan.v1.PauseSyncScheduleRequest\x1a\".npan.v1.PauseSyncScheduleResponse\x12]\n" +
+	"\x12ResumeSyncSchedule\x12\".npan.v1.ResumeSyncScheduleRequest\x1a#.npan.v1.ResumeSyncScheduleResponse\x12]\n" +
+	"\x12DeleteSyncSchedule\x12\".npan.v1.DeleteSyncScheduleRequest\x1a#.npan.v1.DeleteSyncScheduleResponse\x12W\n" +
+	"\x10TestNotification\x12 .npan.v1.TestNotificationRequest\x1a!.npan.v1.TestNotificationResponse\x12\\\n" +
+	"\x11WatchIndexChanges\x12!.npan.v1.WatchIndexChangesRequest\x1a\".npan.v1.WatchIndexChangesResponse0\x01B\x1cZ\x1anpan/gen/go/npan/v1;npanv1b\x06proto3"
 
 var (
 	file_npan_v1_api_proto_rawDescOnce sync.Once
@@ -3333,130 +8692,300 @@ func file_npan_v1_api_proto_rawDescGZIP() []byte {
 	return file_npan_v1_api_proto_rawDescData
 }
 
-var file_npan_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_npan_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_npan_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_npan_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 113)
 var file_npan_v1_api_proto_goTypes = []any{
-	(ItemType)(0),                     // 0: npan.v1.ItemType
-	(SyncStatus)(0),                   // 1: npan.v1.SyncStatus
-	(SyncMode)(0),                     // 2: npan.v1.SyncMode
-	(ErrorCode)(0),                    // 3: npan.v1.ErrorCode
-	(ReadyStatus)(0),                  // 4: npan.v1.ReadyStatus
-	(*IndexDocument)(nil),             // 5: npan.v1.IndexDocument
-	(*QueryResult)(nil),               // 6: npan.v1.QueryResult
-	(*CrawlStats)(nil),                // 7: npan.v1.CrawlStats
-	(*RootSyncProgress)(nil),          // 8: npan.v1.RootSyncProgress
-	(*IncrementalSyncStats)(nil),      // 9: npan.v1.IncrementalSyncStats
-	(*SyncVerification)(nil),          // 10: npan.v1.SyncVerification
-	(*SyncProgressState)(nil),         // 11: npan.v1.SyncProgressState
-	(*ErrorResponse)(nil),             // 12: npan.v1.ErrorResponse
-	(*DownloadURLResult)(nil),         // 13: npan.v1.DownloadURLResult
-	(*RemoteSearchItem)(nil),          // 14: npan.v1.RemoteSearchItem
-	(*RemoteSearchResponse)(nil),      // 15: npan.v1.RemoteSearchResponse
-	(*InspectRootItem)(nil),           // 16: npan.v1.InspectRootItem
-	(*InspectRootError)(nil),          // 17: npan.v1.InspectRootError
-	(*HealthRequest)(nil),             // 18: npan.v1.HealthRequest
-	(*HealthResponse)(nil),            // 19: npan.v1.HealthResponse
-	(*ReadyzRequest)(nil),             // 20: npan.v1.ReadyzRequest
-	(*ReadyzResponse)(nil),            // 21: npan.v1.ReadyzResponse
-	(*GetSearchConfigRequest)(nil),    // 22: npan.v1.GetSearchConfigRequest
-	(*GetSearchConfigResponse)(nil),   // 23: npan.v1.GetSearchConfigResponse
-	(*AppSearchRequest)(nil),          // 24: npan.v1.AppSearchRequest
-	(*AppSearchResponse)(nil),         // 25: npan.v1.AppSearchResponse
-	(*AppDownloadURLRequest)(nil),     // 26: npan.v1.AppDownloadURLRequest
-	(*AppDownloadURLResponse)(nil),    // 27: npan.v1.AppDownloadURLResponse
-	(*CreateTokenRequest)(nil),        // 28: npan.v1.CreateTokenRequest
-	(*CreateTokenResponse)(nil),       // 29: npan.v1.CreateTokenResponse
-	(*RemoteSearchRequest)(nil),       // 30: npan.v1.RemoteSearchRequest
-	(*LocalSearchRequest)(nil),        // 31: npan.v1.LocalSearchRequest
-	(*LocalSearchResponse)(nil),       // 32: npan.v1.LocalSearchResponse
-	(*DownloadURLRequest)(nil),        // 33: npan.v1.DownloadURLRequest
-	(*DownloadURLResponse)(nil),       // 34: npan.v1.DownloadURLResponse
-	(*StartSyncRequest)(nil),          // 35: npan.v1.StartSyncRequest
-	(*StartSyncResponse)(nil),         // 36: npan.v1.StartSyncResponse
-	(*InspectRootsRequest)(nil),       // 37: npan.v1.InspectRootsRequest
-	(*InspectRootsResponse)(nil),      // 38: npan.v1.InspectRootsResponse
-	(*GetIndexStatsRequest)(nil),      // 39: npan.v1.GetIndexStatsRequest
-	(*GetIndexStatsResponse)(nil),     // 40: npan.v1.GetIndexStatsResponse
-	(*GetSyncProgressRequest)(nil),    // 41: npan.v1.GetSyncProgressRequest
-	(*GetSyncProgressResponse)(nil),   // 42: npan.v1.GetSyncProgressResponse
-	(*WatchSyncProgressRequest)(nil),  // 43: npan.v1.WatchSyncProgressRequest
-	(*WatchSyncProgressResponse)(nil), // 44: npan.v1.WatchSyncProgressResponse
-	(*CancelSyncRequest)(nil),         // 45: npan.v1.CancelSyncRequest
-	(*CancelSyncResponse)(nil),        // 46: npan.v1.CancelSyncResponse
-	nil,                               // 47: npan.v1.SyncProgressState.RootNamesEntry
-	nil,                               // 48: npan.v1.SyncProgressState.RootProgressEntry
-	nil,                               // 49: npan.v1.SyncProgressState.CatalogRootNamesEntry
-	nil,                               // 50: npan.v1.SyncProgressState.CatalogRootProgressEntry
-	(*timestamppb.Timestamp)(nil),     // 51: google.protobuf.Timestamp
+	(ItemType)(0),                              // 0: npan.v1.ItemType
+	(SyncStatus)(0),                            // 1: npan.v1.SyncStatus
+	(SyncMode)(0),                              // 2: npan.v1.SyncMode
+	(ErrorCode)(0),                             // 3: npan.v1.ErrorCode
+	(ReadyStatus)(0),                           // 4: npan.v1.ReadyStatus
+	(IndexRebuildStatus)(0),                    // 5: npan.v1.IndexRebuildStatus
+	(FolderResyncMode)(0),                      // 6: npan.v1.FolderResyncMode
+	(IndexChangeOp)(0),                         // 7: npan.v1.IndexChangeOp
+	(*IndexDocument)(nil),                      // 8: npan.v1.IndexDocument
+	(*QueryResult)(nil),                        // 9: npan.v1.QueryResult
+	(*CrawlStats)(nil),                         // 10: npan.v1.CrawlStats
+	(*RootSyncProgress)(nil),                   // 11: npan.v1.RootSyncProgress
+	(*IncrementalSyncStats)(nil),               // 12: npan.v1.IncrementalSyncStats
+	(*SyncVerification)(nil),                   // 13: npan.v1.SyncVerification
+	(*SyncProgressState)(nil),                  // 14: npan.v1.SyncProgressState
+	(*RateControlState)(nil),                   // 15: npan.v1.RateControlState
+	(*DryRunSample)(nil),                       // 16: npan.v1.DryRunSample
+	(*DryRunRootDiff)(nil),                     // 17: npan.v1.DryRunRootDiff
+	(*DryRunReport)(nil),                       // 18: npan.v1.DryRunReport
+	(*IndexRebuildState)(nil),                  // 19: npan.v1.IndexRebuildState
+	(*ErrorResponse)(nil),                      // 20: npan.v1.ErrorResponse
+	(*DownloadURLResult)(nil),                  // 21: npan.v1.DownloadURLResult
+	(*RemoteSearchItem)(nil),                   // 22: npan.v1.RemoteSearchItem
+	(*RemoteSearchResponse)(nil),               // 23: npan.v1.RemoteSearchResponse
+	(*InspectRootItem)(nil),                    // 24: npan.v1.InspectRootItem
+	(*InspectRootError)(nil),                   // 25: npan.v1.InspectRootError
+	(*HealthRequest)(nil),                      // 26: npan.v1.HealthRequest
+	(*HealthResponse)(nil),                     // 27: npan.v1.HealthResponse
+	(*ReadyzRequest)(nil),                      // 28: npan.v1.ReadyzRequest
+	(*ReadyzResponse)(nil),                     // 29: npan.v1.ReadyzResponse
+	(*GetSearchConfigRequest)(nil),             // 30: npan.v1.GetSearchConfigRequest
+	(*GetSearchConfigResponse)(nil),            // 31: npan.v1.GetSearchConfigResponse
+	(*AppSearchRequest)(nil),                   // 32: npan.v1.AppSearchRequest
+	(*AppSearchResponse)(nil),                  // 33: npan.v1.AppSearchResponse
+	(*AppDownloadURLRequest)(nil),              // 34: npan.v1.AppDownloadURLRequest
+	(*AppDownloadURLResponse)(nil),             // 35: npan.v1.AppDownloadURLResponse
+	(*CreateTokenRequest)(nil),                 // 36: npan.v1.CreateTokenRequest
+	(*CreateTokenResponse)(nil),                // 37: npan.v1.CreateTokenResponse
+	(*RemoteSearchRequest)(nil),                // 38: npan.v1.RemoteSearchRequest
+	(*LocalSearchRequest)(nil),                 // 39: npan.v1.LocalSearchRequest
+	(*LocalSearchResponse)(nil),                // 40: npan.v1.LocalSearchResponse
+	(*DownloadURLRequest)(nil),                 // 41: npan.v1.DownloadURLRequest
+	(*DownloadURLResponse)(nil),                // 42: npan.v1.DownloadURLResponse
+	(*StartSyncRequest)(nil),                   // 43: npan.v1.StartSyncRequest
+	(*StartSyncResponse)(nil),                  // 44: npan.v1.StartSyncResponse
+	(*InspectRootsRequest)(nil),                // 45: npan.v1.InspectRootsRequest
+	(*InspectRootsResponse)(nil),               // 46: npan.v1.InspectRootsResponse
+	(*GetIndexStatsRequest)(nil),               // 47: npan.v1.GetIndexStatsRequest
+	(*GetIndexStatsResponse)(nil),              // 48: npan.v1.GetIndexStatsResponse
+	(*OAuthTokenStatus)(nil),                   // 49: npan.v1.OAuthTokenStatus
+	(*GetSyncProgressRequest)(nil),             // 50: npan.v1.GetSyncProgressRequest
+	(*GetSyncProgressResponse)(nil),            // 51: npan.v1.GetSyncProgressResponse
+	(*WatchSyncProgressRequest)(nil),           // 52: npan.v1.WatchSyncProgressRequest
+	(*WatchSyncProgressResponse)(nil),          // 53: npan.v1.WatchSyncProgressResponse
+	(*CancelSyncRequest)(nil),                  // 54: npan.v1.CancelSyncRequest
+	(*CancelSyncResponse)(nil),                 // 55: npan.v1.CancelSyncResponse
+	(*PauseSyncRequest)(nil),                   // 56: npan.v1.PauseSyncRequest
+	(*PauseSyncResponse)(nil),                  // 57: npan.v1.PauseSyncResponse
+	(*ResumeSyncRequest)(nil),                  // 58: npan.v1.ResumeSyncRequest
+	(*ResumeSyncResponse)(nil),                 // 59: npan.v1.ResumeSyncResponse
+	(*SyncLease)(nil),                          // 60: npan.v1.SyncLease
+	(*GetSyncLeaseRequest)(nil),                // 61: npan.v1.GetSyncLeaseRequest
+	(*GetSyncLeaseResponse)(nil),               // 62: npan.v1.GetSyncLeaseResponse
+	(*ForceReleaseSyncLeaseRequest)(nil),       // 63: npan.v1.ForceReleaseSyncLeaseRequest
+	(*ForceReleaseSyncLeaseResponse)(nil),      // 64: npan.v1.ForceReleaseSyncLeaseResponse
+	(*FolderResyncProgress)(nil),               // 65: npan.v1.FolderResyncProgress
+	(*ResyncFolderRequest)(nil),                // 66: npan.v1.ResyncFolderRequest
+	(*ResyncFolderResponse)(nil),               // 67: npan.v1.ResyncFolderResponse
+	(*GetFolderResyncProgressRequest)(nil),     // 68: npan.v1.GetFolderResyncProgressRequest
+	(*GetFolderResyncProgressResponse)(nil),    // 69: npan.v1.GetFolderResyncProgressResponse
+	(*CancelFolderResyncRequest)(nil),          // 70: npan.v1.CancelFolderResyncRequest
+	(*CancelFolderResyncResponse)(nil),         // 71: npan.v1.CancelFolderResyncResponse
+	(*RollbackIndexRebuildRequest)(nil),        // 72: npan.v1.RollbackIndexRebuildRequest
+	(*RollbackIndexRebuildResponse)(nil),       // 73: npan.v1.RollbackIndexRebuildResponse
+	(*SyncRun)(nil),                            // 74: npan.v1.SyncRun
+	(*ListSyncRunsRequest)(nil),                // 75: npan.v1.ListSyncRunsRequest
+	(*ListSyncRunsResponse)(nil),               // 76: npan.v1.ListSyncRunsResponse
+	(*GetSyncRunRequest)(nil),                  // 77: npan.v1.GetSyncRunRequest
+	(*GetSyncRunResponse)(nil),                 // 78: npan.v1.GetSyncRunResponse
+	(*DeadLetter)(nil),                         // 79: npan.v1.DeadLetter
+	(*ListDeadLettersRequest)(nil),             // 80: npan.v1.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),            // 81: npan.v1.ListDeadLettersResponse
+	(*ReplayDeadLettersRequest)(nil),           // 82: npan.v1.ReplayDeadLettersRequest
+	(*ReplayDeadLettersResponse)(nil),          // 83: npan.v1.ReplayDeadLettersResponse
+	(*DiscardDeadLettersRequest)(nil),          // 84: npan.v1.DiscardDeadLettersRequest
+	(*DiscardDeadLettersResponse)(nil),         // 85: npan.v1.DiscardDeadLettersResponse
+	(*DuplicateFile)(nil),                      // 86: npan.v1.DuplicateFile
+	(*DuplicateGroup)(nil),                     // 87: npan.v1.DuplicateGroup
+	(*FindDuplicatesRequest)(nil),              // 88: npan.v1.FindDuplicatesRequest
+	(*FindDuplicatesResponse)(nil),             // 89: npan.v1.FindDuplicatesResponse
+	(*ReconciliationRow)(nil),                  // 90: npan.v1.ReconciliationRow
+	(*ReconciliationReport)(nil),               // 91: npan.v1.ReconciliationReport
+	(*StartReconciliationRequest)(nil),         // 92: npan.v1.StartReconciliationRequest
+	(*StartReconciliationResponse)(nil),        // 93: npan.v1.StartReconciliationResponse
+	(*GetReconciliationReportRequest)(nil),     // 94: npan.v1.GetReconciliationReportRequest
+	(*GetReconciliationReportResponse)(nil),    // 95: npan.v1.GetReconciliationReportResponse
+	(*ExportReconciliationReportRequest)(nil),  // 96: npan.v1.ExportReconciliationReportRequest
+	(*ExportReconciliationReportResponse)(nil), // 97: npan.v1.ExportReconciliationReportResponse
+	(*CancelReconciliationRequest)(nil),        // 98: npan.v1.CancelReconciliationRequest
+	(*CancelReconciliationResponse)(nil),       // 99: npan.v1.CancelReconciliationResponse
+	(*SyncSchedule)(nil),                       // 100: npan.v1.SyncSchedule
+	(*ListSyncSchedulesRequest)(nil),           // 101: npan.v1.ListSyncSchedulesRequest
+	(*ListSyncSchedulesResponse)(nil),          // 102: npan.v1.ListSyncSchedulesResponse
+	(*CreateSyncScheduleRequest)(nil),          // 103: npan.v1.CreateSyncScheduleRequest
+	(*CreateSyncScheduleResponse)(nil),         // 104: npan.v1.CreateSyncScheduleResponse
+	(*PauseSyncScheduleRequest)(nil),           // 105: npan.v1.PauseSyncScheduleRequest
+	(*PauseSyncScheduleResponse)(nil),          // 106: npan.v1.PauseSyncScheduleResponse
+	(*ResumeSyncScheduleRequest)(nil),          // 107: npan.v1.ResumeSyncScheduleRequest
+	(*ResumeSyncScheduleResponse)(nil),         // 108: npan.v1.ResumeSyncScheduleResponse
+	(*DeleteSyncScheduleRequest)(nil),          // 109: npan.v1.DeleteSyncScheduleRequest
+	(*DeleteSyncScheduleResponse)(nil),         // 110: npan.v1.DeleteSyncScheduleResponse
+	(*TestNotificationRequest)(nil),            // 111: npan.v1.TestNotificationRequest
+	(*NotificationSinkResult)(nil),             // 112: npan.v1.NotificationSinkResult
+	(*TestNotificationResponse)(nil),           // 113: npan.v1.TestNotificationResponse
+	(*IndexChange)(nil),                        // 114: npan.v1.IndexChange
+	(*WatchIndexChangesRequest)(nil),           // 115: npan.v1.WatchIndexChangesRequest
+	(*WatchIndexChangesResponse)(nil),          // 116: npan.v1.WatchIndexChangesResponse
+	nil,                                        // 117: npan.v1.SyncProgressState.RootNamesEntry
+	nil,                                        // 118: npan.v1.SyncProgressState.RootProgressEntry
+	nil,                                        // 119: npan.v1.SyncProgressState.CatalogRootNamesEntry
+	nil,                                        // 120: npan.v1.SyncProgressState.CatalogRootProgressEntry
+	(*timestamppb.Timestamp)(nil),              // 121: google.protobuf.Timestamp
 }
 var file_npan_v1_api_proto_depIdxs = []int32{
-	0,  // 0: npan.v1.IndexDocument.type:type_name -> npan.v1.ItemType
-	5,  // 1: npan.v1.QueryResult.items:type_name -> npan.v1.IndexDocument
-	51, // 2: npan.v1.CrawlStats.started_at_ts:type_name -> google.protobuf.Timestamp
-	51, // 3: npan.v1.CrawlStats.ended_at_ts:type_name -> google.protobuf.Timestamp
-	7,  // 4: npan.v1.RootSyncProgress.stats:type_name -> npan.v1.CrawlStats
-	51, // 5: npan.v1.RootSyncProgress.updated_at_ts:type_name -> google.protobuf.Timestamp
-	1,  // 6: npan.v1.SyncProgressState.status:type_name -> npan.v1.SyncStatus
-	2,  // 7: npan.v1.SyncProgressState.mode:type_name -> npan.v1.SyncMode
-	47, // 8: npan.v1.SyncProgressState.root_names:type_name -> npan.v1.SyncProgressState.RootNamesEntry
-	7,  // 9: npan.v1.SyncProgressState.aggregate_stats:type_name -> npan.v1.CrawlStats
-	48, // 10: npan.v1.SyncProgressState.root_progress:type_name -> npan.v1.SyncProgressState.RootProgressEntry
-	49, // 11: npan.v1.SyncProgressState.catalog_root_names:type_name -> npan.v1.SyncProgressState.CatalogRootNamesEntry
-	50, // 12: npan.v1.SyncProgressState.catalog_root_progress:type_name -> npan.v1.SyncProgressState.CatalogRootProgressEntry
-	9,  // 13: npan.v1.SyncProgressState.incremental_stats:type_name -> npan.v1.IncrementalSyncStats
-	10, // 14: npan.v1.SyncProgressState.verification:type_name -> npan.v1.SyncVerification
-	51, // 15: npan.v1.SyncProgressState.started_at_ts:type_name -> google.protobuf.Timestamp
-	51, // 16: npan.v1.SyncProgressState.updated_at_ts:type_name -> google.protobuf.Timestamp
-	3,  // 17: npan.v1.ErrorResponse.code:type_name -> npan.v1.ErrorCode
-	14, // 18: npan.v1.RemoteSearchResponse.files:type_name -> npan.v1.RemoteSearchItem
-	14, // 19: npan.v1.RemoteSearchResponse.folders:type_name -> npan.v1.RemoteSearchItem
-	4,  // 20: npan.v1.ReadyzResponse.status:type_name -> npan.v1.ReadyStatus
-	6,  // 21: npan.v1.AppSearchResponse.result:type_name -> npan.v1.QueryResult
-	13, // 22: npan.v1.AppDownloadURLResponse.result:type_name -> npan.v1.DownloadURLResult
-	6,  // 23: npan.v1.LocalSearchResponse.result:type_name -> npan.v1.QueryResult
-	13, // 24: npan.v1.DownloadURLResponse.result:type_name -> npan.v1.DownloadURLResult
-	2,  // 25: npan.v1.StartSyncRequest.mode:type_name -> npan.v1.SyncMode
-	16, // 26: npan.v1.InspectRootsResponse.items:type_name -> npan.v1.InspectRootItem
-	17, // 27: npan.v1.InspectRootsResponse.errors:type_name -> npan.v1.InspectRootError
-	11, // 28: npan.v1.GetSyncProgressResponse.state:type_name -> npan.v1.SyncProgressState
-	11, // 29: npan.v1.WatchSyncProgressResponse.state:type_name -> npan.v1.SyncProgressState
-	8,  // 30: npan.v1.SyncProgressState.RootProgressEntry.value:type_name -> npan.v1.RootSyncProgress
-	8,  // 31: npan.v1.SyncProgressState.CatalogRootProgressEntry.value:type_name -> npan.v1.RootSyncProgress
-	18, // 32: npan.v1.HealthService.Health:input_type -> npan.v1.HealthRequest
-	20, // 33: npan.v1.HealthService.Readyz:input_type -> npan.v1.ReadyzRequest
-	22, // 34: npan.v1.AppService.GetSearchConfig:input_type -> npan.v1.GetSearchConfigRequest
-	24, // 35: npan.v1.AppService.AppSearch:input_type -> npan.v1.AppSearchRequest
-	26, // 36: npan.v1.AppService.AppDownloadURL:input_type -> npan.v1.AppDownloadURLRequest
-	28, // 37: npan.v1.AuthService.CreateToken:input_type -> npan.v1.CreateTokenRequest
-	30, // 38: npan.v1.SearchService.RemoteSearch:input_type -> npan.v1.RemoteSearchRequest
-	31, // 39: npan.v1.SearchService.LocalSearch:input_type -> npan.v1.LocalSearchRequest
-	33, // 40: npan.v1.SearchService.DownloadURL:input_type -> npan.v1.DownloadURLRequest
-	35, // 41: npan.v1.AdminService.StartSync:input_type -> npan.v1.StartSyncRequest
-	37, // 42: npan.v1.AdminService.InspectRoots:input_type -> npan.v1.InspectRootsRequest
-	39, // 43: npan.v1.AdminService.GetIndexStats:input_type -> npan.v1.GetIndexStatsRequest
-	41, // 44: npan.v1.AdminService.GetSyncProgress:input_type -> npan.v1.GetSyncProgressRequest
-	43, // 45: npan.v1.AdminService.WatchSyncProgress:input_type -> npan.v1.WatchSyncProgressRequest
-	45, // 46: npan.v1.AdminService.CancelSync:input_type -> npan.v1.CancelSyncRequest
-	19, // 47: npan.v1.HealthService.Health:output_type -> npan.v1.HealthResponse
-	21, // 48: npan.v1.HealthService.Readyz:output_type -> npan.v1.ReadyzResponse
-	23, // 49: npan.v1.AppService.GetSearchConfig:output_type -> npan.v1.GetSearchConfigResponse
-	25, // 50: npan.v1.AppService.AppSearch:output_type -> npan.v1.AppSearchResponse
-	27, // 51: npan.v1.AppService.AppDownloadURL:output_type -> npan.v1.AppDownloadURLResponse
-	29, // 52: npan.v1.AuthService.CreateToken:output_type -> npan.v1.CreateTokenResponse
-	15, // 53: npan.v1.SearchService.RemoteSearch:output_type -> npan.v1.RemoteSearchResponse
-	32, // 54: npan.v1.SearchService.LocalSearch:output_type -> npan.v1.LocalSearchResponse
-	34, // 55: npan.v1.SearchService.DownloadURL:output_type -> npan.v1.DownloadURLResponse
-	36, // 56: npan.v1.AdminService.StartSync:output_type -> npan.v1.StartSyncResponse
-	38, // 57: npan.v1.AdminService.InspectRoots:output_type -> npan.v1.InspectRootsResponse
-	40, // 58: npan.v1.AdminService.GetIndexStats:output_type -> npan.v1.GetIndexStatsResponse
-	42, // 59: npan.v1.AdminService.GetSyncProgress:output_type -> npan.v1.GetSyncProgressResponse
-	44, // 60: npan.v1.AdminService.WatchSyncProgress:output_type -> npan.v1.WatchSyncProgressResponse
-	46, // 61: npan.v1.AdminService.CancelSync:output_type -> npan.v1.CancelSyncResponse
-	47, // [47:62] is the sub-list for method output_type
-	32, // [32:47] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	0,   // 0: npan.v1.IndexDocument.type:type_name -> npan.v1.ItemType
+	8,   // 1: npan.v1.QueryResult.items:type_name -> npan.v1.IndexDocument
+	121, // 2: npan.v1.CrawlStats.started_at_ts:type_name -> google.protobuf.Timestamp
+	121, // 3: npan.v1.CrawlStats.ended_at_ts:type_name -> google.protobuf.Timestamp
+	10,  // 4: npan.v1.RootSyncProgress.stats:type_name -> npan.v1.CrawlStats
+	121, // 5: npan.v1.RootSyncProgress.updated_at_ts:type_name -> google.protobuf.Timestamp
+	1,   // 6: npan.v1.SyncProgressState.status:type_name -> npan.v1.SyncStatus
+	2,   // 7: npan.v1.SyncProgressState.mode:type_name -> npan.v1.SyncMode
+	117, // 8: npan.v1.SyncProgressState.root_names:type_name -> npan.v1.SyncProgressState.RootNamesEntry
+	10,  // 9: npan.v1.SyncProgressState.aggregate_stats:type_name -> npan.v1.CrawlStats
+	118, // 10: npan.v1.SyncProgressState.root_progress:type_name -> npan.v1.SyncProgressState.RootProgressEntry
+	119, // 11: npan.v1.SyncProgressState.catalog_root_names:type_name -> npan.v1.SyncProgressState.CatalogRootNamesEntry
+	120, // 12: npan.v1.SyncProgressState.catalog_root_progress:type_name -> npan.v1.SyncProgressState.CatalogRootProgressEntry
+	12,  // 13: npan.v1.SyncProgressState.incremental_stats:type_name -> npan.v1.IncrementalSyncStats
+	13,  // 14: npan.v1.SyncProgressState.verification:type_name -> npan.v1.SyncVerification
+	121, // 15: npan.v1.SyncProgressState.started_at_ts:type_name -> google.protobuf.Timestamp
+	121, // 16: npan.v1.SyncProgressState.updated_at_ts:type_name -> google.protobuf.Timestamp
+	19,  // 17: npan.v1.SyncProgressState.rebuild:type_name -> npan.v1.IndexRebuildState
+	18,  // 18: npan.v1.SyncProgressState.dry_run:type_name -> npan.v1.DryRunReport
+	15,  // 19: npan.v1.SyncProgressState.rate_control:type_name -> npan.v1.RateControlState
+	0,   // 20: npan.v1.DryRunSample.type:type_name -> npan.v1.ItemType
+	16,  // 21: npan.v1.DryRunRootDiff.sample_adds:type_name -> npan.v1.DryRunSample
+	16,  // 22: npan.v1.DryRunRootDiff.sample_updates:type_name -> npan.v1.DryRunSample
+	16,  // 23: npan.v1.DryRunRootDiff.sample_deletes:type_name -> npan.v1.DryRunSample
+	2,   // 24: npan.v1.DryRunReport.mode:type_name -> npan.v1.SyncMode
+	17,  // 25: npan.v1.DryRunReport.roots:type_name -> npan.v1.DryRunRootDiff
+	1,   // 26: npan.v1.DryRunReport.status:type_name -> npan.v1.SyncStatus
+	5,   // 27: npan.v1.IndexRebuildState.status:type_name -> npan.v1.IndexRebuildStatus
+	3,   // 28: npan.v1.ErrorResponse.code:type_name -> npan.v1.ErrorCode
+	22,  // 29: npan.v1.RemoteSearchResponse.files:type_name -> npan.v1.RemoteSearchItem
+	22,  // 30: npan.v1.RemoteSearchResponse.folders:type_name -> npan.v1.RemoteSearchItem
+	4,   // 31: npan.v1.ReadyzResponse.status:type_name -> npan.v1.ReadyStatus
+	9,   // 32: npan.v1.AppSearchResponse.result:type_name -> npan.v1.QueryResult
+	21,  // 33: npan.v1.AppDownloadURLResponse.result:type_name -> npan.v1.DownloadURLResult
+	9,   // 34: npan.v1.LocalSearchResponse.result:type_name -> npan.v1.QueryResult
+	21,  // 35: npan.v1.DownloadURLResponse.result:type_name -> npan.v1.DownloadURLResult
+	2,   // 36: npan.v1.StartSyncRequest.mode:type_name -> npan.v1.SyncMode
+	24,  // 37: npan.v1.InspectRootsResponse.items:type_name -> npan.v1.InspectRootItem
+	25,  // 38: npan.v1.InspectRootsResponse.errors:type_name -> npan.v1.InspectRootError
+	49,  // 39: npan.v1.GetIndexStatsResponse.token:type_name -> npan.v1.OAuthTokenStatus
+	14,  // 40: npan.v1.GetSyncProgressResponse.state:type_name -> npan.v1.SyncProgressState
+	14,  // 41: npan.v1.WatchSyncProgressResponse.state:type_name -> npan.v1.SyncProgressState
+	60,  // 42: npan.v1.GetSyncLeaseResponse.lease:type_name -> npan.v1.SyncLease
+	60,  // 43: npan.v1.ForceReleaseSyncLeaseResponse.released:type_name -> npan.v1.SyncLease
+	6,   // 44: npan.v1.FolderResyncProgress.mode:type_name -> npan.v1.FolderResyncMode
+	1,   // 45: npan.v1.FolderResyncProgress.status:type_name -> npan.v1.SyncStatus
+	6,   // 46: npan.v1.ResyncFolderRequest.mode:type_name -> npan.v1.FolderResyncMode
+	65,  // 47: npan.v1.GetFolderResyncProgressResponse.progress:type_name -> npan.v1.FolderResyncProgress
+	19,  // 48: npan.v1.RollbackIndexRebuildResponse.rebuild:type_name -> npan.v1.IndexRebuildState
+	2,   // 49: npan.v1.SyncRun.mode:type_name -> npan.v1.SyncMode
+	1,   // 50: npan.v1.SyncRun.status:type_name -> npan.v1.SyncStatus
+	121, // 51: npan.v1.SyncRun.started_at_ts:type_name -> google.protobuf.Timestamp
+	121, // 52: npan.v1.SyncRun.ended_at_ts:type_name -> google.protobuf.Timestamp
+	10,  // 53: npan.v1.SyncRun.stats:type_name -> npan.v1.CrawlStats
+	12,  // 54: npan.v1.SyncRun.incremental_stats:type_name -> npan.v1.IncrementalSyncStats
+	13,  // 55: npan.v1.SyncRun.verification:type_name -> npan.v1.SyncVerification
+	2,   // 56: npan.v1.ListSyncRunsRequest.mode:type_name -> npan.v1.SyncMode
+	74,  // 57: npan.v1.ListSyncRunsResponse.runs:type_name -> npan.v1.SyncRun
+	74,  // 58: npan.v1.GetSyncRunResponse.run:type_name -> npan.v1.SyncRun
+	121, // 59: npan.v1.DeadLetter.created_at_ts:type_name -> google.protobuf.Timestamp
+	121, // 60: npan.v1.DeadLetter.updated_at_ts:type_name -> google.protobuf.Timestamp
+	79,  // 61: npan.v1.ListDeadLettersResponse.dead_letters:type_name -> npan.v1.DeadLetter
+	86,  // 62: npan.v1.DuplicateGroup.files:type_name -> npan.v1.DuplicateFile
+	87,  // 63: npan.v1.FindDuplicatesResponse.groups:type_name -> npan.v1.DuplicateGroup
+	1,   // 64: npan.v1.ReconciliationReport.status:type_name -> npan.v1.SyncStatus
+	91,  // 65: npan.v1.StartReconciliationResponse.report:type_name -> npan.v1.ReconciliationReport
+	91,  // 66: npan.v1.GetReconciliationReportResponse.report:type_name -> npan.v1.ReconciliationReport
+	90,  // 67: npan.v1.GetReconciliationReportResponse.rows:type_name -> npan.v1.ReconciliationRow
+	2,   // 68: npan.v1.SyncSchedule.mode:type_name -> npan.v1.SyncMode
+	121, // 69: npan.v1.SyncSchedule.next_run_at_ts:type_name -> google.protobuf.Timestamp
+	121, // 70: npan.v1.SyncSchedule.last_run_at_ts:type_name -> google.protobuf.Timestamp
+	100, // 71: npan.v1.ListSyncSchedulesResponse.schedules:type_name -> npan.v1.SyncSchedule
+	2,   // 72: npan.v1.CreateSyncScheduleRequest.mode:type_name -> npan.v1.SyncMode
+	100, // 73: npan.v1.CreateSyncScheduleResponse.schedule:type_name -> npan.v1.SyncSchedule
+	100, // 74: npan.v1.PauseSyncScheduleResponse.schedule:type_name -> npan.v1.SyncSchedule
+	100, // 75: npan.v1.ResumeSyncScheduleResponse.schedule:type_name -> npan.v1.SyncSchedule
+	112, // 76: npan.v1.TestNotificationResponse.results:type_name -> npan.v1.NotificationSinkResult
+	7,   // 77: npan.v1.IndexChange.op:type_name -> npan.v1.IndexChangeOp
+	8,   // 78: npan.v1.IndexChange.document:type_name -> npan.v1.IndexDocument
+	114, // 79: npan.v1.WatchIndexChangesResponse.changes:type_name -> npan.v1.IndexChange
+	11,  // 80: npan.v1.SyncProgressState.RootProgressEntry.value:type_name -> npan.v1.RootSyncProgress
+	11,  // 81: npan.v1.SyncProgressState.CatalogRootProgressEntry.value:type_name -> npan.v1.RootSyncProgress
+	26,  // 82: npan.v1.HealthService.Health:input_type -> npan.v1.HealthRequest
+	28,  // 83: npan.v1.HealthService.Readyz:input_type -> npan.v1.ReadyzRequest
+	30,  // 84: npan.v1.AppService.GetSearchConfig:input_type -> npan.v1.GetSearchConfigRequest
+	32,  // 85: npan.v1.AppService.AppSearch:input_type -> npan.v1.AppSearchRequest
+	34,  // 86: npan.v1.AppService.AppDownloadURL:input_type -> npan.v1.AppDownloadURLRequest
+	36,  // 87: npan.v1.AuthService.CreateToken:input_type -> npan.v1.CreateTokenRequest
+	38,  // 88: npan.v1.SearchService.RemoteSearch:input_type -> npan.v1.RemoteSearchRequest
+	39,  // 89: npan.v1.SearchService.LocalSearch:input_type -> npan.v1.LocalSearchRequest
+	41,  // 90: npan.v1.SearchService.DownloadURL:input_type -> npan.v1.DownloadURLRequest
+	43,  // 91: npan.v1.AdminService.StartSync:input_type -> npan.v1.StartSyncRequest
+	45,  // 92: npan.v1.AdminService.InspectRoots:input_type -> npan.v1.InspectRootsRequest
+	47,  // 93: npan.v1.AdminService.GetIndexStats:input_type -> npan.v1.GetIndexStatsRequest
+	50,  // 94: npan.v1.AdminService.GetSyncProgress:input_type -> npan.v1.GetSyncProgressRequest
+	52,  // 95: npan.v1.AdminService.WatchSyncProgress:input_type -> npan.v1.WatchSyncProgressRequest
+	54,  // 96: npan.v1.AdminService.CancelSync:input_type -> npan.v1.CancelSyncRequest
+	56,  // 97: npan.v1.AdminService.PauseSync:input_type -> npan.v1.PauseSyncRequest
+	58,  // 98: npan.v1.AdminService.ResumeSync:input_type -> npan.v1.ResumeSyncRequest
+	66,  // 99: npan.v1.AdminService.ResyncFolder:input_type -> npan.v1.ResyncFolderRequest
+	61,  // 100: npan.v1.AdminService.GetSyncLease:input_type -> npan.v1.GetSyncLeaseRequest
+	63,  // 101: npan.v1.AdminService.ForceReleaseSyncLease:input_type -> npan.v1.ForceReleaseSyncLeaseRequest
+	68,  // 102: npan.v1.AdminService.GetFolderResyncProgress:input_type -> npan.v1.GetFolderResyncProgressRequest
+	70,  // 103: npan.v1.AdminService.CancelFolderResync:input_type -> npan.v1.CancelFolderResyncRequest
+	72,  // 104: npan.v1.AdminService.RollbackIndexRebuild:input_type -> npan.v1.RollbackIndexRebuildRequest
+	75,  // 105: npan.v1.AdminService.ListSyncRuns:input_type -> npan.v1.ListSyncRunsRequest
+	77,  // 106: npan.v1.AdminService.GetSyncRun:input_type -> npan.v1.GetSyncRunRequest
+	80,  // 107: npan.v1.AdminService.ListDeadLetters:input_type -> npan.v1.ListDeadLettersRequest
+	82,  // 108: npan.v1.AdminService.ReplayDeadLetters:input_type -> npan.v1.ReplayDeadLettersRequest
+	84,  // 109: npan.v1.AdminService.DiscardDeadLetters:input_type -> npan.v1.DiscardDeadLettersRequest
+	88,  // 110: npan.v1.AdminService.FindDuplicates:input_type -> npan.v1.FindDuplicatesRequest
+	92,  // 111: npan.v1.AdminService.StartReconciliation:input_type -> npan.v1.StartReconciliationRequest
+	94,  // 112: npan.v1.AdminService.GetReconciliationReport:input_type -> npan.v1.GetReconciliationReportRequest
+	96,  // 113: npan.v1.AdminService.ExportReconciliationReport:input_type -> npan.v1.ExportReconciliationReportRequest
+	98,  // 114: npan.v1.AdminService.CancelReconciliation:input_type -> npan.v1.CancelReconciliationRequest
+	101, // 115: npan.v1.AdminService.ListSyncSchedules:input_type -> npan.v1.ListSyncSchedulesRequest
+	103, // 116: npan.v1.AdminService.CreateSyncSchedule:input_type -> npan.v1.CreateSyncScheduleRequest
+	105, // 117: npan.v1.AdminService.PauseSyncSchedule:input_type -> npan.v1.PauseSyncScheduleRequest
+	107, // 118: npan.v1.AdminService.ResumeSyncSchedule:input_type -> npan.v1.ResumeSyncScheduleRequest
+	109, // 119: npan.v1.AdminService.DeleteSyncSchedule:input_type -> npan.v1.DeleteSyncScheduleRequest
+	111, // 120: npan.v1.AdminService.TestNotification:input_type -> npan.v1.TestNotificationRequest
+	115, // 121: npan.v1.AdminService.WatchIndexChanges:input_type -> npan.v1.WatchIndexChangesRequest
+	27,  // 122: npan.v1.HealthService.Health:output_type -> npan.v1.HealthResponse
+	29,  // 123: npan.v1.HealthService.Readyz:output_type -> npan.v1.ReadyzResponse
+	31,  // 124: npan.v1.AppService.GetSearchConfig:output_type -> npan.v1.GetSearchConfigResponse
+	33,  // 125: npan.v1.AppService.AppSearch:output_type -> npan.v1.AppSearchResponse
+	35,  // 126: npan.v1.AppService.AppDownloadURL:output_type -> npan.v1.AppDownloadURLResponse
+	37,  // 127: npan.v1.AuthService.CreateToken:output_type -> npan.v1.CreateTokenResponse
+	23,  // 128: npan.v1.SearchService.RemoteSearch:output_type -> npan.v1.RemoteSearchResponse
+	40,  // 129: npan.v1.SearchService.LocalSearch:output_type -> npan.v1.LocalSearchResponse
+	42,  // 130: npan.v1.SearchService.DownloadURL:output_type -> npan.v1.DownloadURLResponse
+	44,  // 131: npan.v1.AdminService.StartSync:output_type -> npan.v1.StartSyncResponse
+	46,  // 132: npan.v1.AdminService.InspectRoots:output_type -> npan.v1.InspectRootsResponse
+	48,  // 133: npan.v1.AdminService.GetIndexStats:output_type -> npan.v1.GetIndexStatsResponse
+	51,  // 134: npan.v1.AdminService.GetSyncProgress:output_type -> npan.v1.GetSyncProgressResponse
+	53,  // 135: npan.v1.AdminService.WatchSyncProgress:output_type -> npan.v1.WatchSyncProgressResponse
+	55,  // 136: npan.v1.AdminService.CancelSync:output_type -> npan.v1.CancelSyncResponse
+	57,  // 137: npan.v1.AdminService.PauseSync:output_type -> npan.v1.PauseSyncResponse
+	59,  // 138: npan.v1.AdminService.ResumeSync:output_type -> npan.v1.ResumeSyncResponse
+	67,  // 139: npan.v1.AdminService.ResyncFolder:output_type -> npan.v1.ResyncFolderResponse
+	62,  // 140: npan.v1.AdminService.GetSyncLease:output_type -> npan.v1.GetSyncLeaseResponse
+	64,  // 141: npan.v1.AdminService.ForceReleaseSyncLease:output_type -> npan.v1.ForceReleaseSyncLeaseResponse
+	69,  // 142: npan.v1.AdminService.GetFolderResyncProgress:output_type -> npan.v1.GetFolderResyncProgressResponse
+	71,  // 143: npan.v1.AdminService.CancelFolderResync:output_type -> npan.v1.CancelFolderResyncResponse
+	73,  // 144: npan.v1.AdminService.RollbackIndexRebuild:output_type -> npan.v1.RollbackIndexRebuildResponse
+	76,  // 145: npan.v1.AdminService.ListSyncRuns:output_type -> npan.v1.ListSyncRunsResponse
+	78,  // 146: npan.v1.AdminService.GetSyncRun:output_type -> npan.v1.GetSyncRunResponse
+	81,  // 147: npan.v1.AdminService.ListDeadLetters:output_type -> npan.v1.ListDeadLettersResponse
+	83,  // 148: npan.v1.AdminService.ReplayDeadLetters:output_type -> npan.v1.ReplayDeadLettersResponse
+	85,  // 149: npan.v1.AdminService.DiscardDeadLetters:output_type -> npan.v1.DiscardDeadLettersResponse
+	89,  // 150: npan.v1.AdminService.FindDuplicates:output_type -> npan.v1.FindDuplicatesResponse
+	93,  // 151: npan.v1.AdminService.StartReconciliation:output_type -> npan.v1.StartReconciliationResponse
+	95,  // 152: npan.v1.AdminService.GetReconciliationReport:output_type -> npan.v1.GetReconciliationReportResponse
+	97,  // 153: npan.v1.AdminService.ExportReconciliationReport:output_type -> npan.v1.ExportReconciliationReportResponse
+	99,  // 154: npan.v1.AdminService.CancelReconciliation:output_type -> npan.v1.CancelReconciliationResponse
+	102, // 155: npan.v1.AdminService.ListSyncSchedules:output_type -> npan.v1.ListSyncSchedulesResponse
+	104, // 156: npan.v1.AdminService.CreateSyncSchedule:output_type -> npan.v1.CreateSyncScheduleResponse
+	106, // 157: npan.v1.AdminService.PauseSyncSchedule:output_type -> npan.v1.PauseSyncScheduleResponse
+	108, // 158: npan.v1.AdminService.ResumeSyncSchedule:output_type -> npan.v1.ResumeSyncScheduleResponse
+	110, // 159: npan.v1.AdminService.DeleteSyncSchedule:output_type -> npan.v1.DeleteSyncScheduleResponse
+	113, // 160: npan.v1.AdminService.TestNotification:output_type -> npan.v1.TestNotificationResponse
+	116, // 161: npan.v1.AdminService.WatchIndexChanges:output_type -> npan.v1.WatchIndexChangesResponse
+	122, // [122:162] is the sub-list for method output_type
+	82,  // [82:122] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
 }
 
 func init() { file_npan_v1_api_proto_init() }
@@ -3467,22 +8996,66 @@ func file_npan_v1_api_proto_init() {
 	file_npan_v1_api_proto_msgTypes[0].OneofWrappers = []any{}
 	file_npan_v1_api_proto_msgTypes[3].OneofWrappers = []any{}
 	file_npan_v1_api_proto_msgTypes[6].OneofWrappers = []any{}
-	file_npan_v1_api_proto_msgTypes[7].OneofWrappers = []any{}
-	file_npan_v1_api_proto_msgTypes[16].OneofWrappers = []any{}
-	file_npan_v1_api_proto_msgTypes[19].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[10].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[11].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[12].OneofWrappers = []any{}
 	file_npan_v1_api_proto_msgTypes[21].OneofWrappers = []any{}
-	file_npan_v1_api_proto_msgTypes[23].OneofWrappers = []any{}
-	file_npan_v1_api_proto_msgTypes[25].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[22].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[24].OneofWrappers = []any{}
 	file_npan_v1_api_proto_msgTypes[26].OneofWrappers = []any{}
 	file_npan_v1_api_proto_msgTypes[28].OneofWrappers = []any{}
 	file_npan_v1_api_proto_msgTypes[30].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[31].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[33].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[35].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[39].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[40].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[42].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[44].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[46].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[48].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[50].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[53].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[54].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[55].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[56].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[57].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[58].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[60].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[62].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[64].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[66].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[67].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[68].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[69].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[72].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[73].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[74].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[76].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[80].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[82].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[83].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[84].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[86].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[88].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[90].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[92].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[93].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[95].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[97].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[99].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[101].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[103].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[104].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[106].OneofWrappers = []any{}
+	file_npan_v1_api_proto_msgTypes[107].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_npan_v1_api_proto_rawDesc), len(file_npan_v1_api_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   46,
+			NumEnums:      8,
+			NumMessages:   113,
 			NumExtensions: 0,
 			NumServices:   5,
 		},
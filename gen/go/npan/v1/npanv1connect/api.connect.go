@@ -80,6 +80,78 @@ const (
 	AdminServiceWatchSyncProgressProcedure = "/npan.v1.AdminService/WatchSyncProgress"
 	// AdminServiceCancelSyncProcedure is the fully-qualified name of the AdminService's CancelSync RPC.
 	AdminServiceCancelSyncProcedure = "/npan.v1.AdminService/CancelSync"
+	// AdminServicePauseSyncProcedure is the fully-qualified name of the AdminService's PauseSync RPC.
+	AdminServicePauseSyncProcedure = "/npan.v1.AdminService/PauseSync"
+	// AdminServiceResumeSyncProcedure is the fully-qualified name of the AdminService's ResumeSync RPC.
+	AdminServiceResumeSyncProcedure = "/npan.v1.AdminService/ResumeSync"
+	// AdminServiceResyncFolderProcedure is the fully-qualified name of the AdminService's ResyncFolder
+	// RPC.
+	AdminServiceResyncFolderProcedure = "/npan.v1.AdminService/ResyncFolder"
+	// AdminServiceGetSyncLeaseProcedure is the fully-qualified name of the AdminService's GetSyncLease
+	// RPC.
+	AdminServiceGetSyncLeaseProcedure = "/npan.v1.AdminService/GetSyncLease"
+	// AdminServiceForceReleaseSyncLeaseProcedure is the fully-qualified name of the AdminService's
+	// ForceReleaseSyncLease RPC.
+	AdminServiceForceReleaseSyncLeaseProcedure = "/npan.v1.AdminService/ForceReleaseSyncLease"
+	// AdminServiceGetFolderResyncProgressProcedure is the fully-qualified name of the AdminService's
+	// GetFolderResyncProgress RPC.
+	AdminServiceGetFolderResyncProgressProcedure = "/npan.v1.AdminService/GetFolderResyncProgress"
+	// AdminServiceCancelFolderResyncProcedure is the fully-qualified name of the AdminService's
+	// CancelFolderResync RPC.
+	AdminServiceCancelFolderResyncProcedure = "/npan.v1.AdminService/CancelFolderResync"
+	// AdminServiceRollbackIndexRebuildProcedure is the fully-qualified name of the AdminService's
+	// RollbackIndexRebuild RPC.
+	AdminServiceRollbackIndexRebuildProcedure = "/npan.v1.AdminService/RollbackIndexRebuild"
+	// AdminServiceListSyncRunsProcedure is the fully-qualified name of the AdminService's ListSyncRuns
+	// RPC.
+	AdminServiceListSyncRunsProcedure = "/npan.v1.AdminService/ListSyncRuns"
+	// AdminServiceGetSyncRunProcedure is the fully-qualified name of the AdminService's GetSyncRun RPC.
+	AdminServiceGetSyncRunProcedure = "/npan.v1.AdminService/GetSyncRun"
+	// AdminServiceListDeadLettersProcedure is the fully-qualified name of the AdminService's
+	// ListDeadLetters RPC.
+	AdminServiceListDeadLettersProcedure = "/npan.v1.AdminService/ListDeadLetters"
+	// AdminServiceReplayDeadLettersProcedure is the fully-qualified name of the AdminService's
+	// ReplayDeadLetters RPC.
+	AdminServiceReplayDeadLettersProcedure = "/npan.v1.AdminService/ReplayDeadLetters"
+	// AdminServiceDiscardDeadLettersProcedure is the fully-qualified name of the AdminService's
+	// DiscardDeadLetters RPC.
+	AdminServiceDiscardDeadLettersProcedure = "/npan.v1.AdminService/DiscardDeadLetters"
+	// AdminServiceFindDuplicatesProcedure is the fully-qualified name of the AdminService's
+	// FindDuplicates RPC.
+	AdminServiceFindDuplicatesProcedure = "/npan.v1.AdminService/FindDuplicates"
+	// AdminServiceStartReconciliationProcedure is the fully-qualified name of the AdminService's
+	// StartReconciliation RPC.
+	AdminServiceStartReconciliationProcedure = "/npan.v1.AdminService/StartReconciliation"
+	// AdminServiceGetReconciliationReportProcedure is the fully-qualified name of the AdminService's
+	// GetReconciliationReport RPC.
+	AdminServiceGetReconciliationReportProcedure = "/npan.v1.AdminService/GetReconciliationReport"
+	// AdminServiceExportReconciliationReportProcedure is the fully-qualified name of the AdminService's
+	// ExportReconciliationReport RPC.
+	AdminServiceExportReconciliationReportProcedure = "/npan.v1.AdminService/ExportReconciliationReport"
+	// AdminServiceCancelReconciliationProcedure is the fully-qualified name of the AdminService's
+	// CancelReconciliation RPC.
+	AdminServiceCancelReconciliationProcedure = "/npan.v1.AdminService/CancelReconciliation"
+	// AdminServiceListSyncSchedulesProcedure is the fully-qualified name of the AdminService's
+	// ListSyncSchedules RPC.
+	AdminServiceListSyncSchedulesProcedure = "/npan.v1.AdminService/ListSyncSchedules"
+	// AdminServiceCreateSyncScheduleProcedure is the fully-qualified name of the AdminService's
+	// CreateSyncSchedule RPC.
+	AdminServiceCreateSyncScheduleProcedure = "/npan.v1.AdminService/CreateSyncSchedule"
+	// AdminServicePauseSyncScheduleProcedure is the fully-qualified name of the AdminService's
+	// PauseSyncSchedule RPC.
+	AdminServicePauseSyncScheduleProcedure = "/npan.v1.AdminService/PauseSyncSchedule"
+	// AdminServiceResumeSyncScheduleProcedure is the fully-qualified name of the AdminService's
+	// ResumeSyncSchedule RPC.
+	AdminServiceResumeSyncScheduleProcedure = "/npan.v1.AdminService/ResumeSyncSchedule"
+	// AdminServiceDeleteSyncScheduleProcedure is the fully-qualified name of the AdminService's
+	// DeleteSyncSchedule RPC.
+	AdminServiceDeleteSyncScheduleProcedure = "/npan.v1.AdminService/DeleteSyncSchedule"
+	// AdminServiceTestNotificationProcedure is the fully-qualified name of the AdminService's
+	// TestNotification RPC.
+	AdminServiceTestNotificationProcedure = "/npan.v1.AdminService/TestNotification"
+	// AdminServiceWatchIndexChangesProcedure is the fully-qualified name of the AdminService's
+	// WatchIndexChanges RPC.
+	AdminServiceWatchIndexChangesProcedure = "/npan.v1.AdminService/WatchIndexChanges"
 )
 
 // HealthServiceClient is a client for the npan.v1.HealthService service.
@@ -500,6 +572,31 @@ type AdminServiceClient interface {
 	GetSyncProgress(context.Context, *connect.Request[v1.GetSyncProgressRequest]) (*connect.Response[v1.GetSyncProgressResponse], error)
 	WatchSyncProgress(context.Context, *connect.Request[v1.WatchSyncProgressRequest]) (*connect.ServerStreamForClient[v1.WatchSyncProgressResponse], error)
 	CancelSync(context.Context, *connect.Request[v1.CancelSyncRequest]) (*connect.Response[v1.CancelSyncResponse], error)
+	PauseSync(context.Context, *connect.Request[v1.PauseSyncRequest]) (*connect.Response[v1.PauseSyncResponse], error)
+	ResumeSync(context.Context, *connect.Request[v1.ResumeSyncRequest]) (*connect.Response[v1.ResumeSyncResponse], error)
+	ResyncFolder(context.Context, *connect.Request[v1.ResyncFolderRequest]) (*connect.Response[v1.ResyncFolderResponse], error)
+	GetSyncLease(context.Context, *connect.Request[v1.GetSyncLeaseRequest]) (*connect.Response[v1.GetSyncLeaseResponse], error)
+	ForceReleaseSyncLease(context.Context, *connect.Request[v1.ForceReleaseSyncLeaseRequest]) (*connect.Response[v1.ForceReleaseSyncLeaseResponse], error)
+	GetFolderResyncProgress(context.Context, *connect.Request[v1.GetFolderResyncProgressRequest]) (*connect.Response[v1.GetFolderResyncProgressResponse], error)
+	CancelFolderResync(context.Context, *connect.Request[v1.CancelFolderResyncRequest]) (*connect.Response[v1.CancelFolderResyncResponse], error)
+	RollbackIndexRebuild(context.Context, *connect.Request[v1.RollbackIndexRebuildRequest]) (*connect.Response[v1.RollbackIndexRebuildResponse], error)
+	ListSyncRuns(context.Context, *connect.Request[v1.ListSyncRunsRequest]) (*connect.Response[v1.ListSyncRunsResponse], error)
+	GetSyncRun(context.Context, *connect.Request[v1.GetSyncRunRequest]) (*connect.Response[v1.GetSyncRunResponse], error)
+	ListDeadLetters(context.Context, *connect.Request[v1.ListDeadLettersRequest]) (*connect.Response[v1.ListDeadLettersResponse], error)
+	ReplayDeadLetters(context.Context, *connect.Request[v1.ReplayDeadLettersRequest]) (*connect.Response[v1.ReplayDeadLettersResponse], error)
+	DiscardDeadLetters(context.Context, *connect.Request[v1.DiscardDeadLettersRequest]) (*connect.Response[v1.DiscardDeadLettersResponse], error)
+	FindDuplicates(context.Context, *connect.Request[v1.FindDuplicatesRequest]) (*connect.Response[v1.FindDuplicatesResponse], error)
+	StartReconciliation(context.Context, *connect.Request[v1.StartReconciliationRequest]) (*connect.Response[v1.StartReconciliationResponse], error)
+	GetReconciliationReport(context.Context, *connect.Request[v1.GetReconciliationReportRequest]) (*connect.Response[v1.GetReconciliationReportResponse], error)
+	ExportReconciliationReport(context.Context, *connect.Request[v1.ExportReconciliationReportRequest]) (*connect.Response[v1.ExportReconciliationReportResponse], error)
+	CancelReconciliation(context.Context, *connect.Request[v1.CancelReconciliationRequest]) (*connect.Response[v1.CancelReconciliationResponse], error)
+	ListSyncSchedules(context.Context, *connect.Request[v1.ListSyncSchedulesRequest]) (*connect.Response[v1.ListSyncSchedulesResponse], error)
+	CreateSyncSchedule(context.Context, *connect.Request[v1.CreateSyncScheduleRequest]) (*connect.Response[v1.CreateSyncScheduleResponse], error)
+	PauseSyncSchedule(context.Context, *connect.Request[v1.PauseSyncScheduleRequest]) (*connect.Response[v1.PauseSyncScheduleResponse], error)
+	ResumeSyncSchedule(context.Context, *connect.Request[v1.ResumeSyncScheduleRequest]) (*connect.Response[v1.ResumeSyncScheduleResponse], error)
+	DeleteSyncSchedule(context.Context, *connect.Request[v1.DeleteSyncScheduleRequest]) (*connect.Response[v1.DeleteSyncScheduleResponse], error)
+	TestNotification(context.Context, *connect.Request[v1.TestNotificationRequest]) (*connect.Response[v1.TestNotificationResponse], error)
+	WatchIndexChanges(context.Context, *connect.Request[v1.WatchIndexChangesRequest]) (*connect.ServerStreamForClient[v1.WatchIndexChangesResponse], error)
 }
 
 // NewAdminServiceClient constructs a client for the npan.v1.AdminService service. By default, it
@@ -549,17 +646,192 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(adminServiceMethods.ByName("CancelSync")),
 			connect.WithClientOptions(opts...),
 		),
+		pauseSync: connect.NewClient[v1.PauseSyncRequest, v1.PauseSyncResponse](
+			httpClient,
+			baseURL+AdminServicePauseSyncProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("PauseSync")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeSync: connect.NewClient[v1.ResumeSyncRequest, v1.ResumeSyncResponse](
+			httpClient,
+			baseURL+AdminServiceResumeSyncProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ResumeSync")),
+			connect.WithClientOptions(opts...),
+		),
+		resyncFolder: connect.NewClient[v1.ResyncFolderRequest, v1.ResyncFolderResponse](
+			httpClient,
+			baseURL+AdminServiceResyncFolderProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ResyncFolder")),
+			connect.WithClientOptions(opts...),
+		),
+		getSyncLease: connect.NewClient[v1.GetSyncLeaseRequest, v1.GetSyncLeaseResponse](
+			httpClient,
+			baseURL+AdminServiceGetSyncLeaseProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetSyncLease")),
+			connect.WithClientOptions(opts...),
+		),
+		forceReleaseSyncLease: connect.NewClient[v1.ForceReleaseSyncLeaseRequest, v1.ForceReleaseSyncLeaseResponse](
+			httpClient,
+			baseURL+AdminServiceForceReleaseSyncLeaseProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ForceReleaseSyncLease")),
+			connect.WithClientOptions(opts...),
+		),
+		getFolderResyncProgress: connect.NewClient[v1.GetFolderResyncProgressRequest, v1.GetFolderResyncProgressResponse](
+			httpClient,
+			baseURL+AdminServiceGetFolderResyncProgressProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetFolderResyncProgress")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelFolderResync: connect.NewClient[v1.CancelFolderResyncRequest, v1.CancelFolderResyncResponse](
+			httpClient,
+			baseURL+AdminServiceCancelFolderResyncProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("CancelFolderResync")),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackIndexRebuild: connect.NewClient[v1.RollbackIndexRebuildRequest, v1.RollbackIndexRebuildResponse](
+			httpClient,
+			baseURL+AdminServiceRollbackIndexRebuildProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("RollbackIndexRebuild")),
+			connect.WithClientOptions(opts...),
+		),
+		listSyncRuns: connect.NewClient[v1.ListSyncRunsRequest, v1.ListSyncRunsResponse](
+			httpClient,
+			baseURL+AdminServiceListSyncRunsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListSyncRuns")),
+			connect.WithClientOptions(opts...),
+		),
+		getSyncRun: connect.NewClient[v1.GetSyncRunRequest, v1.GetSyncRunResponse](
+			httpClient,
+			baseURL+AdminServiceGetSyncRunProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetSyncRun")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeadLetters: connect.NewClient[v1.ListDeadLettersRequest, v1.ListDeadLettersResponse](
+			httpClient,
+			baseURL+AdminServiceListDeadLettersProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListDeadLetters")),
+			connect.WithClientOptions(opts...),
+		),
+		replayDeadLetters: connect.NewClient[v1.ReplayDeadLettersRequest, v1.ReplayDeadLettersResponse](
+			httpClient,
+			baseURL+AdminServiceReplayDeadLettersProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ReplayDeadLetters")),
+			connect.WithClientOptions(opts...),
+		),
+		discardDeadLetters: connect.NewClient[v1.DiscardDeadLettersRequest, v1.DiscardDeadLettersResponse](
+			httpClient,
+			baseURL+AdminServiceDiscardDeadLettersProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DiscardDeadLetters")),
+			connect.WithClientOptions(opts...),
+		),
+		findDuplicates: connect.NewClient[v1.FindDuplicatesRequest, v1.FindDuplicatesResponse](
+			httpClient,
+			baseURL+AdminServiceFindDuplicatesProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("FindDuplicates")),
+			connect.WithClientOptions(opts...),
+		),
+		startReconciliation: connect.NewClient[v1.StartReconciliationRequest, v1.StartReconciliationResponse](
+			httpClient,
+			baseURL+AdminServiceStartReconciliationProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("StartReconciliation")),
+			connect.WithClientOptions(opts...),
+		),
+		getReconciliationReport: connect.NewClient[v1.GetReconciliationReportRequest, v1.GetReconciliationReportResponse](
+			httpClient,
+			baseURL+AdminServiceGetReconciliationReportProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetReconciliationReport")),
+			connect.WithClientOptions(opts...),
+		),
+		exportReconciliationReport: connect.NewClient[v1.ExportReconciliationReportRequest, v1.ExportReconciliationReportResponse](
+			httpClient,
+			baseURL+AdminServiceExportReconciliationReportProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ExportReconciliationReport")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelReconciliation: connect.NewClient[v1.CancelReconciliationRequest, v1.CancelReconciliationResponse](
+			httpClient,
+			baseURL+AdminServiceCancelReconciliationProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("CancelReconciliation")),
+			connect.WithClientOptions(opts...),
+		),
+		listSyncSchedules: connect.NewClient[v1.ListSyncSchedulesRequest, v1.ListSyncSchedulesResponse](
+			httpClient,
+			baseURL+AdminServiceListSyncSchedulesProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListSyncSchedules")),
+			connect.WithClientOptions(opts...),
+		),
+		createSyncSchedule: connect.NewClient[v1.CreateSyncScheduleRequest, v1.CreateSyncScheduleResponse](
+			httpClient,
+			baseURL+AdminServiceCreateSyncScheduleProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("CreateSyncSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		pauseSyncSchedule: connect.NewClient[v1.PauseSyncScheduleRequest, v1.PauseSyncScheduleResponse](
+			httpClient,
+			baseURL+AdminServicePauseSyncScheduleProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("PauseSyncSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeSyncSchedule: connect.NewClient[v1.ResumeSyncScheduleRequest, v1.ResumeSyncScheduleResponse](
+			httpClient,
+			baseURL+AdminServiceResumeSyncScheduleProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ResumeSyncSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSyncSchedule: connect.NewClient[v1.DeleteSyncScheduleRequest, v1.DeleteSyncScheduleResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteSyncScheduleProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteSyncSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		testNotification: connect.NewClient[v1.TestNotificationRequest, v1.TestNotificationResponse](
+			httpClient,
+			baseURL+AdminServiceTestNotificationProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("TestNotification")),
+			connect.WithClientOptions(opts...),
+		),
+		watchIndexChanges: connect.NewClient[v1.WatchIndexChangesRequest, v1.WatchIndexChangesResponse](
+			httpClient,
+			baseURL+AdminServiceWatchIndexChangesProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("WatchIndexChanges")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // adminServiceClient implements AdminServiceClient.
 type adminServiceClient struct {
-	startSync         *connect.Client[v1.StartSyncRequest, v1.StartSyncResponse]
-	inspectRoots      *connect.Client[v1.InspectRootsRequest, v1.InspectRootsResponse]
-	getIndexStats     *connect.Client[v1.GetIndexStatsRequest, v1.GetIndexStatsResponse]
-	getSyncProgress   *connect.Client[v1.GetSyncProgressRequest, v1.GetSyncProgressResponse]
-	watchSyncProgress *connect.Client[v1.WatchSyncProgressRequest, v1.WatchSyncProgressResponse]
-	cancelSync        *connect.Client[v1.CancelSyncRequest, v1.CancelSyncResponse]
+	startSync                  *connect.Client[v1.StartSyncRequest, v1.StartSyncResponse]
+	inspectRoots               *connect.Client[v1.InspectRootsRequest, v1.InspectRootsResponse]
+	getIndexStats              *connect.Client[v1.GetIndexStatsRequest, v1.GetIndexStatsResponse]
+	getSyncProgress            *connect.Client[v1.GetSyncProgressRequest, v1.GetSyncProgressResponse]
+	watchSyncProgress          *connect.Client[v1.WatchSyncProgressRequest, v1.WatchSyncProgressResponse]
+	cancelSync                 *connect.Client[v1.CancelSyncRequest, v1.CancelSyncResponse]
+	pauseSync                  *connect.Client[v1.PauseSyncRequest, v1.PauseSyncResponse]
+	resumeSync                 *connect.Client[v1.ResumeSyncRequest, v1.ResumeSyncResponse]
+	resyncFolder               *connect.Client[v1.ResyncFolderRequest, v1.ResyncFolderResponse]
+	getSyncLease               *connect.Client[v1.GetSyncLeaseRequest, v1.GetSyncLeaseResponse]
+	forceReleaseSyncLease      *connect.Client[v1.ForceReleaseSyncLeaseRequest, v1.ForceReleaseSyncLeaseResponse]
+	getFolderResyncProgress    *connect.Client[v1.GetFolderResyncProgressRequest, v1.GetFolderResyncProgressResponse]
+	cancelFolderResync         *connect.Client[v1.CancelFolderResyncRequest, v1.CancelFolderResyncResponse]
+	rollbackIndexRebuild       *connect.Client[v1.RollbackIndexRebuildRequest, v1.RollbackIndexRebuildResponse]
+	listSyncRuns               *connect.Client[v1.ListSyncRunsRequest, v1.ListSyncRunsResponse]
+	getSyncRun                 *connect.Client[v1.GetSyncRunRequest, v1.GetSyncRunResponse]
+	listDeadLetters            *connect.Client[v1.ListDeadLettersRequest, v1.ListDeadLettersResponse]
+	replayDeadLetters          *connect.Client[v1.ReplayDeadLettersRequest, v1.ReplayDeadLettersResponse]
+	discardDeadLetters         *connect.Client[v1.DiscardDeadLettersRequest, v1.DiscardDeadLettersResponse]
+	findDuplicates             *connect.Client[v1.FindDuplicatesRequest, v1.FindDuplicatesResponse]
+	startReconciliation        *connect.Client[v1.StartReconciliationRequest, v1.StartReconciliationResponse]
+	getReconciliationReport    *connect.Client[v1.GetReconciliationReportRequest, v1.GetReconciliationReportResponse]
+	exportReconciliationReport *connect.Client[v1.ExportReconciliationReportRequest, v1.ExportReconciliationReportResponse]
+	cancelReconciliation       *connect.Client[v1.CancelReconciliationRequest, v1.CancelReconciliationResponse]
+	listSyncSchedules          *connect.Client[v1.ListSyncSchedulesRequest, v1.ListSyncSchedulesResponse]
+	createSyncSchedule         *connect.Client[v1.CreateSyncScheduleRequest, v1.CreateSyncScheduleResponse]
+	pauseSyncSchedule          *connect.Client[v1.PauseSyncScheduleRequest, v1.PauseSyncScheduleResponse]
+	resumeSyncSchedule         *connect.Client[v1.ResumeSyncScheduleRequest, v1.ResumeSyncScheduleResponse]
+	deleteSyncSchedule         *connect.Client[v1.DeleteSyncScheduleRequest, v1.DeleteSyncScheduleResponse]
+	testNotification           *connect.Client[v1.TestNotificationRequest, v1.TestNotificationResponse]
+	watchIndexChanges          *connect.Client[v1.WatchIndexChangesRequest, v1.WatchIndexChangesResponse]
 }
 
 // StartSync calls npan.v1.AdminService.StartSync.
@@ -592,6 +864,131 @@ func (c *adminServiceClient) CancelSync(ctx context.Context, req *connect.Reques
 	return c.cancelSync.CallUnary(ctx, req)
 }
 
+// PauseSync calls npan.v1.AdminService.PauseSync.
+func (c *adminServiceClient) PauseSync(ctx context.Context, req *connect.Request[v1.PauseSyncRequest]) (*connect.Response[v1.PauseSyncResponse], error) {
+	return c.pauseSync.CallUnary(ctx, req)
+}
+
+// ResumeSync calls npan.v1.AdminService.ResumeSync.
+func (c *adminServiceClient) ResumeSync(ctx context.Context, req *connect.Request[v1.ResumeSyncRequest]) (*connect.Response[v1.ResumeSyncResponse], error) {
+	return c.resumeSync.CallUnary(ctx, req)
+}
+
+// ResyncFolder calls npan.v1.AdminService.ResyncFolder.
+func (c *adminServiceClient) ResyncFolder(ctx context.Context, req *connect.Request[v1.ResyncFolderRequest]) (*connect.Response[v1.ResyncFolderResponse], error) {
+	return c.resyncFolder.CallUnary(ctx, req)
+}
+
+// GetSyncLease calls npan.v1.AdminService.GetSyncLease.
+func (c *adminServiceClient) GetSyncLease(ctx context.Context, req *connect.Request[v1.GetSyncLeaseRequest]) (*connect.Response[v1.GetSyncLeaseResponse], error) {
+	return c.getSyncLease.CallUnary(ctx, req)
+}
+
+// ForceReleaseSyncLease calls npan.v1.AdminService.ForceReleaseSyncLease.
+func (c *adminServiceClient) ForceReleaseSyncLease(ctx context.Context, req *connect.Request[v1.ForceReleaseSyncLeaseRequest]) (*connect.Response[v1.ForceReleaseSyncLeaseResponse], error) {
+	return c.forceReleaseSyncLease.CallUnary(ctx, req)
+}
+
+// GetFolderResyncProgress calls npan.v1.AdminService.GetFolderResyncProgress.
+func (c *adminServiceClient) GetFolderResyncProgress(ctx context.Context, req *connect.Request[v1.GetFolderResyncProgressRequest]) (*connect.Response[v1.GetFolderResyncProgressResponse], error) {
+	return c.getFolderResyncProgress.CallUnary(ctx, req)
+}
+
+// CancelFolderResync calls npan.v1.AdminService.CancelFolderResync.
+func (c *adminServiceClient) CancelFolderResync(ctx context.Context, req *connect.Request[v1.CancelFolderResyncRequest]) (*connect.Response[v1.CancelFolderResyncResponse], error) {
+	return c.cancelFolderResync.CallUnary(ctx, req)
+}
+
+// RollbackIndexRebuild calls npan.v1.AdminService.RollbackIndexRebuild.
+func (c *adminServiceClient) RollbackIndexRebuild(ctx context.Context, req *connect.Request[v1.RollbackIndexRebuildRequest]) (*connect.Response[v1.RollbackIndexRebuildResponse], error) {
+	return c.rollbackIndexRebuild.CallUnary(ctx, req)
+}
+
+// ListSyncRuns calls npan.v1.AdminService.ListSyncRuns.
+func (c *adminServiceClient) ListSyncRuns(ctx context.Context, req *connect.Request[v1.ListSyncRunsRequest]) (*connect.Response[v1.ListSyncRunsResponse], error) {
+	return c.listSyncRuns.CallUnary(ctx, req)
+}
+
+// GetSyncRun calls npan.v1.AdminService.GetSyncRun.
+func (c *adminServiceClient) GetSyncRun(ctx context.Context, req *connect.Request[v1.GetSyncRunRequest]) (*connect.Response[v1.GetSyncRunResponse], error) {
+	return c.getSyncRun.CallUnary(ctx, req)
+}
+
+// ListDeadLetters calls npan.v1.AdminService.ListDeadLetters.
+func (c *adminServiceClient) ListDeadLetters(ctx context.Context, req *connect.Request[v1.ListDeadLettersRequest]) (*connect.Response[v1.ListDeadLettersResponse], error) {
+	return c.listDeadLetters.CallUnary(ctx, req)
+}
+
+// ReplayDeadLetters calls npan.v1.AdminService.ReplayDeadLetters.
+func (c *adminServiceClient) ReplayDeadLetters(ctx context.Context, req *connect.Request[v1.ReplayDeadLettersRequest]) (*connect.Response[v1.ReplayDeadLettersResponse], error) {
+	return c.replayDeadLetters.CallUnary(ctx, req)
+}
+
+// DiscardDeadLetters calls npan.v1.AdminService.DiscardDeadLetters.
+func (c *adminServiceClient) DiscardDeadLetters(ctx context.Context, req *connect.Request[v1.DiscardDeadLettersRequest]) (*connect.Response[v1.DiscardDeadLettersResponse], error) {
+	return c.discardDeadLetters.CallUnary(ctx, req)
+}
+
+// FindDuplicates calls npan.v1.AdminService.FindDuplicates.
+func (c *adminServiceClient) FindDuplicates(ctx context.Context, req *connect.Request[v1.FindDuplicatesRequest]) (*connect.Response[v1.FindDuplicatesResponse], error) {
+	return c.findDuplicates.CallUnary(ctx, req)
+}
+
+// StartReconciliation calls npan.v1.AdminService.StartReconciliation.
+func (c *adminServiceClient) StartReconciliation(ctx context.Context, req *connect.Request[v1.StartReconciliationRequest]) (*connect.Response[v1.StartReconciliationResponse], error) {
+	return c.startReconciliation.CallUnary(ctx, req)
+}
+
+// GetReconciliationReport calls npan.v1.AdminService.GetReconciliationReport.
+func (c *adminServiceClient) GetReconciliationReport(ctx context.Context, req *connect.Request[v1.GetReconciliationReportRequest]) (*connect.Response[v1.GetReconciliationReportResponse], error) {
+	return c.getReconciliationReport.CallUnary(ctx, req)
+}
+
+// ExportReconciliationReport calls npan.v1.AdminService.ExportReconciliationReport.
+func (c *adminServiceClient) ExportReconciliationReport(ctx context.Context, req *connect.Request[v1.ExportReconciliationReportRequest]) (*connect.Response[v1.ExportReconciliationReportResponse], error) {
+	return c.exportReconciliationReport.CallUnary(ctx, req)
+}
+
+// CancelReconciliation calls npan.v1.AdminService.CancelReconciliation.
+func (c *adminServiceClient) CancelReconciliation(ctx context.Context, req *connect.Request[v1.CancelReconciliationRequest]) (*connect.Response[v1.CancelReconciliationResponse], error) {
+	return c.cancelReconciliation.CallUnary(ctx, req)
+}
+
+// ListSyncSchedules calls npan.v1.AdminService.ListSyncSchedules.
+func (c *adminServiceClient) ListSyncSchedules(ctx context.Context, req *connect.Request[v1.ListSyncSchedulesRequest]) (*connect.Response[v1.ListSyncSchedulesResponse], error) {
+	return c.listSyncSchedules.CallUnary(ctx, req)
+}
+
+// CreateSyncSchedule calls npan.v1.AdminService.CreateSyncSchedule.
+func (c *adminServiceClient) CreateSyncSchedule(ctx context.Context, req *connect.Request[v1.CreateSyncScheduleRequest]) (*connect.Response[v1.CreateSyncScheduleResponse], error) {
+	return c.createSyncSchedule.CallUnary(ctx, req)
+}
+
+// PauseSyncSchedule calls npan.v1.AdminService.PauseSyncSchedule.
+func (c *adminServiceClient) PauseSyncSchedule(ctx context.Context, req *connect.Request[v1.PauseSyncScheduleRequest]) (*connect.Response[v1.PauseSyncScheduleResponse], error) {
+	return c.pauseSyncSchedule.CallUnary(ctx, req)
+}
+
+// ResumeSyncSchedule calls npan.v1.AdminService.ResumeSyncSchedule.
+func (c *adminServiceClient) ResumeSyncSchedule(ctx context.Context, req *connect.Request[v1.ResumeSyncScheduleRequest]) (*connect.Response[v1.ResumeSyncScheduleResponse], error) {
+	return c.resumeSyncSchedule.CallUnary(ctx, req)
+}
+
+// DeleteSyncSchedule calls npan.v1.AdminService.DeleteSyncSchedule.
+func (c *adminServiceClient) DeleteSyncSchedule(ctx context.Context, req *connect.Request[v1.DeleteSyncScheduleRequest]) (*connect.Response[v1.DeleteSyncScheduleResponse], error) {
+	return c.deleteSyncSchedule.CallUnary(ctx, req)
+}
+
+// TestNotification calls npan.v1.AdminService.TestNotification.
+func (c *adminServiceClient) TestNotification(ctx context.Context, req *connect.Request[v1.TestNotificationRequest]) (*connect.Response[v1.TestNotificationResponse], error) {
+	return c.testNotification.CallUnary(ctx, req)
+}
+
+// WatchIndexChanges calls npan.v1.AdminService.WatchIndexChanges.
+func (c *adminServiceClient) WatchIndexChanges(ctx context.Context, req *connect.Request[v1.WatchIndexChangesRequest]) (*connect.ServerStreamForClient[v1.WatchIndexChangesResponse], error) {
+	return c.watchIndexChanges.CallServerStream(ctx, req)
+}
+
 // AdminServiceHandler is an implementation of the npan.v1.AdminService service.
 type AdminServiceHandler interface {
 	StartSync(context.Context, *connect.Request[v1.StartSyncRequest]) (*connect.Response[v1.StartSyncResponse], error)
@@ -600,6 +997,31 @@ type AdminServiceHandler interface {
 	GetSyncProgress(context.Context, *connect.Request[v1.GetSyncProgressRequest]) (*connect.Response[v1.GetSyncProgressResponse], error)
 	WatchSyncProgress(context.Context, *connect.Request[v1.WatchSyncProgressRequest], *connect.ServerStream[v1.WatchSyncProgressResponse]) error
 	CancelSync(context.Context, *connect.Request[v1.CancelSyncRequest]) (*connect.Response[v1.CancelSyncResponse], error)
+	PauseSync(context.Context, *connect.Request[v1.PauseSyncRequest]) (*connect.Response[v1.PauseSyncResponse], error)
+	ResumeSync(context.Context, *connect.Request[v1.ResumeSyncRequest]) (*connect.Response[v1.ResumeSyncResponse], error)
+	ResyncFolder(context.Context, *connect.Request[v1.ResyncFolderRequest]) (*connect.Response[v1.ResyncFolderResponse], error)
+	GetSyncLease(context.Context, *connect.Request[v1.GetSyncLeaseRequest]) (*connect.Response[v1.GetSyncLeaseResponse], error)
+	ForceReleaseSyncLease(context.Context, *connect.Request[v1.ForceReleaseSyncLeaseRequest]) (*connect.Response[v1.ForceReleaseSyncLeaseResponse], error)
+	GetFolderResyncProgress(context.Context, *connect.Request[v1.GetFolderResyncProgressRequest]) (*connect.Response[v1.GetFolderResyncProgressResponse], error)
+	CancelFolderResync(context.Context, *connect.Request[v1.CancelFolderResyncRequest]) (*connect.Response[v1.CancelFolderResyncResponse], error)
+	RollbackIndexRebuild(context.Context, *connect.Request[v1.RollbackIndexRebuildRequest]) (*connect.Response[v1.RollbackIndexRebuildResponse], error)
+	ListSyncRuns(context.Context, *connect.Request[v1.ListSyncRunsRequest]) (*connect.Response[v1.ListSyncRunsResponse], error)
+	GetSyncRun(context.Context, *connect.Request[v1.GetSyncRunRequest]) (*connect.Response[v1.GetSyncRunResponse], error)
+	ListDeadLetters(context.Context, *connect.Request[v1.ListDeadLettersRequest]) (*connect.Response[v1.ListDeadLettersResponse], error)
+	ReplayDeadLetters(context.Context, *connect.Request[v1.ReplayDeadLettersRequest]) (*connect.Response[v1.ReplayDeadLettersResponse], error)
+	DiscardDeadLetters(context.Context, *connect.Request[v1.DiscardDeadLettersRequest]) (*connect.Response[v1.DiscardDeadLettersResponse], error)
+	FindDuplicates(context.Context, *connect.Request[v1.FindDuplicatesRequest]) (*connect.Response[v1.FindDuplicatesResponse], error)
+	StartReconciliation(context.Context, *connect.Request[v1.StartReconciliationRequest]) (*connect.Response[v1.StartReconciliationResponse], error)
+	GetReconciliationReport(context.Context, *connect.Request[v1.GetReconciliationReportRequest]) (*connect.Response[v1.GetReconciliationReportResponse], error)
+	ExportReconciliationReport(context.Context, *connect.Request[v1.ExportReconciliationReportRequest]) (*connect.Response[v1.ExportReconciliationReportResponse], error)
+	CancelReconciliation(context.Context, *connect.Request[v1.CancelReconciliationRequest]) (*connect.Response[v1.CancelReconciliationResponse], error)
+	ListSyncSchedules(context.Context, *connect.Request[v1.ListSyncSchedulesRequest]) (*connect.Response[v1.ListSyncSchedulesResponse], error)
+	CreateSyncSchedule(context.Context, *connect.Request[v1.CreateSyncScheduleRequest]) (*connect.Response[v1.CreateSyncScheduleResponse], error)
+	PauseSyncSchedule(context.Context, *connect.Request[v1.PauseSyncScheduleRequest]) (*connect.Response[v1.PauseSyncScheduleResponse], error)
+	ResumeSyncSchedule(context.Context, *connect.Request[v1.ResumeSyncScheduleRequest]) (*connect.Response[v1.ResumeSyncScheduleResponse], error)
+	DeleteSyncSchedule(context.Context, *connect.Request[v1.DeleteSyncScheduleRequest]) (*connect.Response[v1.DeleteSyncScheduleResponse], error)
+	TestNotification(context.Context, *connect.Request[v1.TestNotificationRequest]) (*connect.Response[v1.TestNotificationResponse], error)
+	WatchIndexChanges(context.Context, *connect.Request[v1.WatchIndexChangesRequest], *connect.ServerStream[v1.WatchIndexChangesResponse]) error
 }
 
 // NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -645,6 +1067,156 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(adminServiceMethods.ByName("CancelSync")),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminServicePauseSyncHandler := connect.NewUnaryHandler(
+		AdminServicePauseSyncProcedure,
+		svc.PauseSync,
+		connect.WithSchema(adminServiceMethods.ByName("PauseSync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceResumeSyncHandler := connect.NewUnaryHandler(
+		AdminServiceResumeSyncProcedure,
+		svc.ResumeSync,
+		connect.WithSchema(adminServiceMethods.ByName("ResumeSync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceResyncFolderHandler := connect.NewUnaryHandler(
+		AdminServiceResyncFolderProcedure,
+		svc.ResyncFolder,
+		connect.WithSchema(adminServiceMethods.ByName("ResyncFolder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetSyncLeaseHandler := connect.NewUnaryHandler(
+		AdminServiceGetSyncLeaseProcedure,
+		svc.GetSyncLease,
+		connect.WithSchema(adminServiceMethods.ByName("GetSyncLease")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceForceReleaseSyncLeaseHandler := connect.NewUnaryHandler(
+		AdminServiceForceReleaseSyncLeaseProcedure,
+		svc.ForceReleaseSyncLease,
+		connect.WithSchema(adminServiceMethods.ByName("ForceReleaseSyncLease")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetFolderResyncProgressHandler := connect.NewUnaryHandler(
+		AdminServiceGetFolderResyncProgressProcedure,
+		svc.GetFolderResyncProgress,
+		connect.WithSchema(adminServiceMethods.ByName("GetFolderResyncProgress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCancelFolderResyncHandler := connect.NewUnaryHandler(
+		AdminServiceCancelFolderResyncProcedure,
+		svc.CancelFolderResync,
+		connect.WithSchema(adminServiceMethods.ByName("CancelFolderResync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRollbackIndexRebuildHandler := connect.NewUnaryHandler(
+		AdminServiceRollbackIndexRebuildProcedure,
+		svc.RollbackIndexRebuild,
+		connect.WithSchema(adminServiceMethods.ByName("RollbackIndexRebuild")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListSyncRunsHandler := connect.NewUnaryHandler(
+		AdminServiceListSyncRunsProcedure,
+		svc.ListSyncRuns,
+		connect.WithSchema(adminServiceMethods.ByName("ListSyncRuns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetSyncRunHandler := connect.NewUnaryHandler(
+		AdminServiceGetSyncRunProcedure,
+		svc.GetSyncRun,
+		connect.WithSchema(adminServiceMethods.ByName("GetSyncRun")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListDeadLettersHandler := connect.NewUnaryHandler(
+		AdminServiceListDeadLettersProcedure,
+		svc.ListDeadLetters,
+		connect.WithSchema(adminServiceMethods.ByName("ListDeadLetters")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceReplayDeadLettersHandler := connect.NewUnaryHandler(
+		AdminServiceReplayDeadLettersProcedure,
+		svc.ReplayDeadLetters,
+		connect.WithSchema(adminServiceMethods.ByName("ReplayDeadLetters")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDiscardDeadLettersHandler := connect.NewUnaryHandler(
+		AdminServiceDiscardDeadLettersProcedure,
+		svc.DiscardDeadLetters,
+		connect.WithSchema(adminServiceMethods.ByName("DiscardDeadLetters")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceFindDuplicatesHandler := connect.NewUnaryHandler(
+		AdminServiceFindDuplicatesProcedure,
+		svc.FindDuplicates,
+		connect.WithSchema(adminServiceMethods.ByName("FindDuplicates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceStartReconciliationHandler := connect.NewUnaryHandler(
+		AdminServiceStartReconciliationProcedure,
+		svc.StartReconciliation,
+		connect.WithSchema(adminServiceMethods.ByName("StartReconciliation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetReconciliationReportHandler := connect.NewUnaryHandler(
+		AdminServiceGetReconciliationReportProcedure,
+		svc.GetReconciliationReport,
+		connect.WithSchema(adminServiceMethods.ByName("GetReconciliationReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceExportReconciliationReportHandler := connect.NewUnaryHandler(
+		AdminServiceExportReconciliationReportProcedure,
+		svc.ExportReconciliationReport,
+		connect.WithSchema(adminServiceMethods.ByName("ExportReconciliationReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCancelReconciliationHandler := connect.NewUnaryHandler(
+		AdminServiceCancelReconciliationProcedure,
+		svc.CancelReconciliation,
+		connect.WithSchema(adminServiceMethods.ByName("CancelReconciliation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListSyncSchedulesHandler := connect.NewUnaryHandler(
+		AdminServiceListSyncSchedulesProcedure,
+		svc.ListSyncSchedules,
+		connect.WithSchema(adminServiceMethods.ByName("ListSyncSchedules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCreateSyncScheduleHandler := connect.NewUnaryHandler(
+		AdminServiceCreateSyncScheduleProcedure,
+		svc.CreateSyncSchedule,
+		connect.WithSchema(adminServiceMethods.ByName("CreateSyncSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServicePauseSyncScheduleHandler := connect.NewUnaryHandler(
+		AdminServicePauseSyncScheduleProcedure,
+		svc.PauseSyncSchedule,
+		connect.WithSchema(adminServiceMethods.ByName("PauseSyncSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceResumeSyncScheduleHandler := connect.NewUnaryHandler(
+		AdminServiceResumeSyncScheduleProcedure,
+		svc.ResumeSyncSchedule,
+		connect.WithSchema(adminServiceMethods.ByName("ResumeSyncSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteSyncScheduleHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteSyncScheduleProcedure,
+		svc.DeleteSyncSchedule,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteSyncSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceTestNotificationHandler := connect.NewUnaryHandler(
+		AdminServiceTestNotificationProcedure,
+		svc.TestNotification,
+		connect.WithSchema(adminServiceMethods.ByName("TestNotification")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceWatchIndexChangesHandler := connect.NewServerStreamHandler(
+		AdminServiceWatchIndexChangesProcedure,
+		svc.WatchIndexChanges,
+		connect.WithSchema(adminServiceMethods.ByName("WatchIndexChanges")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/npan.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AdminServiceStartSyncProcedure:
@@ -659,6 +1231,56 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 			adminServiceWatchSyncProgressHandler.ServeHTTP(w, r)
 		case AdminServiceCancelSyncProcedure:
 			adminServiceCancelSyncHandler.ServeHTTP(w, r)
+		case AdminServicePauseSyncProcedure:
+			adminServicePauseSyncHandler.ServeHTTP(w, r)
+		case AdminServiceResumeSyncProcedure:
+			adminServiceResumeSyncHandler.ServeHTTP(w, r)
+		case AdminServiceResyncFolderProcedure:
+			adminServiceResyncFolderHandler.ServeHTTP(w, r)
+		case AdminServiceGetSyncLeaseProcedure:
+			adminServiceGetSyncLeaseHandler.ServeHTTP(w, r)
+		case AdminServiceForceReleaseSyncLeaseProcedure:
+			adminServiceForceReleaseSyncLeaseHandler.ServeHTTP(w, r)
+		case AdminServiceGetFolderResyncProgressProcedure:
+			adminServiceGetFolderResyncProgressHandler.ServeHTTP(w, r)
+		case AdminServiceCancelFolderResyncProcedure:
+			adminServiceCancelFolderResyncHandler.ServeHTTP(w, r)
+		case AdminServiceRollbackIndexRebuildProcedure:
+			adminServiceRollbackIndexRebuildHandler.ServeHTTP(w, r)
+		case AdminServiceListSyncRunsProcedure:
+			adminServiceListSyncRunsHandler.ServeHTTP(w, r)
+		case AdminServiceGetSyncRunProcedure:
+			adminServiceGetSyncRunHandler.ServeHTTP(w, r)
+		case AdminServiceListDeadLettersProcedure:
+			adminServiceListDeadLettersHandler.ServeHTTP(w, r)
+		case AdminServiceReplayDeadLettersProcedure:
+			adminServiceReplayDeadLettersHandler.ServeHTTP(w, r)
+		case AdminServiceDiscardDeadLettersProcedure:
+			adminServiceDiscardDeadLettersHandler.ServeHTTP(w, r)
+		case AdminServiceFindDuplicatesProcedure:
+			adminServiceFindDuplicatesHandler.ServeHTTP(w, r)
+		case AdminServiceStartReconciliationProcedure:
+			adminServiceStartReconciliationHandler.ServeHTTP(w, r)
+		case AdminServiceGetReconciliationReportProcedure:
+			adminServiceGetReconciliationReportHandler.ServeHTTP(w, r)
+		case AdminServiceExportReconciliationReportProcedure:
+			adminServiceExportReconciliationReportHandler.ServeHTTP(w, r)
+		case AdminServiceCancelReconciliationProcedure:
+			adminServiceCancelReconciliationHandler.ServeHTTP(w, r)
+		case AdminServiceListSyncSchedulesProcedure:
+			adminServiceListSyncSchedulesHandler.ServeHTTP(w, r)
+		case AdminServiceCreateSyncScheduleProcedure:
+			adminServiceCreateSyncScheduleHandler.ServeHTTP(w, r)
+		case AdminServicePauseSyncScheduleProcedure:
+			adminServicePauseSyncScheduleHandler.ServeHTTP(w, r)
+		case AdminServiceResumeSyncScheduleProcedure:
+			adminServiceResumeSyncScheduleHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteSyncScheduleProcedure:
+			adminServiceDeleteSyncScheduleHandler.ServeHTTP(w, r)
+		case AdminServiceTestNotificationProcedure:
+			adminServiceTestNotificationHandler.ServeHTTP(w, r)
+		case AdminServiceWatchIndexChangesProcedure:
+			adminServiceWatchIndexChangesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -691,3 +1313,103 @@ func (UnimplementedAdminServiceHandler) WatchSyncProgress(context.Context, *conn
 func (UnimplementedAdminServiceHandler) CancelSync(context.Context, *connect.Request[v1.CancelSyncRequest]) (*connect.Response[v1.CancelSyncResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.CancelSync is not implemented"))
 }
+
+func (UnimplementedAdminServiceHandler) PauseSync(context.Context, *connect.Request[v1.PauseSyncRequest]) (*connect.Response[v1.PauseSyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.PauseSync is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ResumeSync(context.Context, *connect.Request[v1.ResumeSyncRequest]) (*connect.Response[v1.ResumeSyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.ResumeSync is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ResyncFolder(context.Context, *connect.Request[v1.ResyncFolderRequest]) (*connect.Response[v1.ResyncFolderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.ResyncFolder is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetSyncLease(context.Context, *connect.Request[v1.GetSyncLeaseRequest]) (*connect.Response[v1.GetSyncLeaseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.GetSyncLease is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ForceReleaseSyncLease(context.Context, *connect.Request[v1.ForceReleaseSyncLeaseRequest]) (*connect.Response[v1.ForceReleaseSyncLeaseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.ForceReleaseSyncLease is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetFolderResyncProgress(context.Context, *connect.Request[v1.GetFolderResyncProgressRequest]) (*connect.Response[v1.GetFolderResyncProgressResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.GetFolderResyncProgress is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CancelFolderResync(context.Context, *connect.Request[v1.CancelFolderResyncRequest]) (*connect.Response[v1.CancelFolderResyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.CancelFolderResync is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RollbackIndexRebuild(context.Context, *connect.Request[v1.RollbackIndexRebuildRequest]) (*connect.Response[v1.RollbackIndexRebuildResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.RollbackIndexRebuild is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListSyncRuns(context.Context, *connect.Request[v1.ListSyncRunsRequest]) (*connect.Response[v1.ListSyncRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.ListSyncRuns is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetSyncRun(context.Context, *connect.Request[v1.GetSyncRunRequest]) (*connect.Response[v1.GetSyncRunResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.GetSyncRun is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListDeadLetters(context.Context, *connect.Request[v1.ListDeadLettersRequest]) (*connect.Response[v1.ListDeadLettersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.ListDeadLetters is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ReplayDeadLetters(context.Context, *connect.Request[v1.ReplayDeadLettersRequest]) (*connect.Response[v1.ReplayDeadLettersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.ReplayDeadLetters is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DiscardDeadLetters(context.Context, *connect.Request[v1.DiscardDeadLettersRequest]) (*connect.Response[v1.DiscardDeadLettersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.DiscardDeadLetters is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) FindDuplicates(context.Context, *connect.Request[v1.FindDuplicatesRequest]) (*connect.Response[v1.FindDuplicatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.FindDuplicates is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) StartReconciliation(context.Context, *connect.Request[v1.StartReconciliationRequest]) (*connect.Response[v1.StartReconciliationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.StartReconciliation is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetReconciliationReport(context.Context, *connect.Request[v1.GetReconciliationReportRequest]) (*connect.Response[v1.GetReconciliationReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.GetReconciliationReport is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ExportReconciliationReport(context.Context, *connect.Request[v1.ExportReconciliationReportRequest]) (*connect.Response[v1.ExportReconciliationReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.ExportReconciliationReport is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CancelReconciliation(context.Context, *connect.Request[v1.CancelReconciliationRequest]) (*connect.Response[v1.CancelReconciliationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.CancelReconciliation is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListSyncSchedules(context.Context, *connect.Request[v1.ListSyncSchedulesRequest]) (*connect.Response[v1.ListSyncSchedulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.ListSyncSchedules is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CreateSyncSchedule(context.Context, *connect.Request[v1.CreateSyncScheduleRequest]) (*connect.Response[v1.CreateSyncScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.CreateSyncSchedule is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) PauseSyncSchedule(context.Context, *connect.Request[v1.PauseSyncScheduleRequest]) (*connect.Response[v1.PauseSyncScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.PauseSyncSchedule is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ResumeSyncSchedule(context.Context, *connect.Request[v1.ResumeSyncScheduleRequest]) (*connect.Response[v1.ResumeSyncScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.ResumeSyncSchedule is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteSyncSchedule(context.Context, *connect.Request[v1.DeleteSyncScheduleRequest]) (*connect.Response[v1.DeleteSyncScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.DeleteSyncSchedule is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) TestNotification(context.Context, *connect.Request[v1.TestNotificationRequest]) (*connect.Response[v1.TestNotificationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.TestNotification is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) WatchIndexChanges(context.Context, *connect.Request[v1.WatchIndexChangesRequest], *connect.ServerStream[v1.WatchIndexChangesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("npan.v1.AdminService.WatchIndexChanges is not implemented"))
+}
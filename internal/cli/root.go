@@ -15,6 +15,7 @@ import (
 
 	"npan/internal/config"
 	"npan/internal/models"
+	"npan/internal/notify"
 	"npan/internal/npan"
 	"npan/internal/search"
 	"npan/internal/service"
@@ -280,20 +281,88 @@ func resolveAuthOptions(cfg config.Config, options authOptions) npan.AuthResolve
 	}
 }
 
-func resolveToken(ctx context.Context, cfg config.Config, options authOptions) (string, npan.AuthResolverOptions, error) {
-	authOptions := resolveAuthOptions(cfg, options)
+// upstreamAuth 是 CLI 解析出的上游凭据；manager 非空时 token 来自状态库中与服务端共享的缓存。
+type upstreamAuth struct {
+	token   string
+	options npan.AuthResolverOptions
+	manager *npan.TokenManager
+	release func()
+}
+
+// close 释放 token 缓存占用的状态库连接。
+func (a upstreamAuth) close() {
+	if a.release != nil {
+		a.release()
+	}
+}
+
+func resolveToken(ctx context.Context, cfg config.Config, options authOptions) (upstreamAuth, error) {
+	return resolveUpstreamAuth(ctx, cfg, resolveAuthOptions(cfg, options))
+}
+
+func resolveUpstreamAuth(ctx context.Context, cfg config.Config, authOptions npan.AuthResolverOptions) (upstreamAuth, error) {
+	if manager, release := openTokenManager(cfg, authOptions); manager != nil {
+		token, err := manager.Token(ctx)
+		if err != nil {
+			release()
+			return upstreamAuth{}, err
+		}
+		return upstreamAuth{token: token, options: authOptions, manager: manager, release: release}, nil
+	}
 	token, err := npan.ResolveBearerToken(ctx, nil, authOptions)
 	if err != nil {
-		return "", authOptions, err
+		return upstreamAuth{}, err
 	}
-	return token, authOptions, nil
+	return upstreamAuth{token: token, options: authOptions}, nil
 }
 
-func newAPIClient(baseURL string, token string, authOptions npan.AuthResolverOptions) npan.API {
+// openTokenManager 在使用 OAuth 凭据时打开状态库中的 token 缓存；打开失败时退回每次换取 token。
+func openTokenManager(cfg config.Config, authOptions npan.AuthResolverOptions) (*npan.TokenManager, func()) {
+	if strings.TrimSpace(authOptions.Token) != "" || !npan.CanAutoRefresh(authOptions) || cfg.StateDBFile == "" {
+		return nil, nil
+	}
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{StateDBFile: cfg.StateDBFile})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "打开 token 缓存失败，改为直接获取 token: %v\n", err)
+		return nil, nil
+	}
+	manager, err := npan.NewTokenManager(npan.TokenManagerOptions{
+		Auth:          authOptions,
+		Store:         stores.OAuthTokenStore,
+		RefreshBefore: cfg.TokenRefreshBefore,
+		EncryptionKey: cfg.TokenEncryptionKey,
+	})
+	if err != nil {
+		_ = stores.DB.Close()
+		return nil, nil
+	}
+	return manager, func() { _ = stores.DB.Close() }
+}
+
+// resolveTenant 按 --tenant 查找租户配置，未指定时返回 false。
+func resolveTenant(cfg config.Config, tenantID string) (config.TenantConfig, bool, error) {
+	tenantID = strings.TrimSpace(tenantID)
+	if tenantID == "" {
+		return config.TenantConfig{}, false, nil
+	}
+	tenant, ok := cfg.Tenant(tenantID)
+	if !ok {
+		return config.TenantConfig{}, false, fmt.Errorf("未知租户: %s", tenantID)
+	}
+	return tenant, true, nil
+}
+
+func newAPIClient(baseURL string, auth upstreamAuth) npan.API {
+	if auth.manager != nil {
+		return npan.NewHTTPClient(npan.HTTPClientOptions{
+			BaseURL:     baseURL,
+			TokenSource: auth.manager,
+		})
+	}
 	return npan.NewHTTPClient(npan.HTTPClientOptions{
 		BaseURL:        baseURL,
-		Token:          token,
-		TokenRefresher: npan.NewTokenRefresher(nil, authOptions),
+		Token:          auth.token,
+		TokenRefresher: npan.NewTokenRefresher(nil, auth.options),
 	})
 }
 
@@ -348,6 +417,13 @@ func NewRootCommand(cfg config.Config) *cobra.Command {
 	rootCmd.AddCommand(newDownloadURLCommand(cfg))
 	rootCmd.AddCommand(newSyncCommand(cfg))
 	rootCmd.AddCommand(newSyncProgressCommand(cfg))
+	rootCmd.AddCommand(newSyncLeaseCommand(cfg))
+	rootCmd.AddCommand(newResyncFolderCommand(cfg))
+	rootCmd.AddCommand(newSyncHistoryCommand(cfg))
+	rootCmd.AddCommand(newRollbackRebuildCommand(cfg))
+	rootCmd.AddCommand(newDeadLettersCommand(cfg))
+	rootCmd.AddCommand(newDuplicatesCommand(cfg))
+	rootCmd.AddCommand(newReconcileCommand(cfg))
 
 	return rootCmd
 }
@@ -401,12 +477,13 @@ func newSearchRemoteCommand(cfg config.Config) *cobra.Command {
 				return fmt.Errorf("--query 不能为空")
 			}
 
-			token, authOptions, err := resolveToken(cmd.Context(), cfg, options)
+			auth, err := resolveToken(cmd.Context(), cfg, options)
 			if err != nil {
 				return err
 			}
+			defer auth.close()
 
-			api := newAPIClient(firstNotEmpty(options.baseURL, cfg.BaseURL), token, authOptions)
+			api := newAPIClient(firstNotEmpty(options.baseURL, cfg.BaseURL), auth)
 
 			var searchInFolderPtr *int64
 			if hasSearchInFolder {
@@ -455,11 +532,13 @@ func newSearchLocalCommand(cfg config.Config) *cobra.Command {
 	var pageSize int64
 	var parentID int64
 	var hasParentID bool
+	var withinFolderID int64
 	var updatedAfter int64
 	var hasUpdatedAfter bool
 	var updatedBefore int64
 	var hasUpdatedBefore bool
 	var includeDeleted bool
+	var tenantID string
 
 	cmd := &cobra.Command{
 		Use:   "search-local",
@@ -468,6 +547,9 @@ func newSearchLocalCommand(cfg config.Config) *cobra.Command {
 			if strings.TrimSpace(query) == "" {
 				return fmt.Errorf("--query 不能为空")
 			}
+			if withinFolderID < 0 {
+				return fmt.Errorf("--within-folder-id 不能为负数")
+			}
 
 			index, _, err := search.NewIndexOperator(search.BackendConfig{
 				Backend:             searchBackend,
@@ -481,12 +563,24 @@ func newSearchLocalCommand(cfg config.Config) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			tenant, _, err := resolveTenant(cfg, tenantID)
+			if err != nil {
+				return err
+			}
+			index, err = search.ForTenant(index, tenant.ID)
+			if err != nil {
+				return err
+			}
 			queryService := search.NewQueryService(index)
 
 			var parentIDPtr *int64
 			if hasParentID {
 				parentIDPtr = &parentID
 			}
+			var withinFolderIDPtr *int64
+			if withinFolderID > 0 {
+				withinFolderIDPtr = &withinFolderID
+			}
 			var updatedAfterPtr *int64
 			if hasUpdatedAfter {
 				updatedAfterPtr = &updatedAfter
@@ -502,6 +596,7 @@ func newSearchLocalCommand(cfg config.Config) *cobra.Command {
 				Page:           page,
 				PageSize:       pageSize,
 				ParentID:       parentIDPtr,
+				WithinFolderID: withinFolderIDPtr,
 				UpdatedAfter:   updatedAfterPtr,
 				UpdatedBefore:  updatedBeforePtr,
 				IncludeDeleted: includeDeleted,
@@ -527,11 +622,13 @@ func newSearchLocalCommand(cfg config.Config) *cobra.Command {
 	cmd.Flags().Int64Var(&pageSize, "page-size", 20, "每页数量")
 	cmd.Flags().Int64Var(&parentID, "parent-id", 0, "父目录 ID")
 	cmd.Flags().BoolVar(&hasParentID, "with-parent-id", false, "是否启用 parent-id")
+	cmd.Flags().Int64Var(&withinFolderID, "within-folder-id", 0, "仅搜索该目录及其所有子目录下的内容，0 表示不限制")
 	cmd.Flags().Int64Var(&updatedAfter, "updated-after", 0, "起始更新时间")
 	cmd.Flags().BoolVar(&hasUpdatedAfter, "with-updated-after", false, "是否启用 updated-after")
 	cmd.Flags().Int64Var(&updatedBefore, "updated-before", 0, "截止更新时间")
 	cmd.Flags().BoolVar(&hasUpdatedBefore, "with-updated-before", false, "是否启用 updated-before")
 	cmd.Flags().BoolVar(&includeDeleted, "include-deleted", false, "是否包含删除/回收站")
+	cmd.Flags().StringVar(&tenantID, "tenant", "", "租户 ID，只检索该租户的文档")
 
 	return cmd
 }
@@ -550,12 +647,13 @@ func newDownloadURLCommand(cfg config.Config) *cobra.Command {
 				return fmt.Errorf("--file-id 必须是正整数")
 			}
 
-			token, authOptions, err := resolveToken(cmd.Context(), cfg, options)
+			auth, err := resolveToken(cmd.Context(), cfg, options)
 			if err != nil {
 				return err
 			}
+			defer auth.close()
 
-			api := newAPIClient(firstNotEmpty(options.baseURL, cfg.BaseURL), token, authOptions)
+			api := newAPIClient(firstNotEmpty(options.baseURL, cfg.BaseURL), auth)
 
 			downloadService := service.NewDownloadURLService(api)
 			var validPeriodPtr *int64
@@ -589,7 +687,9 @@ func newSyncCommand(cfg config.Config) *cobra.Command {
 	var includeDepartments bool
 	var departmentIDsRaw string
 	var resumeProgress bool
+	var shadowRebuild bool
 	var rootWorkers int
+	var folderWorkers int
 	var progressEvery int
 	var progressOutput string
 	var checkpointTemplate string
@@ -604,6 +704,8 @@ func newSyncCommand(cfg config.Config) *cobra.Command {
 	var windowOverlapMS int64
 	var incrementalQueryWords string
 	var mode string
+	var dryRun bool
+	var tenantID string
 
 	resolveSyncMode := func(raw string) (models.SyncMode, error) {
 		normalized := strings.ToLower(strings.TrimSpace(raw))
@@ -626,16 +728,46 @@ func newSyncCommand(cfg config.Config) *cobra.Command {
 				return err
 			}
 
-			token, authOptions, err := resolveToken(cmd.Context(), cfg, options)
+			tenant, hasTenant, err := resolveTenant(cfg, tenantID)
+			if err != nil {
+				return err
+			}
+			if hasTenant && shadowRebuild {
+				return fmt.Errorf("--shadow-rebuild 不支持租户索引")
+			}
+
+			// 租户使用配置文件中的凭据，忽略命令行的认证参数。
+			var auth upstreamAuth
+			baseURL := firstNotEmpty(options.baseURL, cfg.BaseURL)
+			if hasTenant {
+				auth, err = resolveUpstreamAuth(cmd.Context(), cfg, cfg.TenantAuthOptions(tenant))
+				baseURL = cfg.TenantBaseURL(tenant)
+			} else {
+				auth, err = resolveToken(cmd.Context(), cfg, options)
+			}
 			if err != nil {
 				return err
 			}
+			defer auth.close()
 
 			roots, err := parseInt64CSV(rootFolderIDsRaw)
 			if err != nil {
 				return err
 			}
-			if len(roots) == 0 {
+			// 影子索引会整体替换线上索引，只允许按配置的全部根目录重建。
+			if shadowRebuild && len(roots) > 0 {
+				return fmt.Errorf("--shadow-rebuild 不能与 --root-folder-ids 同时使用")
+			}
+			if shadowRebuild && dryRun {
+				return fmt.Errorf("--shadow-rebuild 不能与 --dry-run 同时使用")
+			}
+			var preserveRootCatalog *bool
+			if len(roots) == 0 && hasTenant && len(tenant.RootFolderIDs) > 0 {
+				roots = append([]int64{}, tenant.RootFolderIDs...)
+				preserve := false
+				preserveRootCatalog = &preserve
+			}
+			if len(roots) == 0 && !hasTenant {
 				roots = append([]int64{}, cfg.DefaultRootFolderIDs...)
 			}
 
@@ -644,7 +776,14 @@ func newSyncCommand(cfg config.Config) *cobra.Command {
 				return err
 			}
 			if len(departmentIDs) == 0 {
-				departmentIDs = append([]int64{}, cfg.DefaultDepartmentIDs...)
+				if hasTenant {
+					departmentIDs = append([]int64{}, tenant.DepartmentIDs...)
+				} else {
+					departmentIDs = append([]int64{}, cfg.DefaultDepartmentIDs...)
+				}
+			}
+			if hasTenant && tenant.IncludeDepartments != nil && !cmd.Flags().Changed("include-departments") {
+				includeDepartments = *tenant.IncludeDepartments
 			}
 
 			index, backendInfo, err := search.NewIndexOperator(search.BackendConfig{
@@ -659,8 +798,11 @@ func newSyncCommand(cfg config.Config) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			if err := index.EnsureSettings(cmd.Context()); err != nil {
-				return err
+			// 演练只读索引，不改动索引设置。
+			if !dryRun {
+				if err := index.EnsureSettings(cmd.Context()); err != nil {
+					return err
+				}
 			}
 
 			stateStores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
@@ -673,24 +815,56 @@ func newSyncCommand(cfg config.Config) *cobra.Command {
 			}
 			defer stateStores.DB.Close()
 
-			syncManager := service.NewSyncManager(service.SyncManagerArgs{
+			notifier := notify.NewDispatcher(cfg.NotifyOptions())
+			defer notifier.Wait()
+
+			index, err = search.ForTenant(index, tenant.ID)
+			if err != nil {
+				return err
+			}
+			tenantStores := stateStores.ForTenant(tenant.ID)
+			syncArgs := service.SyncManagerArgs{
 				Index:              index,
-				ProgressStore:      stateStores.ProgressStore,
-				SyncStateStore:     stateStores.SyncStateStore,
-				CheckpointStores:   stateStores.CheckpointStoreFactory,
+				ProgressStore:      tenantStores.ProgressStore,
+				SyncStateStore:     tenantStores.SyncStateStore,
+				CheckpointStores:   tenantStores.CheckpointStoreFactory,
 				MeiliHost:          backendInfo.Host,
 				MeiliIndex:         backendInfo.Index,
 				CheckpointTemplate: checkpointTemplate,
 				RootWorkers:        rootWorkers,
+				FolderWorkers:      folderWorkers,
+				IndexBatchDocs:     cfg.IndexBatchDocs,
+				IndexBatchBytes:    cfg.IndexBatchBytes,
+				IndexMaxInFlight:   cfg.IndexMaxInFlight,
 				ProgressEvery:      progressEvery,
 				Retry:              cfg.Retry,
 				MaxConcurrent:      cfg.SyncMaxConcurrent,
 				MinTimeMS:          cfg.SyncMinTimeMS,
 				IncrementalQuery:   incrementalQueryWords,
 				WindowOverlapMS:    windowOverlapMS,
-			})
+				RunStore:           tenantStores.SyncRunStore,
+				DeadLetterStore:    tenantStores.DeadLetterStore,
+				PathRewriteLimit:   cfg.PathRewriteMaxFolders,
+				Notifier:           notifier,
+				TenantID:           tenant.ID,
 
-			api := newAPIClient(firstNotEmpty(options.baseURL, cfg.BaseURL), token, authOptions)
+				IndexChangeStore:     tenantStores.IndexChangeStore,
+				IndexChangeRetention: cfg.IndexChangeRetention,
+
+				CircuitBreaker: cfg.NewCircuitBreaker(nil),
+
+				IncrementalWindowMaxPages: cfg.IncrementalWindowMaxPages,
+				IncrementalWindowMaxItems: cfg.IncrementalWindowMaxItems,
+
+				// 与服务端共用状态库中的租约，已有进程在同步时直接拒绝并提示持有者。
+				SyncLeaseStore: stateStores.SyncLeaseStore,
+				SyncLeaseKey:   storage.SyncLeaseKey(tenant.ID),
+				SyncLeaseOwner: service.SyncLeaseOwner("cli"),
+				SyncLeaseTTL:   cfg.SyncLeaseTTL,
+			}
+			syncManager := service.NewSyncManager(syncArgs)
+
+			api := newAPIClient(baseURL, auth)
 
 			if err := syncManager.Start(api, service.SyncStartRequest{
 				Mode:               syncMode,
@@ -698,11 +872,16 @@ func newSyncCommand(cfg config.Config) *cobra.Command {
 				IncludeDepartments: &includeDepartments,
 				DepartmentIDs:      departmentIDs,
 				ResumeProgress:     &resumeProgress,
+				ShadowRebuild:      &shadowRebuild,
 				RootWorkers:        rootWorkers,
+				FolderWorkers:      folderWorkers,
 				ProgressEvery:      progressEvery,
 				CheckpointTemplate: checkpointTemplate,
 				WindowOverlapMS:    windowOverlapMS,
 				IncrementalQuery:   incrementalQueryWords,
+				DryRun:             dryRun,
+
+				PreserveRootCatalog: preserveRootCatalog,
 			}); err != nil {
 				return err
 			}
@@ -760,6 +939,9 @@ func newSyncCommand(cfg config.Config) *cobra.Command {
 				}
 				return fmt.Errorf("同步失败")
 			}
+			if dryRun {
+				return printJSON(progress.DryRun)
+			}
 
 			return printJSON(progress)
 		},
@@ -770,7 +952,9 @@ func newSyncCommand(cfg config.Config) *cobra.Command {
 	cmd.Flags().BoolVar(&includeDepartments, "include-departments", cfg.DefaultIncludeDepartments, "是否自动扫描部门根目录")
 	cmd.Flags().StringVar(&departmentIDsRaw, "department-ids", "", "部门 ID 列表，逗号分隔")
 	cmd.Flags().BoolVar(&resumeProgress, "resume-progress", true, "是否从现有进度恢复")
+	cmd.Flags().BoolVar(&shadowRebuild, "shadow-rebuild", false, "蓝绿重建：写入影子索引，完成后原子切换为线上索引")
 	cmd.Flags().IntVar(&rootWorkers, "root-workers", cfg.SyncRootWorkers, "根目录并发 worker 数")
+	cmd.Flags().IntVar(&folderWorkers, "folder-workers", cfg.SyncFolderWorkers, "每个根目录的目录并发 worker 数，空闲配额可被其它根目录借用")
 	cmd.Flags().IntVar(&progressEvery, "progress-every", cfg.SyncProgressEvery, "每处理 N 页记录一次进度")
 	cmd.Flags().StringVar(&progressOutput, "progress-output", "human", "进度输出模式: human|json")
 	cmd.Flags().StringVar(&checkpointTemplate, "checkpoint-template", cfg.CheckpointTemplate, "checkpoint 文件模板")
@@ -785,6 +969,8 @@ func newSyncCommand(cfg config.Config) *cobra.Command {
 	cmd.Flags().Int64Var(&windowOverlapMS, "window-overlap-ms", 2000, "增量窗口回看毫秒数，防止边界漏数")
 	cmd.Flags().StringVar(&incrementalQueryWords, "incremental-query-words", cfg.IncrementalQuery, "增量查询词（默认 * OR *，可覆盖）")
 	cmd.Flags().StringVar(&mode, "mode", "full", "同步模式: full|incremental")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "演练：只比对并输出将新增、更新、删除的文档，不写索引也不推进增量游标")
+	cmd.Flags().StringVar(&tenantID, "tenant", "", "租户 ID，使用 NPA_TENANTS_FILE 中该租户的凭据、根目录与独立状态")
 
 	return cmd
 }
@@ -803,6 +989,7 @@ func waitSyncManagerStopped(syncManager *service.SyncManager, timeout time.Durat
 func newSyncProgressCommand(cfg config.Config) *cobra.Command {
 	var progressFile string
 	var stateDBFile string
+	var tenantID string
 
 	cmd := &cobra.Command{
 		Use:   "sync-progress",
@@ -817,7 +1004,11 @@ func newSyncProgressCommand(cfg config.Config) *cobra.Command {
 			}
 			defer stateStores.DB.Close()
 
-			progress, err := stateStores.ProgressStore.Load()
+			tenant, _, err := resolveTenant(cfg, tenantID)
+			if err != nil {
+				return err
+			}
+			progress, err := stateStores.ForTenant(tenant.ID).ProgressStore.Load()
 			if err != nil {
 				return err
 			}
@@ -831,5 +1022,744 @@ func newSyncProgressCommand(cfg config.Config) *cobra.Command {
 
 	cmd.Flags().StringVar(&progressFile, "progress-file", cfg.ProgressFile, "进度文件路径")
 	cmd.Flags().StringVar(&stateDBFile, "state-db-file", cfg.StateDBFile, "SQLite 状态库路径")
+	cmd.Flags().StringVar(&tenantID, "tenant", "", "租户 ID，查看该租户的同步进度")
+	return cmd
+}
+
+func newSyncLeaseCommand(cfg config.Config) *cobra.Command {
+	var stateDBFile string
+	var tenantID string
+	var forceRelease bool
+
+	cmd := &cobra.Command{
+		Use:   "sync-lease",
+		Short: "查看或强制释放同步租约",
+		Long:  "同步租约保证同一时间只有一个进程对同一份索引与断点执行同步。持有进程崩溃后租约会在过期后自动失效；需要立即接管时使用 --force-release，仍在运行的持有者会在下一次心跳时停止同步。",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stateStores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+				StateDBFile: stateDBFile,
+			})
+			if err != nil {
+				return err
+			}
+			defer stateStores.DB.Close()
+
+			tenant, _, err := resolveTenant(cfg, tenantID)
+			if err != nil {
+				return err
+			}
+			key := storage.SyncLeaseKey(tenant.ID)
+
+			if forceRelease {
+				lease, err := stateStores.SyncLeaseStore.ForceRelease(key)
+				if err != nil {
+					return err
+				}
+				if lease == nil {
+					fmt.Fprintln(os.Stderr, "当前没有同步租约")
+					return nil
+				}
+				fmt.Fprintf(os.Stderr, "已强制释放 %s 持有的同步租约\n", lease.Owner)
+				return printJSON(lease)
+			}
+
+			lease, err := stateStores.SyncLeaseStore.Get(key)
+			if err != nil {
+				return err
+			}
+			if lease == nil {
+				fmt.Fprintln(os.Stderr, "当前没有同步租约")
+				return nil
+			}
+			if lease.ExpiresAt <= time.Now().UnixMilli() {
+				fmt.Fprintf(os.Stderr, "%s 持有的同步租约已过期，下一次同步会直接接管\n", lease.Owner)
+			}
+			return printJSON(lease)
+		},
+	}
+
+	cmd.Flags().StringVar(&stateDBFile, "state-db-file", cfg.StateDBFile, "SQLite 状态库路径")
+	cmd.Flags().StringVar(&tenantID, "tenant", "", "租户 ID，查看该租户的同步租约")
+	cmd.Flags().BoolVar(&forceRelease, "force-release", false, "无视持有者强制释放租约，仅在确认持有进程已退出或需要接管时使用")
+	return cmd
+}
+
+func newResyncFolderCommand(cfg config.Config) *cobra.Command {
+	var options authOptions
+	var folderID int64
+	var mode string
+	var searchBackend string
+	var meiliHost string
+	var meiliKey string
+	var meiliIndexName string
+	var typesenseHost string
+	var typesenseKey string
+	var typesenseCollection string
+	var tenantID string
+
+	cmd := &cobra.Command{
+		Use:   "resync-folder",
+		Short: "重新爬取单个目录的子树并写入索引（merge 覆盖写入，rebuild 先删后写）",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if folderID <= 0 {
+				return fmt.Errorf("--folder-id 必须大于 0")
+			}
+			resyncMode, err := service.ParseFolderResyncMode(mode)
+			if err != nil {
+				return err
+			}
+
+			tenant, hasTenant, err := resolveTenant(cfg, tenantID)
+			if err != nil {
+				return err
+			}
+			var auth upstreamAuth
+			baseURL := firstNotEmpty(options.baseURL, cfg.BaseURL)
+			if hasTenant {
+				auth, err = resolveUpstreamAuth(cmd.Context(), cfg, cfg.TenantAuthOptions(tenant))
+				baseURL = cfg.TenantBaseURL(tenant)
+			} else {
+				auth, err = resolveToken(cmd.Context(), cfg, options)
+			}
+			if err != nil {
+				return err
+			}
+			defer auth.close()
+
+			index, backendInfo, err := search.NewIndexOperator(search.BackendConfig{
+				Backend:             searchBackend,
+				MeiliHost:           meiliHost,
+				MeiliAPIKey:         meiliKey,
+				MeiliIndex:          meiliIndexName,
+				TypesenseHost:       typesenseHost,
+				TypesenseAPIKey:     typesenseKey,
+				TypesenseCollection: typesenseCollection,
+			})
+			if err != nil {
+				return err
+			}
+			index, err = search.ForTenant(index, tenant.ID)
+			if err != nil {
+				return err
+			}
+
+			stateStores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+				StateDBFile: cfg.StateDBFile,
+			})
+			if err != nil {
+				return err
+			}
+			defer stateStores.DB.Close()
+
+			tenantStores := stateStores.ForTenant(tenant.ID)
+			syncArgs := service.SyncManagerArgs{
+				Index:         index,
+				ProgressStore: tenantStores.ProgressStore,
+				MeiliHost:     backendInfo.Host,
+				MeiliIndex:    backendInfo.Index,
+				Retry:         cfg.Retry,
+				MaxConcurrent: cfg.SyncMaxConcurrent,
+				MinTimeMS:     cfg.SyncMinTimeMS,
+
+				IndexChangeStore:     tenantStores.IndexChangeStore,
+				IndexChangeRetention: cfg.IndexChangeRetention,
+
+				CircuitBreaker: cfg.NewCircuitBreaker(nil),
+
+				SyncLeaseStore: stateStores.SyncLeaseStore,
+				SyncLeaseKey:   storage.SyncLeaseKey(tenant.ID),
+				SyncLeaseOwner: service.SyncLeaseOwner("cli"),
+				SyncLeaseTTL:   cfg.SyncLeaseTTL,
+			}
+			syncManager := service.NewSyncManager(syncArgs)
+
+			if err := syncManager.StartFolderResync(newAPIClient(baseURL, auth), service.FolderResyncRequest{
+				FolderID: folderID,
+				Mode:     resyncMode,
+			}); err != nil {
+				return err
+			}
+
+			sigCh := make(chan os.Signal, 1)
+			signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+			defer signal.Stop(sigCh)
+
+			ticker := time.NewTicker(2 * time.Second)
+			defer ticker.Stop()
+
+			interrupted := false
+			for {
+				progress := syncManager.GetFolderResyncProgress()
+				if progress == nil || progress.FinishedAt > 0 {
+					break
+				}
+				select {
+				case <-cmd.Context().Done():
+					_ = syncManager.CancelFolderResync()
+					interrupted = true
+				case <-sigCh:
+					_ = syncManager.CancelFolderResync()
+					interrupted = true
+				case <-ticker.C:
+					fmt.Fprintf(os.Stderr, "目录 %d：已访问 %d 个目录，已写入 %d 条文档\n", progress.FolderID, progress.FoldersVisited, progress.DocsWritten)
+				}
+				if interrupted {
+					break
+				}
+			}
+
+			if interrupted {
+				deadline := time.Now().Add(15 * time.Second)
+				for {
+					progress := syncManager.GetFolderResyncProgress()
+					if progress == nil || progress.FinishedAt > 0 {
+						break
+					}
+					if time.Now().After(deadline) {
+						return fmt.Errorf("收到中断信号，等待目录重同步停止超时")
+					}
+					time.Sleep(200 * time.Millisecond)
+				}
+			}
+
+			progress := syncManager.GetFolderResyncProgress()
+			if progress == nil {
+				return fmt.Errorf("未找到目录重同步进度")
+			}
+			if err := printJSON(progress); err != nil {
+				return err
+			}
+			switch progress.Status {
+			case "error":
+				return fmt.Errorf("目录重同步失败: %s", progress.LastError)
+			case "cancelled":
+				return fmt.Errorf("收到中断信号，目录重同步已取消")
+			}
+			return nil
+		},
+	}
+
+	addAuthFlags(cmd, &options, cfg)
+	cmd.Flags().Int64Var(&folderID, "folder-id", 0, "要重新同步的目录 ID（不能是同步根目录）")
+	cmd.Flags().StringVar(&mode, "mode", string(models.FolderResyncModeMerge), "重同步模式: merge|rebuild")
+	cmd.Flags().StringVar(&searchBackend, "search-backend", cfg.SearchBackend, "搜索后端: meilisearch|typesense")
+	cmd.Flags().StringVar(&meiliHost, "meili-host", cfg.MeiliHost, "Meili 地址")
+	cmd.Flags().StringVar(&meiliKey, "meili-key", cfg.MeiliAPIKey, "Meili API key")
+	cmd.Flags().StringVar(&meiliIndexName, "meili-index", cfg.MeiliIndex, "Meili 索引名")
+	cmd.Flags().StringVar(&typesenseHost, "typesense-host", cfg.TypesenseHost, "Typesense 地址")
+	cmd.Flags().StringVar(&typesenseKey, "typesense-key", cfg.TypesenseAPIKey, "Typesense API key")
+	cmd.Flags().StringVar(&typesenseCollection, "typesense-collection", cfg.TypesenseCollection, "Typesense collection 名")
+	cmd.Flags().StringVar(&tenantID, "tenant", "", "租户 ID，使用 NPA_TENANTS_FILE 中该租户的凭据与索引")
+	return cmd
+}
+
+func newSyncHistoryCommand(cfg config.Config) *cobra.Command {
+	var stateDBFile string
+	var mode string
+	var limit int
+	var beforeID int64
+	var runID int64
+	var tenantID string
+
+	cmd := &cobra.Command{
+		Use:   "sync-history",
+		Short: "查看同步运行历史",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			syncMode := models.SyncMode(strings.ToLower(strings.TrimSpace(mode)))
+			if syncMode != "" && syncMode != models.SyncModeFull && syncMode != models.SyncModeIncremental {
+				return fmt.Errorf("不支持的同步模式: %s（可选: full|incremental）", mode)
+			}
+			if limit <= 0 {
+				return fmt.Errorf("--limit 必须大于 0")
+			}
+			tenant, _, err := resolveTenant(cfg, tenantID)
+			if err != nil {
+				return err
+			}
+
+			stateStores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+				StateDBFile: stateDBFile,
+			})
+			if err != nil {
+				return err
+			}
+			defer stateStores.DB.Close()
+
+			runStore := stateStores.ForTenant(tenant.ID).SyncRunStore
+			if runID > 0 {
+				run, err := runStore.Get(runID)
+				if err != nil {
+					return err
+				}
+				if run == nil {
+					return fmt.Errorf("同步运行记录不存在: id=%d", runID)
+				}
+				return printJSON(run)
+			}
+
+			runs, err := runStore.List(storage.SyncRunFilter{
+				Mode:     syncMode,
+				Limit:    limit,
+				BeforeID: beforeID,
+			})
+			if err != nil {
+				return err
+			}
+			return printJSON(runs)
+		},
+	}
+
+	cmd.Flags().StringVar(&stateDBFile, "state-db-file", cfg.StateDBFile, "SQLite 状态库路径")
+	cmd.Flags().StringVar(&mode, "mode", "", "只看指定模式: full|incremental")
+	cmd.Flags().IntVar(&limit, "limit", 20, "返回条数")
+	cmd.Flags().Int64Var(&beforeID, "before-id", 0, "只返回 ID 小于该值的记录，用于翻页")
+	cmd.Flags().Int64Var(&runID, "id", 0, "查看单次运行详情")
+	cmd.Flags().StringVar(&tenantID, "tenant", "", "租户 ID，查看该租户的运行历史")
+	return cmd
+}
+
+func newRollbackRebuildCommand(cfg config.Config) *cobra.Command {
+	var stateDBFile string
+	var searchBackend string
+	var meiliHost string
+	var meiliKey string
+	var meiliIndexName string
+	var typesenseHost string
+	var typesenseKey string
+	var typesenseCollection string
+	var tenantID string
+
+	cmd := &cobra.Command{
+		Use:   "rollback-rebuild",
+		Short: "将线上索引切回最近一次蓝绿重建之前的上一代",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tenant, _, err := resolveTenant(cfg, tenantID)
+			if err != nil {
+				return err
+			}
+
+			index, backendInfo, err := search.NewIndexOperator(search.BackendConfig{
+				Backend:             searchBackend,
+				MeiliHost:           meiliHost,
+				MeiliAPIKey:         meiliKey,
+				MeiliIndex:          meiliIndexName,
+				TypesenseHost:       typesenseHost,
+				TypesenseAPIKey:     typesenseKey,
+				TypesenseCollection: typesenseCollection,
+			})
+			if err != nil {
+				return err
+			}
+			index, err = search.ForTenant(index, tenant.ID)
+			if err != nil {
+				return err
+			}
+
+			stateStores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+				StateDBFile: stateDBFile,
+			})
+			if err != nil {
+				return err
+			}
+			defer stateStores.DB.Close()
+
+			tenantStores := stateStores.ForTenant(tenant.ID)
+			syncManager := service.NewSyncManager(service.SyncManagerArgs{
+				Index:            index,
+				ProgressStore:    tenantStores.ProgressStore,
+				MeiliHost:        backendInfo.Host,
+				MeiliIndex:       backendInfo.Index,
+				IndexChangeStore: tenantStores.IndexChangeStore,
+
+				SyncLeaseStore: stateStores.SyncLeaseStore,
+				SyncLeaseKey:   storage.SyncLeaseKey(tenant.ID),
+				SyncLeaseOwner: service.SyncLeaseOwner("cli"),
+				SyncLeaseTTL:   cfg.SyncLeaseTTL,
+			})
+			state, err := syncManager.RollbackRebuild(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return printJSON(state)
+		},
+	}
+
+	cmd.Flags().StringVar(&stateDBFile, "state-db-file", cfg.StateDBFile, "SQLite 状态库路径")
+	cmd.Flags().StringVar(&searchBackend, "search-backend", cfg.SearchBackend, "搜索后端: meilisearch|typesense")
+	cmd.Flags().StringVar(&meiliHost, "meili-host", cfg.MeiliHost, "Meili 地址")
+	cmd.Flags().StringVar(&meiliKey, "meili-key", cfg.MeiliAPIKey, "Meili API key")
+	cmd.Flags().StringVar(&meiliIndexName, "meili-index", cfg.MeiliIndex, "Meili 索引名")
+	cmd.Flags().StringVar(&typesenseHost, "typesense-host", cfg.TypesenseHost, "Typesense 地址")
+	cmd.Flags().StringVar(&typesenseKey, "typesense-key", cfg.TypesenseAPIKey, "Typesense API key")
+	cmd.Flags().StringVar(&typesenseCollection, "typesense-collection", cfg.TypesenseCollection, "Typesense collection 名")
+	cmd.Flags().StringVar(&tenantID, "tenant", "", "租户 ID，使用该租户的索引与同步租约")
+	return cmd
+}
+
+func newDeadLettersCommand(cfg config.Config) *cobra.Command {
+	var stateDBFile string
+	var rootFolderID int64
+	var limit int
+	var beforeID int64
+	var replay bool
+	var discard bool
+	var ids []int64
+	var all bool
+	var searchBackend string
+	var meiliHost string
+	var meiliKey string
+	var meiliIndexName string
+	var typesenseHost string
+	var typesenseKey string
+	var typesenseCollection string
+	var tenantID string
+
+	cmd := &cobra.Command{
+		Use:   "dead-letters",
+		Short: "查看、重放或丢弃写入索引失败的批次",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if replay && discard {
+				return fmt.Errorf("--replay 与 --discard 不能同时使用")
+			}
+			if replay || discard {
+				if all && len(ids) > 0 {
+					return fmt.Errorf("--ids 与 --all 不能同时使用")
+				}
+				if !all && len(ids) == 0 {
+					return fmt.Errorf("请指定 --ids 或 --all")
+				}
+			} else if limit <= 0 {
+				return fmt.Errorf("--limit 必须大于 0")
+			}
+			tenant, _, err := resolveTenant(cfg, tenantID)
+			if err != nil {
+				return err
+			}
+
+			stateStores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+				StateDBFile: stateDBFile,
+			})
+			if err != nil {
+				return err
+			}
+			defer stateStores.DB.Close()
+
+			tenantStores := stateStores.ForTenant(tenant.ID)
+			managerArgs := service.SyncManagerArgs{
+				ProgressStore:    tenantStores.ProgressStore,
+				Retry:            cfg.Retry,
+				DeadLetterStore:  tenantStores.DeadLetterStore,
+				IndexChangeStore: tenantStores.IndexChangeStore,
+				RunStore:         tenantStores.SyncRunStore,
+
+				SyncLeaseStore: stateStores.SyncLeaseStore,
+				SyncLeaseKey:   storage.SyncLeaseKey(tenant.ID),
+				SyncLeaseOwner: service.SyncLeaseOwner("cli"),
+				SyncLeaseTTL:   cfg.SyncLeaseTTL,
+			}
+			if replay {
+				index, _, err := search.NewIndexOperator(search.BackendConfig{
+					Backend:             searchBackend,
+					MeiliHost:           meiliHost,
+					MeiliAPIKey:         meiliKey,
+					MeiliIndex:          meiliIndexName,
+					TypesenseHost:       typesenseHost,
+					TypesenseAPIKey:     typesenseKey,
+					TypesenseCollection: typesenseCollection,
+				})
+				if err != nil {
+					return err
+				}
+				if managerArgs.Index, err = search.ForTenant(index, tenant.ID); err != nil {
+					return err
+				}
+			}
+			syncManager := service.NewSyncManager(managerArgs)
+
+			switch {
+			case replay:
+				result, err := syncManager.ReplayDeadLetters(cmd.Context(), ids, all)
+				if err != nil {
+					return err
+				}
+				return printJSON(result)
+			case discard:
+				discarded, err := syncManager.DiscardDeadLetters(ids, all)
+				if err != nil {
+					return err
+				}
+				return printJSON(map[string]int64{"discarded": discarded})
+			}
+
+			letters, err := syncManager.ListDeadLetters(storage.DeadLetterFilter{
+				RootFolderID: rootFolderID,
+				Limit:        limit,
+				BeforeID:     beforeID,
+			})
+			if err != nil {
+				return err
+			}
+			return printJSON(letters)
+		},
+	}
+
+	cmd.Flags().StringVar(&stateDBFile, "state-db-file", cfg.StateDBFile, "SQLite 状态库路径")
+	cmd.Flags().Int64Var(&rootFolderID, "root-folder-id", 0, "只看指定根目录的死信")
+	cmd.Flags().IntVar(&limit, "limit", 20, "返回条数")
+	cmd.Flags().Int64Var(&beforeID, "before-id", 0, "只返回 ID 小于该值的记录，用于翻页")
+	cmd.Flags().BoolVar(&replay, "replay", false, "重新写入指定死信批次，成功后删除")
+	cmd.Flags().BoolVar(&discard, "discard", false, "丢弃指定死信批次，不写入索引")
+	cmd.Flags().Int64SliceVar(&ids, "ids", nil, "要重放或丢弃的死信 ID，逗号分隔")
+	cmd.Flags().BoolVar(&all, "all", false, "重放或丢弃全部死信")
+	cmd.Flags().StringVar(&tenantID, "tenant", "", "租户 ID，处理该租户的死信")
+	cmd.Flags().StringVar(&searchBackend, "search-backend", cfg.SearchBackend, "搜索后端: meilisearch|typesense")
+	cmd.Flags().StringVar(&meiliHost, "meili-host", cfg.MeiliHost, "Meili 地址")
+	cmd.Flags().StringVar(&meiliKey, "meili-key", cfg.MeiliAPIKey, "Meili API key")
+	cmd.Flags().StringVar(&meiliIndexName, "meili-index", cfg.MeiliIndex, "Meili 索引名")
+	cmd.Flags().StringVar(&typesenseHost, "typesense-host", cfg.TypesenseHost, "Typesense 地址")
+	cmd.Flags().StringVar(&typesenseKey, "typesense-key", cfg.TypesenseAPIKey, "Typesense API key")
+	cmd.Flags().StringVar(&typesenseCollection, "typesense-collection", cfg.TypesenseCollection, "Typesense collection 名")
+	return cmd
+}
+
+func newDuplicatesCommand(cfg config.Config) *cobra.Command {
+	var rootFolderID int64
+	var minSize int64
+	var limit int
+	var format string
+	var output string
+	var searchBackend string
+	var meiliHost string
+	var meiliKey string
+	var meiliIndexName string
+	var typesenseHost string
+	var typesenseKey string
+	var typesenseCollection string
+	var tenantID string
+
+	cmd := &cobra.Command{
+		Use:   "duplicates",
+		Short: "按 SHA1 查找内容重复的文件",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if rootFolderID < 0 || minSize < 0 {
+				return fmt.Errorf("--root-folder-id 与 --min-size 不能为负数")
+			}
+			if limit <= 0 {
+				return fmt.Errorf("--limit 必须大于 0")
+			}
+			if format != "json" && format != "csv" && format != "ndjson" {
+				return fmt.Errorf("--format 仅支持 json|csv|ndjson")
+			}
+			tenant, _, err := resolveTenant(cfg, tenantID)
+			if err != nil {
+				return err
+			}
+
+			index, _, err := search.NewIndexOperator(search.BackendConfig{
+				Backend:             searchBackend,
+				MeiliHost:           meiliHost,
+				MeiliAPIKey:         meiliKey,
+				MeiliIndex:          meiliIndexName,
+				TypesenseHost:       typesenseHost,
+				TypesenseAPIKey:     typesenseKey,
+				TypesenseCollection: typesenseCollection,
+			})
+			if err != nil {
+				return err
+			}
+			index, err = search.ForTenant(index, tenant.ID)
+			if err != nil {
+				return err
+			}
+			syncManager := service.NewSyncManager(service.SyncManagerArgs{Index: index, Retry: cfg.Retry})
+
+			report, err := syncManager.FindDuplicates(cmd.Context(), service.DuplicateFilter{
+				RootFolderID: rootFolderID,
+				MinSize:      minSize,
+				Limit:        limit,
+			})
+			if err != nil {
+				return err
+			}
+			if format == "json" && output == "" {
+				return printJSON(report)
+			}
+
+			out := os.Stdout
+			if output != "" {
+				out, err = os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer out.Close()
+			}
+			if format == "json" {
+				encoder := json.NewEncoder(out)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(report)
+			}
+			return service.WriteDuplicateReport(out, report, format)
+		},
+	}
+
+	cmd.Flags().Int64Var(&rootFolderID, "root-folder-id", 0, "只检测指定根目录下的文件")
+	cmd.Flags().Int64Var(&minSize, "min-size", 1, "只检测不小于该字节数的文件，默认跳过空文件")
+	cmd.Flags().IntVar(&limit, "limit", 100, "最多返回的重复分组数（副本多的优先，最大 1000）")
+	cmd.Flags().StringVar(&format, "format", "json", "输出格式: json|csv|ndjson")
+	cmd.Flags().StringVar(&output, "output", "", "写入文件路径，默认输出到标准输出")
+	cmd.Flags().StringVar(&searchBackend, "search-backend", cfg.SearchBackend, "搜索后端: meilisearch|typesense")
+	cmd.Flags().StringVar(&meiliHost, "meili-host", cfg.MeiliHost, "Meili 地址")
+	cmd.Flags().StringVar(&meiliKey, "meili-key", cfg.MeiliAPIKey, "Meili API key")
+	cmd.Flags().StringVar(&meiliIndexName, "meili-index", cfg.MeiliIndex, "Meili 索引名")
+	cmd.Flags().StringVar(&typesenseHost, "typesense-host", cfg.TypesenseHost, "Typesense 地址")
+	cmd.Flags().StringVar(&typesenseKey, "typesense-key", cfg.TypesenseAPIKey, "Typesense API key")
+	cmd.Flags().StringVar(&typesenseCollection, "typesense-collection", cfg.TypesenseCollection, "Typesense collection 名")
+	cmd.Flags().StringVar(&tenantID, "tenant", "", "租户 ID，只检测该租户的文档")
+	return cmd
+}
+
+func newReconcileCommand(cfg config.Config) *cobra.Command {
+	var options authOptions
+	var stateDBFile string
+	var rootFolderIDsRaw string
+	var sampleSize int
+	var reportID int64
+	var onlyDrift bool
+	var format string
+	var output string
+	var searchBackend string
+	var meiliHost string
+	var meiliKey string
+	var meiliIndexName string
+	var typesenseHost string
+	var typesenseKey string
+	var typesenseCollection string
+	var tenantID string
+
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "逐目录比对上游条目数与索引文档数，导出漂移表；指定 --report-id 时只导出已有报告",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != "csv" && format != "json" {
+				return fmt.Errorf("--format 仅支持 csv|json")
+			}
+			if sampleSize < 0 || reportID < 0 {
+				return fmt.Errorf("--sample-size 与 --report-id 不能为负数")
+			}
+			roots, err := parseInt64CSV(rootFolderIDsRaw)
+			if err != nil {
+				return err
+			}
+			tenant, hasTenant, err := resolveTenant(cfg, tenantID)
+			if err != nil {
+				return err
+			}
+
+			stateStores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+				StateDBFile:        stateDBFile,
+				LegacyProgressFile: cfg.ProgressFile,
+			})
+			if err != nil {
+				return err
+			}
+			defer stateStores.DB.Close()
+
+			tenantStores := stateStores.ForTenant(tenant.ID)
+			managerArgs := service.SyncManagerArgs{
+				ProgressStore:       tenantStores.ProgressStore,
+				Retry:               cfg.Retry,
+				MaxConcurrent:       cfg.SyncMaxConcurrent,
+				MinTimeMS:           cfg.SyncMinTimeMS,
+				CircuitBreaker:      cfg.NewCircuitBreaker(nil),
+				ReconciliationStore: tenantStores.ReconciliationStore,
+
+				SyncLeaseStore: stateStores.SyncLeaseStore,
+				SyncLeaseKey:   storage.SyncLeaseKey(tenant.ID),
+				SyncLeaseOwner: service.SyncLeaseOwner("cli"),
+				SyncLeaseTTL:   cfg.SyncLeaseTTL,
+			}
+
+			if reportID == 0 {
+				// 租户使用配置文件中的凭据，忽略命令行的认证参数。
+				var auth upstreamAuth
+				baseURL := firstNotEmpty(options.baseURL, cfg.BaseURL)
+				if hasTenant {
+					auth, err = resolveUpstreamAuth(cmd.Context(), cfg, cfg.TenantAuthOptions(tenant))
+					baseURL = cfg.TenantBaseURL(tenant)
+				} else {
+					auth, err = resolveToken(cmd.Context(), cfg, options)
+				}
+				if err != nil {
+					return err
+				}
+				defer auth.close()
+
+				index, _, err := search.NewIndexOperator(search.BackendConfig{
+					Backend:             searchBackend,
+					MeiliHost:           meiliHost,
+					MeiliAPIKey:         meiliKey,
+					MeiliIndex:          meiliIndexName,
+					TypesenseHost:       typesenseHost,
+					TypesenseAPIKey:     typesenseKey,
+					TypesenseCollection: typesenseCollection,
+				})
+				if err != nil {
+					return err
+				}
+				if managerArgs.Index, err = search.ForTenant(index, tenant.ID); err != nil {
+					return err
+				}
+				syncManager := service.NewSyncManager(managerArgs)
+
+				ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+				defer stop()
+				report, err := syncManager.RunReconciliation(ctx, newAPIClient(baseURL, auth), service.ReconciliationRequest{
+					RootFolderIDs: roots,
+					SampleSize:    sampleSize,
+				})
+				if err != nil {
+					if report != nil {
+						return fmt.Errorf("对账报告 %d 未完成: %w", report.ID, err)
+					}
+					return err
+				}
+				reportID = report.ID
+				fmt.Fprintf(os.Stderr, "对账报告 %d：检查 %d 个目录，%d 个存在漂移\n", report.ID, report.FoldersChecked, report.FoldersDrifted)
+			}
+
+			// 导出只读取状态库，不需要搜索后端。
+			report, err := service.NewSyncManager(managerArgs).GetReconciliationReport(reportID, storage.ReconciliationRowFilter{OnlyDrift: onlyDrift})
+			if err != nil {
+				return err
+			}
+
+			out := os.Stdout
+			if output != "" {
+				out, err = os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer out.Close()
+			}
+			return service.WriteReconciliationReport(out, report, format)
+		},
+	}
+
+	addAuthFlags(cmd, &options, cfg)
+	cmd.Flags().StringVar(&stateDBFile, "state-db-file", cfg.StateDBFile, "SQLite 状态库路径")
+	cmd.Flags().StringVar(&rootFolderIDsRaw, "root-folder-ids", "", "根目录 ID 列表，逗号分隔，默认使用最近一次同步的根目录")
+	cmd.Flags().IntVar(&sampleSize, "sample-size", 0, "每个根目录最多抽查的目录数，0 表示检查全部目录")
+	cmd.Flags().Int64Var(&reportID, "report-id", 0, "只导出已有的对账报告，不重新对账")
+	cmd.Flags().BoolVar(&onlyDrift, "only-drift", false, "只导出有漂移或查询失败的目录")
+	cmd.Flags().StringVar(&format, "format", "csv", "导出格式: csv|json")
+	cmd.Flags().StringVar(&output, "output", "", "写入文件路径，默认输出到标准输出")
+	cmd.Flags().StringVar(&searchBackend, "search-backend", cfg.SearchBackend, "搜索后端: meilisearch|typesense")
+	cmd.Flags().StringVar(&meiliHost, "meili-host", cfg.MeiliHost, "Meili 地址")
+	cmd.Flags().StringVar(&meiliKey, "meili-key", cfg.MeiliAPIKey, "Meili API key")
+	cmd.Flags().StringVar(&meiliIndexName, "meili-index", cfg.MeiliIndex, "Meili 索引名")
+	cmd.Flags().StringVar(&typesenseHost, "typesense-host", cfg.TypesenseHost, "Typesense 地址")
+	cmd.Flags().StringVar(&typesenseKey, "typesense-key", cfg.TypesenseAPIKey, "Typesense API key")
+	cmd.Flags().StringVar(&typesenseCollection, "typesense-collection", cfg.TypesenseCollection, "Typesense collection 名")
+	cmd.Flags().StringVar(&tenantID, "tenant", "", "租户 ID，使用该租户的凭据、索引与独立的对账报告")
 	return cmd
 }
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"npan/internal/config"
+	"npan/internal/storage"
+)
+
+func TestSyncLeaseCommand_ShowsAndForceReleasesHolder(t *testing.T) {
+	stateDBFile := filepath.Join(t.TempDir(), "sync-state.sqlite")
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{StateDBFile: stateDBFile})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	t.Cleanup(func() { _ = stores.DB.Close() })
+
+	key := storage.SyncLeaseKey("")
+	if _, acquired, err := stores.SyncLeaseStore.Acquire(key, "server-1", "server@a pid 1", time.Minute); err != nil || !acquired {
+		t.Fatalf("acquire lease failed: %v %v", acquired, err)
+	}
+
+	cfg := config.Config{StateDBFile: stateDBFile}
+	cmd := newSyncLeaseCommand(cfg)
+	cmd.SetArgs([]string{})
+	output, err := captureStdout(func() error { return cmd.Execute() })
+	if err != nil {
+		t.Fatalf("sync-lease command failed: %v", err)
+	}
+	if !strings.Contains(output, "server@a pid 1") {
+		t.Fatalf("expected holder in output, got: %s", output)
+	}
+
+	cmd = newSyncLeaseCommand(cfg)
+	cmd.SetArgs([]string{"--force-release"})
+	if _, err := captureStdout(func() error { return cmd.Execute() }); err != nil {
+		t.Fatalf("sync-lease --force-release failed: %v", err)
+	}
+	if lease, err := stores.SyncLeaseStore.Get(key); err != nil || lease != nil {
+		t.Fatalf("expected lease to be released, got %#v %v", lease, err)
+	}
+}
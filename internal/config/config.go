@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"npan/internal/models"
+	"npan/internal/notify"
 	"npan/internal/npan"
 	"npan/internal/search"
 )
@@ -54,6 +55,12 @@ type Config struct {
 	IncrementalQuery    string
 	SyncWindowOverlapMS int64
 
+	IncrementalWindowMaxPages int64
+	IncrementalWindowMaxItems int64
+
+	// SyncLeaseTTL 是跨进程同步租约的有效期，持有者每隔三分之一有效期续约一次。
+	SyncLeaseTTL time.Duration
+
 	DefaultIncludeDepartments bool
 	DefaultRootFolderIDs      []int64
 	DefaultDepartmentIDs      []int64
@@ -61,10 +68,43 @@ type Config struct {
 	SyncMaxConcurrent            int
 	SyncMinTimeMS                int
 	SyncRootWorkers              int
+	SyncFolderWorkers            int
+	IndexBatchDocs               int
+	IndexBatchBytes              int
+	IndexMaxInFlight             int
 	SyncProgressEvery            int
+	PathRewriteMaxFolders        int
+	IndexChangeRetention         time.Duration
 	InspectRootsMaxConcurrency   int
 	InspectRootsPerFolderTimeout time.Duration
 
+	CircuitFailureThreshold int
+	CircuitOpenTimeout      time.Duration
+
+	TokenRefreshBefore time.Duration
+	TokenEncryptionKey string
+
+	TenantsFile string
+	Tenants     []TenantConfig
+	tenantsErr  error
+
+	SchedulerEnabled      bool
+	SchedulerTickInterval time.Duration
+	SchedulerTimezone     string
+
+	NotifyEvents            []string
+	NotifyWebhookURL        string
+	NotifyWebhookSecret     string
+	NotifyWebhookMaxRetries int
+	NotifySMTPHost          string
+	NotifySMTPPort          int
+	NotifySMTPUsername      string
+	NotifySMTPPassword      string
+	NotifySMTPFrom          string
+	NotifySMTPTo            []string
+	NotifyFile              string
+	NotifyTimeout           time.Duration
+
 	Retry models.RetryPolicyOptions
 }
 
@@ -141,6 +181,21 @@ func readInt64List(key string) []int64 {
 	return result
 }
 
+func readStringList(key string) []string {
+	raw := strings.TrimSpace(os.Getenv(key))
+	if raw == "" {
+		return nil
+	}
+	parts := strings.Split(raw, ",")
+	result := make([]string, 0, len(parts))
+	for _, item := range parts {
+		if trimmed := strings.TrimSpace(item); trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+	return result
+}
+
 func Load() Config {
 	loadDotEnv()
 
@@ -154,6 +209,9 @@ func Load() Config {
 		rootIDs = []int64{0}
 	}
 
+	tenantsFile := readString("NPA_TENANTS_FILE", "")
+	tenants, tenantsErr := loadTenants(tenantsFile)
+
 	return Config{
 		ServerAddr:              readString("SERVER_ADDR", ":1323"),
 		ServerReadHeaderTimeout: readDuration("SERVER_READ_HEADER_TIMEOUT", 5*time.Second),
@@ -195,6 +253,11 @@ func Load() Config {
 		IncrementalQuery:    readString("NPA_INCREMENTAL_QUERY_WORDS", "* OR *"),
 		SyncWindowOverlapMS: readInt64("NPA_SYNC_WINDOW_OVERLAP_MS", 2000),
 
+		IncrementalWindowMaxPages: readInt64("NPA_INCREMENTAL_WINDOW_MAX_PAGES", 100),
+		IncrementalWindowMaxItems: readInt64("NPA_INCREMENTAL_WINDOW_MAX_ITEMS", 10000),
+
+		SyncLeaseTTL: readDuration("NPA_SYNC_LEASE_TTL", time.Minute),
+
 		DefaultIncludeDepartments: readBool("NPA_INCLUDE_DEPARTMENTS", true),
 		DefaultRootFolderIDs:      rootIDs,
 		DefaultDepartmentIDs:      readInt64List("NPA_DEPARTMENT_IDS"),
@@ -202,10 +265,43 @@ func Load() Config {
 		SyncMaxConcurrent:            readInt("NPA_SYNC_MAX_CONCURRENT", 2),
 		SyncMinTimeMS:                readInt("NPA_SYNC_MIN_TIME_MS", 200),
 		SyncRootWorkers:              readInt("NPA_SYNC_ROOT_WORKERS", 2),
+		SyncFolderWorkers:            readInt("NPA_SYNC_FOLDER_WORKERS", 2),
+		IndexBatchDocs:               readInt("NPA_INDEX_BATCH_DOCS", 1000),
+		IndexBatchBytes:              readInt("NPA_INDEX_BATCH_BYTES", 8<<20),
+		IndexMaxInFlight:             readInt("NPA_INDEX_MAX_INFLIGHT", 2),
 		SyncProgressEvery:            readInt("NPA_SYNC_PROGRESS_EVERY", 1),
+		PathRewriteMaxFolders:        readInt("NPA_PATH_REWRITE_MAX_FOLDERS", 500),
+		IndexChangeRetention:         readDuration("NPA_INDEX_CHANGE_RETENTION", 7*24*time.Hour),
 		InspectRootsMaxConcurrency:   readInt("NPA_INSPECT_ROOTS_MAX_CONCURRENCY", 6),
 		InspectRootsPerFolderTimeout: readDuration("NPA_INSPECT_ROOTS_PER_FOLDER_TIMEOUT", 10*time.Second),
 
+		CircuitFailureThreshold: readInt("NPA_CIRCUIT_FAILURE_THRESHOLD", 5),
+		CircuitOpenTimeout:      readDuration("NPA_CIRCUIT_OPEN_TIMEOUT", 30*time.Second),
+
+		TokenRefreshBefore: readDuration("NPA_TOKEN_REFRESH_BEFORE", 5*time.Minute),
+		TokenEncryptionKey: readString("NPA_TOKEN_ENCRYPTION_KEY", ""),
+
+		TenantsFile: tenantsFile,
+		Tenants:     tenants,
+		tenantsErr:  tenantsErr,
+
+		SchedulerEnabled:      readBool("NPA_SCHEDULER_ENABLED", true),
+		SchedulerTickInterval: readDuration("NPA_SCHEDULER_TICK_INTERVAL", 15*time.Second),
+		SchedulerTimezone:     readString("NPA_SCHEDULER_TIMEZONE", ""),
+
+		NotifyEvents:            readStringList("NPA_NOTIFY_EVENTS"),
+		NotifyWebhookURL:        readString("NPA_NOTIFY_WEBHOOK_URL", ""),
+		NotifyWebhookSecret:     readString("NPA_NOTIFY_WEBHOOK_SECRET", ""),
+		NotifyWebhookMaxRetries: readInt("NPA_NOTIFY_WEBHOOK_MAX_RETRIES", 3),
+		NotifySMTPHost:          readString("NPA_NOTIFY_SMTP_HOST", ""),
+		NotifySMTPPort:          readInt("NPA_NOTIFY_SMTP_PORT", 587),
+		NotifySMTPUsername:      readString("NPA_NOTIFY_SMTP_USERNAME", ""),
+		NotifySMTPPassword:      readString("NPA_NOTIFY_SMTP_PASSWORD", ""),
+		NotifySMTPFrom:          readString("NPA_NOTIFY_SMTP_FROM", ""),
+		NotifySMTPTo:            readStringList("NPA_NOTIFY_SMTP_TO"),
+		NotifyFile:              readString("NPA_NOTIFY_FILE", ""),
+		NotifyTimeout:           readDuration("NPA_NOTIFY_TIMEOUT", 30*time.Second),
+
 		Retry: models.RetryPolicyOptions{
 			MaxRetries:  readInt("NPA_MAX_RETRIES", 3),
 			BaseDelayMS: readInt("NPA_BASE_DELAY_MS", 500),
@@ -225,3 +321,69 @@ func (c Config) PublicSearchBootstrap(backend search.Backend) (host string, inde
 		return strings.TrimSpace(c.PublicSearchHost), strings.TrimSpace(c.PublicSearchIndexName), strings.TrimSpace(c.PublicSearchAPIKey)
 	}
 }
+
+// SchedulerLocation 返回解析 cron 规则使用的时区，未配置时使用本地时区。
+func (c Config) SchedulerLocation() (*time.Location, error) {
+	name := strings.TrimSpace(c.SchedulerTimezone)
+	if name == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(name)
+}
+
+// NotifyOptions 返回同步通知渠道配置，未配置的渠道不启用。
+func (c Config) NotifyOptions() notify.Options {
+	return notify.Options{
+		Events: c.NotifyEvents,
+		Webhook: notify.WebhookConfig{
+			URL:        c.NotifyWebhookURL,
+			Secret:     c.NotifyWebhookSecret,
+			MaxRetries: c.NotifyWebhookMaxRetries,
+		},
+		SMTP: notify.SMTPConfig{
+			Host:     c.NotifySMTPHost,
+			Port:     c.NotifySMTPPort,
+			Username: c.NotifySMTPUsername,
+			Password: c.NotifySMTPPassword,
+			From:     c.NotifySMTPFrom,
+			To:       c.NotifySMTPTo,
+		},
+		FilePath:    c.NotifyFile,
+		SendTimeout: c.NotifyTimeout,
+	}
+}
+
+// NewCircuitBreaker 按配置创建 Npan API 熔断器；阈值不大于 0 时返回 nil，表示不启用。
+func (c Config) NewCircuitBreaker(onStateChange func(from npan.CircuitState, to npan.CircuitState)) *npan.CircuitBreaker {
+	if c.CircuitFailureThreshold <= 0 {
+		return nil
+	}
+	return npan.NewCircuitBreaker(npan.CircuitBreakerOptions{
+		FailureThreshold: c.CircuitFailureThreshold,
+		OpenTimeout:      c.CircuitOpenTimeout,
+		OnStateChange:    onStateChange,
+	})
+}
+
+// NewTokenManager 为配置中的 OAuth 凭据创建 token 管理器；配置了静态 token 或凭据不完整时返回 nil。
+func (c Config) NewTokenManager(store npan.TokenStore) (*npan.TokenManager, error) {
+	if strings.TrimSpace(c.Token) != "" {
+		return nil, nil
+	}
+	auth := npan.AuthResolverOptions{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		SubID:        c.SubID,
+		SubType:      c.SubType,
+		OAuthHost:    c.OAuthHost,
+	}
+	if !npan.CanAutoRefresh(auth) {
+		return nil, nil
+	}
+	return npan.NewTokenManager(npan.TokenManagerOptions{
+		Auth:          auth,
+		Store:         store,
+		RefreshBefore: c.TokenRefreshBefore,
+		EncryptionKey: c.TokenEncryptionKey,
+	})
+}
@@ -0,0 +1,146 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"npan/internal/npan"
+)
+
+// TenantConfig 是一个租户（企业或账号）的独立凭据与同步范围。
+type TenantConfig struct {
+	ID                 string                `json:"id"`
+	Name               string                `json:"name,omitempty"`
+	BaseURL            string                `json:"base_url,omitempty"`
+	OAuthHost          string                `json:"oauth_host,omitempty"`
+	Token              string                `json:"token,omitempty"`
+	ClientID           string                `json:"client_id,omitempty"`
+	ClientSecret       string                `json:"client_secret,omitempty"`
+	SubID              int64                 `json:"sub_id,omitempty"`
+	SubType            npan.TokenSubjectType `json:"sub_type,omitempty"`
+	RootFolderIDs      []int64               `json:"root_folder_ids,omitempty"`
+	DepartmentIDs      []int64               `json:"department_ids,omitempty"`
+	IncludeDepartments *bool                 `json:"include_departments,omitempty"`
+	// PublicSearchAPIKey 是浏览器直连搜索使用的租户专属 key，必须在搜索后端限定为只能检索本租户文档。
+	PublicSearchAPIKey string `json:"public_search_api_key,omitempty"`
+}
+
+// tenantIDPattern 限制租户 ID 只含小写字母、数字与连字符：它会拼进文档 ID 与过滤表达式。
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// loadTenants 读取 NPA_TENANTS_FILE 指向的 JSON 数组，未配置时返回 nil。
+func loadTenants(path string) ([]TenantConfig, error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, nil
+	}
+	payload, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取租户配置失败: %w", err)
+	}
+	var tenants []TenantConfig
+	if err := json.Unmarshal(payload, &tenants); err != nil {
+		return nil, fmt.Errorf("解析租户配置失败: %w", err)
+	}
+	for i := range tenants {
+		tenants[i].ID = strings.TrimSpace(tenants[i].ID)
+		if tenants[i].SubType == "" {
+			tenants[i].SubType = npan.TokenSubjectUser
+		}
+	}
+	return tenants, nil
+}
+
+func (c Config) validateTenants() []string {
+	if c.tenantsErr != nil {
+		return []string{fmt.Sprintf("NPA_TENANTS_FILE 无效: %v", c.tenantsErr)}
+	}
+	if strings.TrimSpace(c.TenantsFile) != "" && len(c.Tenants) == 0 {
+		return []string{"NPA_TENANTS_FILE 未定义任何租户"}
+	}
+
+	var errs []string
+	seen := map[string]struct{}{}
+	for _, tenant := range c.Tenants {
+		if !tenantIDPattern.MatchString(tenant.ID) {
+			errs = append(errs, fmt.Sprintf("租户 ID %q 无效，只能包含小写字母、数字与连字符，最长 32 字符", tenant.ID))
+			continue
+		}
+		if _, ok := seen[tenant.ID]; ok {
+			errs = append(errs, fmt.Sprintf("租户 ID %s 重复", tenant.ID))
+		}
+		seen[tenant.ID] = struct{}{}
+		if strings.TrimSpace(tenant.Token) == "" && !npan.CanAutoRefresh(c.TenantAuthOptions(tenant)) {
+			errs = append(errs, fmt.Sprintf("租户 %s 未提供 token 或完整 OAuth 三元组", tenant.ID))
+		}
+		if key := strings.TrimSpace(tenant.PublicSearchAPIKey); key != "" && (key == strings.TrimSpace(c.MeiliAPIKey) || key == strings.TrimSpace(c.TypesenseAPIKey)) {
+			errs = append(errs, fmt.Sprintf("租户 %s 的 public_search_api_key 不能复用私有 key", tenant.ID))
+		}
+	}
+	return errs
+}
+
+// MultiTenant 判断是否配置了多租户。
+func (c Config) MultiTenant() bool {
+	return len(c.Tenants) > 0
+}
+
+// DefaultTenantID 返回请求未指定租户时使用的租户，即配置中的第一个；未配置多租户时为空。
+func (c Config) DefaultTenantID() string {
+	if len(c.Tenants) == 0 {
+		return ""
+	}
+	return c.Tenants[0].ID
+}
+
+// Tenant 按 ID 查找租户配置。
+func (c Config) Tenant(id string) (TenantConfig, bool) {
+	for _, tenant := range c.Tenants {
+		if tenant.ID == id {
+			return tenant, true
+		}
+	}
+	return TenantConfig{}, false
+}
+
+// TenantBaseURL 返回租户的 OpenAPI 地址，未单独配置时使用全局 NPA_BASE_URL。
+func (c Config) TenantBaseURL(tenant TenantConfig) string {
+	return firstNonBlank(tenant.BaseURL, c.BaseURL)
+}
+
+// TenantAuthOptions 返回租户的上游认证参数，OAuth 地址未单独配置时使用全局 NPA_OAUTH_HOST。
+func (c Config) TenantAuthOptions(tenant TenantConfig) npan.AuthResolverOptions {
+	return npan.AuthResolverOptions{
+		Token:        strings.TrimSpace(tenant.Token),
+		ClientID:     strings.TrimSpace(tenant.ClientID),
+		ClientSecret: strings.TrimSpace(tenant.ClientSecret),
+		SubID:        tenant.SubID,
+		SubType:      tenant.SubType,
+		OAuthHost:    firstNonBlank(tenant.OAuthHost, c.OAuthHost, npan.DefaultOAuthHost),
+	}
+}
+
+// NewTenantTokenManager 为租户的 OAuth 凭据创建 token 管理器；租户配置了静态 token 时返回 nil。
+func (c Config) NewTenantTokenManager(tenant TenantConfig, store npan.TokenStore) (*npan.TokenManager, error) {
+	auth := c.TenantAuthOptions(tenant)
+	if auth.Token != "" || !npan.CanAutoRefresh(auth) {
+		return nil, nil
+	}
+	return npan.NewTokenManager(npan.TokenManagerOptions{
+		Auth:          auth,
+		Store:         store,
+		RefreshBefore: c.TokenRefreshBefore,
+		EncryptionKey: c.TokenEncryptionKey,
+	})
+}
+
+func firstNonBlank(values ...string) string {
+	for _, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			return trimmed
+		}
+	}
+	return ""
+}
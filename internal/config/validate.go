@@ -3,8 +3,10 @@ package config
 import (
 	"fmt"
 	"log/slog"
+	"net/url"
 	"strings"
 
+	"npan/internal/notify"
 	"npan/internal/search"
 )
 
@@ -103,9 +105,21 @@ func (c Config) Validate() error {
 		errs = append(errs, "NPA_MAX_RETRIES 应在 0-10 之间")
 	}
 
+	if c.SchedulerEnabled {
+		if c.SchedulerTickInterval <= 0 {
+			errs = append(errs, "NPA_SCHEDULER_TICK_INTERVAL 必须大于 0")
+		}
+		if _, err := c.SchedulerLocation(); err != nil {
+			errs = append(errs, fmt.Sprintf("NPA_SCHEDULER_TIMEZONE 无效: %v", err))
+		}
+	}
+
+	errs = append(errs, c.validateNotify()...)
+	errs = append(errs, c.validateTenants()...)
+
 	hasClientCreds := c.ClientID != "" && c.ClientSecret != "" && c.SubID > 0
 	hasToken := c.Token != ""
-	if c.AllowConfigAuthFallback && !hasClientCreds && !hasToken {
+	if c.AllowConfigAuthFallback && !hasClientCreds && !hasToken && !c.MultiTenant() {
 		errs = append(errs, "NPA_ALLOW_CONFIG_AUTH_FALLBACK=true 但未提供有效凭据")
 	}
 
@@ -115,6 +129,32 @@ func (c Config) Validate() error {
 	return nil
 }
 
+func (c Config) validateNotify() []string {
+	var errs []string
+	for _, name := range c.NotifyEvents {
+		switch notify.EventType(name) {
+		case notify.EventSyncStarted, notify.EventSyncFinished, notify.EventSyncFailed, notify.EventSyncCancelled, notify.EventVerificationWarning:
+		default:
+			errs = append(errs, fmt.Sprintf("NPA_NOTIFY_EVENTS 包含未知事件: %s", name))
+		}
+	}
+	if raw := strings.TrimSpace(c.NotifyWebhookURL); raw != "" {
+		parsed, err := url.Parse(raw)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			errs = append(errs, "NPA_NOTIFY_WEBHOOK_URL 必须是 http(s) 地址")
+		}
+	}
+	if strings.TrimSpace(c.NotifySMTPHost) != "" {
+		if strings.TrimSpace(c.NotifySMTPFrom) == "" {
+			errs = append(errs, "NPA_NOTIFY_SMTP_FROM 不能为空")
+		}
+		if len(c.NotifySMTPTo) == 0 {
+			errs = append(errs, "NPA_NOTIFY_SMTP_TO 不能为空")
+		}
+	}
+	return errs
+}
+
 func (c Config) LogValue() slog.Value {
 	return slog.GroupValue(
 		slog.String("ServerAddr", c.ServerAddr),
@@ -130,6 +170,7 @@ func (c Config) LogValue() slog.Value {
 		slog.String("TypesensePublicSearchHost", c.TypesensePublicSearchHost),
 		slog.String("TypesensePublicSearchIndex", c.TypesensePublicSearchIndex),
 		slog.Bool("PublicSearchInstantsearchOn", c.PublicSearchInstantsearchOn),
+		slog.Int("Tenants", len(c.Tenants)),
 		slog.String("AdminAPIKey", "[REDACTED]"),
 		slog.String("ClientSecret", "[REDACTED]"),
 		slog.String("Token", "[REDACTED]"),
@@ -137,5 +178,7 @@ func (c Config) LogValue() slog.Value {
 		slog.String("TypesenseAPIKey", "[REDACTED]"),
 		slog.String("PublicSearchAPIKey", "[REDACTED]"),
 		slog.String("TypesensePublicSearchAPIKey", "[REDACTED]"),
+		slog.String("NotifyWebhookSecret", "[REDACTED]"),
+		slog.String("NotifySMTPPassword", "[REDACTED]"),
 	)
 }
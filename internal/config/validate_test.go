@@ -3,6 +3,7 @@ package config
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"npan/internal/models"
 	"npan/internal/npan"
@@ -212,3 +213,68 @@ func TestValidate_PublicSearchConfigMustNotReusePrivateMeiliAPIKey(t *testing.T)
 		t.Fatalf("expected validation error to mention private MEILI_API_KEY reuse, got: %s", err.Error())
 	}
 }
+
+func TestValidate_SchedulerRejectsUnknownTimezone(t *testing.T) {
+	cfg := validConfig()
+	cfg.SchedulerEnabled = true
+	cfg.SchedulerTickInterval = 15 * time.Second
+	cfg.SchedulerTimezone = "Mars/Olympus_Mons"
+
+	err := cfg.Validate()
+
+	if err == nil || !strings.Contains(err.Error(), "NPA_SCHEDULER_TIMEZONE") {
+		t.Fatalf("expected scheduler timezone error, got: %v", err)
+	}
+}
+
+func TestValidate_SchedulerRequiresPositiveTickInterval(t *testing.T) {
+	cfg := validConfig()
+	cfg.SchedulerEnabled = true
+
+	err := cfg.Validate()
+
+	if err == nil || !strings.Contains(err.Error(), "NPA_SCHEDULER_TICK_INTERVAL") {
+		t.Fatalf("expected scheduler tick interval error, got: %v", err)
+	}
+}
+
+func TestValidate_NotifyRejectsInvalidSinkConfig(t *testing.T) {
+	cfg := validConfig()
+	cfg.NotifyEvents = []string{"sync_failed", "sync_exploded"}
+	cfg.NotifyWebhookURL = "ftp://hooks.example.com"
+	cfg.NotifySMTPHost = "mail.example.com"
+
+	err := cfg.Validate()
+
+	if err == nil {
+		t.Fatal("expected notify validation errors")
+	}
+	for _, want := range []string{"sync_exploded", "NPA_NOTIFY_WEBHOOK_URL", "NPA_NOTIFY_SMTP_FROM", "NPA_NOTIFY_SMTP_TO"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected validation error to mention %s, got: %s", want, err.Error())
+		}
+	}
+}
+
+func TestValidate_TenantsRejectInvalidEntries(t *testing.T) {
+	cfg := validConfig()
+	cfg.MeiliAPIKey = "private-meili-key"
+	cfg.Tenants = []TenantConfig{
+		{ID: "acme", Token: "acme-token"},
+		{ID: "acme", Token: "other-token"},
+		{ID: "Bad_ID", Token: "token"},
+		{ID: "globex"},
+		{ID: "initech", Token: "token", PublicSearchAPIKey: "private-meili-key"},
+	}
+
+	err := cfg.Validate()
+
+	if err == nil {
+		t.Fatal("expected tenant validation errors")
+	}
+	for _, want := range []string{"acme 重复", `"Bad_ID"`, "globex 未提供", "initech 的 public_search_api_key"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected validation error to mention %s, got: %s", want, err.Error())
+		}
+	}
+}
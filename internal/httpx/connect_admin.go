@@ -14,6 +14,8 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	npanv1 "npan/gen/go/npan/v1"
 	"npan/internal/models"
+	"npan/internal/npan"
+	"npan/internal/search"
 	"npan/internal/service"
 )
 
@@ -37,12 +39,27 @@ func (s *adminConnectServer) StartSync(ctx context.Context, req *connect.Request
 	if force := req.Msg.ForceRebuild != nil && req.Msg.GetForceRebuild(); force && len(req.Msg.GetRootFolderIds()) > 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("force_rebuild 仅允许全量全库执行"))
 	}
+	if shadow := req.Msg.GetShadowRebuild(); shadow {
+		if len(req.Msg.GetRootFolderIds()) > 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("shadow_rebuild 仅允许全量全库执行"))
+		}
+		if req.Msg.GetForceRebuild() {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("shadow_rebuild 不能与 force_rebuild 同时使用"))
+		}
+	}
 
-	token, authOptions, err := s.handlers.resolveTokenForConnect(ctx, req.Header(), authPayload{}, true)
+	tenant, err := s.handlers.resolveTenant(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	syncManager := s.handlers.syncManager
+	if tenant != nil {
+		syncManager = tenant.SyncManager
+	}
+	api, err := s.handlers.syncAPIClient(ctx, req.Header(), tenant)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("启动同步失败"))
 	}
-	api := s.handlers.newAPIClient(token, authOptions)
 
 	checkpointTemplate := req.Msg.GetCheckpointTemplate()
 	if checkpointTemplate != "" {
@@ -59,8 +76,12 @@ func (s *adminConnectServer) StartSync(ctx context.Context, req *connect.Request
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
+	folderWorkers, err := optionalInt64ToInt(req.Msg.FolderWorkers)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 
-	startErr := s.handlers.syncManager.Start(api, service.SyncStartRequest{
+	request := service.SyncStartRequest{
 		Mode:                fromProtoSyncMode(req.Msg.Mode),
 		RootFolderIDs:       req.Msg.GetRootFolderIds(),
 		IncludeDepartments:  req.Msg.IncludeDepartments,
@@ -68,12 +89,27 @@ func (s *adminConnectServer) StartSync(ctx context.Context, req *connect.Request
 		DepartmentIDs:       req.Msg.GetDepartmentIds(),
 		ResumeProgress:      req.Msg.ResumeProgress,
 		ForceRebuild:        req.Msg.ForceRebuild,
+		ShadowRebuild:       req.Msg.ShadowRebuild,
 		RootWorkers:         rootWorkers,
+		FolderWorkers:       folderWorkers,
 		ProgressEvery:       progressEvery,
 		CheckpointTemplate:  checkpointTemplate,
 		WindowOverlapMS:     req.Msg.GetWindowOverlapMs(),
 		IncrementalQuery:    req.Msg.GetIncrementalQuery(),
-	})
+		DryRun:              req.Msg.GetDryRun(),
+	}
+	applyTenantSyncDefaults(tenant, &request)
+	startErr := syncManager.Start(api, request)
+	if errors.Is(startErr, search.ErrRebuildUnsupported) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, startErr)
+	}
+	if errors.Is(startErr, service.ErrDryRunWithRebuild) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, startErr)
+	}
+	// 其他进程持有同步租约时原样返回持有者信息，便于判断是否需要强制释放。
+	if errors.Is(startErr, service.ErrSyncLeaseHeld) || errors.Is(startErr, service.ErrReconciliationRunning) || errors.Is(startErr, service.ErrFolderResyncRunning) {
+		return nil, connect.NewError(connect.CodeAborted, startErr)
+	}
 	if startErr != nil {
 		return nil, connect.NewError(connect.CodeAborted, errors.New("启动同步失败"))
 	}
@@ -95,6 +131,9 @@ func (s *adminConnectServer) InspectRoots(ctx context.Context, req *connect.Requ
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("folder_ids 必须是正整数数组"))
 	}
+	if s.handlers.circuitOpen() {
+		return nil, connect.NewError(connect.CodeUnavailable, npan.ErrCircuitOpen)
+	}
 
 	token, authOptions, err := s.handlers.resolveTokenForConnect(ctx, req.Header(), authPayload{}, true)
 	if err != nil {
@@ -214,25 +253,56 @@ func (s *adminConnectServer) InspectRoots(ctx context.Context, req *connect.Requ
 	return connect.NewResponse(resp), nil
 }
 
-func (s *adminConnectServer) GetIndexStats(ctx context.Context, _ *connect.Request[npanv1.GetIndexStatsRequest]) (*connect.Response[npanv1.GetIndexStatsResponse], error) {
+func (s *adminConnectServer) GetIndexStats(ctx context.Context, req *connect.Request[npanv1.GetIndexStatsRequest]) (*connect.Response[npanv1.GetIndexStatsResponse], error) {
 	if s.handlers == nil || s.handlers.syncManager == nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
 	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
 
-	count, err := s.handlers.syncManager.GetIndexDocumentCount(ctx)
+	count, err := syncManager.GetIndexDocumentCount(ctx)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.New("无法读取索引状态"))
 	}
 
-	return connect.NewResponse(&npanv1.GetIndexStatsResponse{DocumentCount: count}), nil
+	resp := &npanv1.GetIndexStatsResponse{DocumentCount: count}
+	if s.handlers.tokenManager != nil {
+		resp.Token = toProtoOAuthTokenStatus(s.handlers.tokenManager.Status())
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func toProtoOAuthTokenStatus(status npan.TokenStatus) *npanv1.OAuthTokenStatus {
+	return &npanv1.OAuthTokenStatus{
+		State:        string(status.State),
+		ExpiresAt:    unixMilliOrZero(status.ExpiresAt),
+		RefreshedAt:  unixMilliOrZero(status.RefreshedAt),
+		Source:       status.Source,
+		RefreshCount: status.RefreshCount,
+		LastError:    status.LastError,
+		LastErrorAt:  unixMilliOrZero(status.LastErrorAt),
+	}
+}
+
+func unixMilliOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
 }
 
-func (s *adminConnectServer) GetSyncProgress(_ context.Context, _ *connect.Request[npanv1.GetSyncProgressRequest]) (*connect.Response[npanv1.GetSyncProgressResponse], error) {
+func (s *adminConnectServer) GetSyncProgress(_ context.Context, req *connect.Request[npanv1.GetSyncProgressRequest]) (*connect.Response[npanv1.GetSyncProgressResponse], error) {
 	if s.handlers == nil || s.handlers.syncManager == nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
 	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
 
-	progress, err := s.handlers.syncManager.GetProgress()
+	progress, err := syncManager.GetProgress()
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.New("无法读取同步进度"))
 	}
@@ -247,15 +317,19 @@ func (s *adminConnectServer) GetSyncProgress(_ context.Context, _ *connect.Reque
 
 func (s *adminConnectServer) WatchSyncProgress(
 	ctx context.Context,
-	_ *connect.Request[npanv1.WatchSyncProgressRequest],
+	req *connect.Request[npanv1.WatchSyncProgressRequest],
 	stream *connect.ServerStream[npanv1.WatchSyncProgressResponse],
 ) error {
 	if s.handlers == nil || s.handlers.syncManager == nil {
 		return connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
 	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return err
+	}
 
 	sendProgress := func() (bool, error) {
-		progress, err := s.handlers.syncManager.GetProgress()
+		progress, err := syncManager.GetProgress()
 		if err != nil {
 			return false, connect.NewError(connect.CodeInternal, errors.New("无法读取同步进度"))
 		}
@@ -306,12 +380,16 @@ func (s *adminConnectServer) WatchSyncProgress(
 	}
 }
 
-func (s *adminConnectServer) CancelSync(_ context.Context, _ *connect.Request[npanv1.CancelSyncRequest]) (*connect.Response[npanv1.CancelSyncResponse], error) {
+func (s *adminConnectServer) CancelSync(_ context.Context, req *connect.Request[npanv1.CancelSyncRequest]) (*connect.Response[npanv1.CancelSyncResponse], error) {
 	if s.handlers == nil || s.handlers.syncManager == nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
 	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
 
-	if !s.handlers.syncManager.Cancel() {
+	if !syncManager.Cancel() {
 		return nil, connect.NewError(connect.CodeAborted, errors.New("当前没有运行中的同步任务"))
 	}
 
@@ -320,6 +398,77 @@ func (s *adminConnectServer) CancelSync(_ context.Context, _ *connect.Request[np
 	}), nil
 }
 
+func (s *adminConnectServer) PauseSync(_ context.Context, req *connect.Request[npanv1.PauseSyncRequest]) (*connect.Response[npanv1.PauseSyncResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := syncManager.Pause(); err != nil {
+		return nil, toSyncPauseConnectError(err)
+	}
+
+	return connect.NewResponse(&npanv1.PauseSyncResponse{
+		Message: "同步已暂停，缓冲文档已写入",
+	}), nil
+}
+
+func (s *adminConnectServer) ResumeSync(_ context.Context, req *connect.Request[npanv1.ResumeSyncRequest]) (*connect.Response[npanv1.ResumeSyncResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := syncManager.Resume(); err != nil {
+		return nil, toSyncPauseConnectError(err)
+	}
+
+	return connect.NewResponse(&npanv1.ResumeSyncResponse{
+		Message: "同步已恢复",
+	}), nil
+}
+
+func toSyncPauseConnectError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrSyncNotRunning):
+		return connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, service.ErrSyncAlreadyPaused), errors.Is(err, service.ErrSyncNotPaused):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, errors.New("操作同步失败"))
+	}
+}
+
+func (s *adminConnectServer) RollbackIndexRebuild(ctx context.Context, req *connect.Request[npanv1.RollbackIndexRebuildRequest]) (*connect.Response[npanv1.RollbackIndexRebuildResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := syncManager.RollbackRebuild(ctx)
+	switch {
+	case errors.Is(err, service.ErrSyncInProgress), errors.Is(err, service.ErrSyncLeaseHeld):
+		return nil, connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, service.ErrNoRebuildToRollback), errors.Is(err, search.ErrRebuildUnsupported):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, errors.New("回滚索引失败"))
+	}
+
+	return connect.NewResponse(&npanv1.RollbackIndexRebuildResponse{
+		Rebuild: toProtoIndexRebuildState(state),
+	}), nil
+}
+
 func optionalInt64ToInt(v *int64) (int, error) {
 	if v == nil {
 		return 0, nil
@@ -366,39 +515,124 @@ func toProtoSyncProgressState(state *models.SyncProgressState) *npanv1.SyncProgr
 		CatalogRoots:        state.CatalogRoots,
 		CatalogRootNames:    int64MapToStringKeyMap(state.CatalogRootNames),
 		CatalogRootProgress: toProtoRootProgressMap(state.CatalogRootProgress),
+		StaleRemoved:        state.StaleRemoved,
+	}
+	if state.PausedAt > 0 {
+		resp.PausedAt = &state.PausedAt
 	}
 
 	if mode := toProtoSyncMode(state.Mode); mode != nil {
 		resp.Mode = mode
 	}
-	if state.IncrementalStats != nil {
-		resp.IncrementalStats = &npanv1.IncrementalSyncStats{
-			ChangesFetched: state.IncrementalStats.ChangesFetched,
-			Upserted:       state.IncrementalStats.Upserted,
-			Deleted:        state.IncrementalStats.Deleted,
-			SkippedUpserts: state.IncrementalStats.SkippedUpserts,
-			SkippedDeletes: state.IncrementalStats.SkippedDeletes,
-			CursorBefore:   state.IncrementalStats.CursorBefore,
-			CursorAfter:    state.IncrementalStats.CursorAfter,
-		}
-	}
-	if state.Verification != nil {
-		resp.Verification = &npanv1.SyncVerification{
-			MeiliDocCount:      state.Verification.MeiliDocCount,
-			CrawledDocCount:    state.Verification.CrawledDocCount,
-			DiscoveredDocCount: state.Verification.DiscoveredDocCount,
-			SkippedCount:       state.Verification.SkippedCount,
-			Verified:           state.Verification.Verified,
-			Warnings:           state.Verification.Warnings,
-		}
-	}
+	resp.IncrementalStats = toProtoIncrementalSyncStats(state.IncrementalStats)
+	resp.Verification = toProtoSyncVerification(state.Verification)
 	if lastError := toOptionalString(state.LastError); lastError != nil {
 		resp.LastError = lastError
 	}
+	if state.Rebuild != nil {
+		resp.Rebuild = toProtoIndexRebuildState(state.Rebuild)
+	}
+	resp.DryRun = toProtoDryRunReport(state.DryRun)
+	resp.RateControl = toProtoRateControlState(state.RateControl)
+
+	return resp
+}
+
+func toProtoRateControlState(state *models.RateControlState) *npanv1.RateControlState {
+	if state == nil {
+		return nil
+	}
+	return &npanv1.RateControlState{
+		BaseRate:           state.BaseRate,
+		EffectiveRate:      state.EffectiveRate,
+		Backoff:            state.Backoff,
+		PausedUntil:        state.PausedUntil,
+		ThrottleEvents:     state.ThrottleEvents,
+		LastThrottleAt:     state.LastThrottleAt,
+		LastThrottleStatus: int32(state.LastThrottleStatus),
+	}
+}
+
+func toProtoIncrementalSyncStats(stats *models.IncrementalSyncStats) *npanv1.IncrementalSyncStats {
+	if stats == nil {
+		return nil
+	}
+	return &npanv1.IncrementalSyncStats{
+		ChangesFetched: stats.ChangesFetched,
+		Upserted:       stats.Upserted,
+		Deleted:        stats.Deleted,
+		SkippedUpserts: stats.SkippedUpserts,
+		SkippedDeletes: stats.SkippedDeletes,
+		CursorBefore:   stats.CursorBefore,
+		CursorAfter:    stats.CursorAfter,
+
+		FoldersMoved:        stats.FoldersMoved,
+		PathsRewritten:      stats.PathsRewritten,
+		PathRewritesPending: stats.PathRewritesPending,
+
+		CascadeDeleted:  stats.CascadeDeleted,
+		FoldersRestored: stats.FoldersRestored,
+		RestoredDocs:    stats.RestoredDocs,
+		RecrawlsPending: stats.RecrawlsPending,
+
+		WindowsFetched: stats.WindowsFetched,
+		WindowsSplit:   stats.WindowsSplit,
+		WindowsPending: stats.WindowsPending,
+	}
+}
 
+func toProtoSyncVerification(verification *models.SyncVerification) *npanv1.SyncVerification {
+	if verification == nil {
+		return nil
+	}
+	return &npanv1.SyncVerification{
+		MeiliDocCount:      verification.MeiliDocCount,
+		CrawledDocCount:    verification.CrawledDocCount,
+		DiscoveredDocCount: verification.DiscoveredDocCount,
+		SkippedCount:       verification.SkippedCount,
+		StaleRemoved:       verification.StaleRemoved,
+		Verified:           verification.Verified,
+		Warnings:           verification.Warnings,
+		DeadLetterCount:    verification.DeadLetterCount,
+	}
+}
+
+func toProtoIndexRebuildState(state *models.IndexRebuildState) *npanv1.IndexRebuildState {
+	if state == nil {
+		return nil
+	}
+
+	resp := &npanv1.IndexRebuildState{
+		Status:      toProtoIndexRebuildStatus(state.Status),
+		LiveIndex:   state.LiveIndex,
+		ShadowIndex: state.ShadowIndex,
+		StartedAt:   state.StartedAt,
+		LastError:   toOptionalString(state.LastError),
+	}
+	if state.SwappedAt > 0 {
+		swappedAt := state.SwappedAt
+		resp.SwappedAt = &swappedAt
+	}
+	if state.RolledBackAt > 0 {
+		rolledBackAt := state.RolledBackAt
+		resp.RolledBackAt = &rolledBackAt
+	}
 	return resp
 }
 
+func toProtoIndexRebuildStatus(raw string) npanv1.IndexRebuildStatus {
+	switch raw {
+	case models.RebuildStatusBuilding:
+		return npanv1.IndexRebuildStatus_INDEX_REBUILD_STATUS_BUILDING
+	case models.RebuildStatusSwapped:
+		return npanv1.IndexRebuildStatus_INDEX_REBUILD_STATUS_SWAPPED
+	case models.RebuildStatusRolledBack:
+		return npanv1.IndexRebuildStatus_INDEX_REBUILD_STATUS_ROLLED_BACK
+	default:
+		return npanv1.IndexRebuildStatus_INDEX_REBUILD_STATUS_UNSPECIFIED
+	}
+}
+
 func toProtoSyncStatus(raw string) npanv1.SyncStatus {
 	switch strings.ToLower(strings.TrimSpace(raw)) {
 	case "idle":
@@ -413,6 +647,8 @@ func toProtoSyncStatus(raw string) npanv1.SyncStatus {
 		return npanv1.SyncStatus_SYNC_STATUS_CANCELLED
 	case "interrupted":
 		return npanv1.SyncStatus_SYNC_STATUS_INTERRUPTED
+	case "paused":
+		return npanv1.SyncStatus_SYNC_STATUS_PAUSED
 	default:
 		return npanv1.SyncStatus_SYNC_STATUS_UNSPECIFIED
 	}
@@ -475,6 +711,7 @@ func toProtoRootProgressMap(in map[string]*models.RootSyncProgress) map[string]*
 			CurrentPageId:      value.CurrentPageID,
 			CurrentPageCount:   value.CurrentPageCount,
 			QueueLength:        value.QueueLength,
+			StaleRemoved:       value.StaleRemoved,
 		}
 		if value.Error != "" {
 			errorCopy := value.Error
@@ -0,0 +1,154 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/internal/models"
+	"npan/internal/service"
+	"npan/internal/storage"
+)
+
+const defaultListDeadLettersLimit = 20
+
+func (s *adminConnectServer) ListDeadLetters(_ context.Context, req *connect.Request[npanv1.ListDeadLettersRequest]) (*connect.Response[npanv1.ListDeadLettersResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	limit := defaultListDeadLettersLimit
+	if req.Msg.Limit != nil {
+		limit = int(req.Msg.GetLimit())
+	}
+	letters, err := syncManager.ListDeadLetters(storage.DeadLetterFilter{
+		RootFolderID: req.Msg.GetRootFolderId(),
+		Limit:        limit,
+		BeforeID:     req.Msg.GetBeforeId(),
+	})
+	if err != nil {
+		return nil, deadLetterConnectError(err, "无法读取死信队列")
+	}
+	total, err := syncManager.CountDeadLetters()
+	if err != nil {
+		return nil, deadLetterConnectError(err, "无法读取死信队列")
+	}
+
+	resp := &npanv1.ListDeadLettersResponse{
+		DeadLetters: make([]*npanv1.DeadLetter, 0, len(letters)),
+		Total:       total,
+	}
+	for i := range letters {
+		resp.DeadLetters = append(resp.DeadLetters, toProtoDeadLetter(&letters[i]))
+	}
+	if len(letters) == limit {
+		nextBeforeID := letters[len(letters)-1].ID
+		resp.NextBeforeId = &nextBeforeID
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *adminConnectServer) ReplayDeadLetters(ctx context.Context, req *connect.Request[npanv1.ReplayDeadLettersRequest]) (*connect.Response[npanv1.ReplayDeadLettersResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDeadLetterSelection(req.Msg.GetIds(), req.Msg.GetAll()); err != nil {
+		return nil, err
+	}
+
+	result, err := syncManager.ReplayDeadLetters(ctx, req.Msg.GetIds(), req.Msg.GetAll())
+	if err != nil {
+		return nil, deadLetterConnectError(err, "重放死信失败")
+	}
+	remaining, err := syncManager.CountDeadLetters()
+	if err != nil {
+		return nil, deadLetterConnectError(err, "无法读取死信队列")
+	}
+
+	return connect.NewResponse(&npanv1.ReplayDeadLettersResponse{
+		ReplayedIds:   result.Replayed,
+		FailedIds:     result.Failed,
+		Remaining:     remaining,
+		SupersededIds: result.Superseded,
+	}), nil
+}
+
+func (s *adminConnectServer) DiscardDeadLetters(_ context.Context, req *connect.Request[npanv1.DiscardDeadLettersRequest]) (*connect.Response[npanv1.DiscardDeadLettersResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDeadLetterSelection(req.Msg.GetIds(), req.Msg.GetAll()); err != nil {
+		return nil, err
+	}
+
+	discarded, err := syncManager.DiscardDeadLetters(req.Msg.GetIds(), req.Msg.GetAll())
+	if err != nil {
+		return nil, deadLetterConnectError(err, "丢弃死信失败")
+	}
+	remaining, err := syncManager.CountDeadLetters()
+	if err != nil {
+		return nil, deadLetterConnectError(err, "无法读取死信队列")
+	}
+
+	return connect.NewResponse(&npanv1.DiscardDeadLettersResponse{
+		Discarded: discarded,
+		Remaining: remaining,
+	}), nil
+}
+
+func validateDeadLetterSelection(ids []int64, all bool) error {
+	if all && len(ids) > 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("ids 与 all 不能同时指定"))
+	}
+	if !all && len(ids) == 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("请指定 ids 或 all"))
+	}
+	return nil
+}
+
+func deadLetterConnectError(err error, internalMessage string) error {
+	switch {
+	case errors.Is(err, service.ErrDeadLetterNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, service.ErrDeadLettersDisabled):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, service.ErrSyncInProgress), errors.Is(err, service.ErrSyncLeaseHeld):
+		return connect.NewError(connect.CodeAborted, err)
+	default:
+		return connect.NewError(connect.CodeInternal, errors.New(internalMessage))
+	}
+}
+
+func toProtoDeadLetter(letter *models.DeadLetter) *npanv1.DeadLetter {
+	docIDs := make([]string, 0, len(letter.Documents))
+	for _, doc := range letter.Documents {
+		docIDs = append(docIDs, doc.DocID)
+	}
+	return &npanv1.DeadLetter{
+		Id:           letter.ID,
+		RunId:        letter.RunID,
+		RootFolderId: letter.RootFolderID,
+		DocCount:     int64(len(letter.Documents)),
+		DocIds:       docIDs,
+		Error:        letter.Error,
+		Attempts:     letter.Attempts,
+		CreatedAt:    letter.CreatedAt,
+		CreatedAtTs:  millisToProtoTimestamp(letter.CreatedAt),
+		UpdatedAt:    letter.UpdatedAt,
+		UpdatedAtTs:  millisToProtoTimestamp(letter.UpdatedAt),
+	}
+}
@@ -0,0 +1,60 @@
+package httpx
+
+import (
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/internal/models"
+)
+
+func toProtoDryRunReport(report *models.DryRunReport) *npanv1.DryRunReport {
+	if report == nil {
+		return nil
+	}
+
+	resp := &npanv1.DryRunReport{
+		Mode:      toProtoSyncMode(report.Mode),
+		StartedAt: report.StartedAt,
+		Roots:     make([]*npanv1.DryRunRootDiff, 0, len(report.Roots)),
+		Adds:      report.Adds,
+		Updates:   report.Updates,
+		Deletes:   report.Deletes,
+
+		Status:     toProtoSyncStatus(report.Status),
+		ActiveRoot: report.ActiveRoot,
+	}
+	if report.LastError != "" {
+		lastError := report.LastError
+		resp.LastError = &lastError
+	}
+	if report.FinishedAt > 0 {
+		finishedAt := report.FinishedAt
+		resp.FinishedAt = &finishedAt
+	}
+	for _, root := range report.Roots {
+		resp.Roots = append(resp.Roots, &npanv1.DryRunRootDiff{
+			RootFolderId:  root.RootFolderID,
+			RootName:      root.RootName,
+			Adds:          root.Adds,
+			Updates:       root.Updates,
+			Deletes:       root.Deletes,
+			Unchanged:     root.Unchanged,
+			SampleAdds:    toProtoDryRunSamples(root.SampleAdds),
+			SampleUpdates: toProtoDryRunSamples(root.SampleUpdates),
+			SampleDeletes: toProtoDryRunSamples(root.SampleDeletes),
+		})
+	}
+	return resp
+}
+
+func toProtoDryRunSamples(samples []models.DryRunSample) []*npanv1.DryRunSample {
+	result := make([]*npanv1.DryRunSample, 0, len(samples))
+	for _, sample := range samples {
+		result = append(result, &npanv1.DryRunSample{
+			DocId:         sample.DocID,
+			SourceId:      sample.SourceID,
+			Type:          toProtoItemType(sample.Type),
+			Path:          sample.Path,
+			ChangedFields: sample.ChangedFields,
+		})
+	}
+	return result
+}
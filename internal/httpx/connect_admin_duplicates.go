@@ -0,0 +1,72 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/internal/service"
+)
+
+func (s *adminConnectServer) FindDuplicates(ctx context.Context, req *connect.Request[npanv1.FindDuplicatesRequest]) (*connect.Response[npanv1.FindDuplicatesResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := syncManager.FindDuplicates(ctx, service.DuplicateFilter{
+		RootFolderID: req.Msg.GetRootFolderId(),
+		MinSize:      req.Msg.GetMinSize(),
+		Limit:        int(req.Msg.GetLimit()),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("重复文件检测失败"))
+	}
+
+	resp := &npanv1.FindDuplicatesResponse{
+		Groups:      make([]*npanv1.DuplicateGroup, 0, len(report.Groups)),
+		WastedBytes: report.WastedBytes,
+		TotalGroups: report.TotalGroups,
+		Truncated:   report.Truncated,
+	}
+	for _, group := range report.Groups {
+		resp.Groups = append(resp.Groups, toProtoDuplicateGroup(group))
+	}
+	if req.Msg.ExportFormat != nil {
+		var export strings.Builder
+		if err := service.WriteDuplicateReport(&export, report, req.Msg.GetExportFormat()); err != nil {
+			if errors.Is(err, service.ErrUnsupportedExportFormat) {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			return nil, connect.NewError(connect.CodeInternal, errors.New("导出重复文件报告失败"))
+		}
+		resp.Export = export.String()
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func toProtoDuplicateGroup(group service.DuplicateGroup) *npanv1.DuplicateGroup {
+	files := make([]*npanv1.DuplicateFile, 0, len(group.Files))
+	for _, file := range group.Files {
+		files = append(files, &npanv1.DuplicateFile{
+			DocId:    file.DocID,
+			SourceId: file.SourceID,
+			Name:     file.Name,
+			Path:     file.Path,
+			Size:     file.Size,
+		})
+	}
+	return &npanv1.DuplicateGroup{
+		Sha1:        group.SHA1,
+		Size:        group.Size,
+		Copies:      group.Copies,
+		WastedBytes: group.WastedBytes,
+		Files:       files,
+	}
+}
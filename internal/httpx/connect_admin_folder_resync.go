@@ -0,0 +1,154 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/internal/models"
+	"npan/internal/npan"
+	"npan/internal/service"
+)
+
+func (s *adminConnectServer) ResyncFolder(ctx context.Context, req *connect.Request[npanv1.ResyncFolderRequest]) (*connect.Response[npanv1.ResyncFolderResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	mode, err := fromProtoFolderResyncMode(req.Msg.Mode)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tenant, err := s.handlers.resolveTenant(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	syncManager := s.handlers.syncManager
+	if tenant != nil {
+		syncManager = tenant.SyncManager
+	}
+	api, err := s.handlers.syncAPIClient(ctx, req.Header(), tenant)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("启动目录重同步失败"))
+	}
+
+	startErr := syncManager.StartFolderResync(api, service.FolderResyncRequest{
+		FolderID: req.Msg.GetFolderId(),
+		Mode:     mode,
+	})
+	switch {
+	case errors.Is(startErr, service.ErrFolderResyncRunning), errors.Is(startErr, service.ErrSyncLeaseHeld), errors.Is(startErr, service.ErrReconciliationRunning), errors.Is(startErr, service.ErrSyncInProgress):
+		return nil, connect.NewError(connect.CodeAborted, startErr)
+	case errors.Is(startErr, service.ErrFolderResyncRootFolder), errors.Is(startErr, service.ErrFolderResyncDuringRebuild):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, startErr)
+	case startErr != nil:
+		return nil, connect.NewError(connect.CodeInternal, errors.New("启动目录重同步失败"))
+	}
+
+	return connect.NewResponse(&npanv1.ResyncFolderResponse{
+		Message: "目录重同步任务已启动",
+	}), nil
+}
+
+func (s *adminConnectServer) GetFolderResyncProgress(_ context.Context, req *connect.Request[npanv1.GetFolderResyncProgressRequest]) (*connect.Response[npanv1.GetFolderResyncProgressResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	progress := syncManager.GetFolderResyncProgress()
+	if progress == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("未找到目录重同步记录"))
+	}
+	return connect.NewResponse(&npanv1.GetFolderResyncProgressResponse{
+		Progress: toProtoFolderResyncProgress(progress),
+	}), nil
+}
+
+func (s *adminConnectServer) CancelFolderResync(_ context.Context, req *connect.Request[npanv1.CancelFolderResyncRequest]) (*connect.Response[npanv1.CancelFolderResyncResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := syncManager.CancelFolderResync(); err != nil {
+		return nil, connect.NewError(connect.CodeAborted, err)
+	}
+	return connect.NewResponse(&npanv1.CancelFolderResyncResponse{
+		Message: "目录重同步取消信号已发送",
+	}), nil
+}
+
+// syncAPIClient 为后台同步任务创建上游客户端：租户使用自身凭据，否则按请求头与服务端配置解析 token。
+func (h *Handlers) syncAPIClient(ctx context.Context, header http.Header, tenant *Tenant) (npan.API, error) {
+	if tenant != nil {
+		return h.tenantAPIClient(ctx, tenant)
+	}
+	token, authOptions, err := h.resolveTokenForConnect(ctx, header, authPayload{}, true)
+	if err != nil {
+		return nil, err
+	}
+	return h.newAPIClient(token, authOptions), nil
+}
+
+func fromProtoFolderResyncMode(mode *npanv1.FolderResyncMode) (models.FolderResyncMode, error) {
+	if mode == nil {
+		return models.FolderResyncModeMerge, nil
+	}
+	switch *mode {
+	case npanv1.FolderResyncMode_FOLDER_RESYNC_MODE_UNSPECIFIED, npanv1.FolderResyncMode_FOLDER_RESYNC_MODE_MERGE:
+		return models.FolderResyncModeMerge, nil
+	case npanv1.FolderResyncMode_FOLDER_RESYNC_MODE_REBUILD:
+		return models.FolderResyncModeRebuild, nil
+	default:
+		return "", fmt.Errorf("不支持的重同步模式: %d", int32(*mode))
+	}
+}
+
+func toProtoFolderResyncMode(mode models.FolderResyncMode) npanv1.FolderResyncMode {
+	switch mode {
+	case models.FolderResyncModeMerge:
+		return npanv1.FolderResyncMode_FOLDER_RESYNC_MODE_MERGE
+	case models.FolderResyncModeRebuild:
+		return npanv1.FolderResyncMode_FOLDER_RESYNC_MODE_REBUILD
+	default:
+		return npanv1.FolderResyncMode_FOLDER_RESYNC_MODE_UNSPECIFIED
+	}
+}
+
+func toProtoFolderResyncProgress(progress *models.FolderResyncProgress) *npanv1.FolderResyncProgress {
+	if progress == nil {
+		return nil
+	}
+	out := &npanv1.FolderResyncProgress{
+		FolderId:        progress.FolderID,
+		FolderName:      progress.FolderName,
+		Mode:            toProtoFolderResyncMode(progress.Mode),
+		Status:          toProtoSyncStatus(progress.Status),
+		StartedAt:       progress.StartedAt,
+		UpdatedAt:       progress.UpdatedAt,
+		DocsDeleted:     progress.DocsDeleted,
+		DocsWritten:     progress.DocsWritten,
+		FoldersVisited:  progress.FoldersVisited,
+		CurrentFolderId: progress.CurrentFolderID,
+	}
+	if progress.FinishedAt > 0 {
+		finishedAt := progress.FinishedAt
+		out.FinishedAt = &finishedAt
+	}
+	if progress.LastError != "" {
+		lastError := progress.LastError
+		out.LastError = &lastError
+	}
+	return out
+}
@@ -0,0 +1,102 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/internal/models"
+	"npan/internal/service"
+	"npan/internal/storage"
+)
+
+const defaultListSyncRunsLimit = 20
+
+func (s *adminConnectServer) ListSyncRuns(_ context.Context, req *connect.Request[npanv1.ListSyncRunsRequest]) (*connect.Response[npanv1.ListSyncRunsResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	limit := defaultListSyncRunsLimit
+	if req.Msg.Limit != nil {
+		limit = int(req.Msg.GetLimit())
+	}
+	runs, err := syncManager.ListSyncRuns(storage.SyncRunFilter{
+		Mode:     fromProtoSyncMode(req.Msg.Mode),
+		Limit:    limit,
+		BeforeID: req.Msg.GetBeforeId(),
+	})
+	if errors.Is(err, service.ErrSyncHistoryDisabled) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("无法读取同步历史"))
+	}
+
+	resp := &npanv1.ListSyncRunsResponse{
+		Runs: make([]*npanv1.SyncRun, 0, len(runs)),
+	}
+	for i := range runs {
+		resp.Runs = append(resp.Runs, toProtoSyncRun(&runs[i]))
+	}
+	if len(runs) == limit {
+		nextBeforeID := runs[len(runs)-1].ID
+		resp.NextBeforeId = &nextBeforeID
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *adminConnectServer) GetSyncRun(_ context.Context, req *connect.Request[npanv1.GetSyncRunRequest]) (*connect.Response[npanv1.GetSyncRunResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := syncManager.GetSyncRun(req.Msg.GetId())
+	switch {
+	case errors.Is(err, service.ErrSyncRunNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, service.ErrSyncHistoryDisabled):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, errors.New("无法读取同步历史"))
+	}
+
+	return connect.NewResponse(&npanv1.GetSyncRunResponse{Run: toProtoSyncRun(run)}), nil
+}
+
+func toProtoSyncRun(run *models.SyncRun) *npanv1.SyncRun {
+	if run == nil {
+		return nil
+	}
+
+	resp := &npanv1.SyncRun{
+		Id:               run.ID,
+		Status:           toProtoSyncStatus(run.Status),
+		Roots:            run.Roots,
+		StartedAt:        run.StartedAt,
+		StartedAtTs:      millisToProtoTimestamp(run.StartedAt),
+		EndedAt:          run.EndedAt,
+		EndedAtTs:        millisToProtoTimestamp(run.EndedAt),
+		Stats:            toProtoCrawlStats(run.Stats),
+		IncrementalStats: toProtoIncrementalSyncStats(run.IncrementalStats),
+		Verification:     toProtoSyncVerification(run.Verification),
+		Error:            toOptionalString(run.Error),
+	}
+	if mode := toProtoSyncMode(string(run.Mode)); mode != nil {
+		resp.Mode = *mode
+	}
+	if run.EndedAt > run.StartedAt {
+		resp.DurationMs = run.EndedAt - run.StartedAt
+	}
+	return resp
+}
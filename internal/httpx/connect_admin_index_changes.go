@@ -0,0 +1,132 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/internal/models"
+	"npan/internal/service"
+)
+
+var watchIndexChangesPollInterval = time.Second
+
+// watchIndexChangesBatch 是单条流消息携带的最大变更数；读满一批时不等待轮询间隔，直接继续追赶。
+const watchIndexChangesBatch = 500
+
+func (s *adminConnectServer) WatchIndexChanges(
+	ctx context.Context,
+	req *connect.Request[npanv1.WatchIndexChangesRequest],
+	stream *connect.ServerStream[npanv1.WatchIndexChangesResponse],
+) error {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	manager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return err
+	}
+
+	afterSeq := req.Msg.GetAfterSeq()
+	if req.Msg.GetFromLatest() {
+		latest, err := manager.LatestIndexChangeSeq()
+		if err != nil {
+			return toIndexChangesError(err)
+		}
+		afterSeq = latest
+		// 先告知起始序号，消费方断线后可从这里续读。
+		if err := stream.Send(&npanv1.WatchIndexChangesResponse{LatestSeq: latest}); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(watchIndexChangesPollInterval)
+	defer ticker.Stop()
+
+	for {
+		changes, err := manager.ListIndexChanges(afterSeq, watchIndexChangesBatch)
+		if err != nil {
+			return toIndexChangesError(err)
+		}
+		if len(changes) > 0 {
+			latest, err := manager.LatestIndexChangeSeq()
+			if err != nil {
+				return toIndexChangesError(err)
+			}
+			resp := &npanv1.WatchIndexChangesResponse{
+				Changes:   make([]*npanv1.IndexChange, 0, len(changes)),
+				LatestSeq: latest,
+			}
+			for i := range changes {
+				resp.Changes = append(resp.Changes, toProtoIndexChange(&changes[i]))
+			}
+			if err := stream.Send(resp); err != nil {
+				if errors.Is(err, context.Canceled) {
+					return nil
+				}
+				return err
+			}
+			afterSeq = changes[len(changes)-1].Seq
+			if len(changes) == watchIndexChangesBatch {
+				continue
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return nil
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func toIndexChangesError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrIndexChangesDisabled):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, service.ErrIndexChangesExpired):
+		return connect.NewError(connect.CodeOutOfRange, err)
+	default:
+		return connect.NewError(connect.CodeInternal, errors.New("无法读取索引变更"))
+	}
+}
+
+func toProtoIndexChange(change *models.IndexChange) *npanv1.IndexChange {
+	item := &npanv1.IndexChange{
+		Seq:          change.Seq,
+		Op:           toProtoIndexChangeOp(change.Op),
+		DocId:        change.DocID,
+		RootFolderId: change.RootFolderID,
+		RunId:        change.RunID,
+		Removed:      change.Removed,
+		OccurredAt:   change.OccurredAt,
+	}
+	if change.Document != nil {
+		item.Document = toProtoIndexDocument(*change.Document)
+	}
+	return item
+}
+
+func toProtoIndexChangeOp(op models.IndexChangeOp) npanv1.IndexChangeOp {
+	switch op {
+	case models.IndexChangeUpsert:
+		return npanv1.IndexChangeOp_INDEX_CHANGE_OP_UPSERT
+	case models.IndexChangeDelete:
+		return npanv1.IndexChangeOp_INDEX_CHANGE_OP_DELETE
+	case models.IndexChangeSweep:
+		return npanv1.IndexChangeOp_INDEX_CHANGE_OP_SWEEP
+	case models.IndexChangeReset:
+		return npanv1.IndexChangeOp_INDEX_CHANGE_OP_RESET
+	default:
+		return npanv1.IndexChangeOp_INDEX_CHANGE_OP_UNSPECIFIED
+	}
+}
@@ -0,0 +1,150 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/gen/go/npan/v1/npanv1connect"
+	"npan/internal/models"
+	"npan/internal/service"
+	"npan/internal/storage"
+)
+
+func newIndexChangesTestClient(t *testing.T) (npanv1connect.AdminServiceClient, storage.IndexChangeStore) {
+	t.Helper()
+
+	originalInterval := watchIndexChangesPollInterval
+	watchIndexChangesPollInterval = 20 * time.Millisecond
+	t.Cleanup(func() {
+		watchIndexChangesPollInterval = originalInterval
+	})
+
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	t.Cleanup(func() { _ = stores.DB.Close() })
+
+	handlers := newTestHandlers(t)
+	handlers.syncManager = service.NewSyncManager(service.SyncManagerArgs{
+		ProgressStore:    stores.ProgressStore,
+		IndexChangeStore: stores.IndexChangeStore,
+	})
+
+	ts := httptest.NewServer(NewServer(handlers, testAdminKey, testDistFS(), nil))
+	t.Cleanup(ts.Close)
+	return npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL), stores.IndexChangeStore
+}
+
+func TestConnectAdminWatchIndexChanges_StreamsAndResumes(t *testing.T) {
+	client, store := newIndexChangesTestClient(t)
+	if err := store.Append([]models.IndexChange{
+		{Op: models.IndexChangeUpsert, DocID: "file_1", Document: &models.IndexDocument{DocID: "file_1", Name: "a.pdf", Type: models.ItemTypeFile}, RootFolderID: 100, OccurredAt: 1_000},
+		{Op: models.IndexChangeDelete, DocID: "file_2", OccurredAt: 2_000},
+	}); err != nil {
+		t.Fatalf("append failed: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	stream, err := client.WatchIndexChanges(ctx, withAdminKey(&npanv1.WatchIndexChangesRequest{}))
+	if err != nil {
+		t.Fatalf("WatchIndexChanges returned error: %v", err)
+	}
+
+	if !stream.Receive() {
+		t.Fatalf("expected first batch, err=%v", stream.Err())
+	}
+	changes := stream.Msg().GetChanges()
+	if len(changes) != 2 || stream.Msg().GetLatestSeq() != 2 {
+		t.Fatalf("unexpected first batch: %#v", stream.Msg())
+	}
+	if changes[0].GetOp() != npanv1.IndexChangeOp_INDEX_CHANGE_OP_UPSERT || changes[0].GetDocument().GetName() != "a.pdf" || changes[0].GetRootFolderId() != 100 {
+		t.Fatalf("unexpected upsert change: %#v", changes[0])
+	}
+	if changes[1].GetOp() != npanv1.IndexChangeOp_INDEX_CHANGE_OP_DELETE || changes[1].GetDocId() != "file_2" || changes[1].Document != nil {
+		t.Fatalf("unexpected delete change: %#v", changes[1])
+	}
+
+	if err := store.Append([]models.IndexChange{{Op: models.IndexChangeSweep, RootFolderID: 100, Removed: 3, OccurredAt: 3_000}}); err != nil {
+		t.Fatalf("append failed: %v", err)
+	}
+	if !stream.Receive() {
+		t.Fatalf("expected change appended after subscribe, err=%v", stream.Err())
+	}
+	if got := stream.Msg().GetChanges(); len(got) != 1 || got[0].GetSeq() != 3 || got[0].GetRemoved() != 3 {
+		t.Fatalf("unexpected follow-up batch: %#v", got)
+	}
+	cancel()
+
+	resumeCtx, resumeCancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer resumeCancel()
+	resumed, err := client.WatchIndexChanges(resumeCtx, withAdminKey(&npanv1.WatchIndexChangesRequest{AfterSeq: 2}))
+	if err != nil {
+		t.Fatalf("WatchIndexChanges returned error: %v", err)
+	}
+	if !resumed.Receive() {
+		t.Fatalf("expected resumed batch, err=%v", resumed.Err())
+	}
+	if got := resumed.Msg().GetChanges(); len(got) != 1 || got[0].GetSeq() != 3 {
+		t.Fatalf("expected resume to start after seq 2, got %#v", got)
+	}
+
+	fromLatest := true
+	latestStream, err := client.WatchIndexChanges(resumeCtx, withAdminKey(&npanv1.WatchIndexChangesRequest{FromLatest: &fromLatest}))
+	if err != nil {
+		t.Fatalf("WatchIndexChanges returned error: %v", err)
+	}
+	if !latestStream.Receive() {
+		t.Fatalf("expected latest sequence message, err=%v", latestStream.Err())
+	}
+	if len(latestStream.Msg().GetChanges()) != 0 || latestStream.Msg().GetLatestSeq() != 3 {
+		t.Fatalf("expected empty message carrying latest seq 3, got %#v", latestStream.Msg())
+	}
+}
+
+func TestConnectAdminWatchIndexChanges_ExpiredAndDisabled(t *testing.T) {
+	client, store := newIndexChangesTestClient(t)
+	if err := store.Append([]models.IndexChange{
+		{Op: models.IndexChangeDelete, DocID: "file_1", OccurredAt: 1_000},
+		{Op: models.IndexChangeDelete, DocID: "file_2", OccurredAt: 2_000},
+	}); err != nil {
+		t.Fatalf("append failed: %v", err)
+	}
+	if _, err := store.PruneBefore(1_500); err != nil {
+		t.Fatalf("prune failed: %v", err)
+	}
+
+	stream, err := client.WatchIndexChanges(context.Background(), withAdminKey(&npanv1.WatchIndexChangesRequest{}))
+	if err != nil {
+		t.Fatalf("WatchIndexChanges returned error: %v", err)
+	}
+	if stream.Receive() {
+		t.Fatalf("expected stream to fail for a pruned sequence")
+	}
+	var connectErr *connect.Error
+	if !errors.As(stream.Err(), &connectErr) || connectErr.Code() != connect.CodeOutOfRange {
+		t.Fatalf("expected out of range, got %v", stream.Err())
+	}
+
+	disabled := newScheduleTestClient(t, false)
+	stream, err = disabled.WatchIndexChanges(context.Background(), withAdminKey(&npanv1.WatchIndexChangesRequest{}))
+	if err != nil {
+		t.Fatalf("WatchIndexChanges returned error: %v", err)
+	}
+	if stream.Receive() {
+		t.Fatalf("expected stream to fail without a change log")
+	}
+	if !errors.As(stream.Err(), &connectErr) || connectErr.Code() != connect.CodeFailedPrecondition {
+		t.Fatalf("expected failed precondition, got %v", stream.Err())
+	}
+}
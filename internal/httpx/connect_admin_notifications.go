@@ -0,0 +1,45 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/internal/notify"
+)
+
+func (s *adminConnectServer) TestNotification(ctx context.Context, req *connect.Request[npanv1.TestNotificationRequest]) (*connect.Response[npanv1.TestNotificationResponse], error) {
+	if s.handlers == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("服务未初始化"))
+	}
+	if s.handlers.notifier == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, notify.ErrNoSinks)
+	}
+
+	results, err := s.handlers.notifier.SendTest(ctx, req.Msg.GetSink())
+	if err != nil {
+		switch {
+		case errors.Is(err, notify.ErrNoSinks):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, notify.ErrNoSinks)
+		case errors.Is(err, notify.ErrSinkNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("指定的通知渠道未启用"))
+		default:
+			return nil, connect.NewError(connect.CodeInternal, errors.New("发送测试通知失败"))
+		}
+	}
+
+	resp := &npanv1.TestNotificationResponse{
+		Results: make([]*npanv1.NotificationSinkResult, 0, len(results)),
+	}
+	for _, result := range results {
+		item := &npanv1.NotificationSinkResult{Sink: result.Sink, Ok: result.Err == nil}
+		if result.Err != nil {
+			message := result.Err.Error()
+			item.Error = &message
+		}
+		resp.Results = append(resp.Results, item)
+	}
+	return connect.NewResponse(resp), nil
+}
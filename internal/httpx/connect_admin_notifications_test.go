@@ -0,0 +1,84 @@
+package httpx
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/gen/go/npan/v1/npanv1connect"
+	"npan/internal/notify"
+)
+
+func newNotificationTestClient(t *testing.T, notifier *notify.Dispatcher) npanv1connect.AdminServiceClient {
+	t.Helper()
+
+	handlers := newTestHandlers(t)
+	if notifier != nil {
+		handlers.SetNotifier(notifier)
+	}
+	ts := httptest.NewServer(NewServer(handlers, testAdminKey, testDistFS(), nil))
+	t.Cleanup(ts.Close)
+	return npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+}
+
+func TestConnectAdminTestNotification_WritesFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events", "notify.ndjson")
+	client := newNotificationTestClient(t, notify.NewDispatcher(notify.Options{FilePath: path}))
+
+	resp, err := client.TestNotification(context.Background(), withAdminKey(&npanv1.TestNotificationRequest{}))
+	if err != nil {
+		t.Fatalf("test notification failed: %v", err)
+	}
+	results := resp.Msg.GetResults()
+	if len(results) != 1 || results[0].GetSink() != "file" || !results[0].GetOk() || results[0].Error != nil {
+		t.Fatalf("unexpected results: %#v", results)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open notify file failed: %v", err)
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	if !scanner.Scan() {
+		t.Fatalf("expected one event line")
+	}
+	var event notify.Event
+	if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+		t.Fatalf("decode event failed: %v", err)
+	}
+	if event.Type != notify.EventTest {
+		t.Fatalf("expected test event, got %q", event.Type)
+	}
+
+	sink := "webhook"
+	_, err = client.TestNotification(context.Background(), withAdminKey(&npanv1.TestNotificationRequest{Sink: &sink}))
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeNotFound {
+		t.Fatalf("expected not found for disabled sink, got %v", err)
+	}
+}
+
+func TestConnectAdminTestNotification_NoSinks(t *testing.T) {
+	for name, notifier := range map[string]*notify.Dispatcher{
+		"not injected": nil,
+		"empty":        notify.NewDispatcher(notify.Options{}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			client := newNotificationTestClient(t, notifier)
+			_, err := client.TestNotification(context.Background(), withAdminKey(&npanv1.TestNotificationRequest{}))
+			var connectErr *connect.Error
+			if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeFailedPrecondition {
+				t.Fatalf("expected failed precondition, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/internal/models"
+	"npan/internal/service"
+	"npan/internal/storage"
+)
+
+const defaultReconciliationRowLimit = 100
+
+func (s *adminConnectServer) StartReconciliation(ctx context.Context, req *connect.Request[npanv1.StartReconciliationRequest]) (*connect.Response[npanv1.StartReconciliationResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+
+	tenant, err := s.handlers.resolveTenant(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	syncManager := s.handlers.syncManager
+	if tenant != nil {
+		syncManager = tenant.SyncManager
+	}
+	api, err := s.handlers.syncAPIClient(ctx, req.Header(), tenant)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("启动对账失败"))
+	}
+
+	report, err := syncManager.StartReconciliation(api, service.ReconciliationRequest{
+		RootFolderIDs: req.Msg.GetRootFolderIds(),
+		SampleSize:    int(req.Msg.GetSampleSize()),
+	})
+	if err != nil {
+		return nil, reconciliationConnectError(err, "启动对账失败")
+	}
+	return connect.NewResponse(&npanv1.StartReconciliationResponse{
+		Report: toProtoReconciliationReport(report),
+	}), nil
+}
+
+func (s *adminConnectServer) GetReconciliationReport(_ context.Context, req *connect.Request[npanv1.GetReconciliationReportRequest]) (*connect.Response[npanv1.GetReconciliationReportResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	limit := defaultReconciliationRowLimit
+	if req.Msg.Limit != nil {
+		limit = int(req.Msg.GetLimit())
+	}
+	report, err := syncManager.GetReconciliationReport(req.Msg.GetReportId(), storage.ReconciliationRowFilter{
+		OnlyDrift: req.Msg.GetOnlyDrift(),
+		Limit:     limit,
+	})
+	if err != nil {
+		return nil, reconciliationConnectError(err, "读取对账报告失败")
+	}
+
+	resp := &npanv1.GetReconciliationReportResponse{
+		Report: toProtoReconciliationReport(report),
+		Rows:   make([]*npanv1.ReconciliationRow, 0, len(report.Rows)),
+	}
+	for _, row := range report.Rows {
+		resp.Rows = append(resp.Rows, toProtoReconciliationRow(row))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *adminConnectServer) ExportReconciliationReport(_ context.Context, req *connect.Request[npanv1.ExportReconciliationReportRequest]) (*connect.Response[npanv1.ExportReconciliationReportResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := syncManager.GetReconciliationReport(req.Msg.GetReportId(), storage.ReconciliationRowFilter{
+		OnlyDrift: req.Msg.GetOnlyDrift(),
+	})
+	if err != nil {
+		return nil, reconciliationConnectError(err, "读取对账报告失败")
+	}
+
+	var export strings.Builder
+	if err := service.WriteReconciliationReport(&export, report, req.Msg.GetFormat()); err != nil {
+		if errors.Is(err, service.ErrUnsupportedExportFormat) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, errors.New("导出对账报告失败"))
+	}
+	return connect.NewResponse(&npanv1.ExportReconciliationReportResponse{
+		Filename: fmt.Sprintf("reconciliation-%d.%s", report.ID, req.Msg.GetFormat()),
+		Export:   export.String(),
+	}), nil
+}
+
+func (s *adminConnectServer) CancelReconciliation(_ context.Context, req *connect.Request[npanv1.CancelReconciliationRequest]) (*connect.Response[npanv1.CancelReconciliationResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := syncManager.CancelReconciliation(); err != nil {
+		return nil, connect.NewError(connect.CodeAborted, err)
+	}
+	return connect.NewResponse(&npanv1.CancelReconciliationResponse{
+		Message: "对账取消信号已发送",
+	}), nil
+}
+
+func reconciliationConnectError(err error, internalMessage string) error {
+	switch {
+	case errors.Is(err, service.ErrReconciliationNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, service.ErrReconciliationDisabled), errors.Is(err, service.ErrReconciliationNoRoots):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, service.ErrReconciliationRunning), errors.Is(err, service.ErrSyncInProgress), errors.Is(err, service.ErrSyncLeaseHeld):
+		return connect.NewError(connect.CodeAborted, err)
+	default:
+		return connect.NewError(connect.CodeInternal, errors.New(internalMessage))
+	}
+}
+
+func toProtoReconciliationReport(report *models.ReconciliationReport) *npanv1.ReconciliationReport {
+	out := &npanv1.ReconciliationReport{
+		Id:             report.ID,
+		Status:         toProtoSyncStatus(report.Status),
+		Roots:          append([]int64{}, report.Roots...),
+		SampleSize:     report.SampleSize,
+		StartedAt:      report.StartedAt,
+		FoldersChecked: report.FoldersChecked,
+		FoldersDrifted: report.FoldersDrifted,
+	}
+	if report.FinishedAt > 0 {
+		finishedAt := report.FinishedAt
+		out.FinishedAt = &finishedAt
+	}
+	if report.Error != "" {
+		message := report.Error
+		out.Error = &message
+	}
+	return out
+}
+
+func toProtoReconciliationRow(row models.ReconciliationRow) *npanv1.ReconciliationRow {
+	out := &npanv1.ReconciliationRow{
+		RootFolderId:  row.RootFolderID,
+		FolderId:      row.FolderID,
+		Path:          row.Path,
+		UpstreamItems: row.UpstreamItems,
+		IndexedItems:  row.IndexedItems,
+		Drift:         row.Drift,
+	}
+	if row.Error != "" {
+		message := row.Error
+		out.Error = &message
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+package httpx
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/gen/go/npan/v1/npanv1connect"
+	"npan/internal/models"
+	"npan/internal/service"
+	"npan/internal/storage"
+)
+
+func TestConnectAdminReconciliation_GetAndExport(t *testing.T) {
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	t.Cleanup(func() { _ = stores.DB.Close() })
+
+	report := &models.ReconciliationReport{Status: "done", Roots: []int64{100}, StartedAt: 1_000, FinishedAt: 2_000, FoldersChecked: 2, FoldersDrifted: 1}
+	if err := stores.ReconciliationStore.Create(report); err != nil {
+		t.Fatalf("create report failed: %v", err)
+	}
+	if err := stores.ReconciliationStore.AddRows(report.ID, []models.ReconciliationRow{
+		{RootFolderID: 100, FolderID: 100, Path: "root", UpstreamItems: 3, IndexedItems: 3},
+		{RootFolderID: 100, FolderID: 200, Path: "root/docs", UpstreamItems: 2, IndexedItems: 1, Drift: -1},
+	}); err != nil {
+		t.Fatalf("add rows failed: %v", err)
+	}
+
+	handlers := newTestHandlers(t)
+	handlers.syncManager = service.NewSyncManager(service.SyncManagerArgs{
+		ProgressStore:       stores.ProgressStore,
+		ReconciliationStore: stores.ReconciliationStore,
+	})
+	ts := httptest.NewServer(NewServer(handlers, testAdminKey, testDistFS(), nil))
+	defer ts.Close()
+	client := npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+
+	onlyDrift := true
+	got, err := client.GetReconciliationReport(context.Background(), withAdminKey(&npanv1.GetReconciliationReportRequest{OnlyDrift: &onlyDrift}))
+	if err != nil {
+		t.Fatalf("GetReconciliationReport failed: %v", err)
+	}
+	if got.Msg.GetReport().GetId() != report.ID || got.Msg.GetReport().GetStatus() != npanv1.SyncStatus_SYNC_STATUS_DONE || got.Msg.GetReport().GetFoldersDrifted() != 1 {
+		t.Fatalf("unexpected report: %+v", got.Msg.GetReport())
+	}
+	if len(got.Msg.GetRows()) != 1 || got.Msg.GetRows()[0].GetFolderId() != 200 || got.Msg.GetRows()[0].GetDrift() != -1 {
+		t.Fatalf("expected only the drifted folder, got %+v", got.Msg.GetRows())
+	}
+
+	exported, err := client.ExportReconciliationReport(context.Background(), withAdminKey(&npanv1.ExportReconciliationReportRequest{
+		ReportId: &report.ID,
+		Format:   "csv",
+	}))
+	if err != nil {
+		t.Fatalf("ExportReconciliationReport failed: %v", err)
+	}
+	if exported.Msg.GetFilename() != "reconciliation-1.csv" || strings.Count(exported.Msg.GetExport(), "\n") != 3 {
+		t.Fatalf("unexpected export %q:\n%s", exported.Msg.GetFilename(), exported.Msg.GetExport())
+	}
+
+	_, err = client.ExportReconciliationReport(context.Background(), withAdminKey(&npanv1.ExportReconciliationReportRequest{Format: "xml"}))
+	if got := connect.CodeOf(err); got != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid export format to be rejected, got %v", got)
+	}
+
+	missing := int64(99)
+	_, err = client.GetReconciliationReport(context.Background(), withAdminKey(&npanv1.GetReconciliationReportRequest{ReportId: &missing}))
+	if got := connect.CodeOf(err); got != connect.CodeNotFound {
+		t.Fatalf("expected not_found for missing report, got %v", got)
+	}
+}
+
+func TestConnectAdminReconciliation_DisabledWithoutStore(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(NewServer(newTestHandlers(t), testAdminKey, testDistFS(), nil))
+	defer ts.Close()
+	client := npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+
+	_, err := client.GetReconciliationReport(context.Background(), withAdminKey(&npanv1.GetReconciliationReportRequest{}))
+	if got := connect.CodeOf(err); got != connect.CodeFailedPrecondition {
+		t.Fatalf("expected failed_precondition without reconciliation store, got %v", got)
+	}
+}
+
+func TestConnectAdminReconciliation_CancelWithoutRunningReconciliation(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(NewServer(newTestHandlers(t), testAdminKey, testDistFS(), nil))
+	defer ts.Close()
+	client := npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+
+	_, err := client.CancelReconciliation(context.Background(), withAdminKey(&npanv1.CancelReconciliationRequest{}))
+	if got := connect.CodeOf(err); got != connect.CodeAborted {
+		t.Fatalf("expected aborted without a running reconciliation, got %v", got)
+	}
+}
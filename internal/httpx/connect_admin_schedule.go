@@ -0,0 +1,141 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/internal/models"
+	"npan/internal/service"
+)
+
+func (s *adminConnectServer) syncScheduler(tenantID string) (*service.SyncScheduler, error) {
+	if s.handlers == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("服务未初始化"))
+	}
+	return s.handlers.syncSchedulerFor(tenantID)
+}
+
+func (s *adminConnectServer) ListSyncSchedules(_ context.Context, req *connect.Request[npanv1.ListSyncSchedulesRequest]) (*connect.Response[npanv1.ListSyncSchedulesResponse], error) {
+	scheduler, err := s.syncScheduler(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, err := scheduler.List()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("无法读取同步计划"))
+	}
+
+	resp := &npanv1.ListSyncSchedulesResponse{
+		Schedules: make([]*npanv1.SyncSchedule, 0, len(schedules)),
+	}
+	for i := range schedules {
+		resp.Schedules = append(resp.Schedules, toProtoSyncSchedule(&schedules[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *adminConnectServer) CreateSyncSchedule(_ context.Context, req *connect.Request[npanv1.CreateSyncScheduleRequest]) (*connect.Response[npanv1.CreateSyncScheduleResponse], error) {
+	scheduler, err := s.syncScheduler(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := scheduler.Create(service.SyncScheduleInput{
+		Name:          req.Msg.GetName(),
+		CronExpr:      req.Msg.GetCronExpr(),
+		Mode:          fromProtoSyncMode(req.Msg.Mode),
+		JitterSeconds: req.Msg.GetJitterSeconds(),
+		Paused:        req.Msg.GetPaused(),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return connect.NewResponse(&npanv1.CreateSyncScheduleResponse{
+		Schedule: toProtoSyncSchedule(schedule),
+	}), nil
+}
+
+func (s *adminConnectServer) PauseSyncSchedule(_ context.Context, req *connect.Request[npanv1.PauseSyncScheduleRequest]) (*connect.Response[npanv1.PauseSyncScheduleResponse], error) {
+	schedule, err := s.setSyncSchedulePaused(req.Msg.GetTenantId(), req.Msg.GetId(), true)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&npanv1.PauseSyncScheduleResponse{Schedule: schedule}), nil
+}
+
+func (s *adminConnectServer) ResumeSyncSchedule(_ context.Context, req *connect.Request[npanv1.ResumeSyncScheduleRequest]) (*connect.Response[npanv1.ResumeSyncScheduleResponse], error) {
+	schedule, err := s.setSyncSchedulePaused(req.Msg.GetTenantId(), req.Msg.GetId(), false)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&npanv1.ResumeSyncScheduleResponse{Schedule: schedule}), nil
+}
+
+func (s *adminConnectServer) setSyncSchedulePaused(tenantID string, id int64, paused bool) (*npanv1.SyncSchedule, error) {
+	scheduler, err := s.syncScheduler(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := scheduler.SetPaused(id, paused)
+	if errors.Is(err, service.ErrSyncScheduleNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("更新同步计划失败"))
+	}
+	return toProtoSyncSchedule(schedule), nil
+}
+
+func (s *adminConnectServer) DeleteSyncSchedule(_ context.Context, req *connect.Request[npanv1.DeleteSyncScheduleRequest]) (*connect.Response[npanv1.DeleteSyncScheduleResponse], error) {
+	scheduler, err := s.syncScheduler(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := scheduler.Delete(req.Msg.GetId()); err != nil {
+		if errors.Is(err, service.ErrSyncScheduleNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, errors.New("删除同步计划失败"))
+	}
+
+	return connect.NewResponse(&npanv1.DeleteSyncScheduleResponse{
+		Message: "同步计划已删除",
+	}), nil
+}
+
+func toProtoSyncSchedule(schedule *models.SyncSchedule) *npanv1.SyncSchedule {
+	if schedule == nil {
+		return nil
+	}
+
+	resp := &npanv1.SyncSchedule{
+		Id:            schedule.ID,
+		Name:          schedule.Name,
+		CronExpr:      schedule.CronExpr,
+		JitterSeconds: schedule.JitterSeconds,
+		Paused:        schedule.Paused,
+		NextRunAt:     schedule.NextRunAt,
+		LastRunAt:     schedule.LastRunAt,
+		LastRunStatus: toOptionalString(schedule.LastRunStatus),
+		LastError:     toOptionalString(schedule.LastError),
+		CreatedAt:     schedule.CreatedAt,
+		UpdatedAt:     schedule.UpdatedAt,
+	}
+	if mode := toProtoSyncMode(string(schedule.Mode)); mode != nil {
+		resp.Mode = *mode
+	}
+	if !schedule.Paused && schedule.NextRunAt > 0 {
+		resp.NextRunAtTs = millisToProtoTimestamp(schedule.NextRunAt)
+	}
+	if schedule.LastRunAt > 0 {
+		resp.LastRunAtTs = millisToProtoTimestamp(schedule.LastRunAt)
+	}
+	return resp
+}
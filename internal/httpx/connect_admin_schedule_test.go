@@ -0,0 +1,132 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/gen/go/npan/v1/npanv1connect"
+	"npan/internal/service"
+	"npan/internal/storage"
+)
+
+func newScheduleTestClient(t *testing.T, withScheduler bool) npanv1connect.AdminServiceClient {
+	t.Helper()
+
+	handlers := newTestHandlers(t)
+	if withScheduler {
+		stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+			StateDBFile: filepath.Join(t.TempDir(), "state.sqlite"),
+		})
+		if err != nil {
+			t.Fatalf("create sqlite stores failed: %v", err)
+		}
+		t.Cleanup(func() { _ = stores.DB.Close() })
+
+		handlers.SetSyncScheduler(service.NewSyncScheduler(service.SyncSchedulerArgs{
+			Store:       stores.ScheduleStore,
+			SyncManager: handlers.syncManager,
+			Location:    time.UTC,
+			Now: func() time.Time {
+				return time.Date(2026, 5, 1, 2, 10, 0, 0, time.UTC)
+			},
+		}))
+	}
+
+	ts := httptest.NewServer(NewServer(handlers, testAdminKey, testDistFS(), nil))
+	t.Cleanup(ts.Close)
+	return npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+}
+
+func withAdminKey[T any](msg *T) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("X-API-Key", testAdminKey)
+	return req
+}
+
+func TestConnectAdminSyncSchedules_Lifecycle(t *testing.T) {
+	client := newScheduleTestClient(t, true)
+	ctx := context.Background()
+
+	mode := npanv1.SyncMode_SYNC_MODE_INCREMENTAL
+	jitter := int64(0)
+	created, err := client.CreateSyncSchedule(ctx, withAdminKey(&npanv1.CreateSyncScheduleRequest{
+		Name:          "nightly",
+		CronExpr:      "0 3 * * *",
+		Mode:          &mode,
+		JitterSeconds: &jitter,
+	}))
+	if err != nil {
+		t.Fatalf("create schedule failed: %v", err)
+	}
+	schedule := created.Msg.GetSchedule()
+	if schedule.GetId() <= 0 || schedule.GetMode() != npanv1.SyncMode_SYNC_MODE_INCREMENTAL {
+		t.Fatalf("unexpected created schedule: %#v", schedule)
+	}
+	wantNext := time.Date(2026, 5, 1, 3, 0, 0, 0, time.UTC)
+	if schedule.GetNextRunAt() != wantNext.UnixMilli() || !schedule.GetNextRunAtTs().AsTime().Equal(wantNext) {
+		t.Fatalf("unexpected next run: %d %v", schedule.GetNextRunAt(), schedule.GetNextRunAtTs())
+	}
+
+	listed, err := client.ListSyncSchedules(ctx, withAdminKey(&npanv1.ListSyncSchedulesRequest{}))
+	if err != nil {
+		t.Fatalf("list schedules failed: %v", err)
+	}
+	if len(listed.Msg.GetSchedules()) != 1 || listed.Msg.GetSchedules()[0].GetName() != "nightly" {
+		t.Fatalf("unexpected schedules: %#v", listed.Msg.GetSchedules())
+	}
+
+	paused, err := client.PauseSyncSchedule(ctx, withAdminKey(&npanv1.PauseSyncScheduleRequest{Id: schedule.GetId()}))
+	if err != nil {
+		t.Fatalf("pause schedule failed: %v", err)
+	}
+	if !paused.Msg.GetSchedule().GetPaused() || paused.Msg.GetSchedule().GetNextRunAtTs() != nil {
+		t.Fatalf("expected paused schedule without next fire timestamp: %#v", paused.Msg.GetSchedule())
+	}
+
+	resumed, err := client.ResumeSyncSchedule(ctx, withAdminKey(&npanv1.ResumeSyncScheduleRequest{Id: schedule.GetId()}))
+	if err != nil {
+		t.Fatalf("resume schedule failed: %v", err)
+	}
+	if resumed.Msg.GetSchedule().GetPaused() {
+		t.Fatalf("expected resumed schedule")
+	}
+
+	if _, err := client.DeleteSyncSchedule(ctx, withAdminKey(&npanv1.DeleteSyncScheduleRequest{Id: schedule.GetId()})); err != nil {
+		t.Fatalf("delete schedule failed: %v", err)
+	}
+	_, err = client.DeleteSyncSchedule(ctx, withAdminKey(&npanv1.DeleteSyncScheduleRequest{Id: schedule.GetId()}))
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeNotFound {
+		t.Fatalf("expected not found on second delete, got %v", err)
+	}
+}
+
+func TestConnectAdminCreateSyncSchedule_InvalidCron(t *testing.T) {
+	client := newScheduleTestClient(t, true)
+
+	_, err := client.CreateSyncSchedule(context.Background(), withAdminKey(&npanv1.CreateSyncScheduleRequest{
+		Name:     "broken",
+		CronExpr: "61 * * * *",
+	}))
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+}
+
+func TestConnectAdminSyncSchedules_SchedulerDisabled(t *testing.T) {
+	client := newScheduleTestClient(t, false)
+
+	_, err := client.ListSyncSchedules(context.Background(), withAdminKey(&npanv1.ListSyncSchedulesRequest{}))
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeFailedPrecondition {
+		t.Fatalf("expected failed precondition, got %v", err)
+	}
+}
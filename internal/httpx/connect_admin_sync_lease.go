@@ -0,0 +1,77 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/internal/models"
+	"npan/internal/service"
+)
+
+func (s *adminConnectServer) GetSyncLease(_ context.Context, req *connect.Request[npanv1.GetSyncLeaseRequest]) (*connect.Response[npanv1.GetSyncLeaseResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	lease, err := syncManager.GetSyncLease()
+	if err != nil {
+		return nil, syncLeaseConnectError(err, "读取同步租约失败")
+	}
+	return connect.NewResponse(&npanv1.GetSyncLeaseResponse{
+		Lease: toProtoSyncLease(lease),
+	}), nil
+}
+
+// ForceReleaseSyncLease 用于持有进程已崩溃、租约尚未过期的情况；仍在运行的持有者会在下一次心跳时停止同步。
+func (s *adminConnectServer) ForceReleaseSyncLease(_ context.Context, req *connect.Request[npanv1.ForceReleaseSyncLeaseRequest]) (*connect.Response[npanv1.ForceReleaseSyncLeaseResponse], error) {
+	if s.handlers == nil || s.handlers.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	syncManager, err := s.handlers.syncManagerFor(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	lease, err := syncManager.ForceReleaseSyncLease()
+	if err != nil {
+		return nil, syncLeaseConnectError(err, "释放同步租约失败")
+	}
+	message := "当前没有同步租约"
+	if lease != nil {
+		message = fmt.Sprintf("已强制释放 %s 持有的同步租约", lease.Owner)
+	}
+	return connect.NewResponse(&npanv1.ForceReleaseSyncLeaseResponse{
+		Released: toProtoSyncLease(lease),
+		Message:  message,
+	}), nil
+}
+
+func syncLeaseConnectError(err error, fallback string) error {
+	if errors.Is(err, service.ErrSyncLeaseDisabled) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewError(connect.CodeInternal, errors.New(fallback))
+}
+
+func toProtoSyncLease(lease *models.SyncLease) *npanv1.SyncLease {
+	if lease == nil {
+		return nil
+	}
+	return &npanv1.SyncLease{
+		OwnerId:     lease.OwnerID,
+		Owner:       lease.Owner,
+		AcquiredAt:  lease.AcquiredAt,
+		HeartbeatAt: lease.HeartbeatAt,
+		ExpiresAt:   lease.ExpiresAt,
+		Expired:     lease.ExpiresAt <= time.Now().UnixMilli(),
+	}
+}
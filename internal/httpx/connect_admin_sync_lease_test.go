@@ -0,0 +1,93 @@
+package httpx
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/gen/go/npan/v1/npanv1connect"
+	"npan/internal/service"
+	"npan/internal/storage"
+)
+
+func TestConnectAdminSyncLease_StartSyncRefusedUntilForceReleased(t *testing.T) {
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	t.Cleanup(func() { _ = stores.DB.Close() })
+
+	key := storage.SyncLeaseKey("")
+	if _, acquired, err := stores.SyncLeaseStore.Acquire(key, "cli-1", "cli@b pid 2", time.Minute); err != nil || !acquired {
+		t.Fatalf("acquire lease failed: %v %v", acquired, err)
+	}
+
+	handlers := newTestHandlers(t)
+	handlers.syncManager = service.NewSyncManager(service.SyncManagerArgs{
+		ProgressStore:  stores.ProgressStore,
+		SyncLeaseStore: stores.SyncLeaseStore,
+		SyncLeaseOwner: "server@a pid 1",
+	})
+	ts := httptest.NewServer(NewServer(handlers, testAdminKey, testDistFS(), nil))
+	defer ts.Close()
+	client := npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+
+	req := connect.NewRequest(&npanv1.StartSyncRequest{RootFolderIds: []int64{1}})
+	req.Header().Set("X-API-Key", testAdminKey)
+	req.Header().Set("Authorization", "Bearer dummy-token")
+	_, err = client.StartSync(context.Background(), req)
+	if got := connect.CodeOf(err); got != connect.CodeAborted {
+		t.Fatalf("expected aborted while lease is held, got %v (%v)", got, err)
+	}
+	if !strings.Contains(err.Error(), "cli@b pid 2") {
+		t.Fatalf("expected refusal to name the lease holder, got %q", err.Error())
+	}
+	if handlers.syncManager.IsRunning() {
+		t.Fatal("refused sync must not start")
+	}
+
+	got, err := client.GetSyncLease(context.Background(), withAdminKey(&npanv1.GetSyncLeaseRequest{}))
+	if err != nil {
+		t.Fatalf("GetSyncLease failed: %v", err)
+	}
+	if got.Msg.GetLease().GetOwner() != "cli@b pid 2" || got.Msg.GetLease().GetExpired() {
+		t.Fatalf("unexpected lease: %+v", got.Msg.GetLease())
+	}
+
+	released, err := client.ForceReleaseSyncLease(context.Background(), withAdminKey(&npanv1.ForceReleaseSyncLeaseRequest{}))
+	if err != nil {
+		t.Fatalf("ForceReleaseSyncLease failed: %v", err)
+	}
+	if released.Msg.GetReleased().GetOwnerId() != "cli-1" || !strings.Contains(released.Msg.GetMessage(), "cli@b pid 2") {
+		t.Fatalf("unexpected force release response: %+v", released.Msg)
+	}
+
+	got, err = client.GetSyncLease(context.Background(), withAdminKey(&npanv1.GetSyncLeaseRequest{}))
+	if err != nil {
+		t.Fatalf("GetSyncLease after release failed: %v", err)
+	}
+	if got.Msg.Lease != nil {
+		t.Fatalf("expected no lease after force release, got %+v", got.Msg.GetLease())
+	}
+}
+
+func TestConnectAdminSyncLease_DisabledWithoutStore(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(NewServer(newTestHandlers(t), testAdminKey, testDistFS(), nil))
+	defer ts.Close()
+	client := npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+
+	_, err := client.ForceReleaseSyncLease(context.Background(), withAdminKey(&npanv1.ForceReleaseSyncLeaseRequest{}))
+	if got := connect.CodeOf(err); got != connect.CodeFailedPrecondition {
+		t.Fatalf("expected failed_precondition without lease store, got %v", got)
+	}
+}
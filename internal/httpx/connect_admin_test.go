@@ -205,6 +205,89 @@ func TestConnectAdminStartSync_ForceRebuildWithRoots_ReturnsInvalidArgument(t *t
 	}
 }
 
+func TestConnectAdminStartSync_DryRunWithForceRebuild_ReturnsInvalidArgument(t *testing.T) {
+	t.Parallel()
+
+	handlers := newTestHandlers(t)
+	e := NewServer(handlers, testAdminKey, testDistFS(), prometheus.NewRegistry())
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+
+	client := npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+	forceRebuild := true
+	dryRun := true
+	req := connect.NewRequest(&npanv1.StartSyncRequest{
+		ForceRebuild: &forceRebuild,
+		DryRun:       &dryRun,
+	})
+	req.Header().Set("X-API-Key", testAdminKey)
+	req.Header().Set("Authorization", "Bearer dummy-token")
+	_, err := client.StartSync(context.Background(), req)
+	if got := connect.CodeOf(err); got != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid_argument, got %v (%v)", got, err)
+	}
+	if handlers.syncManager.IsRunning() {
+		t.Fatal("rejected dry run must not start a sync")
+	}
+}
+
+func TestToProtoSyncProgressState_IncludesDryRunReport(t *testing.T) {
+	t.Parallel()
+
+	state := toProtoSyncProgressState(&models.SyncProgressState{
+		Status: "done",
+		DryRun: &models.DryRunReport{
+			Mode:       "full",
+			Status:     "error",
+			LastError:  "boom",
+			FinishedAt: 2000,
+			Adds:       1,
+			Roots: []models.DryRunRootDiff{{
+				RootFolderID:  100,
+				Adds:          1,
+				SampleUpdates: []models.DryRunSample{{DocID: "file_2", Type: models.ItemTypeFile, ChangedFields: []string{"name"}}},
+			}},
+		},
+	})
+
+	report := state.GetDryRun()
+	if report.GetMode() != npanv1.SyncMode_SYNC_MODE_FULL || report.GetFinishedAt() != 2000 || len(report.GetRoots()) != 1 {
+		t.Fatalf("unexpected dry run report %+v", report)
+	}
+	if report.GetStatus() != npanv1.SyncStatus_SYNC_STATUS_ERROR || report.GetLastError() != "boom" || state.GetStatus() != npanv1.SyncStatus_SYNC_STATUS_DONE {
+		t.Fatalf("dry run status must be reported separately from the sync status, report=%+v sync=%s", report, state.GetStatus())
+	}
+	sample := report.GetRoots()[0].GetSampleUpdates()[0]
+	if sample.GetType() != npanv1.ItemType_ITEM_TYPE_FILE || sample.GetChangedFields()[0] != "name" {
+		t.Fatalf("unexpected update sample %+v", sample)
+	}
+}
+
+func TestToProtoSyncProgressState_IncludesRateControl(t *testing.T) {
+	t.Parallel()
+
+	state := toProtoSyncProgressState(&models.SyncProgressState{
+		Status: "running",
+		RateControl: &models.RateControlState{
+			BaseRate:           5,
+			EffectiveRate:      2.5,
+			Backoff:            true,
+			PausedUntil:        3000,
+			ThrottleEvents:     4,
+			LastThrottleAt:     2000,
+			LastThrottleStatus: 429,
+		},
+	})
+
+	rc := state.GetRateControl()
+	if rc.GetEffectiveRate() != 2.5 || !rc.GetBackoff() || rc.GetPausedUntil() != 3000 || rc.GetThrottleEvents() != 4 || rc.GetLastThrottleStatus() != 429 {
+		t.Fatalf("unexpected rate control state %+v", rc)
+	}
+	if toProtoSyncProgressState(&models.SyncProgressState{Status: "idle"}).RateControl != nil {
+		t.Fatal("expected rate control to be omitted when absent")
+	}
+}
+
 func TestConnectAdminInspectRoots_PartialSuccess(t *testing.T) {
 	t.Parallel()
 
@@ -375,6 +458,60 @@ func TestConnectAdminGetSyncProgress_NotFoundAndCancelConflict(t *testing.T) {
 	if got := connect.CodeOf(err); got != connect.CodeAborted {
 		t.Fatalf("expected aborted, got %v", got)
 	}
+
+	pauseReq := connect.NewRequest(&npanv1.PauseSyncRequest{})
+	pauseReq.Header().Set("X-API-Key", testAdminKey)
+	_, err = client.PauseSync(context.Background(), pauseReq)
+	if got := connect.CodeOf(err); got != connect.CodeAborted {
+		t.Fatalf("expected PauseSync aborted without running sync, got %v", got)
+	}
+
+	resumeReq := connect.NewRequest(&npanv1.ResumeSyncRequest{})
+	resumeReq.Header().Set("X-API-Key", testAdminKey)
+	_, err = client.ResumeSync(context.Background(), resumeReq)
+	if got := connect.CodeOf(err); got != connect.CodeAborted {
+		t.Fatalf("expected ResumeSync aborted without running sync, got %v", got)
+	}
+}
+
+func TestConnectAdminFolderResync_ValidatesAndReportsIdle(t *testing.T) {
+	t.Parallel()
+
+	handlers := newTestHandlers(t)
+	e := NewServer(handlers, testAdminKey, testDistFS(), nil)
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+
+	client := npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+
+	missingFolderReq := connect.NewRequest(&npanv1.ResyncFolderRequest{})
+	missingFolderReq.Header().Set("X-API-Key", testAdminKey)
+	_, err := client.ResyncFolder(context.Background(), missingFolderReq)
+	if got := connect.CodeOf(err); got != connect.CodeInvalidArgument {
+		t.Fatalf("expected ResyncFolder without folder_id to be invalid, got %v", got)
+	}
+
+	invalidMode := npanv1.FolderResyncMode(9)
+	invalidModeReq := connect.NewRequest(&npanv1.ResyncFolderRequest{FolderId: 200, Mode: &invalidMode})
+	invalidModeReq.Header().Set("X-API-Key", testAdminKey)
+	_, err = client.ResyncFolder(context.Background(), invalidModeReq)
+	if got := connect.CodeOf(err); got != connect.CodeInvalidArgument {
+		t.Fatalf("expected ResyncFolder with unknown mode to be invalid, got %v", got)
+	}
+
+	progressReq := connect.NewRequest(&npanv1.GetFolderResyncProgressRequest{})
+	progressReq.Header().Set("X-API-Key", testAdminKey)
+	_, err = client.GetFolderResyncProgress(context.Background(), progressReq)
+	if got := connect.CodeOf(err); got != connect.CodeNotFound {
+		t.Fatalf("expected GetFolderResyncProgress not_found, got %v", got)
+	}
+
+	cancelReq := connect.NewRequest(&npanv1.CancelFolderResyncRequest{})
+	cancelReq.Header().Set("X-API-Key", testAdminKey)
+	_, err = client.CancelFolderResync(context.Background(), cancelReq)
+	if got := connect.CodeOf(err); got != connect.CodeAborted {
+		t.Fatalf("expected CancelFolderResync aborted without running resync, got %v", got)
+	}
 }
 
 func TestConnectAdminWatchSyncProgress_StreamsUntilTerminal(t *testing.T) {
@@ -532,3 +669,61 @@ func TestToProtoSyncProgressState_PopulatesTimestampSidecar(t *testing.T) {
 }
 
 func ptrInt64(v int64) *int64 { return &v }
+
+func TestConnectAdminDeadLetters_SelectionAndDisabledStore(t *testing.T) {
+	t.Parallel()
+
+	handlers := newTestHandlers(t)
+	e := NewServer(handlers, testAdminKey, testDistFS(), nil)
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+
+	client := npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+
+	replayReq := connect.NewRequest(&npanv1.ReplayDeadLettersRequest{})
+	replayReq.Header().Set("X-API-Key", testAdminKey)
+	_, err := client.ReplayDeadLetters(context.Background(), replayReq)
+	if got := connect.CodeOf(err); got != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid_argument without ids or all, got %v", got)
+	}
+
+	discardReq := connect.NewRequest(&npanv1.DiscardDeadLettersRequest{Ids: []int64{1}, All: true})
+	discardReq.Header().Set("X-API-Key", testAdminKey)
+	_, err = client.DiscardDeadLetters(context.Background(), discardReq)
+	if got := connect.CodeOf(err); got != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid_argument with both ids and all, got %v", got)
+	}
+
+	listReq := connect.NewRequest(&npanv1.ListDeadLettersRequest{})
+	listReq.Header().Set("X-API-Key", testAdminKey)
+	_, err = client.ListDeadLetters(context.Background(), listReq)
+	if got := connect.CodeOf(err); got != connect.CodeFailedPrecondition {
+		t.Fatalf("expected failed_precondition without a dead letter store, got %v", got)
+	}
+}
+
+func TestConnectAdminFindDuplicates_RejectsUnknownExportFormat(t *testing.T) {
+	t.Parallel()
+
+	handlers := newTestHandlers(t)
+	e := NewServer(handlers, testAdminKey, testDistFS(), nil)
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+
+	client := npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+
+	format := "xml"
+	req := connect.NewRequest(&npanv1.FindDuplicatesRequest{ExportFormat: &format})
+	req.Header().Set("X-API-Key", testAdminKey)
+	_, err := client.FindDuplicates(context.Background(), req)
+	if got := connect.CodeOf(err); got != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid_argument for unknown export format, got %v", got)
+	}
+
+	req = connect.NewRequest(&npanv1.FindDuplicatesRequest{MinSize: -1})
+	req.Header().Set("X-API-Key", testAdminKey)
+	_, err = client.FindDuplicates(context.Background(), req)
+	if got := connect.CodeOf(err); got != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid_argument for negative min_size, got %v", got)
+	}
+}
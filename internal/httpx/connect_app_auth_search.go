@@ -84,17 +84,42 @@ func (h *Handlers) resolveAuthOptionsForConnect(header http.Header, payload auth
 
 func (h *Handlers) resolveTokenForConnect(ctx context.Context, header http.Header, payload authPayload, allowFallback bool) (string, npan.AuthResolverOptions, error) {
 	authOptions := h.resolveAuthOptionsForConnect(header, payload, allowFallback)
-	token, err := npan.ResolveBearerToken(ctx, nil, authOptions)
+	token, err := h.bearerToken(ctx, authOptions)
 	if err != nil {
 		return "", authOptions, err
 	}
 	return token, authOptions, nil
 }
 
-func (s *appConnectServer) GetSearchConfig(_ context.Context, _ *connect.Request[npanv1.GetSearchConfigRequest]) (*connect.Response[npanv1.GetSearchConfigResponse], error) {
+// downloadAPIClient 创建生成下载链接的上游客户端：多租户时文件属于指定租户，使用租户凭据；
+// 否则按请求携带的凭据（allowFallback 时回退到服务端配置）。
+func (h *Handlers) downloadAPIClient(ctx context.Context, header http.Header, tenantID string, allowFallback bool) (npan.API, error) {
+	tenant, err := h.resolveTenant(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if tenant != nil {
+		return h.tenantAPIClient(ctx, tenant)
+	}
+	token, authOptions, err := h.resolveTokenForConnect(ctx, header, authPayload{}, allowFallback)
+	if err != nil {
+		return nil, err
+	}
+	return h.newAPIClient(token, authOptions), nil
+}
+
+func (s *appConnectServer) GetSearchConfig(_ context.Context, req *connect.Request[npanv1.GetSearchConfigRequest]) (*connect.Response[npanv1.GetSearchConfigResponse], error) {
+	tenant, err := s.handlers.resolveTenant(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
 	cfg := s.handlers.cfg
 	backend, _ := search.ParseBackend(cfg.SearchBackend)
 	host, indexName, searchAPIKey := cfg.PublicSearchBootstrap(backend)
+	// 多租户时共享的公开 key 能检索所有租户，只下发租户专属 key；未配置时前端回退到 AppSearch。
+	if tenant != nil {
+		searchAPIKey = strings.TrimSpace(tenant.Config.PublicSearchAPIKey)
+	}
 	instantsearchEnabled := search.SupportsPublicInstantsearch(backend) &&
 		cfg.PublicSearchInstantsearchOn &&
 		host != "" &&
@@ -104,6 +129,7 @@ func (s *appConnectServer) GetSearchConfig(_ context.Context, _ *connect.Request
 	response := &npanv1.GetSearchConfigResponse{
 		InstantsearchEnabled: instantsearchEnabled,
 		Provider:             string(backend),
+		TenantId:             tenantSearchID(tenant),
 	}
 	if instantsearchEnabled {
 		response.Host = host
@@ -119,6 +145,10 @@ func (s *appConnectServer) AppSearch(_ context.Context, req *connect.Request[npa
 	if query == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("缺少 query 参数"))
 	}
+	tenant, err := s.handlers.resolveTenant(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
 
 	page := int64(1)
 	if req.Msg.Page != nil {
@@ -144,7 +174,9 @@ func (s *appConnectServer) AppSearch(_ context.Context, req *connect.Request[npa
 		Type:           string(models.ItemTypeFile),
 		Page:           page,
 		PageSize:       pageSize,
+		WithinFolderID: req.Msg.WithinFolderId,
 		IncludeDeleted: false,
+		TenantID:       tenantSearchID(tenant),
 	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.New("搜索服务暂不可用"))
@@ -167,15 +199,21 @@ func (s *appConnectServer) AppDownloadURL(ctx context.Context, req *connect.Requ
 		validPeriod = &v
 	}
 
-	token, authOptions, err := s.handlers.resolveTokenForConnect(ctx, req.Header(), authPayload{}, true)
+	api, err := s.handlers.downloadAPIClient(ctx, req.Header(), req.Msg.GetTenantId(), true)
 	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
 		return nil, connect.NewError(connect.CodeUnavailable, errors.New("下载服务暂不可用，请联系管理员检查服务端凭据"))
 	}
 
-	api := s.handlers.newAPIClient(token, authOptions)
 	downloadService := service.NewDownloadURLService(api)
 	downloadURL, err := downloadService.GetDownloadURL(ctx, fileID, validPeriod)
 	if err != nil {
+		if circuitErr := circuitOpenError(err); circuitErr != nil {
+			return nil, circuitErr
+		}
 		return nil, connect.NewError(connect.CodeUnavailable, errors.New("生成下载链接失败，请稍后重试"))
 	}
 
@@ -246,6 +284,9 @@ func (s *searchConnectServer) RemoteSearch(ctx context.Context, req *connect.Req
 		UpdatedTimeRange: strings.TrimSpace(req.Msg.GetUpdatedTimeRange()),
 	})
 	if err != nil {
+		if circuitErr := circuitOpenError(err); circuitErr != nil {
+			return nil, circuitErr
+		}
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("搜索请求失败，请稍后重试"))
 	}
 
@@ -281,6 +322,10 @@ func (s *searchConnectServer) LocalSearch(_ context.Context, req *connect.Reques
 	if err := validateType(typeParam); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
+	tenant, err := s.handlers.resolveTenant(req.Msg.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
 
 	result, err := s.handlers.queryService.Query(models.LocalSearchParams{
 		Query:          query,
@@ -288,9 +333,11 @@ func (s *searchConnectServer) LocalSearch(_ context.Context, req *connect.Reques
 		Page:           page,
 		PageSize:       pageSize,
 		ParentID:       req.Msg.ParentId,
+		WithinFolderID: req.Msg.WithinFolderId,
 		UpdatedAfter:   req.Msg.UpdatedAfter,
 		UpdatedBefore:  req.Msg.UpdatedBefore,
 		IncludeDeleted: req.Msg.GetIncludeDeleted(),
+		TenantID:       tenantSearchID(tenant),
 	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.New("搜索服务暂不可用"))
@@ -313,15 +360,21 @@ func (s *searchConnectServer) DownloadURL(ctx context.Context, req *connect.Requ
 		validPeriod = &v
 	}
 
-	token, authOptions, err := s.handlers.resolveTokenForConnect(ctx, req.Header(), authPayload{}, false)
+	api, err := s.handlers.downloadAPIClient(ctx, req.Header(), req.Msg.GetTenantId(), false)
 	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("获取下载链接失败"))
 	}
 
-	api := s.handlers.newAPIClient(token, authOptions)
 	downloadService := service.NewDownloadURLService(api)
 	downloadURL, err := downloadService.GetDownloadURL(ctx, fileID, validPeriod)
 	if err != nil {
+		if circuitErr := circuitOpenError(err); circuitErr != nil {
+			return nil, circuitErr
+		}
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("获取下载链接失败"))
 	}
 
@@ -336,21 +389,7 @@ func (s *searchConnectServer) DownloadURL(ctx context.Context, req *connect.Requ
 func toProtoQueryResult(result search.QueryResult) *npanv1.QueryResult {
 	items := make([]*npanv1.IndexDocument, 0, len(result.Items))
 	for _, item := range result.Items {
-		items = append(items, &npanv1.IndexDocument{
-			DocId:           item.DocID,
-			SourceId:        item.SourceID,
-			Type:            toProtoItemType(item.Type),
-			Name:            item.Name,
-			PathText:        item.PathText,
-			ParentId:        item.ParentID,
-			ModifiedAt:      item.ModifiedAt,
-			CreatedAt:       item.CreatedAt,
-			Size:            item.Size,
-			Sha1:            item.SHA1,
-			InTrash:         item.InTrash,
-			IsDeleted:       item.IsDeleted,
-			HighlightedName: toOptionalString(item.HighlightedName),
-		})
+		items = append(items, toProtoIndexDocument(item))
 	}
 
 	return &npanv1.QueryResult{
@@ -359,6 +398,26 @@ func toProtoQueryResult(result search.QueryResult) *npanv1.QueryResult {
 	}
 }
 
+func toProtoIndexDocument(item models.IndexDocument) *npanv1.IndexDocument {
+	return &npanv1.IndexDocument{
+		DocId:           item.DocID,
+		SourceId:        item.SourceID,
+		Type:            toProtoItemType(item.Type),
+		Name:            item.Name,
+		PathText:        item.PathText,
+		ParentId:        item.ParentID,
+		ModifiedAt:      item.ModifiedAt,
+		CreatedAt:       item.CreatedAt,
+		Size:            item.Size,
+		Sha1:            item.SHA1,
+		InTrash:         item.InTrash,
+		IsDeleted:       item.IsDeleted,
+		HighlightedName: toOptionalString(item.HighlightedName),
+		AncestorIds:     item.AncestorIDs,
+		TenantId:        toOptionalString(item.TenantID),
+	}
+}
+
 func toProtoItemType(itemType models.ItemType) npanv1.ItemType {
 	switch itemType {
 	case models.ItemTypeFile:
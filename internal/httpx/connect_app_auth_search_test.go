@@ -3,6 +3,7 @@ package httpx
 import (
 	"context"
 	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"connectrpc.com/connect"
@@ -10,8 +11,30 @@ import (
 
 	npanv1 "npan/gen/go/npan/v1"
 	"npan/gen/go/npan/v1/npanv1connect"
+	"npan/internal/models"
+	"npan/internal/search"
 )
 
+type captureSearchService struct {
+	mu     sync.Mutex
+	params []models.LocalSearchParams
+}
+
+func (c *captureSearchService) Ping() error { return nil }
+
+func (c *captureSearchService) Query(params models.LocalSearchParams) (search.QueryResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.params = append(c.params, params)
+	return search.QueryResult{}, nil
+}
+
+func (c *captureSearchService) last() models.LocalSearchParams {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.params[len(c.params)-1]
+}
+
 func requireAppServiceDescriptor(t *testing.T) protoreflect.ServiceDescriptor {
 	t.Helper()
 
@@ -218,6 +241,48 @@ func TestConnectSearchLocal_RequiresAPIKey(t *testing.T) {
 	}
 }
 
+func TestConnectSearch_WithinFolderIDPassedToQuery(t *testing.T) {
+	t.Parallel()
+
+	handlers := newTestHandlers(t)
+	capture := &captureSearchService{}
+	handlers.queryService = capture
+	ts := httptest.NewServer(NewServer(handlers, testAdminKey, testDistFS(), nil))
+	defer ts.Close()
+
+	folderID := int64(42)
+	appClient := npanv1connect.NewAppServiceClient(ts.Client(), ts.URL)
+	if _, err := appClient.AppSearch(context.Background(), connect.NewRequest(&npanv1.AppSearchRequest{
+		Query:          "demo",
+		WithinFolderId: &folderID,
+	})); err != nil {
+		t.Fatalf("AppSearch RPC returned error: %v", err)
+	}
+	if got := capture.last().WithinFolderID; got == nil || *got != 42 {
+		t.Fatalf("expected AppSearch within_folder_id=42, got %v", got)
+	}
+
+	searchClient := npanv1connect.NewSearchServiceClient(ts.Client(), ts.URL)
+	if _, err := searchClient.LocalSearch(context.Background(), withAdminKey(&npanv1.LocalSearchRequest{
+		Query:          "demo",
+		WithinFolderId: &folderID,
+	})); err != nil {
+		t.Fatalf("LocalSearch RPC returned error: %v", err)
+	}
+	if got := capture.last().WithinFolderID; got == nil || *got != 42 {
+		t.Fatalf("expected LocalSearch within_folder_id=42, got %v", got)
+	}
+
+	negative := int64(-1)
+	_, err := searchClient.LocalSearch(context.Background(), withAdminKey(&npanv1.LocalSearchRequest{
+		Query:          "demo",
+		WithinFolderId: &negative,
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid_argument for negative within_folder_id, got %v", err)
+	}
+}
+
 func TestConnectAuthCreateToken_ValidatesPayload(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,109 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/gen/go/npan/v1/npanv1connect"
+	"npan/internal/models"
+	"npan/internal/npan"
+)
+
+func TestConnectCircuitBreaker_FailsFastWithUnavailable(t *testing.T) {
+	t.Parallel()
+
+	handlers := newTestHandlers(t)
+	handlers.SetCircuitBreaker(npan.NewCircuitBreaker(npan.CircuitBreakerOptions{FailureThreshold: 1}))
+	handlers.apiFactory = func(_ string, _ npan.AuthResolverOptions) npan.API {
+		return &adminConnectTestAPI{
+			folderInfo: map[int64]models.NpanFolder{},
+			folderErrs: map[int64]error{1: errors.New("connection refused")},
+		}
+	}
+
+	e := NewServer(handlers, testAdminKey, testDistFS(), nil)
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+
+	admin := npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+	inspect := func() error {
+		req := connect.NewRequest(&npanv1.InspectRootsRequest{FolderIds: []int64{1}})
+		req.Header().Set("X-API-Key", testAdminKey)
+		req.Header().Set("Authorization", "Bearer dummy-token")
+		_, err := admin.InspectRoots(context.Background(), req)
+		return err
+	}
+
+	if err := inspect(); err != nil {
+		t.Fatalf("first InspectRoots should report a per-folder error, got %v", err)
+	}
+	if err := inspect(); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("expected Unavailable once the circuit is open, got %v", err)
+	}
+
+	search := npanv1connect.NewSearchServiceClient(ts.Client(), ts.URL)
+	req := connect.NewRequest(&npanv1.DownloadURLRequest{FileId: 9})
+	req.Header().Set("X-API-Key", testAdminKey)
+	req.Header().Set("Authorization", "Bearer dummy-token")
+	if _, err := search.DownloadURL(context.Background(), req); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("expected DownloadURL to fail fast with Unavailable, got %v", err)
+	}
+
+	health := npanv1connect.NewHealthServiceClient(ts.Client(), ts.URL)
+	resp, err := health.Readyz(context.Background(), connect.NewRequest(&npanv1.ReadyzRequest{}))
+	if err != nil {
+		t.Fatalf("Readyz RPC returned error: %v", err)
+	}
+	if resp.Msg.GetStatus() != npanv1.ReadyStatus_READY_STATUS_READY {
+		t.Fatalf("open circuit should not affect readiness, got %v", resp.Msg.GetStatus())
+	}
+	if got := resp.Msg.GetNpanApi(); got != string(npan.CircuitOpen) {
+		t.Fatalf("expected npan_api=open, got %q", got)
+	}
+}
+
+func TestConnectCircuitBreaker_TenantUpstreamsTripIndependently(t *testing.T) {
+	t.Parallel()
+
+	handlers := newTestHandlers(t)
+	handlers.SetCircuitBreaker(npan.NewCircuitBreaker(npan.CircuitBreakerOptions{FailureThreshold: 1}))
+	failingAPI := func(context.Context) (npan.API, error) {
+		return &adminConnectTestAPI{}, nil
+	}
+	acme := newTestTenant(t, "acme", nil)
+	acme.APIFactory = failingAPI
+	acme.CircuitBreaker = npan.NewCircuitBreaker(npan.CircuitBreakerOptions{FailureThreshold: 1})
+	globex := newTestTenant(t, "globex", nil)
+	globex.APIFactory = failingAPI
+	handlers.SetTenants([]*Tenant{acme, globex})
+
+	e := NewServer(handlers, testAdminKey, testDistFS(), nil)
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+
+	search := npanv1connect.NewSearchServiceClient(ts.Client(), ts.URL)
+	download := func(tenantID string) error {
+		req := connect.NewRequest(&npanv1.DownloadURLRequest{FileId: 9, TenantId: &tenantID})
+		req.Header().Set("X-API-Key", testAdminKey)
+		_, err := search.DownloadURL(context.Background(), req)
+		return err
+	}
+
+	if err := download("acme"); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("first acme download should fail upstream, got %v", err)
+	}
+	if err := download("acme"); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("expected acme circuit to be open, got %v", err)
+	}
+	if err := download("globex"); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("globex must not be rejected by acme's open circuit, got %v", err)
+	}
+	if got := handlers.circuitBreaker.Snapshot().State; got != npan.CircuitOpen {
+		t.Fatalf("expected globex to use the shared breaker, got %q", got)
+	}
+}
@@ -32,12 +32,32 @@ func (s *healthConnectServer) Readyz(_ context.Context, _ *connect.Request[npanv
 		if err := s.handlers.queryService.Ping(); err != nil {
 			meili := "unreachable"
 			return connect.NewResponse(&npanv1.ReadyzResponse{
-				Status: npanv1.ReadyStatus_READY_STATUS_NOT_READY,
-				Meili:  &meili,
+				Status:    npanv1.ReadyStatus_READY_STATUS_NOT_READY,
+				Meili:     &meili,
+				NpanApi:   s.circuitState(),
+				NpanToken: s.tokenState(),
 			}), nil
 		}
 	}
 	return connect.NewResponse(&npanv1.ReadyzResponse{
-		Status: npanv1.ReadyStatus_READY_STATUS_READY,
+		Status:    npanv1.ReadyStatus_READY_STATUS_READY,
+		NpanApi:   s.circuitState(),
+		NpanToken: s.tokenState(),
 	}), nil
 }
+
+func (s *healthConnectServer) circuitState() *string {
+	if s.handlers == nil || s.handlers.circuitBreaker == nil {
+		return nil
+	}
+	state := string(s.handlers.circuitBreaker.Snapshot().State)
+	return &state
+}
+
+func (s *healthConnectServer) tokenState() *string {
+	if s.handlers == nil || s.handlers.tokenManager == nil {
+		return nil
+	}
+	state := string(s.handlers.tokenManager.Status().State)
+	return &state
+}
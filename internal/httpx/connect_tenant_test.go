@@ -0,0 +1,211 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/gen/go/npan/v1/npanv1connect"
+	"npan/internal/config"
+	"npan/internal/models"
+	"npan/internal/service"
+	"npan/internal/storage"
+)
+
+func newTestTenant(t *testing.T, id string, progress *models.SyncProgressState) *Tenant {
+	t.Helper()
+
+	progressStore := storage.NewJSONProgressStore(filepath.Join(t.TempDir(), id+"-progress.json"))
+	if progress != nil {
+		if err := progressStore.Save(progress); err != nil {
+			t.Fatalf("save progress: %v", err)
+		}
+	}
+	return &Tenant{
+		Config: config.TenantConfig{ID: id, PublicSearchAPIKey: id + "-public-key"},
+		SyncManager: service.NewSyncManager(service.SyncManagerArgs{
+			ProgressStore:    progressStore,
+			CheckpointStores: storage.NewJSONCheckpointStoreFactory(),
+		}),
+	}
+}
+
+func TestConnectTenants_RouteRequestsToTenant(t *testing.T) {
+	t.Parallel()
+
+	captured := &captureSearchService{}
+	handlers := newTestHandlers(t)
+	handlers.queryService = captured
+	handlers.cfg.PublicSearchHost = "https://search.example.com"
+	handlers.cfg.PublicSearchIndexName = "npan-public"
+	handlers.cfg.PublicSearchAPIKey = "shared-public-key"
+	handlers.cfg.PublicSearchInstantsearchOn = true
+
+	acme := newTestTenant(t, "acme", nil)
+	globex := newTestTenant(t, "globex", &models.SyncProgressState{Status: "done", Roots: []int64{7}})
+	handlers.SetTenants([]*Tenant{acme, globex})
+	handlers.syncManager = acme.SyncManager
+
+	e := NewServer(handlers, testAdminKey, testDistFS(), nil)
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+
+	app := npanv1connect.NewAppServiceClient(ts.Client(), ts.URL)
+	searchConfig, err := app.GetSearchConfig(context.Background(), connect.NewRequest(&npanv1.GetSearchConfigRequest{TenantId: proto.String("globex")}))
+	if err != nil {
+		t.Fatalf("GetSearchConfig RPC returned error: %v", err)
+	}
+	if got := searchConfig.Msg.GetSearchApiKey(); got != "globex-public-key" {
+		t.Fatalf("expected tenant public key, got %q", got)
+	}
+	if got := searchConfig.Msg.GetTenantId(); got != "globex" {
+		t.Fatalf("expected tenant_id=globex, got %q", got)
+	}
+
+	searchClient := npanv1connect.NewSearchServiceClient(ts.Client(), ts.URL)
+	searchReq := connect.NewRequest(&npanv1.LocalSearchRequest{Query: "demo", TenantId: proto.String("globex")})
+	searchReq.Header().Set("X-API-Key", testAdminKey)
+	if _, err := searchClient.LocalSearch(context.Background(), searchReq); err != nil {
+		t.Fatalf("LocalSearch RPC returned error: %v", err)
+	}
+	if got := captured.last().TenantID; got != "globex" {
+		t.Fatalf("expected LocalSearch scoped to globex, got %q", got)
+	}
+
+	if _, err := app.AppSearch(context.Background(), connect.NewRequest(&npanv1.AppSearchRequest{Query: "demo"})); err != nil {
+		t.Fatalf("AppSearch RPC returned error: %v", err)
+	}
+	if got := captured.last().TenantID; got != "acme" {
+		t.Fatalf("expected AppSearch to default to acme, got %q", got)
+	}
+
+	admin := npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+	progressReq := connect.NewRequest(&npanv1.GetSyncProgressRequest{TenantId: proto.String("globex")})
+	progressReq.Header().Set("X-API-Key", testAdminKey)
+	progress, err := admin.GetSyncProgress(context.Background(), progressReq)
+	if err != nil {
+		t.Fatalf("GetSyncProgress RPC returned error: %v", err)
+	}
+	if got := progress.Msg.GetState().GetRoots(); len(got) != 1 || got[0] != 7 {
+		t.Fatalf("expected globex progress, got roots %v", got)
+	}
+
+	defaultReq := connect.NewRequest(&npanv1.GetSyncProgressRequest{})
+	defaultReq.Header().Set("X-API-Key", testAdminKey)
+	_, err = admin.GetSyncProgress(context.Background(), defaultReq)
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeNotFound {
+		t.Fatalf("expected default tenant to have no progress, got %v", err)
+	}
+
+	unknownReq := connect.NewRequest(&npanv1.GetSyncProgressRequest{TenantId: proto.String("initech")})
+	unknownReq.Header().Set("X-API-Key", testAdminKey)
+	_, err = admin.GetSyncProgress(context.Background(), unknownReq)
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+		t.Fatalf("expected InvalidArgument for unknown tenant, got %v", err)
+	}
+}
+
+func TestConnectTenants_RejectTenantWhenNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	handlers := newTestHandlers(t)
+	e := NewServer(handlers, testAdminKey, testDistFS(), nil)
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+
+	app := npanv1connect.NewAppServiceClient(ts.Client(), ts.URL)
+	_, err := app.AppSearch(context.Background(), connect.NewRequest(&npanv1.AppSearchRequest{Query: "demo", TenantId: proto.String("acme")}))
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+		t.Fatalf("expected InvalidArgument without tenants, got %v", err)
+	}
+}
+
+func TestConnectTenants_RouteAdminStoresToTenant(t *testing.T) {
+	t.Parallel()
+
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	t.Cleanup(func() { _ = stores.DB.Close() })
+
+	newStoreTenant := func(id string) *Tenant {
+		tenantStores := stores.ForTenant(id)
+		syncManager := service.NewSyncManager(service.SyncManagerArgs{
+			ProgressStore:    tenantStores.ProgressStore,
+			CheckpointStores: tenantStores.CheckpointStoreFactory,
+			RunStore:         tenantStores.SyncRunStore,
+			DeadLetterStore:  tenantStores.DeadLetterStore,
+		})
+		return &Tenant{
+			Config:      config.TenantConfig{ID: id},
+			SyncManager: syncManager,
+			Scheduler: service.NewSyncScheduler(service.SyncSchedulerArgs{
+				Store:       tenantStores.ScheduleStore,
+				SyncManager: syncManager,
+			}),
+		}
+	}
+	acme := newStoreTenant("acme")
+	globex := newStoreTenant("globex")
+
+	handlers := newTestHandlers(t)
+	handlers.SetTenants([]*Tenant{acme, globex})
+	handlers.syncManager = acme.SyncManager
+
+	if err := stores.ForTenant("globex").SyncRunStore.Create(&models.SyncRun{Mode: models.SyncModeFull, Status: "done", StartedAt: 1}); err != nil {
+		t.Fatalf("create globex run failed: %v", err)
+	}
+	if err := stores.ForTenant("acme").DeadLetterStore.Add(&models.DeadLetter{RootFolderID: 1, CreatedAt: 1}); err != nil {
+		t.Fatalf("add acme dead letter failed: %v", err)
+	}
+
+	e := NewServer(handlers, testAdminKey, testDistFS(), nil)
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+	admin := npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+
+	runs, err := admin.ListSyncRuns(context.Background(), withAdminKey(&npanv1.ListSyncRunsRequest{TenantId: proto.String("globex")}))
+	if err != nil || len(runs.Msg.GetRuns()) != 1 {
+		t.Fatalf("expected globex run history, got %v err=%v", runs, err)
+	}
+	runs, err = admin.ListSyncRuns(context.Background(), withAdminKey(&npanv1.ListSyncRunsRequest{}))
+	if err != nil || len(runs.Msg.GetRuns()) != 0 {
+		t.Fatalf("expected default tenant to have no runs, got %v err=%v", runs, err)
+	}
+
+	letters, err := admin.ListDeadLetters(context.Background(), withAdminKey(&npanv1.ListDeadLettersRequest{}))
+	if err != nil || letters.Msg.GetTotal() != 1 {
+		t.Fatalf("expected acme dead letter by default, got %v err=%v", letters, err)
+	}
+	letters, err = admin.ListDeadLetters(context.Background(), withAdminKey(&npanv1.ListDeadLettersRequest{TenantId: proto.String("globex")}))
+	if err != nil || letters.Msg.GetTotal() != 0 {
+		t.Fatalf("expected globex to have no dead letters, got %v err=%v", letters, err)
+	}
+
+	if _, err := admin.CreateSyncSchedule(context.Background(), withAdminKey(&npanv1.CreateSyncScheduleRequest{
+		Name:     "nightly",
+		CronExpr: "0 2 * * *",
+		TenantId: proto.String("globex"),
+	})); err != nil {
+		t.Fatalf("CreateSyncSchedule RPC returned error: %v", err)
+	}
+	schedules, err := admin.ListSyncSchedules(context.Background(), withAdminKey(&npanv1.ListSyncSchedulesRequest{TenantId: proto.String("globex")}))
+	if err != nil || len(schedules.Msg.GetSchedules()) != 1 {
+		t.Fatalf("expected globex schedule, got %v err=%v", schedules, err)
+	}
+	schedules, err = admin.ListSyncSchedules(context.Background(), withAdminKey(&npanv1.ListSyncSchedulesRequest{}))
+	if err != nil || len(schedules.Msg.GetSchedules()) != 0 {
+		t.Fatalf("expected default tenant to have no schedules, got %v err=%v", schedules, err)
+	}
+}
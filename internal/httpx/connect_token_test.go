@@ -0,0 +1,98 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/meilisearch/meilisearch-go"
+
+	npanv1 "npan/gen/go/npan/v1"
+	"npan/gen/go/npan/v1/npanv1connect"
+	"npan/internal/config"
+	"npan/internal/npan"
+	"npan/internal/search"
+	"npan/internal/service"
+	"npan/internal/storage"
+)
+
+func newConnectTokenManager(t *testing.T) *npan.TokenManager {
+	t.Helper()
+	oauth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"managed-token","expires_in":3600}`))
+	}))
+	t.Cleanup(oauth.Close)
+
+	manager, err := npan.NewTokenManager(npan.TokenManagerOptions{
+		Auth: npan.AuthResolverOptions{
+			ClientID:     "client",
+			ClientSecret: "secret",
+			SubID:        42,
+			SubType:      npan.TokenSubjectUser,
+			OAuthHost:    oauth.URL,
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewTokenManager: %v", err)
+	}
+	return manager
+}
+
+func TestConnectTokenManager_ReportsTokenState(t *testing.T) {
+	t.Parallel()
+
+	manager := newConnectTokenManager(t)
+	progressStore := storage.NewJSONProgressStore(filepath.Join(t.TempDir(), "progress.json"))
+	syncManager := service.NewSyncManager(service.SyncManagerArgs{
+		Index: search.NewMeiliIndexFromManager(&adminConnectStatsIndex{
+			stats: &meilisearch.StatsIndex{NumberOfDocuments: 1},
+		}),
+		ProgressStore:    progressStore,
+		CheckpointStores: storage.NewJSONCheckpointStoreFactory(),
+	})
+	handlers := &Handlers{
+		cfg:          config.Config{AllowConfigAuthFallback: true},
+		queryService: &mockSearchService{},
+		syncManager:  syncManager,
+	}
+	handlers.SetTokenManager(manager)
+
+	e := NewServer(handlers, testAdminKey, testDistFS(), nil)
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+
+	health := npanv1connect.NewHealthServiceClient(ts.Client(), ts.URL)
+	readyz := func() string {
+		resp, err := health.Readyz(context.Background(), connect.NewRequest(&npanv1.ReadyzRequest{}))
+		if err != nil {
+			t.Fatalf("Readyz RPC returned error: %v", err)
+		}
+		return resp.Msg.GetNpanToken()
+	}
+
+	if got := readyz(); got != string(npan.TokenStateMissing) {
+		t.Fatalf("expected npan_token=missing before first refresh, got %q", got)
+	}
+	if _, err := manager.Token(context.Background()); err != nil {
+		t.Fatalf("Token: %v", err)
+	}
+	if got := readyz(); got != string(npan.TokenStateValid) {
+		t.Fatalf("expected npan_token=valid after refresh, got %q", got)
+	}
+
+	admin := npanv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+	req := connect.NewRequest(&npanv1.GetIndexStatsRequest{})
+	req.Header().Set("X-API-Key", testAdminKey)
+	resp, err := admin.GetIndexStats(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetIndexStats returned error: %v", err)
+	}
+	token := resp.Msg.GetToken()
+	if token.GetState() != string(npan.TokenStateValid) || token.GetRefreshCount() != 1 || token.GetExpiresAt() == 0 {
+		t.Fatalf("unexpected token status: %+v", token)
+	}
+}
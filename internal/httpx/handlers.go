@@ -1,15 +1,19 @@
 package httpx
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/labstack/echo/v5"
 
 	"npan/internal/config"
 	"npan/internal/models"
+	"npan/internal/notify"
 	"npan/internal/npan"
 	"npan/internal/search"
 	"npan/internal/service"
@@ -25,9 +29,18 @@ type Handlers struct {
 	cfg                          config.Config
 	queryService                 searchService
 	syncManager                  *service.SyncManager
+	syncScheduler                *service.SyncScheduler
 	apiFactory                   func(token string, authOptions npan.AuthResolverOptions) npan.API
 	inspectRootsMaxConcurrency   int
 	inspectRootsPerFolderTimeout time.Duration
+
+	notifier *notify.Dispatcher
+
+	circuitBreaker *npan.CircuitBreaker
+	tokenManager   *npan.TokenManager
+
+	tenants       map[string]*Tenant
+	defaultTenant *Tenant
 }
 
 func NewHandlers(cfg config.Config, queryService search.Searcher, syncManager *service.SyncManager) *Handlers {
@@ -40,6 +53,47 @@ func NewHandlers(cfg config.Config, queryService search.Searcher, syncManager *s
 	}
 }
 
+// SetSyncScheduler 注入内置同步调度器；未注入时计划相关 RPC 返回未启用。
+func (h *Handlers) SetSyncScheduler(scheduler *service.SyncScheduler) {
+	h.syncScheduler = scheduler
+}
+
+// SetNotifier 注入同步通知分发器；未注入时测试通知 RPC 返回未配置。
+func (h *Handlers) SetNotifier(notifier *notify.Dispatcher) {
+	h.notifier = notifier
+}
+
+// SetCircuitBreaker 注入 Npan API 熔断器；注入后所有上游客户端共享熔断状态。
+func (h *Handlers) SetCircuitBreaker(breaker *npan.CircuitBreaker) {
+	h.circuitBreaker = breaker
+}
+
+// SetTokenManager 注入服务端凭据的 token 管理器；使用配置凭据的请求共享它缓存的 token。
+func (h *Handlers) SetTokenManager(manager *npan.TokenManager) {
+	h.tokenManager = manager
+}
+
+// bearerToken 解析上游 token：与托管凭据一致时复用缓存的 token，否则按请求参数换取。
+func (h *Handlers) bearerToken(ctx context.Context, authOptions npan.AuthResolverOptions) (string, error) {
+	if h.tokenManager.Matches(authOptions) {
+		return h.tokenManager.Token(ctx)
+	}
+	return npan.ResolveBearerToken(ctx, nil, authOptions)
+}
+
+// circuitOpen 判断熔断器是否处于打开状态，打开时上游请求应直接失败。
+func (h *Handlers) circuitOpen() bool {
+	return h.circuitBreaker != nil && h.circuitBreaker.Snapshot().State == npan.CircuitOpen
+}
+
+// circuitOpenError 在上游调用因熔断被拒时返回 Unavailable，其余错误返回 nil 交给调用方处理。
+func circuitOpenError(err error) error {
+	if errors.Is(err, npan.ErrCircuitOpen) {
+		return connect.NewError(connect.CodeUnavailable, npan.ErrCircuitOpen)
+	}
+	return nil
+}
+
 type authPayload struct {
 	Token        string `json:"token"`
 	ClientID     string `json:"client_id"`
@@ -121,7 +175,7 @@ func (h *Handlers) resolveAuthOptions(c *echo.Context, payload authPayload) npan
 
 func (h *Handlers) resolveToken(c *echo.Context, payload authPayload) (string, npan.AuthResolverOptions, error) {
 	authOptions := h.resolveAuthOptions(c, payload)
-	token, err := npan.ResolveBearerToken(c.Request().Context(), nil, authOptions)
+	token, err := h.bearerToken(c.Request().Context(), authOptions)
 	if err != nil {
 		return "", authOptions, err
 	}
@@ -149,13 +203,19 @@ func firstPositive(values ...int64) int64 {
 
 func (h *Handlers) newAPIClient(token string, authOptions npan.AuthResolverOptions) npan.API {
 	if h.apiFactory != nil {
-		return h.apiFactory(token, authOptions)
+		return h.circuitBreaker.Wrap(h.apiFactory(token, authOptions))
 	}
-	return npan.NewHTTPClient(npan.HTTPClientOptions{
+	if h.tokenManager.Matches(authOptions) {
+		return h.circuitBreaker.Wrap(npan.NewHTTPClient(npan.HTTPClientOptions{
+			BaseURL:     h.cfg.BaseURL,
+			TokenSource: h.tokenManager,
+		}))
+	}
+	return h.circuitBreaker.Wrap(npan.NewHTTPClient(npan.HTTPClientOptions{
 		BaseURL:        h.cfg.BaseURL,
 		Token:          token,
 		TokenRefresher: npan.NewTokenRefresher(nil, authOptions),
-	})
+	}))
 }
 
 func (h *Handlers) Health(c *echo.Context) error {
@@ -165,15 +225,29 @@ func (h *Handlers) Health(c *echo.Context) error {
 	})
 }
 
-// Readyz 就绪检查端点，检测 Meilisearch 连通性。
+// Readyz 就绪检查端点，检测 Meilisearch 连通性，并附带 Npan API 熔断状态与托管 token 状态。
+// 两者只影响同步与下载，本地搜索仍可用，因此不改变就绪结果。
 func (h *Handlers) Readyz(c *echo.Context) error {
 	if err := h.queryService.Ping(); err != nil {
-		return c.JSON(http.StatusServiceUnavailable, map[string]any{
+		body := map[string]any{
 			"status": "not_ready",
 			"meili":  "unreachable",
-		})
+		}
+		h.addUpstreamState(body)
+		return c.JSON(http.StatusServiceUnavailable, body)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
+	body := map[string]any{
 		"status": "ready",
-	})
+	}
+	h.addUpstreamState(body)
+	return c.JSON(http.StatusOK, body)
+}
+
+func (h *Handlers) addUpstreamState(body map[string]any) {
+	if h.circuitBreaker != nil {
+		body["npan_api"] = string(h.circuitBreaker.Snapshot().State)
+	}
+	if h.tokenManager != nil {
+		body["npan_token"] = string(h.tokenManager.Status().State)
+	}
 }
@@ -0,0 +1,134 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"npan/internal/config"
+	"npan/internal/npan"
+	"npan/internal/service"
+)
+
+// Tenant 是一个租户的运行时：独立的同步管理器与同步调度器，以及使用租户凭据的上游客户端工厂。
+type Tenant struct {
+	Config      config.TenantConfig
+	SyncManager *service.SyncManager
+	APIFactory  func(ctx context.Context) (npan.API, error)
+	// Scheduler 为空表示未启用调度器。
+	Scheduler *service.SyncScheduler
+	// CircuitBreaker 是租户上游地址的熔断器，为空时使用全局熔断器。
+	CircuitBreaker *npan.CircuitBreaker
+}
+
+// SetTenants 注入多租户运行时，第一个租户是请求未指定 tenant_id 时的默认租户。
+func (h *Handlers) SetTenants(tenants []*Tenant) {
+	h.tenants = make(map[string]*Tenant, len(tenants))
+	h.defaultTenant = nil
+	for _, tenant := range tenants {
+		h.tenants[tenant.Config.ID] = tenant
+		if h.defaultTenant == nil {
+			h.defaultTenant = tenant
+		}
+	}
+}
+
+// resolveTenant 返回请求指定的租户。未配置多租户时返回 nil，调用方沿用单租户逻辑。
+func (h *Handlers) resolveTenant(tenantID string) (*Tenant, error) {
+	tenantID = strings.TrimSpace(tenantID)
+	if len(h.tenants) == 0 {
+		if tenantID != "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("未配置多租户，不能指定 tenant_id"))
+		}
+		return nil, nil
+	}
+	if tenantID == "" {
+		return h.defaultTenant, nil
+	}
+	tenant, ok := h.tenants[tenantID]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("未知租户: %s", tenantID))
+	}
+	return tenant, nil
+}
+
+// syncManagerFor 返回租户的同步管理器；单租户时返回全局同步管理器。
+func (h *Handlers) syncManagerFor(tenantID string) (*service.SyncManager, error) {
+	tenant, err := h.resolveTenant(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if tenant != nil {
+		return tenant.SyncManager, nil
+	}
+	if h.syncManager == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("同步服务未初始化"))
+	}
+	return h.syncManager, nil
+}
+
+// syncSchedulerFor 返回租户的同步调度器；单租户时返回全局调度器。
+func (h *Handlers) syncSchedulerFor(tenantID string) (*service.SyncScheduler, error) {
+	tenant, err := h.resolveTenant(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	scheduler := h.syncScheduler
+	if tenant != nil {
+		scheduler = tenant.Scheduler
+	}
+	if scheduler == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("同步调度器未启用"))
+	}
+	return scheduler, nil
+}
+
+// tenantAPIClient 使用租户凭据创建上游客户端，与同一上游地址的其他客户端共享熔断器。
+func (h *Handlers) tenantAPIClient(ctx context.Context, tenant *Tenant) (npan.API, error) {
+	api, err := tenant.APIFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	breaker := h.circuitBreaker
+	if tenant.CircuitBreaker != nil {
+		breaker = tenant.CircuitBreaker
+	}
+	return breaker.Wrap(api), nil
+}
+
+// ApplySyncDefaults 把租户配置的同步范围填入未指定范围的请求，供计划同步使用。
+func (t *Tenant) ApplySyncDefaults(request *service.SyncStartRequest) {
+	applyTenantSyncDefaults(t, request)
+}
+
+// applyTenantSyncDefaults 在请求未指定同步范围时使用租户配置的根目录与部门。
+// 配置的根目录就是该租户的全部同步范围，因此默认不保留范围外的根目录文档。
+func applyTenantSyncDefaults(tenant *Tenant, request *service.SyncStartRequest) {
+	if tenant == nil {
+		return
+	}
+	if len(request.RootFolderIDs) == 0 && len(tenant.Config.RootFolderIDs) > 0 {
+		request.RootFolderIDs = append([]int64{}, tenant.Config.RootFolderIDs...)
+		if request.PreserveRootCatalog == nil {
+			preserve := false
+			request.PreserveRootCatalog = &preserve
+		}
+	}
+	if len(request.DepartmentIDs) == 0 {
+		request.DepartmentIDs = append([]int64{}, tenant.Config.DepartmentIDs...)
+	}
+	if request.IncludeDepartments == nil {
+		request.IncludeDepartments = tenant.Config.IncludeDepartments
+	}
+}
+
+// tenantSearchID 返回检索时使用的租户过滤条件，单租户时为空。
+func tenantSearchID(tenant *Tenant) string {
+	if tenant == nil {
+		return ""
+	}
+	return tenant.Config.ID
+}
@@ -0,0 +1,190 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"npan/internal/models"
+)
+
+const (
+	defaultBatchMaxDocs     = 1000
+	defaultBatchMaxBytes    = 8 << 20
+	defaultBatchMaxInFlight = 2
+)
+
+// AsyncIndexWriter 异步写入文档。done 在文档所在批次写入完成（含重试后最终失败）时回调且只回调一次；
+// Enqueue 返回错误时 done 同样会被回调。Flush 提交缓冲区并等待所有已提交批次结束。
+type AsyncIndexWriter interface {
+	Enqueue(ctx context.Context, docs []models.IndexDocument, done func(err error)) error
+	Flush(ctx context.Context) error
+}
+
+type BatchWriterOptions struct {
+	MaxDocs     int
+	MaxBytes    int
+	MaxInFlight int
+	Retry       models.RetryPolicyOptions
+}
+
+// BatchIndexWriter 位于爬取与搜索后端之间，按文档数与字节数合并写入，
+// 并最多保持 MaxInFlight 个后端任务并行。同一次 Enqueue 的文档不会被拆到两个批次，
+// 因此每页文档的写入结果可以整体回报给爬取统计与断点。
+type BatchIndexWriter struct {
+	target IndexWriter
+	opts   BatchWriterOptions
+	slots  chan struct{}
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	pending indexBatch
+}
+
+type indexBatch struct {
+	docs  []models.IndexDocument
+	bytes int
+	done  []func(err error)
+}
+
+func NewBatchIndexWriter(target IndexWriter, opts BatchWriterOptions) *BatchIndexWriter {
+	if opts.MaxDocs <= 0 {
+		opts.MaxDocs = defaultBatchMaxDocs
+	}
+	if opts.MaxBytes <= 0 {
+		opts.MaxBytes = defaultBatchMaxBytes
+	}
+	if opts.MaxInFlight <= 0 {
+		opts.MaxInFlight = defaultBatchMaxInFlight
+	}
+	return &BatchIndexWriter{
+		target: target,
+		opts:   opts,
+		slots:  make(chan struct{}, opts.MaxInFlight),
+	}
+}
+
+func (w *BatchIndexWriter) Enqueue(ctx context.Context, docs []models.IndexDocument, done func(err error)) error {
+	if done == nil {
+		done = func(error) {}
+	}
+	if len(docs) == 0 {
+		done(nil)
+		return nil
+	}
+
+	size := estimateDocsBytes(docs)
+	var ready []indexBatch
+
+	w.mu.Lock()
+	if len(w.pending.docs) > 0 && (len(w.pending.docs)+len(docs) > w.opts.MaxDocs || w.pending.bytes+size > w.opts.MaxBytes) {
+		ready = append(ready, w.takeLocked())
+	}
+	w.pending.docs = append(w.pending.docs, docs...)
+	w.pending.bytes += size
+	w.pending.done = append(w.pending.done, done)
+	if len(w.pending.docs) >= w.opts.MaxDocs || w.pending.bytes >= w.opts.MaxBytes {
+		ready = append(ready, w.takeLocked())
+	}
+	w.mu.Unlock()
+
+	return w.submit(ctx, ready)
+}
+
+func (w *BatchIndexWriter) Flush(ctx context.Context) error {
+	w.mu.Lock()
+	var ready []indexBatch
+	if len(w.pending.docs) > 0 {
+		ready = append(ready, w.takeLocked())
+	}
+	w.mu.Unlock()
+
+	err := w.submit(ctx, ready)
+	w.wg.Wait()
+	return err
+}
+
+// SubmitPending 立即提交缓冲区并等待这一批写完（含其 done 回调），不等待其他在途批次。
+// 与 Flush 不同，它可以在仍有 Enqueue 并发调用时使用。
+func (w *BatchIndexWriter) SubmitPending(ctx context.Context) error {
+	w.mu.Lock()
+	if len(w.pending.docs) == 0 {
+		w.mu.Unlock()
+		return nil
+	}
+	batch := w.takeLocked()
+	w.mu.Unlock()
+
+	finished := make(chan struct{})
+	batch.done = append(batch.done, func(error) { close(finished) })
+	if err := w.submit(ctx, []indexBatch{batch}); err != nil {
+		return err
+	}
+	select {
+	case <-finished:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// UpsertDocuments 同步写入，供只需要 IndexWriter 的调用方使用。
+func (w *BatchIndexWriter) UpsertDocuments(ctx context.Context, docs []models.IndexDocument) error {
+	result := make(chan error, 1)
+	if err := w.Enqueue(ctx, docs, func(err error) { result <- err }); err != nil {
+		return err
+	}
+	if err := w.Flush(ctx); err != nil {
+		return err
+	}
+	return <-result
+}
+
+func (w *BatchIndexWriter) takeLocked() indexBatch {
+	batch := w.pending
+	w.pending = indexBatch{}
+	return batch
+}
+
+// submit 依次提交批次，在途任务已满时阻塞等待，形成对爬取的背压。
+func (w *BatchIndexWriter) submit(ctx context.Context, batches []indexBatch) error {
+	for i, batch := range batches {
+		select {
+		case w.slots <- struct{}{}:
+		case <-ctx.Done():
+			for _, rest := range batches[i:] {
+				rest.finish(ctx.Err())
+			}
+			return ctx.Err()
+		}
+
+		w.wg.Add(1)
+		go func(batch indexBatch) {
+			defer w.wg.Done()
+			defer func() { <-w.slots }()
+			err := WithRetryVoid(ctx, func() error {
+				return w.target.UpsertDocuments(ctx, batch.docs)
+			}, w.opts.Retry)
+			batch.finish(err)
+		}(batch)
+	}
+	return nil
+}
+
+func (b indexBatch) finish(err error) {
+	for _, done := range b.done {
+		done(err)
+	}
+}
+
+func estimateDocsBytes(docs []models.IndexDocument) int {
+	total := 0
+	for _, doc := range docs {
+		encoded, err := json.Marshal(doc)
+		if err != nil {
+			continue
+		}
+		total += len(encoded) + 1
+	}
+	return total
+}
@@ -0,0 +1,298 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"npan/internal/models"
+)
+
+// batchRecordingTarget 记录每个批次的大小与并发度，可按文档 ID 注入失败或阻塞。
+type batchRecordingTarget struct {
+	delay   time.Duration
+	failDoc string
+	blockOn string
+	written chan string
+
+	current atomic.Int64
+	peak    atomic.Int64
+
+	mu      sync.Mutex
+	batches [][]string
+}
+
+func (t *batchRecordingTarget) UpsertDocuments(ctx context.Context, docs []models.IndexDocument) error {
+	now := t.current.Add(1)
+	defer t.current.Add(-1)
+	for {
+		peak := t.peak.Load()
+		if now <= peak || t.peak.CompareAndSwap(peak, now) {
+			break
+		}
+	}
+
+	ids := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.DocID)
+		if doc.DocID == t.blockOn {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		if doc.DocID == t.failDoc {
+			return errors.New("index rejected batch")
+		}
+	}
+	if t.delay > 0 {
+		time.Sleep(t.delay)
+	}
+
+	t.mu.Lock()
+	t.batches = append(t.batches, ids)
+	t.mu.Unlock()
+	if t.written != nil {
+		for _, id := range ids {
+			t.written <- id
+		}
+	}
+	return nil
+}
+
+func (t *batchRecordingTarget) batchSizes() []int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sizes := make([]int, 0, len(t.batches))
+	for _, batch := range t.batches {
+		sizes = append(sizes, len(batch))
+	}
+	return sizes
+}
+
+func docsFor(ids ...int64) []models.IndexDocument {
+	docs := make([]models.IndexDocument, 0, len(ids))
+	for _, id := range ids {
+		docs = append(docs, models.IndexDocument{DocID: fmt.Sprintf("file_%d", id), SourceID: id, Name: fmt.Sprintf("file-%d", id)})
+	}
+	return docs
+}
+
+func TestBatchIndexWriter_GroupsByDocCountWithoutSplittingPages(t *testing.T) {
+	t.Parallel()
+
+	target := &batchRecordingTarget{}
+	writer := NewBatchIndexWriter(target, BatchWriterOptions{MaxDocs: 5, MaxInFlight: 1})
+
+	var acked atomic.Int64
+	for i := int64(0); i < 4; i++ {
+		if err := writer.Enqueue(context.Background(), docsFor(i*10, i*10+1, i*10+2), func(err error) {
+			if err != nil {
+				t.Errorf("unexpected write error: %v", err)
+			}
+			acked.Add(1)
+		}); err != nil {
+			t.Fatalf("Enqueue returned error: %v", err)
+		}
+	}
+	if err := writer.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush returned error: %v", err)
+	}
+
+	if acked.Load() != 4 {
+		t.Fatalf("expected 4 acknowledgements, got %d", acked.Load())
+	}
+	sizes := target.batchSizes()
+	if len(sizes) != 4 {
+		t.Fatalf("expected every 3-doc page to be written as its own batch, got %v", sizes)
+	}
+	for _, size := range sizes {
+		if size != 3 {
+			t.Fatalf("expected pages not to be split, got %v", sizes)
+		}
+	}
+}
+
+func TestBatchIndexWriter_GroupsByBytes(t *testing.T) {
+	t.Parallel()
+
+	target := &batchRecordingTarget{}
+	pageBytes := estimateDocsBytes(docsFor(11, 12))
+	writer := NewBatchIndexWriter(target, BatchWriterOptions{MaxDocs: 100, MaxBytes: pageBytes*2 + 1, MaxInFlight: 1})
+
+	for i := int64(0); i < 5; i++ {
+		if err := writer.Enqueue(context.Background(), docsFor(i*10+11, i*10+12), nil); err != nil {
+			t.Fatalf("Enqueue returned error: %v", err)
+		}
+	}
+	if err := writer.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush returned error: %v", err)
+	}
+
+	sizes := target.batchSizes()
+	if len(sizes) != 3 || sizes[0] != 4 || sizes[1] != 4 || sizes[2] != 2 {
+		t.Fatalf("expected byte-bounded batches [4 4 2], got %v", sizes)
+	}
+}
+
+func TestBatchIndexWriter_BoundsInFlightBatches(t *testing.T) {
+	t.Parallel()
+
+	target := &batchRecordingTarget{delay: 20 * time.Millisecond}
+	writer := NewBatchIndexWriter(target, BatchWriterOptions{MaxDocs: 1, MaxInFlight: 2})
+
+	for i := int64(0); i < 8; i++ {
+		if err := writer.Enqueue(context.Background(), docsFor(i), nil); err != nil {
+			t.Fatalf("Enqueue returned error: %v", err)
+		}
+	}
+	if err := writer.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush returned error: %v", err)
+	}
+
+	if peak := target.peak.Load(); peak != 2 {
+		t.Fatalf("expected 2 batches in flight, peak=%d", peak)
+	}
+	if len(target.batchSizes()) != 8 {
+		t.Fatalf("expected 8 batches, got %v", target.batchSizes())
+	}
+}
+
+func TestRunFullCrawl_BatchWriterReportsFailedBatches(t *testing.T) {
+	t.Parallel()
+
+	api := &mockCrawlAPI{pages: map[int64][]models.FolderChildrenPage{
+		1: {
+			{Files: makeFiles(10, 2), PageCount: 3},
+			{Files: makeFiles(20, 3), PageCount: 3},
+			{Files: makeFiles(30, 1), PageCount: 3},
+		},
+	}}
+	target := &batchRecordingTarget{failDoc: "file_21"}
+	store := &syncCheckpointStore{}
+
+	stats, err := RunFullCrawl(context.Background(), FullCrawlDeps{
+		API:             api,
+		IndexWriter:     NewBatchIndexWriter(target, BatchWriterOptions{MaxDocs: 1, MaxInFlight: 2}),
+		Limiter:         NewRequestLimiter(4, 0),
+		CheckpointStore: store,
+		RootFolderID:    1,
+		RootName:        "资料",
+	})
+	if err != nil {
+		t.Fatalf("RunFullCrawl returned error: %v", err)
+	}
+
+	if stats.FilesDiscovered != 6 || stats.FilesIndexed != 3 || stats.SkippedFiles != 3 || stats.FailedRequests != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+	if checkpoint, _ := store.Load(); checkpoint != nil {
+		t.Fatalf("expected checkpoint to be cleared, got %+v", checkpoint)
+	}
+}
+
+// gatedCrawlAPI 在返回指定页之前等待 gate 回调。
+type gatedCrawlAPI struct {
+	mockCrawlAPI
+	gate func(folderID int64, pageID int64) error
+}
+
+func (a *gatedCrawlAPI) ListFolderChildren(ctx context.Context, folderID int64, pageID int64) (models.FolderChildrenPage, error) {
+	if err := a.gate(folderID, pageID); err != nil {
+		return models.FolderChildrenPage{}, err
+	}
+	return a.mockCrawlAPI.ListFolderChildren(ctx, folderID, pageID)
+}
+
+func TestRunFullCrawl_CheckpointWaitsForPendingWrites(t *testing.T) {
+	t.Parallel()
+
+	target := &batchRecordingTarget{blockOn: "file_20", written: make(chan string, 8)}
+	api := &gatedCrawlAPI{
+		mockCrawlAPI: mockCrawlAPI{pages: map[int64][]models.FolderChildrenPage{
+			1: {
+				{Files: makeFiles(10, 1), PageCount: 3},
+				{Files: makeFiles(20, 1), PageCount: 3},
+				{Files: makeFiles(30, 1), PageCount: 3},
+			},
+		}},
+		gate: func(_ int64, pageID int64) error {
+			if pageID != 2 {
+				return nil
+			}
+			// 第 0 页写入完成后再让第 2 页失败，此时第 1 页仍在写入中。
+			for id := range target.written {
+				if id == "file_10" {
+					break
+				}
+			}
+			return errors.New("bad request")
+		},
+	}
+	store := &syncCheckpointStore{}
+
+	_, err := RunFullCrawl(context.Background(), FullCrawlDeps{
+		API:             api,
+		IndexWriter:     NewBatchIndexWriter(target, BatchWriterOptions{MaxDocs: 1, MaxInFlight: 2}),
+		Limiter:         NewRequestLimiter(4, 0),
+		CheckpointStore: store,
+		RootFolderID:    1,
+		RootName:        "资料",
+	})
+	if err == nil {
+		t.Fatal("expected crawl to fail on page 2")
+	}
+
+	checkpoint, _ := store.Load()
+	if checkpoint == nil {
+		t.Fatal("expected checkpoint after page 0 was written")
+	}
+	if len(checkpoint.InFlight) != 1 || checkpoint.InFlight[0] != (models.FolderCursor{FolderID: 1, PageID: 1}) {
+		t.Fatalf("expected checkpoint to stop before the unwritten page 1, got %+v", checkpoint.InFlight)
+	}
+}
+
+// failingFlushWriter 同步确认每次写入，但最后的 Flush 失败。
+type failingFlushWriter struct {
+	err error
+}
+
+func (w *failingFlushWriter) UpsertDocuments(context.Context, []models.IndexDocument) error {
+	return nil
+}
+
+func (w *failingFlushWriter) Enqueue(_ context.Context, _ []models.IndexDocument, done func(err error)) error {
+	done(nil)
+	return nil
+}
+
+func (w *failingFlushWriter) Flush(context.Context) error {
+	return w.err
+}
+
+func TestRunFullCrawl_FlushFailureKeepsCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	api := &mockCrawlAPI{pages: map[int64][]models.FolderChildrenPage{
+		1: {{Files: makeFiles(10, 2), PageCount: 1}},
+	}}
+	flushErr := errors.New("flush failed")
+	store := &syncCheckpointStore{}
+
+	_, err := RunFullCrawl(context.Background(), FullCrawlDeps{
+		API:             api,
+		IndexWriter:     &failingFlushWriter{err: flushErr},
+		Limiter:         NewRequestLimiter(4, 0),
+		CheckpointStore: store,
+		RootFolderID:    1,
+		RootName:        "资料",
+	})
+	if !errors.Is(err, flushErr) {
+		t.Fatalf("expected flush error to fail the crawl, got %v", err)
+	}
+	if checkpoint, _ := store.Load(); checkpoint == nil {
+		t.Fatal("expected checkpoint to be kept after a failed flush")
+	}
+}
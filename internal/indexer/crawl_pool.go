@@ -0,0 +1,34 @@
+package indexer
+
+import "context"
+
+// CrawlWorkerPool 是多个根目录爬取共享的目录 worker 配额。
+// 每个 worker 处理完一个目录即归还配额，小根目录完成后释放出的配额会被仍有
+// 待处理目录的大根目录取用，从而实现根目录之间的工作窃取。
+type CrawlWorkerPool struct {
+	slots chan struct{}
+}
+
+func NewCrawlWorkerPool(size int) *CrawlWorkerPool {
+	if size <= 0 {
+		size = 1
+	}
+	return &CrawlWorkerPool{slots: make(chan struct{}, size)}
+}
+
+func (p *CrawlWorkerPool) Size() int {
+	return cap(p.slots)
+}
+
+func (p *CrawlWorkerPool) acquire(ctx context.Context) error {
+	select {
+	case p.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *CrawlWorkerPool) release() {
+	<-p.slots
+}
@@ -0,0 +1,394 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"npan/internal/models"
+)
+
+// concurrentCrawlAPI 在 mockCrawlAPI 基础上记录并发度，并可对指定页返回错误。
+type concurrentCrawlAPI struct {
+	mockCrawlAPI
+	delay   time.Duration
+	failOn  map[[2]int64]error
+	current atomic.Int64
+	peak    atomic.Int64
+
+	mu    sync.Mutex
+	calls map[[2]int64]int
+}
+
+func (a *concurrentCrawlAPI) ListFolderChildren(ctx context.Context, folderID int64, pageID int64) (models.FolderChildrenPage, error) {
+	now := a.current.Add(1)
+	defer a.current.Add(-1)
+	for {
+		peak := a.peak.Load()
+		if now <= peak || a.peak.CompareAndSwap(peak, now) {
+			break
+		}
+	}
+
+	a.mu.Lock()
+	if a.calls == nil {
+		a.calls = map[[2]int64]int{}
+	}
+	a.calls[[2]int64{folderID, pageID}]++
+	a.mu.Unlock()
+
+	if a.delay > 0 {
+		time.Sleep(a.delay)
+	}
+	if err := a.failOn[[2]int64{folderID, pageID}]; err != nil {
+		return models.FolderChildrenPage{}, err
+	}
+	return a.mockCrawlAPI.ListFolderChildren(ctx, folderID, pageID)
+}
+
+func (a *concurrentCrawlAPI) callCount(folderID int64, pageID int64) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.calls[[2]int64{folderID, pageID}]
+}
+
+type syncRecordingWriter struct {
+	mu   sync.Mutex
+	docs map[string]models.IndexDocument
+}
+
+func (w *syncRecordingWriter) UpsertDocuments(_ context.Context, docs []models.IndexDocument) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.docs == nil {
+		w.docs = map[string]models.IndexDocument{}
+	}
+	for _, doc := range docs {
+		w.docs[doc.DocID] = doc
+	}
+	return nil
+}
+
+type syncCheckpointStore struct {
+	mu   sync.Mutex
+	data *models.CrawlCheckpoint
+}
+
+func (s *syncCheckpointStore) Load() (*models.CrawlCheckpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data, nil
+}
+
+func (s *syncCheckpointStore) Save(checkpoint *models.CrawlCheckpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = checkpoint
+	return nil
+}
+
+func (s *syncCheckpointStore) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = nil
+	return nil
+}
+
+func TestRunFullCrawl_FolderWorkersCrawlInParallel(t *testing.T) {
+	t.Parallel()
+
+	rootFolders := make([]models.NpanFolder, 0, 8)
+	pages := map[int64][]models.FolderChildrenPage{}
+	for i := int64(0); i < 8; i++ {
+		folderID := 100 + i
+		rootFolders = append(rootFolders, models.NpanFolder{ID: folderID, Name: fmt.Sprintf("dir-%d", i), ParentID: 1})
+		pages[folderID] = []models.FolderChildrenPage{{Files: makeFiles(folderID*10, 3), PageCount: 1}}
+	}
+	pages[1] = []models.FolderChildrenPage{{Folders: rootFolders, PageCount: 1}}
+
+	api := &concurrentCrawlAPI{mockCrawlAPI: mockCrawlAPI{pages: pages}, delay: 20 * time.Millisecond}
+	writer := &syncRecordingWriter{}
+
+	stats, err := RunFullCrawl(context.Background(), FullCrawlDeps{
+		API:             api,
+		IndexWriter:     writer,
+		Limiter:         NewRequestLimiter(4, 0),
+		CheckpointStore: &syncCheckpointStore{},
+		RootFolderID:    1,
+		RootName:        "资料",
+		Workers:         NewCrawlWorkerPool(4),
+	})
+	if err != nil {
+		t.Fatalf("RunFullCrawl returned error: %v", err)
+	}
+	if stats.FoldersVisited != 9 || stats.FilesIndexed != 24 || stats.PagesFetched != 9 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+	if len(writer.docs) != 1+8+24 {
+		t.Fatalf("expected 33 docs, got %d", len(writer.docs))
+	}
+	if api.peak.Load() < 2 {
+		t.Fatalf("expected folders to be crawled concurrently, peak=%d", api.peak.Load())
+	}
+	assertDocPath(t, writer.docs, "file_1030", "资料/dir-3/file-1030", []int64{1, 103})
+}
+
+func TestRunFullCrawl_CheckpointRecordsInFlightFolders(t *testing.T) {
+	t.Parallel()
+
+	api := &concurrentCrawlAPI{
+		mockCrawlAPI: mockCrawlAPI{pages: map[int64][]models.FolderChildrenPage{
+			1: {{Folders: []models.NpanFolder{{ID: 2, Name: "a", ParentID: 1}, {ID: 3, Name: "b", ParentID: 1}}, PageCount: 1}},
+			2: {{Files: makeFiles(20, 1), PageCount: 2}, {Files: makeFiles(21, 1), PageCount: 2}},
+		}},
+		failOn: map[[2]int64]error{{2, 1}: errors.New("bad request")},
+	}
+	store := &syncCheckpointStore{}
+
+	_, err := RunFullCrawl(context.Background(), FullCrawlDeps{
+		API:             api,
+		IndexWriter:     &syncRecordingWriter{},
+		Limiter:         NewRequestLimiter(1, 0),
+		CheckpointStore: store,
+		RootFolderID:    1,
+		RootName:        "资料",
+	})
+	if err == nil {
+		t.Fatal("expected crawl to fail on folder 2 page 1")
+	}
+
+	checkpoint, _ := store.Load()
+	if checkpoint == nil {
+		t.Fatal("expected checkpoint to be kept after failure")
+	}
+	if len(checkpoint.InFlight) != 1 || checkpoint.InFlight[0] != (models.FolderCursor{FolderID: 2, PageID: 1}) {
+		t.Fatalf("expected folder 2 page 1 in flight, got %+v", checkpoint.InFlight)
+	}
+	if len(checkpoint.Queue) != 1 || checkpoint.Queue[0] != 3 {
+		t.Fatalf("expected folder 3 queued, got %v", checkpoint.Queue)
+	}
+	if checkpoint.FolderPaths[2].PathText != "资料/a" || checkpoint.FolderPaths[3].PathText != "资料/b" {
+		t.Fatalf("expected folder paths for in-flight and queued folders, got %+v", checkpoint.FolderPaths)
+	}
+}
+
+func TestRunFullCrawl_ResumesInFlightFoldersAtSavedPage(t *testing.T) {
+	t.Parallel()
+
+	api := &concurrentCrawlAPI{
+		mockCrawlAPI: mockCrawlAPI{pages: map[int64][]models.FolderChildrenPage{
+			2: {{Files: makeFiles(20, 1), PageCount: 2}, {Files: makeFiles(21, 1), PageCount: 2}},
+			3: {{Files: makeFiles(30, 1), PageCount: 1}},
+			4: {{Files: makeFiles(40, 1), PageCount: 3}, {PageCount: 3}, {Files: makeFiles(42, 1), PageCount: 3}},
+		}},
+	}
+	writer := &syncRecordingWriter{}
+	store := &syncCheckpointStore{data: &models.CrawlCheckpoint{
+		Queue:    []int64{3},
+		InFlight: []models.FolderCursor{{FolderID: 2, PageID: 1}, {FolderID: 4, PageID: 2}},
+		FolderPaths: map[int64]models.FolderPath{
+			2: {PathText: "资料/a", Lineage: []int64{1, 2}},
+			3: {PathText: "资料/b", Lineage: []int64{1, 3}},
+			4: {PathText: "资料/c", Lineage: []int64{1, 4}},
+		},
+	}}
+
+	if _, err := RunFullCrawl(context.Background(), FullCrawlDeps{
+		API:             api,
+		IndexWriter:     writer,
+		Limiter:         NewRequestLimiter(2, 0),
+		CheckpointStore: store,
+		RootFolderID:    1,
+		RootName:        "资料",
+		Workers:         NewCrawlWorkerPool(2),
+	}); err != nil {
+		t.Fatalf("RunFullCrawl returned error: %v", err)
+	}
+
+	for _, fetched := range [][2]int64{{2, 0}, {4, 0}, {4, 1}} {
+		if got := api.callCount(fetched[0], fetched[1]); got != 0 {
+			t.Fatalf("expected folder %d page %d to be skipped on resume, fetched %d times", fetched[0], fetched[1], got)
+		}
+	}
+	for _, docID := range []string{"file_21", "file_30", "file_42"} {
+		if _, ok := writer.docs[docID]; !ok {
+			t.Fatalf("expected %s to be indexed after resume", docID)
+		}
+	}
+	assertDocPath(t, writer.docs, "file_42", "资料/c/file-42", []int64{1, 4})
+	if checkpoint, _ := store.Load(); checkpoint != nil {
+		t.Fatalf("expected checkpoint to be cleared, got %+v", checkpoint)
+	}
+}
+
+func TestRunFullCrawl_SharedPoolLendsIdleWorkersAcrossRoots(t *testing.T) {
+	t.Parallel()
+
+	bigPages := map[int64][]models.FolderChildrenPage{}
+	bigFolders := make([]models.NpanFolder, 0, 6)
+	for i := int64(0); i < 6; i++ {
+		folderID := 200 + i
+		bigFolders = append(bigFolders, models.NpanFolder{ID: folderID, Name: fmt.Sprintf("d%d", i), ParentID: 1})
+		bigPages[folderID] = []models.FolderChildrenPage{{Files: makeFiles(folderID*10, 1), PageCount: 1}}
+	}
+	bigPages[1] = []models.FolderChildrenPage{{Folders: bigFolders, PageCount: 1}}
+	bigPages[9] = []models.FolderChildrenPage{{Files: makeFiles(90, 1), PageCount: 1}}
+
+	api := &concurrentCrawlAPI{mockCrawlAPI: mockCrawlAPI{pages: bigPages}, delay: 20 * time.Millisecond}
+	pool := NewCrawlWorkerPool(4)
+	limiter := NewRequestLimiter(4, 0)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, rootID := range []int64{1, 9} {
+		wg.Add(1)
+		go func(i int, rootID int64) {
+			defer wg.Done()
+			_, errs[i] = RunFullCrawl(context.Background(), FullCrawlDeps{
+				API:             api,
+				IndexWriter:     &syncRecordingWriter{},
+				Limiter:         limiter,
+				CheckpointStore: &syncCheckpointStore{},
+				RootFolderID:    rootID,
+				Workers:         pool,
+			})
+		}(i, rootID)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("RunFullCrawl returned error: %v", err)
+		}
+	}
+	// 小根目录只有一个目录，大根目录必须借用剩余配额才能达到 3 以上的并发。
+	if api.peak.Load() < 3 {
+		t.Fatalf("expected big root to use idle workers, peak=%d", api.peak.Load())
+	}
+}
+
+// frontierCheckpointStore 在内存中按目录行应用断点变化，模拟 SQLite 的 crawl_frontier。
+type frontierCheckpointStore struct {
+	mu         sync.Mutex
+	rows       map[int64]models.CrawlFrontierChange
+	order      []int64
+	generation int64
+	fullSaves  int
+}
+
+func (s *frontierCheckpointStore) Load() (*models.CrawlCheckpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.rows == nil {
+		return nil, nil
+	}
+	checkpoint := &models.CrawlCheckpoint{SyncGeneration: s.generation, FolderPaths: map[int64]models.FolderPath{}}
+	for _, folderID := range s.order {
+		row := s.rows[folderID]
+		switch row.State {
+		case models.CrawlFrontierPending:
+			checkpoint.Queue = append(checkpoint.Queue, folderID)
+		case models.CrawlFrontierInProgress:
+			checkpoint.InFlight = append(checkpoint.InFlight, models.FolderCursor{FolderID: folderID, PageID: row.PageID})
+		default:
+			continue
+		}
+		if row.Path != nil {
+			checkpoint.FolderPaths[folderID] = *row.Path
+		}
+	}
+	return checkpoint, nil
+}
+
+func (s *frontierCheckpointStore) Save(*models.CrawlCheckpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fullSaves++
+	return nil
+}
+
+func (s *frontierCheckpointStore) SaveFrontier(generation int64, changes []models.CrawlFrontierChange) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.rows == nil {
+		s.rows = map[int64]models.CrawlFrontierChange{}
+	}
+	s.generation = generation
+	for _, change := range changes {
+		existing, ok := s.rows[change.FolderID]
+		if !ok {
+			s.order = append(s.order, change.FolderID)
+		}
+		if change.Path == nil && change.State != models.CrawlFrontierDone {
+			change.Path = existing.Path
+		}
+		s.rows[change.FolderID] = change
+	}
+	return nil
+}
+
+func (s *frontierCheckpointStore) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rows = nil
+	s.order = nil
+	return nil
+}
+
+func TestRunFullCrawl_FrontierStoreSavesChangesAndResumes(t *testing.T) {
+	t.Parallel()
+
+	api := &concurrentCrawlAPI{
+		mockCrawlAPI: mockCrawlAPI{pages: map[int64][]models.FolderChildrenPage{
+			1: {{Folders: []models.NpanFolder{{ID: 2, Name: "a", ParentID: 1}, {ID: 3, Name: "b", ParentID: 1}}, PageCount: 1}},
+			2: {{Files: makeFiles(20, 1), PageCount: 1}},
+			3: {{Files: makeFiles(30, 1), PageCount: 2}, {Files: makeFiles(31, 1), PageCount: 2}},
+		}},
+		failOn: map[[2]int64]error{{3, 1}: errors.New("boom")},
+	}
+	store := &frontierCheckpointStore{}
+	deps := FullCrawlDeps{
+		API:             api,
+		IndexWriter:     &syncRecordingWriter{},
+		Limiter:         NewRequestLimiter(1, 0),
+		CheckpointStore: store,
+		RootFolderID:    1,
+		RootName:        "资料",
+		Workers:         NewCrawlWorkerPool(1),
+		SyncGeneration:  7,
+	}
+	if _, err := RunFullCrawl(context.Background(), deps); err == nil {
+		t.Fatal("expected the failing page to stop the crawl")
+	}
+
+	checkpoint, _ := store.Load()
+	if checkpoint == nil || len(checkpoint.Queue) != 0 || len(checkpoint.InFlight) != 1 || checkpoint.InFlight[0] != (models.FolderCursor{FolderID: 3, PageID: 1}) {
+		t.Fatalf("unexpected frontier checkpoint: %+v", checkpoint)
+	}
+	if checkpoint.FolderPaths[3].PathText != "资料/b" || checkpoint.SyncGeneration != 7 {
+		t.Fatalf("expected folder path and generation to be kept, got %+v", checkpoint)
+	}
+
+	api.failOn = nil
+	writer := &syncRecordingWriter{}
+	deps.IndexWriter = writer
+	if _, err := RunFullCrawl(context.Background(), deps); err != nil {
+		t.Fatalf("resume returned error: %v", err)
+	}
+	for _, fetched := range [][2]int64{{1, 0}, {2, 0}, {3, 0}} {
+		if got := api.callCount(fetched[0], fetched[1]); got != 1 {
+			t.Fatalf("expected folder %d page %d to be fetched once, got %d", fetched[0], fetched[1], got)
+		}
+	}
+	assertDocPath(t, writer.docs, "file_31", "资料/b/file-31", []int64{1, 3})
+	if store.fullSaves != 0 {
+		t.Fatalf("expected only frontier saves, got %d full saves", store.fullSaves)
+	}
+	if checkpoint, _ := store.Load(); checkpoint != nil {
+		t.Fatalf("expected checkpoint to be cleared, got %+v", checkpoint)
+	}
+}
@@ -2,7 +2,9 @@ package indexer
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"npan/internal/models"
@@ -20,14 +22,28 @@ type CheckpointStore interface {
 	Clear() error
 }
 
+// FrontierStore 是按目录行保存断点的 CheckpointStore。爬取时只写入自上次保存以来状态变化的目录，
+// 每页断点的开销与该页的子目录数成正比，而不是与剩余队列长度成正比；Load 与 Clear 仍按完整断点工作。
+type FrontierStore interface {
+	CheckpointStore
+	SaveFrontier(generation int64, changes []models.CrawlFrontierChange) error
+}
+
 type FullCrawlDeps struct {
 	API             npan.API
 	IndexWriter     IndexWriter
 	Limiter         *RequestLimiter
 	CheckpointStore CheckpointStore
 	RootFolderID    int64
+	RootName        string
+	PathResolver    *FolderPathResolver
+	Workers         *CrawlWorkerPool
+	SyncGeneration  int64
 	Retry           models.RetryPolicyOptions
 	OnProgress      func(event ProgressEvent)
+	// OnWriteFailure 在一批文档重试后仍写入失败时调用，调用方可保存这批文档以便重放。
+	// 它在该页断点落盘之前执行；取消导致的写入失败不会触发。
+	OnWriteFailure func(docs []models.IndexDocument, err error)
 }
 
 type ProgressEvent struct {
@@ -36,6 +52,7 @@ type ProgressEvent struct {
 	CurrentPageID    int64
 	CurrentPageCount int64
 	QueueLength      int64
+	InFlight         int64
 	Stats            models.CrawlStats
 }
 
@@ -45,6 +62,61 @@ func defaultCheckpoint(rootFolderID int64) *models.CrawlCheckpoint {
 	}
 }
 
+// crawlState 是一次根目录爬取中各目录 worker 共享的队列、断点与统计。
+type crawlState struct {
+	deps FullCrawlDeps
+
+	mu       sync.Mutex
+	cond     *sync.Cond
+	resumed  []models.FolderCursor
+	queue    []int64
+	inFlight map[int64]int64
+	order    []int64
+	active   int
+	paths    map[int64]models.FolderPath
+	stats    models.CrawlStats
+	err      error
+	cancel   context.CancelFunc
+
+	// 写入序号与待落盘断点：断点只有在其之前提交的全部写入都结束后才保存，
+	// 避免异步写入尚未完成时断点已越过对应页面。
+	writeSeq  int64
+	unacked   map[int64]struct{}
+	snapshots []checkpointSnapshot
+
+	// frontier 非空时断点按目录行增量保存，frontierChanges 是尚未归入快照的状态变化。
+	frontier        FrontierStore
+	frontierChanges []models.CrawlFrontierChange
+}
+
+// checkpointSnapshot 是某次写入对应的断点：普通 CheckpointStore 保存完整断点，FrontierStore 只保存变化。
+type checkpointSnapshot struct {
+	seq        int64
+	checkpoint *models.CrawlCheckpoint
+	changes    []models.CrawlFrontierChange
+}
+
+// syncIndexWriter 把普通 IndexWriter 适配为 AsyncIndexWriter，写入在 Enqueue 内同步完成。
+type syncIndexWriter struct {
+	writer IndexWriter
+	retry  models.RetryPolicyOptions
+}
+
+func (w syncIndexWriter) Enqueue(ctx context.Context, docs []models.IndexDocument, done func(err error)) error {
+	if len(docs) == 0 {
+		done(nil)
+		return nil
+	}
+	done(WithRetryVoid(ctx, func() error {
+		return w.writer.UpsertDocuments(ctx, docs)
+	}, w.retry))
+	return nil
+}
+
+func (w syncIndexWriter) Flush(context.Context) error {
+	return nil
+}
+
 func RunFullCrawl(ctx context.Context, deps FullCrawlDeps) (models.CrawlStats, error) {
 	startedAt := time.Now().UnixMilli()
 	stats := models.CrawlStats{
@@ -56,120 +128,442 @@ func RunFullCrawl(ctx context.Context, deps FullCrawlDeps) (models.CrawlStats, e
 		EndedAt:        startedAt,
 	}
 
-	state, err := deps.CheckpointStore.Load()
+	checkpoint, err := deps.CheckpointStore.Load()
 	if err != nil {
 		return stats, err
 	}
-	if state == nil {
-		state = defaultCheckpoint(deps.RootFolderID)
+	if checkpoint == nil {
+		checkpoint = defaultCheckpoint(deps.RootFolderID)
+	}
+
+	crawlCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	state := newCrawlState(deps, checkpoint, stats, cancel)
+	workers := deps.Workers
+	if workers == nil {
+		workers = NewCrawlWorkerPool(1)
+	}
+	writer, ok := deps.IndexWriter.(AsyncIndexWriter)
+	if !ok {
+		writer = syncIndexWriter{writer: deps.IndexWriter, retry: deps.Retry}
 	}
 
-	queue := append([]int64{}, state.Queue...)
+	var wg sync.WaitGroup
+	for {
+		state.mu.Lock()
+		for state.err == nil && crawlCtx.Err() == nil && !state.hasPending() && state.active > 0 {
+			state.cond.Wait()
+		}
+		if state.err != nil || crawlCtx.Err() != nil || !state.hasPending() {
+			state.mu.Unlock()
+			break
+		}
+		state.mu.Unlock()
 
-	for len(queue) > 0 {
-		folderID := queue[0]
-		queue = queue[1:]
-		stats.FoldersVisited++
+		// 先拿到 worker 配额再出队，等待配额期间目录仍留在断点的 Queue 中。
+		// 只有派发循环会出队，因此拿到配额后待处理目录不会减少。
+		if err := workers.acquire(crawlCtx); err != nil {
+			break
+		}
+		state.mu.Lock()
+		if state.err != nil {
+			state.mu.Unlock()
+			workers.release()
+			break
+		}
+		task := state.next()
+		state.mu.Unlock()
 
-		pageID := int64(0)
-		pageCount := int64(1)
+		wg.Add(1)
+		go func(task models.FolderCursor) {
+			defer wg.Done()
+			defer workers.release()
+			state.crawlFolder(crawlCtx, writer, task)
+		}(task)
+	}
+	wg.Wait()
+	// 等待缓冲区与在途批次全部写完，写入结果回调会补齐统计并保存断点。
+	flushErr := writer.Flush(crawlCtx)
 
-		for pageID < pageCount {
-			checkpoint := &models.CrawlCheckpoint{
-				Queue: []int64{folderID},
-			}
-			checkpoint.Queue = append(checkpoint.Queue, queue...)
-			checkpoint.CurrentFolderID = &folderID
-			checkpoint.CurrentPageID = &pageID
+	state.mu.Lock()
+	stats = state.stats
+	crawlErr := state.err
+	state.mu.Unlock()
 
-			if err := deps.CheckpointStore.Save(checkpoint); err != nil {
-				return stats, err
-			}
+	if crawlErr != nil {
+		return stats, crawlErr
+	}
+	// 最后一批没能提交时保留断点，下次从未确认的页面续爬。
+	if flushErr != nil {
+		return stats, fmt.Errorf("写入剩余文档失败: %w", flushErr)
+	}
+	if ctx.Err() != nil {
+		return stats, ctx.Err()
+	}
+
+	if err := deps.CheckpointStore.Clear(); err != nil {
+		return stats, err
+	}
+
+	stats.EndedAt = time.Now().UnixMilli()
+	return stats, nil
+}
+
+func newCrawlState(deps FullCrawlDeps, checkpoint *models.CrawlCheckpoint, stats models.CrawlStats, cancel context.CancelFunc) *crawlState {
+	s := &crawlState{
+		deps:     deps,
+		queue:    append([]int64{}, checkpoint.Queue...),
+		inFlight: map[int64]int64{},
+		paths:    make(map[int64]models.FolderPath, len(checkpoint.FolderPaths)+1),
+		stats:    stats,
+		cancel:   cancel,
+		unacked:  map[int64]struct{}{},
+	}
+	s.cond = sync.NewCond(&s.mu)
+	if frontier, ok := deps.CheckpointStore.(FrontierStore); ok {
+		s.frontier = frontier
+	}
+
+	for id, path := range checkpoint.FolderPaths {
+		s.paths[id] = path
+	}
+	if _, ok := s.paths[deps.RootFolderID]; !ok {
+		s.paths[deps.RootFolderID] = RootFolderPath(deps.RootFolderID, deps.RootName)
+	}
+
+	// 处理中的目录从断点页继续，并计入 inFlight，保证尚未重新派发前的断点仍能记录它们。
+	for _, cursor := range checkpoint.InFlight {
+		if _, exists := s.inFlight[cursor.FolderID]; exists {
+			continue
+		}
+		s.resumed = append(s.resumed, cursor)
+		s.trackInFlight(cursor.FolderID, cursor.PageID)
+	}
+	return s
+}
+
+func (s *crawlState) hasPending() bool {
+	return len(s.resumed) > 0 || len(s.queue) > 0
+}
+
+// next 取出下一个待处理目录并登记为处理中，调用方需持有锁。
+func (s *crawlState) next() models.FolderCursor {
+	s.active++
+	if len(s.resumed) > 0 {
+		task := s.resumed[0]
+		s.resumed = s.resumed[1:]
+		return task
+	}
+	folderID := s.queue[0]
+	s.queue = s.queue[1:]
+	s.trackInFlight(folderID, 0)
+	s.recordFrontier(folderID, models.CrawlFrontierInProgress, 0)
+	return models.FolderCursor{FolderID: folderID}
+}
+
+// recordFrontier 记录目录的状态变化，调用方需持有锁。新入队或刚出队的目录带上路径，
+// 翻页与完成只更新状态。
+func (s *crawlState) recordFrontier(folderID int64, state models.CrawlFrontierState, pageID int64) {
+	if s.frontier == nil {
+		return
+	}
+	change := models.CrawlFrontierChange{FolderID: folderID, State: state, PageID: pageID}
+	if state != models.CrawlFrontierDone && pageID == 0 {
+		if path, ok := s.paths[folderID]; ok {
+			change.Path = &path
+		}
+	}
+	s.frontierChanges = append(s.frontierChanges, change)
+}
+
+func (s *crawlState) trackInFlight(folderID int64, pageID int64) {
+	if _, exists := s.inFlight[folderID]; !exists {
+		s.order = append(s.order, folderID)
+	}
+	s.inFlight[folderID] = pageID
+}
+
+func (s *crawlState) untrackInFlight(folderID int64) {
+	delete(s.inFlight, folderID)
+	for i, id := range s.order {
+		if id == folderID {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// checkpoint 生成当前断点，调用方需持有锁。
+func (s *crawlState) checkpoint() *models.CrawlCheckpoint {
+	checkpoint := &models.CrawlCheckpoint{
+		Queue:          append([]int64{}, s.queue...),
+		SyncGeneration: s.deps.SyncGeneration,
+	}
+	for _, folderID := range s.order {
+		checkpoint.InFlight = append(checkpoint.InFlight, models.FolderCursor{FolderID: folderID, PageID: s.inFlight[folderID]})
+	}
+
+	ids := make([]int64, 0, len(s.order)+len(s.queue))
+	ids = append(ids, s.order...)
+	ids = append(ids, s.queue...)
+	checkpoint.FolderPaths = checkpointFolderPaths(s.paths, ids)
+	return checkpoint
+}
+
+// fail 记录首个错误并取消其它 worker，调用方需持有锁。
+func (s *crawlState) fail(err error) {
+	if s.err == nil {
+		s.err = err
+		s.cancel()
+	}
+	s.cond.Broadcast()
+}
+
+func (s *crawlState) folderPath(ctx context.Context, folderID int64) (models.FolderPath, error) {
+	s.mu.Lock()
+	path, ok := s.paths[folderID]
+	s.mu.Unlock()
+	if ok {
+		return path, nil
+	}
+
+	path, err := resolveFolderPath(ctx, s.deps.PathResolver, folderID)
+	if err != nil {
+		return models.FolderPath{}, err
+	}
+	s.mu.Lock()
+	s.paths[folderID] = path
+	s.mu.Unlock()
+	return path, nil
+}
+
+func (s *crawlState) crawlFolder(ctx context.Context, writer AsyncIndexWriter, task models.FolderCursor) {
+	deps := s.deps
+	folderID := task.FolderID
+
+	defer func() {
+		s.mu.Lock()
+		s.active--
+		s.cond.Broadcast()
+		s.mu.Unlock()
+	}()
+
+	s.mu.Lock()
+	s.stats.FoldersVisited++
+	s.mu.Unlock()
+
+	folderPath, err := s.folderPath(ctx, folderID)
+	if err != nil {
+		s.mu.Lock()
+		s.fail(err)
+		s.mu.Unlock()
+		return
+	}
 
-			var page models.FolderChildrenPage
-			err := deps.Limiter.Schedule(ctx, func() error {
-				result, requestErr := WithRetry(ctx, func() (models.FolderChildrenPage, error) {
-					return deps.API.ListFolderChildren(ctx, folderID, pageID)
-				}, deps.Retry)
+	pageID := task.PageID
+	pageCount := pageID + 1
+	for pageID < pageCount {
+		var page models.FolderChildrenPage
+		// 每次尝试都经过限速器，限速器才能感知上游限流并降速；重试等待期间也不占用并发配额。
+		err := WithRetryVoid(ctx, func() error {
+			return deps.Limiter.Schedule(ctx, func() error {
+				result, requestErr := deps.API.ListFolderChildren(ctx, folderID, pageID)
 				if requestErr != nil {
 					return requestErr
 				}
 				page = result
 				return nil
 			})
-			if err != nil {
-				stats.FailedRequests++
-				return stats, err
+		}, deps.Retry)
+		if err != nil {
+			s.mu.Lock()
+			if !errors.Is(err, context.Canceled) || s.err == nil {
+				s.stats.FailedRequests++
 			}
+			s.fail(err)
+			s.mu.Unlock()
+			return
+		}
 
-			stats.PagesFetched++
-			pageCount = page.PageCount
-			if pageCount <= 0 {
-				pageCount = 1
-			}
+		pageCount = page.PageCount
+		if pageCount <= 0 {
+			pageCount = 1
+		}
 
-			for _, folder := range page.Folders {
-				queue = append(queue, folder.ID)
+		docs := make([]models.IndexDocument, 0, len(page.Folders)+len(page.Files)+1)
+		if folderID == deps.RootFolderID && pageID == 0 {
+			rootName := deps.RootName
+			if rootName == "" {
+				rootName = "全部文件"
 			}
+			docs = append(docs, search.MapFolderToIndexDoc(models.NpanFolder{
+				ID:       deps.RootFolderID,
+				Name:     rootName,
+				ParentID: deps.RootFolderID,
+			}, rootName))
+		}
 
-			docs := make([]models.IndexDocument, 0, len(page.Folders)+len(page.Files)+1)
-			if folderID == deps.RootFolderID && pageID == 0 {
-				docs = append(docs, search.MapFolderToIndexDoc(models.NpanFolder{
-					ID:       deps.RootFolderID,
-					Name:     "全部文件",
-					ParentID: deps.RootFolderID,
-				}, "全部文件"))
-			}
+		for _, folder := range page.Folders {
+			docs = append(docs, FolderDoc(folderPath, folder))
+		}
+		for _, file := range page.Files {
+			docs = append(docs, FileDoc(folderPath, file))
+		}
 
-			for _, folder := range page.Folders {
-				docs = append(docs, search.MapFolderToIndexDoc(folder, fmt.Sprintf("folder/%d/%s", folder.ID, folder.Name)))
-			}
-			for _, file := range page.Files {
-				docs = append(docs, search.MapFileToIndexDoc(file, fmt.Sprintf("file/%d/%s", file.ID, file.Name)))
-			}
+		stampSyncGeneration(docs, deps.RootFolderID, deps.SyncGeneration)
+		filesInBatch := int64(len(page.Files))
 
-			stats.FilesDiscovered += int64(len(page.Files))
-			filesInBatch := int64(len(page.Files))
-			if len(docs) > 0 {
-				err := WithRetryVoid(ctx, func() error {
-					return deps.IndexWriter.UpsertDocuments(ctx, docs)
-				}, deps.Retry)
-				if err != nil {
-					stats.FailedRequests++
-					stats.SkippedFiles += filesInBatch
-					if ctx.Err() != nil {
-						return stats, ctx.Err()
-					}
-				} else {
-					stats.FilesIndexed += filesInBatch
-				}
-			} else {
-				stats.FilesIndexed += filesInBatch
-			}
+		s.mu.Lock()
+		s.stats.PagesFetched++
+		s.stats.FilesDiscovered += filesInBatch
+
+		// 子目录入队与页码推进在同一次断点中落盘，恢复时不会丢失或重复派发目录。
+		for _, folder := range page.Folders {
+			s.queue = append(s.queue, folder.ID)
+			s.paths[folder.ID] = ChildFolderPath(folderPath, folder)
+			s.recordFrontier(folder.ID, models.CrawlFrontierPending, 0)
+		}
+		pageID++
+		if pageID < pageCount {
+			s.inFlight[folderID] = pageID
+			s.recordFrontier(folderID, models.CrawlFrontierInProgress, pageID)
+		} else {
+			s.untrackInFlight(folderID)
+			delete(s.paths, folderID)
+			s.recordFrontier(folderID, models.CrawlFrontierDone, 0)
+		}
+		s.writeSeq++
+		seq := s.writeSeq
+		s.unacked[seq] = struct{}{}
+		snapshot := checkpointSnapshot{seq: seq}
+		if s.frontier != nil {
+			snapshot.changes = s.frontierChanges
+			s.frontierChanges = nil
+		} else {
+			snapshot.checkpoint = s.checkpoint()
+		}
+		s.snapshots = append(s.snapshots, snapshot)
+		s.cond.Broadcast()
+		s.mu.Unlock()
 
-			if deps.OnProgress != nil {
-				deps.OnProgress(ProgressEvent{
-					RootFolderID:     deps.RootFolderID,
-					CurrentFolderID:  folderID,
-					CurrentPageID:    pageID,
-					CurrentPageCount: pageCount,
-					QueueLength:      int64(len(queue)),
-					Stats:            stats,
-				})
+		event := ProgressEvent{
+			RootFolderID:     deps.RootFolderID,
+			CurrentFolderID:  folderID,
+			CurrentPageID:    pageID - 1,
+			CurrentPageCount: pageCount,
+		}
+		if err := writer.Enqueue(ctx, docs, func(err error) {
+			if err != nil && ctx.Err() == nil && deps.OnWriteFailure != nil {
+				deps.OnWriteFailure(docs, err)
 			}
+			s.ackWrite(ctx, seq, filesInBatch, event, err)
+		}); err != nil {
+			return
+		}
+	}
+}
+
+// ackWrite 处理一页文档的写入结果：更新统计，并保存所有已完成写入覆盖到的最新断点。
+func (s *crawlState) ackWrite(ctx context.Context, seq int64, files int64, event ProgressEvent, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil && ctx.Err() != nil {
+		// 取消导致的写入失败不算跳过，该页保持未确认，断点不会越过它。
+		s.fail(ctx.Err())
+		return
+	}
+
+	delete(s.unacked, seq)
+	if err != nil {
+		s.stats.FailedRequests++
+		s.stats.SkippedFiles += files
+	} else {
+		s.stats.FilesIndexed += files
+	}
 
-			pageID++
+	if saveErr := s.saveAckedCheckpoint(); saveErr != nil {
+		s.fail(saveErr)
+		return
+	}
+
+	if s.deps.OnProgress != nil {
+		event.QueueLength = int64(len(s.queue))
+		event.InFlight = int64(len(s.order))
+		event.Stats = s.stats
+		s.deps.OnProgress(event)
+	}
+}
+
+// saveAckedCheckpoint 保存序号低于最小未确认写入的最新断点，调用方需持有锁。
+func (s *crawlState) saveAckedCheckpoint() error {
+	watermark := s.writeSeq + 1
+	for seq := range s.unacked {
+		if seq < watermark {
+			watermark = seq
 		}
+	}
 
-		if err := deps.CheckpointStore.Save(&models.CrawlCheckpoint{Queue: append([]int64{}, queue...)}); err != nil {
-			return stats, err
+	ready := -1
+	for i, snapshot := range s.snapshots {
+		if snapshot.seq >= watermark {
+			break
 		}
+		ready = i
 	}
+	if ready < 0 {
+		return nil
+	}
+	saved := s.snapshots[:ready+1]
+	s.snapshots = s.snapshots[ready+1:]
+	if s.frontier != nil {
+		// 按顺序合并已确认快照的变化，一次写入即可推进到最新的已确认断点。
+		var changes []models.CrawlFrontierChange
+		for _, snapshot := range saved {
+			changes = append(changes, snapshot.changes...)
+		}
+		return s.frontier.SaveFrontier(s.deps.SyncGeneration, changes)
+	}
+	return s.deps.CheckpointStore.Save(saved[ready].checkpoint)
+}
 
-	if err := deps.CheckpointStore.Clear(); err != nil {
-		return stats, err
+// stampSyncGeneration 标记文档所属的同步根与同步代次，根目录完成后据此清理未再出现的旧文档。
+func stampSyncGeneration(docs []models.IndexDocument, rootFolderID int64, generation int64) {
+	if generation <= 0 {
+		return
 	}
+	for i := range docs {
+		rootID := rootFolderID
+		docs[i].SyncGeneration = generation
+		docs[i].SyncRootID = &rootID
+	}
+}
 
-	stats.EndedAt = time.Now().UnixMilli()
-	return stats, nil
+// resolveFolderPath 补全断点中缺失的目录路径（旧版 checkpoint）。借助 PathResolver 回溯，
+// 回溯失败则退化为仅包含目录自身 ID 的路径，不中断爬取。
+func resolveFolderPath(ctx context.Context, resolver *FolderPathResolver, folderID int64) (models.FolderPath, error) {
+	if resolver != nil {
+		path, err := resolver.Resolve(ctx, folderID)
+		if err == nil {
+			return path, nil
+		}
+		if ctx.Err() != nil {
+			return models.FolderPath{}, ctx.Err()
+		}
+	}
+	return fallbackFolderPath(folderID), nil
+}
+
+func checkpointFolderPaths(paths map[int64]models.FolderPath, ids []int64) map[int64]models.FolderPath {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make(map[int64]models.FolderPath, len(ids))
+	for _, id := range ids {
+		if path, ok := paths[id]; ok {
+			result[id] = path
+		}
+	}
+	return result
 }
@@ -224,6 +224,52 @@ func TestRunFullCrawl_SkippedFilesOnUpsertFailure(t *testing.T) {
   }
 }
 
+// TestRunFullCrawl_ReportsFailedBatch verifies that the documents of a page
+// whose upsert failed are handed to OnWriteFailure together with the error.
+func TestRunFullCrawl_ReportsFailedBatch(t *testing.T) {
+  t.Parallel()
+
+  api := &mockCrawlAPI{
+    pages: map[int64][]models.FolderChildrenPage{
+      1: {
+        {Files: makeFiles(1, 3), PageCount: 2},
+        {Files: makeFiles(4, 2), PageCount: 2},
+      },
+    },
+  }
+  writer := &mockCrawlIndexWriter{
+    failOnCall: 2,
+    failErr:    errors.New("bad request"),
+  }
+
+  var failedDocs []models.IndexDocument
+  var failedErr error
+  stats, err := RunFullCrawl(context.Background(), FullCrawlDeps{
+    API:             api,
+    IndexWriter:     writer,
+    Limiter:         NewRequestLimiter(10, 0),
+    CheckpointStore: &memCheckpointStore{},
+    RootFolderID:    1,
+    Retry:           models.RetryPolicyOptions{},
+    OnWriteFailure: func(docs []models.IndexDocument, err error) {
+      failedDocs = append(failedDocs, docs...)
+      failedErr = err
+    },
+  })
+  if err != nil {
+    t.Fatalf("expected crawl to complete, got: %v", err)
+  }
+  if stats.SkippedFiles != 2 {
+    t.Errorf("SkippedFiles = %d, want 2", stats.SkippedFiles)
+  }
+  if len(failedDocs) != 2 || failedDocs[0].DocID != "file_4" || failedDocs[1].DocID != "file_5" {
+    t.Fatalf("expected second page documents to be reported, got %+v", failedDocs)
+  }
+  if failedErr == nil || failedErr.Error() != "bad request" {
+    t.Errorf("failed batch error = %v, want bad request", failedErr)
+  }
+}
+
 // TestRunFullCrawl_UpsertRetrySuccess verifies that a retriable upsert error
 // (syscall.ECONNRESET) is retried and, upon success, the files are counted as
 // indexed (1 folder, 1 page, 10 files; first attempt fails, retry succeeds).
@@ -268,3 +314,41 @@ func TestRunFullCrawl_UpsertRetrySuccess(t *testing.T) {
     t.Errorf("SkippedFiles = %d, want 0", stats.SkippedFiles)
   }
 }
+
+// TestRunFullCrawl_StampsSyncGeneration verifies that every document and the
+// saved checkpoint carry the sync generation of the crawl.
+func TestRunFullCrawl_StampsSyncGeneration(t *testing.T) {
+  t.Parallel()
+
+  api := &concurrentCrawlAPI{
+    mockCrawlAPI: mockCrawlAPI{pages: map[int64][]models.FolderChildrenPage{
+      1: {{Files: makeFiles(10, 1), PageCount: 2}, {Files: makeFiles(11, 1), PageCount: 2}},
+    }},
+    failOn: map[[2]int64]error{{1, 1}: errors.New("bad request")},
+  }
+  writer := &recordingIndexWriter{}
+  store := &syncCheckpointStore{}
+
+  _, err := RunFullCrawl(context.Background(), FullCrawlDeps{
+    API:             api,
+    IndexWriter:     writer,
+    Limiter:         NewRequestLimiter(10, 0),
+    CheckpointStore: store,
+    RootFolderID:    1,
+    SyncGeneration:  42,
+  })
+  if err == nil {
+    t.Fatal("expected crawl to fail on page 1")
+  }
+
+  for _, docID := range []string{"folder_1", "file_10"} {
+    doc := writer.docs[docID]
+    if doc.SyncGeneration != 42 || doc.SyncRootID == nil || *doc.SyncRootID != 1 {
+      t.Fatalf("%s: expected generation 42 and root 1, got gen=%d root=%v", docID, doc.SyncGeneration, doc.SyncRootID)
+    }
+  }
+  checkpoint, _ := store.Load()
+  if checkpoint == nil || checkpoint.SyncGeneration != 42 {
+    t.Fatalf("expected checkpoint to record generation 42, got %+v", checkpoint)
+  }
+}
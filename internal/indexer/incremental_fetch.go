@@ -3,8 +3,10 @@ package indexer
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"sort"
 	"strings"
+	"time"
 
 	"npan/internal/models"
 	"npan/internal/search"
@@ -14,6 +16,7 @@ type UpdatedWindowFetcher func(ctx context.Context, start *int64, end *int64, pa
 
 // IncrementalFetchProgress 是增量拉取过程中每页完成后的进度快照。
 type IncrementalFetchProgress struct {
+	Window    models.IncrementalWindow
 	PageID    int64
 	PageCount int64
 	Changes   int
@@ -25,26 +28,64 @@ type IncrementalFetchOptions struct {
 	Retry      models.RetryPolicyOptions
 	Fetch      UpdatedWindowFetcher
 	OnProgress func(IncrementalFetchProgress)
+	// Paths 非空时按父目录链解析面包屑路径与祖先目录；为空时沿用接口返回的 path_text。
+	Paths *FolderPathResolver
+
+	// MaxPagesPerWindow / MaxItemsPerWindow 大于 0 时，窗口首页报告的页数或 total_count 超过阈值就二分时间范围，
+	// 不再往下翻页。与阈值无关，翻完全部页后实际拿到的条目少于 total_count 时同样视为被上游截断并二分。
+	MaxPagesPerWindow int64
+	MaxItemsPerWindow int64
 }
 
+// FetchIncrementalChanges 拉取 [Since, Until] 内的全部变更，窗口被截断或过大时自动二分，
+// 各子窗口的结果按时间顺序合并去重。
 func FetchIncrementalChanges(ctx context.Context, opts IncrementalFetchOptions) ([]IncrementalInputItem, error) {
 	if opts.Fetch == nil {
 		return nil, fmt.Errorf("缺少 Fetch 函数")
 	}
 
+	windows := []models.IncrementalWindow{{Start: max(opts.Since, 0), End: max(opts.Until, 0)}}
+	changesByID := map[string]IncrementalInputItem{}
+	for len(windows) > 0 {
+		changes, split, err := FetchIncrementalWindow(ctx, opts, windows[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(split) > 0 {
+			windows = append(split, windows[1:]...)
+			continue
+		}
+		windows = windows[1:]
+		for _, item := range changes {
+			changesByID[item.Doc.DocID] = item
+		}
+	}
+
+	return sortedIncrementalChanges(changesByID), nil
+}
+
+// FetchIncrementalWindow 拉取单个时间窗口的全部变更。窗口被上游截断或超过阈值且还能再分时不返回变更，
+// 而是返回按时间顺序二分后的两个子窗口，由调用方依次拉取；已经不能再分的窗口照常返回拿到的变更。
+func FetchIncrementalWindow(ctx context.Context, opts IncrementalFetchOptions, window models.IncrementalWindow) ([]IncrementalInputItem, []models.IncrementalWindow, error) {
+	if opts.Fetch == nil {
+		return nil, nil, fmt.Errorf("缺少 Fetch 函数")
+	}
+
 	var start *int64
-	if opts.Since > 0 {
-		since := opts.Since
+	if window.Start > 0 {
+		since := window.Start
 		start = &since
 	}
 
 	var end *int64
-	if opts.Until > 0 {
-		until := opts.Until
+	if window.End > 0 {
+		until := window.End
 		end = &until
 	}
 
 	pageID := int64(0)
+	totalCount := int64(0)
+	rowsFetched := int64(0)
 	changesByID := map[string]IncrementalInputItem{}
 
 	for {
@@ -52,7 +93,23 @@ func FetchIncrementalChanges(ctx context.Context, opts IncrementalFetchOptions)
 			return opts.Fetch(ctx, start, end, pageID)
 		}, opts.Retry)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+
+		pageCount := toInt64(page["page_count"], 1)
+		if pageCount <= 0 {
+			pageCount = 1
+		}
+		if pageID == 0 {
+			totalCount = toInt64(page["total_count"], 0)
+			oversized := (opts.MaxPagesPerWindow > 0 && pageCount > opts.MaxPagesPerWindow) ||
+				(opts.MaxItemsPerWindow > 0 && totalCount > opts.MaxItemsPerWindow)
+			if oversized {
+				if split, ok := bisectIncrementalWindow(window); ok {
+					return nil, split, nil
+				}
+				slog.Warn("增量窗口结果过多但已无法再二分，继续拉取", "start", window.Start, "end", window.End, "page_count", pageCount, "total_count", totalCount)
+			}
 		}
 
 		files := asMapList(page["files"])
@@ -107,20 +164,21 @@ func FetchIncrementalChanges(ctx context.Context, opts IncrementalFetchOptions)
 				IsDeleted:  isDeleted,
 			}
 
+			if opts.Paths != nil {
+				opts.Paths.Remember(folder)
+			}
+
 			doc := search.MapFolderToIndexDoc(folder, resolvePathText(row, "folder", id, name))
 			changesByID[doc.DocID] = IncrementalInputItem{
 				Doc:     doc,
 				Deleted: inTrash || isDeleted,
 			}
 		}
-
-		pageCount := toInt64(page["page_count"], 1)
-		if pageCount <= 0 {
-			pageCount = 1
-		}
+		rowsFetched += int64(len(files) + len(folders))
 
 		if opts.OnProgress != nil {
 			opts.OnProgress(IncrementalFetchProgress{
+				Window:    window,
 				PageID:    pageID,
 				PageCount: pageCount,
 				Changes:   len(changesByID),
@@ -133,6 +191,51 @@ func FetchIncrementalChanges(ctx context.Context, opts IncrementalFetchOptions)
 		}
 	}
 
+	if totalCount > 0 && rowsFetched < totalCount {
+		if split, ok := bisectIncrementalWindow(window); ok {
+			return nil, split, nil
+		}
+		slog.Warn("增量窗口被上游截断且已无法再二分，部分变更可能缺失", "start", window.Start, "end", window.End, "fetched", rowsFetched, "total_count", totalCount)
+	}
+
+	changes := sortedIncrementalChanges(changesByID)
+	if opts.Paths != nil {
+		for i := range changes {
+			if changes[i].Deleted {
+				continue
+			}
+			parent, err := opts.Paths.Resolve(ctx, changes[i].Doc.ParentID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, nil, ctx.Err()
+				}
+				parent = fallbackFolderPath(changes[i].Doc.ParentID)
+			}
+			changes[i].Doc.PathText = JoinPath(parent.PathText, changes[i].Doc.Name)
+			changes[i].Doc.AncestorIDs = append([]int64{}, parent.Lineage...)
+		}
+	}
+	return changes, nil, nil
+}
+
+// bisectIncrementalWindow 把窗口从中点分成不重叠的两半。没有上界的窗口先以当前时间为上界；
+// 窗口只剩一秒时无法再分。
+func bisectIncrementalWindow(window models.IncrementalWindow) ([]models.IncrementalWindow, bool) {
+	end := window.End
+	if end <= 0 {
+		end = time.Now().Unix()
+	}
+	if end <= window.Start {
+		return nil, false
+	}
+	mid := window.Start + (end-window.Start)/2
+	return []models.IncrementalWindow{
+		{Start: window.Start, End: mid},
+		{Start: mid + 1, End: window.End},
+	}, true
+}
+
+func sortedIncrementalChanges(changesByID map[string]IncrementalInputItem) []IncrementalInputItem {
 	keys := make([]string, 0, len(changesByID))
 	for key := range changesByID {
 		keys = append(keys, key)
@@ -143,8 +246,7 @@ func FetchIncrementalChanges(ctx context.Context, opts IncrementalFetchOptions)
 	for _, key := range keys {
 		result = append(result, changesByID[key])
 	}
-
-	return result, nil
+	return result
 }
 
 func asMapList(input any) []map[string]any {
@@ -3,6 +3,8 @@ package indexer
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"testing"
 
 	"npan/internal/models"
@@ -185,3 +187,81 @@ func TestFetchIncrementalChanges_OpenEndedWindowOmitsZeroUntil(t *testing.T) {
 		t.Fatalf("expected 1 call, got %d", calls)
 	}
 }
+
+// cappedUpdatedWindow 模拟只返回每个窗口前 capPerWindow 条、但 total_count 报告真实总数的上游搜索。
+func cappedUpdatedWindow(updatedAt map[int64]int64, capPerWindow int) UpdatedWindowFetcher {
+	return func(_ context.Context, start *int64, end *int64, _ int64) (map[string]any, error) {
+		var ids []int64
+		for id, ts := range updatedAt {
+			if (start == nil || ts >= *start) && (end == nil || ts <= *end) {
+				ids = append(ids, id)
+			}
+		}
+		slices.Sort(ids)
+
+		files := []any{}
+		for i, id := range ids {
+			if i >= capPerWindow {
+				break
+			}
+			files = append(files, map[string]any{"id": id, "name": fmt.Sprintf("f%d", id)})
+		}
+		return map[string]any{"page_count": 1, "total_count": len(ids), "files": files}, nil
+	}
+}
+
+func TestFetchIncrementalChanges_BisectsTruncatedWindow(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := map[int64]int64{1: 100, 2: 101, 3: 103, 4: 150, 5: 151, 6: 199, 7: 200}
+	items, err := FetchIncrementalChanges(context.Background(), IncrementalFetchOptions{
+		Since: 100,
+		Until: 200,
+		Retry: models.RetryPolicyOptions{MaxRetries: 1},
+		Fetch: cappedUpdatedWindow(updatedAt, 2),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != len(updatedAt) {
+		t.Fatalf("expected all %d changes after bisecting, got %d", len(updatedAt), len(items))
+	}
+}
+
+func TestFetchIncrementalWindow_SplitsOversizedWindowBeforePaging(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	changes, split, err := FetchIncrementalWindow(context.Background(), IncrementalFetchOptions{
+		Retry:             models.RetryPolicyOptions{MaxRetries: 1},
+		MaxPagesPerWindow: 3,
+		Fetch: func(_ context.Context, _ *int64, _ *int64, pageID int64) (map[string]any, error) {
+			calls++
+			return map[string]any{"page_count": 5, "total_count": 10}, nil
+		},
+	}, models.IncrementalWindow{Start: 100, End: 200})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 1 || changes != nil {
+		t.Fatalf("expected to stop after the first page, calls=%d changes=%v", calls, changes)
+	}
+	want := []models.IncrementalWindow{{Start: 100, End: 150}, {Start: 151, End: 200}}
+	if !slices.Equal(split, want) {
+		t.Fatalf("expected split %v, got %v", want, split)
+	}
+
+	// 只剩一秒的窗口不能再分，照常翻完全部页。
+	calls = 0
+	_, split, err = FetchIncrementalWindow(context.Background(), IncrementalFetchOptions{
+		Retry:             models.RetryPolicyOptions{MaxRetries: 1},
+		MaxPagesPerWindow: 3,
+		Fetch: func(_ context.Context, _ *int64, _ *int64, pageID int64) (map[string]any, error) {
+			calls++
+			return map[string]any{"page_count": 5}, nil
+		},
+	}, models.IncrementalWindow{Start: 100, End: 100})
+	if err != nil || split != nil || calls != 5 {
+		t.Fatalf("expected unsplittable window to page through, calls=%d split=%v err=%v", calls, split, err)
+	}
+}
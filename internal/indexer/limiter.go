@@ -2,10 +2,17 @@ package indexer
 
 import (
 	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
 	"sync"
 	"time"
 
 	"golang.org/x/time/rate"
+
+	"npan/internal/models"
+	"npan/internal/npan"
 )
 
 // ActivityChecker 用于判断搜索是否处于活跃状态。
@@ -13,12 +20,49 @@ type ActivityChecker interface {
 	IsActive() bool
 }
 
+// UpstreamGate 在请求前阻塞到上游可用，例如熔断器打开期间。
+type UpstreamGate interface {
+	Wait(ctx context.Context) error
+}
+
+// OutageAbsorber 由熔断器一类的闸门实现：判断失败的请求是否应等闸门放行后重发，
+// 这类失败不计入调用方的重试次数。absorbed 是本次调度中已经重发的次数。
+type OutageAbsorber interface {
+	AbsorbOutage(err error, absorbed int) bool
+}
+
+// RateControlListener 在限速状态变化时回调；throttled 为 true 表示本次变化由上游限流响应触发。
+type RateControlListener func(state models.RateControlState, throttled bool)
+
+const (
+	// 收到 429/503 时速率乘以 backoffMultiplier，最低降到基础速率的 minRateFactor。
+	backoffMultiplier = 0.5
+	minRateFactor     = 1.0 / 32
+	// 每次成功请求把速率恢复基础速率的 rampUpStep，直到回到基础速率。
+	rampUpStep = 0.02
+	// 并发请求往往同时收到限流响应，冷却期内只降速一次。
+	backoffCooldown = time.Second
+	// 未配置最小间隔（不限速）时，降速以此速率为基准。
+	unlimitedBackoffBase rate.Limit = 20
+)
+
 type RequestLimiter struct {
 	concurrency chan struct{}
 	limiter     *rate.Limiter
 	baseRate    rate.Limit
 	checkerMu   sync.Mutex
 	checker     ActivityChecker
+
+	stateMu      sync.Mutex
+	factor       float64
+	lastBackoff  time.Time
+	pausedUntil  time.Time
+	state        models.RateControlState
+	listener     RateControlListener
+	now          func() time.Time
+	activeFactor float64
+
+	gate UpstreamGate
 }
 
 func NewRequestLimiter(maxConcurrent int, minTimeMS int) *RequestLimiter {
@@ -37,9 +81,12 @@ func NewRequestLimiter(maxConcurrent int, minTimeMS int) *RequestLimiter {
 	}
 
 	return &RequestLimiter{
-		concurrency: make(chan struct{}, maxConcurrent),
-		limiter:     rate.NewLimiter(baseRate, burst),
-		baseRate:    baseRate,
+		concurrency:  make(chan struct{}, maxConcurrent),
+		limiter:      rate.NewLimiter(baseRate, burst),
+		baseRate:     baseRate,
+		factor:       1,
+		now:          time.Now,
+		activeFactor: 1,
 	}
 }
 
@@ -49,17 +96,165 @@ func (l *RequestLimiter) SetActivityChecker(checker ActivityChecker) {
 	l.checker = checker
 }
 
+// SetListener 注册限速状态回调，用于把状态同步到指标。回调在调用方 goroutine 中同步执行，应保持轻量。
+func (l *RequestLimiter) SetListener(listener RateControlListener) {
+	l.stateMu.Lock()
+	defer l.stateMu.Unlock()
+	l.listener = listener
+}
+
+// SetGate 注册上游闸门。请求前先等待闸门放行；请求因熔断被拒、或失败由闸门接管（见 OutageAbsorber）时
+// 重新等待，而不是把错误交给重试逻辑。
+func (l *RequestLimiter) SetGate(gate UpstreamGate) {
+	l.stateMu.Lock()
+	defer l.stateMu.Unlock()
+	l.gate = gate
+}
+
+// effectiveLimitLocked 返回叠加自适应系数与搜索活跃降速后的速率。
+func (l *RequestLimiter) effectiveLimitLocked() rate.Limit {
+	base := l.baseRate
+	if l.factor >= 1 {
+		if base == rate.Inf {
+			return rate.Inf
+		}
+		return base * rate.Limit(l.activeFactor)
+	}
+	if base == rate.Inf {
+		base = unlimitedBackoffBase
+	}
+	return base * rate.Limit(l.factor*l.activeFactor)
+}
+
 func (l *RequestLimiter) adjustRate() {
 	l.checkerMu.Lock()
 	checker := l.checker
 	l.checkerMu.Unlock()
-	if checker == nil {
+
+	l.stateMu.Lock()
+	defer l.stateMu.Unlock()
+	if checker != nil && checker.IsActive() {
+		l.activeFactor = 0.5
+	} else {
+		l.activeFactor = 1
+	}
+	l.limiter.SetLimit(l.effectiveLimitLocked())
+}
+
+// Snapshot 返回当前限速状态。
+func (l *RequestLimiter) Snapshot() models.RateControlState {
+	l.stateMu.Lock()
+	defer l.stateMu.Unlock()
+	return l.snapshotLocked()
+}
+
+func (l *RequestLimiter) snapshotLocked() models.RateControlState {
+	state := l.state
+	state.BaseRate = limitPerSecond(l.baseRate)
+	state.EffectiveRate = limitPerSecond(l.effectiveLimitLocked())
+	state.Backoff = l.factor < 1
+	state.PausedUntil = 0
+	if l.pausedUntil.After(l.now()) {
+		state.PausedUntil = l.pausedUntil.UnixMilli()
+	}
+	return state
+}
+
+func limitPerSecond(limit rate.Limit) float64 {
+	if limit == rate.Inf {
+		return 0
+	}
+	return math.Round(float64(limit)*1000) / 1000
+}
+
+// waitPause 在上游要求的 Retry-After 窗口内阻塞。
+func (l *RequestLimiter) waitPause(ctx context.Context) error {
+	for {
+		l.stateMu.Lock()
+		wait := l.pausedUntil.Sub(l.now())
+		l.stateMu.Unlock()
+		if wait <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// throttleStatus 判断错误是否为上游限流响应（429 或 503）。
+func throttleStatus(err error) (int, time.Duration, bool) {
+	var statusErr *npan.StatusError
+	if !errors.As(err, &statusErr) {
+		return 0, 0, false
+	}
+	if statusErr.Status != http.StatusTooManyRequests && statusErr.Status != http.StatusServiceUnavailable {
+		return 0, 0, false
+	}
+	return statusErr.Status, statusErr.RetryAfter, true
+}
+
+// observe 根据请求结果调整速率：限流响应乘性降速并遵守 Retry-After，成功请求加性恢复。
+func (l *RequestLimiter) observe(err error) {
+	if status, retryAfter, ok := throttleStatus(err); ok {
+		l.onThrottle(status, retryAfter)
 		return
 	}
-	if checker.IsActive() {
-		l.limiter.SetLimit(l.baseRate / 2)
-	} else {
-		l.limiter.SetLimit(l.baseRate)
+	if err == nil {
+		l.onSuccess()
+	}
+}
+
+func (l *RequestLimiter) onThrottle(status int, retryAfter time.Duration) {
+	l.stateMu.Lock()
+	now := l.now()
+	l.state.ThrottleEvents++
+	l.state.LastThrottleAt = now.UnixMilli()
+	l.state.LastThrottleStatus = status
+
+	backedOff := false
+	if l.lastBackoff.IsZero() || now.Sub(l.lastBackoff) >= backoffCooldown {
+		l.factor = math.Max(l.factor*backoffMultiplier, minRateFactor)
+		l.lastBackoff = now
+		backedOff = true
+	}
+	if retryAfter > 0 {
+		if until := now.Add(retryAfter); until.After(l.pausedUntil) {
+			l.pausedUntil = until
+		}
+	}
+	l.limiter.SetLimit(l.effectiveLimitLocked())
+	state := l.snapshotLocked()
+	listener := l.listener
+	l.stateMu.Unlock()
+
+	if backedOff {
+		slog.Warn("上游限流，降低同步请求速率", "status", status, "rate", state.EffectiveRate, "retry_after", retryAfter)
+	}
+	if listener != nil {
+		listener(state, true)
+	}
+}
+
+func (l *RequestLimiter) onSuccess() {
+	l.stateMu.Lock()
+	if l.factor >= 1 || l.pausedUntil.After(l.now()) {
+		l.stateMu.Unlock()
+		return
+	}
+	l.factor = math.Min(l.factor+rampUpStep, 1)
+	l.limiter.SetLimit(l.effectiveLimitLocked())
+	state := l.snapshotLocked()
+	listener := l.listener
+	l.stateMu.Unlock()
+
+	if listener != nil {
+		listener(state, false)
 	}
 }
 
@@ -71,11 +266,38 @@ func (l *RequestLimiter) Schedule(ctx context.Context, fn func() error) error {
 	}
 	defer func() { <-l.concurrency }()
 
-	l.adjustRate()
+	l.stateMu.Lock()
+	gate := l.gate
+	l.stateMu.Unlock()
+
+	absorber, _ := gate.(OutageAbsorber)
+	absorbed := 0
+	for {
+		if gate != nil {
+			if err := gate.Wait(ctx); err != nil {
+				return err
+			}
+		}
+
+		if err := l.waitPause(ctx); err != nil {
+			return err
+		}
 
-	if err := l.limiter.Wait(ctx); err != nil {
+		l.adjustRate()
+
+		if err := l.limiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		err := fn()
+		if gate != nil && errors.Is(err, npan.ErrCircuitOpen) {
+			continue
+		}
+		if err != nil && absorber != nil && absorber.AbsorbOutage(err, absorbed) {
+			absorbed++
+			continue
+		}
+		l.observe(err)
 		return err
 	}
-
-	return fn()
 }
@@ -0,0 +1,199 @@
+package indexer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"npan/internal/models"
+	"npan/internal/npan"
+)
+
+func TestRequestLimiter_BacksOffOnThrottleAndRampsUp(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRequestLimiter(1, 100)
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+
+	var events []models.RateControlState
+	var throttled int
+	limiter.SetListener(func(state models.RateControlState, isThrottle bool) {
+		events = append(events, state)
+		if isThrottle {
+			throttled++
+		}
+	})
+
+	throttle := &npan.StatusError{Status: http.StatusTooManyRequests, Message: "HTTP 429"}
+	limiter.observe(throttle)
+	// 冷却期内的并发限流响应不会再次降速。
+	limiter.observe(&npan.StatusError{Status: http.StatusServiceUnavailable, Message: "HTTP 503"})
+
+	state := limiter.Snapshot()
+	if state.BaseRate != 10 || state.EffectiveRate != 5 || !state.Backoff {
+		t.Fatalf("expected one halving to 5 req/s, got %+v", state)
+	}
+	if state.ThrottleEvents != 2 || state.LastThrottleStatus != http.StatusServiceUnavailable || state.LastThrottleAt != now.UnixMilli() {
+		t.Fatalf("unexpected throttle bookkeeping: %+v", state)
+	}
+
+	now = now.Add(backoffCooldown)
+	limiter.observe(throttle)
+	if got := limiter.Snapshot().EffectiveRate; got != 2.5 {
+		t.Fatalf("expected second halving after cooldown, got %v", got)
+	}
+
+	for range 100 {
+		limiter.observe(nil)
+	}
+	state = limiter.Snapshot()
+	if state.EffectiveRate != 10 || state.Backoff {
+		t.Fatalf("expected successes to restore the base rate, got %+v", state)
+	}
+	if throttled != 3 || len(events) <= 3 || events[len(events)-1].Backoff {
+		t.Fatalf("expected listener to see throttles and the recovery, got %d throttles / %d events", throttled, len(events))
+	}
+
+	recorded := len(events)
+	limiter.observe(nil)
+	if len(events) != recorded {
+		t.Fatalf("expected no listener calls once back at the base rate")
+	}
+}
+
+func TestRequestLimiter_BackoffFloorAndUnlimitedBase(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRequestLimiter(1, 0)
+	if state := limiter.Snapshot(); state.BaseRate != 0 || state.EffectiveRate != 0 || state.Backoff {
+		t.Fatalf("expected unlimited limiter to report zero rates, got %+v", state)
+	}
+
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+	for range 10 {
+		limiter.observe(&npan.StatusError{Status: http.StatusTooManyRequests})
+		now = now.Add(backoffCooldown)
+	}
+	state := limiter.Snapshot()
+	if want := float64(unlimitedBackoffBase) * minRateFactor; state.EffectiveRate != want || !state.Backoff {
+		t.Fatalf("expected backoff to stop at the floor %v, got %+v", want, state)
+	}
+
+	limiter.observe(&npan.StatusError{Status: http.StatusBadRequest})
+	if got := limiter.Snapshot().ThrottleEvents; got != 10 {
+		t.Fatalf("expected non-throttle errors to be ignored, got %d events", got)
+	}
+}
+
+func TestRequestLimiter_HonorsRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRequestLimiter(1, 0)
+	err := limiter.Schedule(context.Background(), func() error {
+		return &npan.StatusError{Status: http.StatusTooManyRequests, RetryAfter: 150 * time.Millisecond}
+	})
+	if err == nil {
+		t.Fatal("expected throttle error to be returned")
+	}
+	if state := limiter.Snapshot(); state.PausedUntil == 0 {
+		t.Fatalf("expected limiter to report the pause, got %+v", state)
+	}
+
+	start := time.Now()
+	if err := limiter.Schedule(context.Background(), func() error { return nil }); err != nil {
+		t.Fatalf("schedule after pause failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 120*time.Millisecond {
+		t.Fatalf("expected next request to wait for Retry-After, waited %v", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	limiter.observe(&npan.StatusError{Status: http.StatusServiceUnavailable, RetryAfter: time.Minute})
+	if err := limiter.Schedule(ctx, func() error { return nil }); err == nil {
+		t.Fatal("expected cancelled context to abort the pause")
+	}
+}
+
+type countingGate struct {
+	waits int
+}
+
+func (g *countingGate) Wait(ctx context.Context) error {
+	g.waits++
+	return ctx.Err()
+}
+
+func TestRequestLimiter_WaitsAtGateWhenCircuitOpen(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRequestLimiter(1, 0)
+	gate := &countingGate{}
+	limiter.SetGate(gate)
+
+	calls := 0
+	err := limiter.Schedule(context.Background(), func() error {
+		calls++
+		if calls == 1 {
+			return npan.ErrCircuitOpen
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected request to succeed after the circuit recovered, got %v", err)
+	}
+	if calls != 2 || gate.waits != 2 {
+		t.Fatalf("expected the rejected call to wait at the gate again, calls=%d waits=%d", calls, gate.waits)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := limiter.Schedule(ctx, func() error { return nil }); err == nil {
+		t.Fatal("expected cancelled context to abort the gate wait")
+	}
+}
+
+func TestRequestLimiter_AbsorbedFailuresWaitAtGate(t *testing.T) {
+	t.Parallel()
+
+	breaker := npan.NewCircuitBreaker(npan.CircuitBreakerOptions{FailureThreshold: 2, OpenTimeout: time.Millisecond})
+	limiter := NewRequestLimiter(1, 0)
+	limiter.SetGate(Gates{breaker})
+
+	api := breaker.Wrap(&failingFolderAPI{failures: 6})
+	if err := limiter.Schedule(context.Background(), func() error {
+		_, err := api.GetFolderInfo(context.Background(), 1)
+		return err
+	}); err != nil {
+		t.Fatalf("expected the outage to be waited out inside one schedule, got %v", err)
+	}
+
+	// 熔断器始终关闭时（失败之间穿插成功），同一请求重发阈值次后把错误交回调用方。
+	calls := 0
+	err := limiter.Schedule(context.Background(), func() error {
+		calls++
+		if _, err := api.GetFolderInfo(context.Background(), 1); err != nil {
+			return err
+		}
+		return &npan.StatusError{Status: http.StatusInternalServerError, Message: "HTTP 500"}
+	})
+	if err == nil || calls != 3 {
+		t.Fatalf("expected a request-specific failure to return after the threshold, calls=%d err=%v", calls, err)
+	}
+}
+
+type failingFolderAPI struct {
+	npan.API
+	failures int
+}
+
+func (a *failingFolderAPI) GetFolderInfo(context.Context, int64) (models.NpanFolder, error) {
+	if a.failures > 0 {
+		a.failures--
+		return models.NpanFolder{}, &npan.StatusError{Status: http.StatusBadGateway, Message: "HTTP 502"}
+	}
+	return models.NpanFolder{}, nil
+}
@@ -0,0 +1,177 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"npan/internal/models"
+	"npan/internal/search"
+)
+
+// maxFolderPathDepth 防止父目录链出现环时无限回溯。
+const maxFolderPathDepth = 256
+
+// JoinPath 拼接面包屑路径，父路径为空时直接返回名称。
+func JoinPath(parent string, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "/" + name
+}
+
+// RootFolderPath 返回同步根目录自身的路径；根 0 是虚拟的“全部文件”，不计入路径。
+func RootFolderPath(rootID int64, rootName string) models.FolderPath {
+	if rootID <= 0 {
+		return models.FolderPath{}
+	}
+	return models.FolderPath{PathText: rootName, Lineage: []int64{rootID}}
+}
+
+// ChildFolderPath 基于父目录路径计算子目录路径。
+func ChildFolderPath(parent models.FolderPath, folder models.NpanFolder) models.FolderPath {
+	lineage := make([]int64, 0, len(parent.Lineage)+1)
+	lineage = append(lineage, parent.Lineage...)
+	lineage = append(lineage, folder.ID)
+	return models.FolderPath{
+		PathText: JoinPath(parent.PathText, folder.Name),
+		Lineage:  lineage,
+	}
+}
+
+// FolderDoc 构建位于 parent 下的目录文档。
+func FolderDoc(parent models.FolderPath, folder models.NpanFolder) models.IndexDocument {
+	doc := search.MapFolderToIndexDoc(folder, JoinPath(parent.PathText, folder.Name))
+	doc.AncestorIDs = append([]int64{}, parent.Lineage...)
+	return doc
+}
+
+// FileDoc 构建位于 parent 下的文件文档。
+func FileDoc(parent models.FolderPath, file models.NpanFile) models.IndexDocument {
+	doc := search.MapFileToIndexDoc(file, JoinPath(parent.PathText, file.Name))
+	doc.AncestorIDs = append([]int64{}, parent.Lineage...)
+	return doc
+}
+
+// FolderInfoFetcher 按 ID 拉取目录详情，用于向上回溯父目录链。
+type FolderInfoFetcher func(ctx context.Context, folderID int64) (models.NpanFolder, error)
+
+// FolderPathResolver 通过回溯父目录链解析目录路径，并缓存已解析结果。
+// 同步根目录作为回溯终点，保证与全量爬取得到的路径一致。
+type FolderPathResolver struct {
+	fetch FolderInfoFetcher
+
+	mu      sync.Mutex
+	paths   map[int64]models.FolderPath
+	folders map[int64]models.NpanFolder
+}
+
+func NewFolderPathResolver(fetch FolderInfoFetcher, rootNames map[int64]string) *FolderPathResolver {
+	r := &FolderPathResolver{
+		fetch:   fetch,
+		paths:   map[int64]models.FolderPath{},
+		folders: map[int64]models.NpanFolder{},
+	}
+	for rootID, name := range rootNames {
+		if rootID > 0 {
+			r.paths[rootID] = RootFolderPath(rootID, name)
+		}
+	}
+	return r
+}
+
+// Seed 记录一个已知的目录路径。
+func (r *FolderPathResolver) Seed(folderID int64, path models.FolderPath) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.paths[folderID] = path
+}
+
+// Remember 记录目录的名称与父目录，回溯时优先使用，避免额外请求。
+// 目录可能已被移动或重命名，因此会丢弃该目录及其子孙之前缓存的路径（根目录除外）；
+// 子孙的目录信息仍保留，重新解析时不需要额外请求。
+func (r *FolderPathResolver) Remember(folder models.NpanFolder) {
+	if folder.ID <= 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.folders[folder.ID] = folder
+	if path, ok := r.paths[folder.ID]; ok && isRootPath(folder.ID, path) {
+		return
+	}
+	for id, path := range r.paths {
+		if !isRootPath(id, path) && slices.Contains(path.Lineage, folder.ID) {
+			delete(r.paths, id)
+		}
+	}
+}
+
+func isRootPath(folderID int64, path models.FolderPath) bool {
+	return len(path.Lineage) == 1 && path.Lineage[0] == folderID
+}
+
+// Resolve 返回目录的路径与目录 ID 链（含自身）。
+func (r *FolderPathResolver) Resolve(ctx context.Context, folderID int64) (models.FolderPath, error) {
+	var chain []models.NpanFolder
+	base := models.FolderPath{}
+	current := folderID
+
+	for depth := 0; ; depth++ {
+		if current <= 0 {
+			break
+		}
+		r.mu.Lock()
+		known, ok := r.paths[current]
+		folder, remembered := r.folders[current]
+		r.mu.Unlock()
+		if ok {
+			base = known
+			break
+		}
+		if depth >= maxFolderPathDepth {
+			return models.FolderPath{}, fmt.Errorf("目录 %d 的父目录链过深", folderID)
+		}
+
+		if !remembered {
+			if r.fetch == nil {
+				return models.FolderPath{}, fmt.Errorf("无法解析目录 %d 的路径", current)
+			}
+			fetched, err := r.fetch(ctx, current)
+			if err != nil {
+				return models.FolderPath{}, fmt.Errorf("获取目录 %d 详情失败: %w", current, err)
+			}
+			if fetched.ID <= 0 {
+				fetched.ID = current
+			}
+			folder = fetched
+			r.mu.Lock()
+			r.folders[current] = folder
+			r.mu.Unlock()
+		}
+
+		chain = append(chain, folder)
+		if folder.ParentID == folder.ID {
+			break
+		}
+		current = folder.ParentID
+	}
+
+	path := base
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := len(chain) - 1; i >= 0; i-- {
+		path = ChildFolderPath(path, chain[i])
+		r.paths[chain[i].ID] = path
+	}
+	return path, nil
+}
+
+// fallbackFolderPath 在无法解析目录路径时退化为仅包含该目录 ID 的路径。
+func fallbackFolderPath(folderID int64) models.FolderPath {
+	if folderID <= 0 {
+		return models.FolderPath{}
+	}
+	return models.FolderPath{Lineage: []int64{folderID}}
+}
@@ -0,0 +1,243 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"npan/internal/models"
+)
+
+type recordingIndexWriter struct {
+	docs map[string]models.IndexDocument
+}
+
+func (w *recordingIndexWriter) UpsertDocuments(_ context.Context, docs []models.IndexDocument) error {
+	if w.docs == nil {
+		w.docs = map[string]models.IndexDocument{}
+	}
+	for _, doc := range docs {
+		w.docs[doc.DocID] = doc
+	}
+	return nil
+}
+
+func assertDocPath(t *testing.T, docs map[string]models.IndexDocument, docID string, wantPath string, wantAncestors []int64) {
+	t.Helper()
+	doc, ok := docs[docID]
+	if !ok {
+		t.Fatalf("missing doc %s", docID)
+	}
+	if doc.PathText != wantPath {
+		t.Errorf("%s path_text = %q, want %q", docID, doc.PathText, wantPath)
+	}
+	if len(doc.AncestorIDs) != 0 || len(wantAncestors) != 0 {
+		if !reflect.DeepEqual(doc.AncestorIDs, wantAncestors) {
+			t.Errorf("%s ancestor_ids = %v, want %v", docID, doc.AncestorIDs, wantAncestors)
+		}
+	}
+}
+
+func TestRunFullCrawl_BuildsBreadcrumbPaths(t *testing.T) {
+	t.Parallel()
+
+	api := &mockCrawlAPI{
+		pages: map[int64][]models.FolderChildrenPage{
+			1: {{Folders: []models.NpanFolder{{ID: 2, Name: "设计", ParentID: 1}}, Files: []models.NpanFile{{ID: 10, Name: "readme.txt", ParentID: 1}}, PageCount: 1}},
+			2: {{Files: []models.NpanFile{{ID: 11, Name: "a.pdf", ParentID: 2}}, PageCount: 1}},
+		},
+	}
+	writer := &recordingIndexWriter{}
+
+	_, err := RunFullCrawl(context.Background(), FullCrawlDeps{
+		API:             api,
+		IndexWriter:     writer,
+		Limiter:         NewRequestLimiter(10, 0),
+		CheckpointStore: &memCheckpointStore{},
+		RootFolderID:    1,
+		RootName:        "资料",
+	})
+	if err != nil {
+		t.Fatalf("RunFullCrawl returned error: %v", err)
+	}
+
+	assertDocPath(t, writer.docs, "folder_1", "资料", nil)
+	assertDocPath(t, writer.docs, "folder_2", "资料/设计", []int64{1})
+	assertDocPath(t, writer.docs, "file_10", "资料/readme.txt", []int64{1})
+	assertDocPath(t, writer.docs, "file_11", "资料/设计/a.pdf", []int64{1, 2})
+}
+
+func TestRunFullCrawl_RootZeroIsNotAPathSegment(t *testing.T) {
+	t.Parallel()
+
+	api := &mockCrawlAPI{
+		pages: map[int64][]models.FolderChildrenPage{
+			0: {{Folders: []models.NpanFolder{{ID: 2, Name: "部门", ParentID: 0}}, PageCount: 1}},
+			2: {{Files: []models.NpanFile{{ID: 11, Name: "a.pdf", ParentID: 2}}, PageCount: 1}},
+		},
+	}
+	writer := &recordingIndexWriter{}
+
+	if _, err := RunFullCrawl(context.Background(), FullCrawlDeps{
+		API:             api,
+		IndexWriter:     writer,
+		Limiter:         NewRequestLimiter(10, 0),
+		CheckpointStore: &memCheckpointStore{},
+		RootFolderID:    0,
+	}); err != nil {
+		t.Fatalf("RunFullCrawl returned error: %v", err)
+	}
+
+	assertDocPath(t, writer.docs, "folder_0", "全部文件", nil)
+	assertDocPath(t, writer.docs, "folder_2", "部门", nil)
+	assertDocPath(t, writer.docs, "file_11", "部门/a.pdf", []int64{2})
+}
+
+func TestRunFullCrawl_ResumesFolderPathsFromCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	api := &mockCrawlAPI{
+		pages: map[int64][]models.FolderChildrenPage{
+			2: {{Files: []models.NpanFile{{ID: 11, Name: "a.pdf", ParentID: 2}}, PageCount: 1}},
+		},
+	}
+	writer := &recordingIndexWriter{}
+	store := &memCheckpointStore{data: &models.CrawlCheckpoint{
+		Queue: []int64{2},
+		FolderPaths: map[int64]models.FolderPath{
+			2: {PathText: "资料/设计", Lineage: []int64{1, 2}},
+		},
+	}}
+
+	if _, err := RunFullCrawl(context.Background(), FullCrawlDeps{
+		API:             api,
+		IndexWriter:     writer,
+		Limiter:         NewRequestLimiter(10, 0),
+		CheckpointStore: store,
+		RootFolderID:    1,
+		RootName:        "资料",
+	}); err != nil {
+		t.Fatalf("RunFullCrawl returned error: %v", err)
+	}
+
+	assertDocPath(t, writer.docs, "file_11", "资料/设计/a.pdf", []int64{1, 2})
+}
+
+func TestRunFullCrawl_LegacyCheckpointResolvesMissingPaths(t *testing.T) {
+	t.Parallel()
+
+	api := &mockCrawlAPI{
+		pages: map[int64][]models.FolderChildrenPage{
+			3: {{Files: []models.NpanFile{{ID: 11, Name: "a.pdf", ParentID: 3}}, PageCount: 1}},
+		},
+	}
+	writer := &recordingIndexWriter{}
+	resolver := NewFolderPathResolver(func(_ context.Context, folderID int64) (models.NpanFolder, error) {
+		switch folderID {
+		case 3:
+			return models.NpanFolder{ID: 3, Name: "图纸", ParentID: 2}, nil
+		case 2:
+			return models.NpanFolder{ID: 2, Name: "设计", ParentID: 1}, nil
+		}
+		return models.NpanFolder{}, errors.New("unexpected folder")
+	}, map[int64]string{1: "资料"})
+
+	if _, err := RunFullCrawl(context.Background(), FullCrawlDeps{
+		API:             api,
+		IndexWriter:     writer,
+		Limiter:         NewRequestLimiter(10, 0),
+		CheckpointStore: &memCheckpointStore{data: &models.CrawlCheckpoint{Queue: []int64{3}}},
+		RootFolderID:    1,
+		RootName:        "资料",
+		PathResolver:    resolver,
+	}); err != nil {
+		t.Fatalf("RunFullCrawl returned error: %v", err)
+	}
+
+	assertDocPath(t, writer.docs, "file_11", "资料/设计/图纸/a.pdf", []int64{1, 2, 3})
+}
+
+func TestFetchIncrementalChanges_ResolvesPathsThroughParents(t *testing.T) {
+	t.Parallel()
+
+	fetched := map[int64]int{}
+	resolver := NewFolderPathResolver(func(_ context.Context, folderID int64) (models.NpanFolder, error) {
+		fetched[folderID]++
+		if folderID == 9 {
+			return models.NpanFolder{ID: 9, Name: "外部", ParentID: 0}, nil
+		}
+		return models.NpanFolder{}, errors.New("boom")
+	}, map[int64]string{1: "资料"})
+
+	items, err := FetchIncrementalChanges(context.Background(), IncrementalFetchOptions{
+		Fetch: func(_ context.Context, _ *int64, _ *int64, _ int64) (map[string]any, error) {
+			return map[string]any{
+				"page_count": 1,
+				"files": []any{
+					map[string]any{"id": 20, "name": "a.pdf", "parent": map[string]any{"id": 5}},
+					map[string]any{"id": 21, "name": "b.pdf", "parent": map[string]any{"id": 9}},
+					map[string]any{"id": 22, "name": "c.pdf", "parent": map[string]any{"id": 9}},
+					map[string]any{"id": 23, "name": "lost.pdf", "parent": map[string]any{"id": 404}},
+				},
+				"folders": []any{
+					map[string]any{"id": 5, "name": "新建", "parent": map[string]any{"id": 1}},
+				},
+			}, nil
+		},
+		Paths: resolver,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	docs := map[string]models.IndexDocument{}
+	for _, item := range items {
+		docs[item.Doc.DocID] = item.Doc
+	}
+	assertDocPath(t, docs, "folder_5", "资料/新建", []int64{1})
+	assertDocPath(t, docs, "file_20", "资料/新建/a.pdf", []int64{1, 5})
+	assertDocPath(t, docs, "file_21", "外部/b.pdf", []int64{9})
+	assertDocPath(t, docs, "file_22", "外部/c.pdf", []int64{9})
+	assertDocPath(t, docs, "file_23", "lost.pdf", []int64{404})
+
+	if fetched[5] != 0 || fetched[9] != 1 {
+		t.Fatalf("expected remembered folder to skip fetch and cache lookups, got %v", fetched)
+	}
+}
+
+func TestFolderPathResolver_DetectsParentCycle(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewFolderPathResolver(nil, nil)
+	resolver.Remember(models.NpanFolder{ID: 7, Name: "a", ParentID: 8})
+	resolver.Remember(models.NpanFolder{ID: 8, Name: "b", ParentID: 7})
+
+	if _, err := resolver.Resolve(context.Background(), 7); err == nil {
+		t.Fatal("expected cycle to be rejected")
+	}
+}
+
+func TestFolderPathResolver_RememberEvictsDescendantPaths(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewFolderPathResolver(nil, map[int64]string{100: "Root"})
+	resolver.Remember(models.NpanFolder{ID: 10, Name: "old", ParentID: 100})
+	resolver.Remember(models.NpanFolder{ID: 11, Name: "sub", ParentID: 10})
+	if path, err := resolver.Resolve(context.Background(), 11); err != nil || path.PathText != "Root/old/sub" {
+		t.Fatalf("unexpected initial path %+v %v", path, err)
+	}
+
+	// 同一轮增量中父目录被重命名，之后解析的子目录要使用新名称。
+	resolver.Remember(models.NpanFolder{ID: 10, Name: "new", ParentID: 100})
+	path, err := resolver.Resolve(context.Background(), 11)
+	if err != nil {
+		t.Fatalf("resolve after rename failed: %v", err)
+	}
+	if path.PathText != "Root/new/sub" || !reflect.DeepEqual(path.Lineage, []int64{100, 10, 11}) {
+		t.Fatalf("expected descendant path to follow the rename, got %+v", path)
+	}
+	if root, err := resolver.Resolve(context.Background(), 100); err != nil || root.PathText != "Root" {
+		t.Fatalf("expected root path to stay cached, got %+v %v", root, err)
+	}
+}
@@ -0,0 +1,95 @@
+package indexer
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// PauseGate 是可人工暂停的请求闸门：暂停期间 Wait 阻塞，恢复后全部放行。
+// 它挂在限速器上，暂停时已发出的请求照常完成，之后的请求停在限速器前。
+type PauseGate struct {
+	mu       sync.Mutex
+	resumed  chan struct{}
+	pausedAt time.Time
+}
+
+func NewPauseGate() *PauseGate {
+	return &PauseGate{}
+}
+
+// Pause 关闭闸门，已处于暂停状态时返回 false。
+func (g *PauseGate) Pause() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.resumed != nil {
+		return false
+	}
+	g.resumed = make(chan struct{})
+	g.pausedAt = time.Now()
+	return true
+}
+
+// Resume 打开闸门并唤醒所有等待者，未暂停时返回 false。
+func (g *PauseGate) Resume() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.resumed == nil {
+		return false
+	}
+	close(g.resumed)
+	g.resumed = nil
+	g.pausedAt = time.Time{}
+	return true
+}
+
+// PausedAt 返回暂停开始时间，未暂停时返回 false。
+func (g *PauseGate) PausedAt() (time.Time, bool) {
+	if g == nil {
+		return time.Time{}, false
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.pausedAt, g.resumed != nil
+}
+
+func (g *PauseGate) Wait(ctx context.Context) error {
+	if g == nil {
+		return nil
+	}
+	for {
+		g.mu.Lock()
+		resumed := g.resumed
+		g.mu.Unlock()
+		if resumed == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-resumed:
+		}
+	}
+}
+
+// Gates 依次等待多个闸门，全部放行后才发请求。
+type Gates []UpstreamGate
+
+func (g Gates) Wait(ctx context.Context) error {
+	for _, gate := range g {
+		if err := gate.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AbsorbOutage 只要有一个闸门接管失败，请求就等闸门放行后重发。
+func (g Gates) AbsorbOutage(err error, absorbed int) bool {
+	for _, gate := range g {
+		if absorber, ok := gate.(OutageAbsorber); ok && absorber.AbsorbOutage(err, absorbed) {
+			return true
+		}
+	}
+	return false
+}
@@ -92,6 +92,15 @@ func isRetriableNetworkError(err error) bool {
 	return false
 }
 
+// retryAfterHint 返回上游 Retry-After 要求的最短等待，退避延迟不应短于它。
+func retryAfterHint(err error) time.Duration {
+	var statusErr *npan.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.RetryAfter
+	}
+	return 0
+}
+
 func computeDelay(attempt int, opts models.RetryPolicyOptions) time.Duration {
 	if attempt <= 0 {
 		attempt = 1
@@ -142,6 +151,9 @@ func WithRetry[T any](ctx context.Context, operation func() (T, error), opts mod
 		}
 
 		delay := computeDelay(attempt, opts)
+		if retryAfter := retryAfterHint(err); retryAfter > delay {
+			delay = retryAfter
+		}
 		timer := time.NewTimer(delay)
 		select {
 		case <-ctx.Done():
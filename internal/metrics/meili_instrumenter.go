@@ -55,6 +55,26 @@ func (i *InstrumentedMeiliIndex) DeleteAllDocuments(ctx context.Context) error {
 	return err
 }
 
+func (i *InstrumentedMeiliIndex) DeleteStaleDocuments(ctx context.Context, rootFolderID int64, generation int64) (int64, error) {
+	start := time.Now()
+	removed, err := i.inner.DeleteStaleDocuments(ctx, rootFolderID, generation)
+	i.metrics.MeiliDurationSeconds.WithLabelValues("delete_stale").Observe(time.Since(start).Seconds())
+	if err != nil {
+		i.metrics.MeiliErrorsTotal.WithLabelValues("delete_stale").Inc()
+	}
+	return removed, err
+}
+
+func (i *InstrumentedMeiliIndex) ListStaleDocumentIDs(ctx context.Context, rootFolderID int64, generation int64) ([]string, error) {
+	start := time.Now()
+	docIDs, err := i.inner.ListStaleDocumentIDs(ctx, rootFolderID, generation)
+	i.metrics.MeiliDurationSeconds.WithLabelValues("list_stale").Observe(time.Since(start).Seconds())
+	if err != nil {
+		i.metrics.MeiliErrorsTotal.WithLabelValues("list_stale").Inc()
+	}
+	return docIDs, err
+}
+
 func (i *InstrumentedMeiliIndex) Search(params models.LocalSearchParams) ([]models.IndexDocument, int64, error) {
 	start := time.Now()
 	docs, total, err := i.inner.Search(params)
@@ -69,6 +89,36 @@ func (i *InstrumentedMeiliIndex) Ping() error {
 	return i.inner.Ping()
 }
 
+func (i *InstrumentedMeiliIndex) GetDocuments(ctx context.Context, docIDs []string) ([]models.IndexDocument, error) {
+	start := time.Now()
+	docs, err := i.inner.GetDocuments(ctx, docIDs)
+	i.metrics.MeiliDurationSeconds.WithLabelValues("get_documents").Observe(time.Since(start).Seconds())
+	if err != nil {
+		i.metrics.MeiliErrorsTotal.WithLabelValues("get_documents").Inc()
+	}
+	return docs, err
+}
+
+func (i *InstrumentedMeiliIndex) UpdateDocumentPaths(ctx context.Context, docs []models.IndexDocument) error {
+	start := time.Now()
+	err := i.inner.UpdateDocumentPaths(ctx, docs)
+	i.metrics.MeiliDurationSeconds.WithLabelValues("update_paths").Observe(time.Since(start).Seconds())
+	if err != nil {
+		i.metrics.MeiliErrorsTotal.WithLabelValues("update_paths").Inc()
+	}
+	return err
+}
+
+func (i *InstrumentedMeiliIndex) CountDuplicateSHA1(ctx context.Context, params models.LocalSearchParams, limit int) (models.DuplicateSHA1Summary, error) {
+	start := time.Now()
+	summary, err := i.inner.CountDuplicateSHA1(ctx, params, limit)
+	i.metrics.MeiliDurationSeconds.WithLabelValues("count_duplicates").Observe(time.Since(start).Seconds())
+	if err != nil {
+		i.metrics.MeiliErrorsTotal.WithLabelValues("count_duplicates").Inc()
+	}
+	return summary, err
+}
+
 func (i *InstrumentedMeiliIndex) DocumentCount(ctx context.Context) (int64, error) {
 	start := time.Now()
 	count, err := i.inner.DocumentCount(ctx)
@@ -37,10 +37,30 @@ func (m *mockIndexOperator) DeleteAllDocuments(ctx context.Context) error {
 	return m.deleteErr
 }
 
+func (m *mockIndexOperator) DeleteStaleDocuments(ctx context.Context, rootFolderID int64, generation int64) (int64, error) {
+	return 0, m.deleteErr
+}
+
+func (m *mockIndexOperator) ListStaleDocumentIDs(ctx context.Context, rootFolderID int64, generation int64) ([]string, error) {
+	return nil, m.searchErr
+}
+
 func (m *mockIndexOperator) Search(params models.LocalSearchParams) ([]models.IndexDocument, int64, error) {
 	return m.searchDocs, m.searchTotal, m.searchErr
 }
 
+func (m *mockIndexOperator) GetDocuments(ctx context.Context, docIDs []string) ([]models.IndexDocument, error) {
+	return nil, m.searchErr
+}
+
+func (m *mockIndexOperator) UpdateDocumentPaths(ctx context.Context, docs []models.IndexDocument) error {
+	return m.upsertErr
+}
+
+func (m *mockIndexOperator) CountDuplicateSHA1(ctx context.Context, params models.LocalSearchParams, limit int) (models.DuplicateSHA1Summary, error) {
+	return models.DuplicateSHA1Summary{}, m.searchErr
+}
+
 func (m *mockIndexOperator) DocumentCount(ctx context.Context) (int64, error) {
 	return m.docCount, m.docCountErr
 }
@@ -10,6 +10,10 @@ type SyncMetrics struct {
 	FilesFailedTotal        *prometheus.CounterVec
 	Running                 prometheus.Gauge
 	IncrementalChangesTotal *prometheus.CounterVec
+
+	UpstreamRateLimit      prometheus.Gauge
+	UpstreamBackoff        prometheus.Gauge
+	UpstreamThrottledTotal *prometheus.CounterVec
 }
 
 // NewSyncMetrics creates and registers sync metrics with the given registerer.
@@ -34,12 +38,24 @@ func NewSyncMetrics(reg prometheus.Registerer) *SyncMetrics {
 		}, []string{"mode"}),
 		Running: prometheus.NewGauge(prometheus.GaugeOpts{
 			Name: "npan_sync_running",
-			Help: "1 if a sync task is currently running, 0 otherwise.",
+			Help: "Number of tenants with a sync task currently running (0 or 1 without tenants).",
 		}),
 		IncrementalChangesTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Name: "npan_sync_incremental_changes_total",
 			Help: "Total number of incremental sync change items by operation type.",
 		}, []string{"op"}),
+		UpstreamRateLimit: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "npan_sync_upstream_rate_limit",
+			Help: "Current effective upstream request rate per second during sync (0 means unlimited).",
+		}),
+		UpstreamBackoff: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "npan_sync_upstream_backoff",
+			Help: "1 if the sync request limiter is backing off after upstream throttling, 0 otherwise.",
+		}),
+		UpstreamThrottledTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "npan_sync_upstream_throttled_total",
+			Help: "Total number of throttled upstream responses observed by the sync request limiter.",
+		}, []string{"status"}),
 	}
 	reg.MustRegister(
 		m.TasksTotal,
@@ -48,6 +64,9 @@ func NewSyncMetrics(reg prometheus.Registerer) *SyncMetrics {
 		m.FilesFailedTotal,
 		m.Running,
 		m.IncrementalChangesTotal,
+		m.UpstreamRateLimit,
+		m.UpstreamBackoff,
+		m.UpstreamThrottledTotal,
 	)
 	return m
 }
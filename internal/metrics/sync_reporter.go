@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"strconv"
+	"sync"
 	"time"
 
 	"npan/internal/models"
@@ -19,25 +21,54 @@ type SyncEvent struct {
 type SyncReporter interface {
 	ReportSyncStarted(mode models.SyncMode)
 	ReportSyncFinished(event SyncEvent)
+	// ReportRateControl reports the adaptive request limiter state; throttled is true
+	// when the change was caused by a throttled upstream response.
+	ReportRateControl(state models.RateControlState, throttled bool)
 }
 
 // PrometheusSyncReporter implements SyncReporter using SyncMetrics.
 type PrometheusSyncReporter struct {
-	m *SyncMetrics
+	m       *SyncMetrics
+	tenant  string
+	running *runningSyncs
+}
+
+// runningSyncs tracks which tenants have a sync in flight, shared by all
+// reporters derived from the same root so the Running gauge counts every tenant.
+type runningSyncs struct {
+	mu      sync.Mutex
+	tenants map[string]struct{}
 }
 
 // NewPrometheusSyncReporter creates a new reporter backed by the given SyncMetrics.
 func NewPrometheusSyncReporter(m *SyncMetrics) *PrometheusSyncReporter {
-	return &PrometheusSyncReporter{m: m}
+	return &PrometheusSyncReporter{m: m, running: &runningSyncs{tenants: make(map[string]struct{})}}
+}
+
+// ForTenant returns a reporter for the given tenant's sync manager. Counters are
+// shared with r; the Running gauge reports how many tenants are syncing.
+func (r *PrometheusSyncReporter) ForTenant(tenantID string) *PrometheusSyncReporter {
+	return &PrometheusSyncReporter{m: r.m, tenant: tenantID, running: r.running}
+}
+
+func (r *PrometheusSyncReporter) setRunning(running bool) {
+	r.running.mu.Lock()
+	defer r.running.mu.Unlock()
+	if running {
+		r.running.tenants[r.tenant] = struct{}{}
+	} else {
+		delete(r.running.tenants, r.tenant)
+	}
+	r.m.Running.Set(float64(len(r.running.tenants)))
 }
 
 func (r *PrometheusSyncReporter) ReportSyncStarted(mode models.SyncMode) {
-	r.m.Running.Set(1)
+	r.setRunning(true)
 }
 
 func (r *PrometheusSyncReporter) ReportSyncFinished(event SyncEvent) {
 	modeStr := string(event.Mode)
-	r.m.Running.Set(0)
+	r.setRunning(false)
 	r.m.TasksTotal.WithLabelValues(modeStr, event.Status).Inc()
 	r.m.DurationSeconds.WithLabelValues(modeStr).Observe(event.Duration.Seconds())
 	r.m.FilesIndexedTotal.WithLabelValues(modeStr).Add(float64(event.Stats.FilesIndexed))
@@ -50,3 +81,15 @@ func (r *PrometheusSyncReporter) ReportSyncFinished(event SyncEvent) {
 		r.m.IncrementalChangesTotal.WithLabelValues("skip_delete").Add(float64(event.IncrStats.SkippedDeletes))
 	}
 }
+
+func (r *PrometheusSyncReporter) ReportRateControl(state models.RateControlState, throttled bool) {
+	r.m.UpstreamRateLimit.Set(state.EffectiveRate)
+	if state.Backoff {
+		r.m.UpstreamBackoff.Set(1)
+	} else {
+		r.m.UpstreamBackoff.Set(0)
+	}
+	if throttled {
+		r.m.UpstreamThrottledTotal.WithLabelValues(strconv.Itoa(state.LastThrottleStatus)).Inc()
+	}
+}
@@ -22,6 +22,27 @@ func TestPrometheusSyncReporter_Started(t *testing.T) {
 	}
 }
 
+func TestPrometheusSyncReporter_ForTenantCountsRunningTenants(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	sm := metrics.NewSyncMetrics(reg)
+	r := metrics.NewPrometheusSyncReporter(sm)
+	acme := r.ForTenant("acme")
+	globex := r.ForTenant("globex")
+
+	acme.ReportSyncStarted(models.SyncModeFull)
+	globex.ReportSyncStarted(models.SyncModeIncremental)
+	if v := testutil.ToFloat64(sm.Running); v != 2 {
+		t.Errorf("Running with two tenants: got %f, want 2", v)
+	}
+	acme.ReportSyncFinished(metrics.SyncEvent{Mode: models.SyncModeFull, Status: "done"})
+	if v := testutil.ToFloat64(sm.Running); v != 1 {
+		t.Errorf("Running after one tenant finished: got %f, want 1", v)
+	}
+	if v := testutil.ToFloat64(sm.TasksTotal.WithLabelValues("full", "done")); v != 1 {
+		t.Errorf("TasksTotal full/done: got %f, want 1", v)
+	}
+}
+
 func TestPrometheusSyncReporter_FinishedDone(t *testing.T) {
 	reg := prometheus.NewRegistry()
 	sm := metrics.NewSyncMetrics(reg)
@@ -108,3 +129,28 @@ func TestPrometheusSyncReporter_IncrementalStats(t *testing.T) {
 		t.Errorf("incr skip_delete: got %f, want 2", v)
 	}
 }
+
+func TestPrometheusSyncReporter_RateControl(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	sm := metrics.NewSyncMetrics(reg)
+	r := metrics.NewPrometheusSyncReporter(sm)
+
+	r.ReportRateControl(models.RateControlState{BaseRate: 5, EffectiveRate: 2.5, Backoff: true, LastThrottleStatus: 429}, true)
+	if v := testutil.ToFloat64(sm.UpstreamRateLimit); v != 2.5 {
+		t.Errorf("UpstreamRateLimit: got %f, want 2.5", v)
+	}
+	if v := testutil.ToFloat64(sm.UpstreamBackoff); v != 1 {
+		t.Errorf("UpstreamBackoff: got %f, want 1", v)
+	}
+	if v := testutil.ToFloat64(sm.UpstreamThrottledTotal.WithLabelValues("429")); v != 1 {
+		t.Errorf("UpstreamThrottledTotal 429: got %f, want 1", v)
+	}
+
+	r.ReportRateControl(models.RateControlState{BaseRate: 5, EffectiveRate: 5, LastThrottleStatus: 429}, false)
+	if v := testutil.ToFloat64(sm.UpstreamBackoff); v != 0 {
+		t.Errorf("UpstreamBackoff after recovery: got %f, want 0", v)
+	}
+	if v := testutil.ToFloat64(sm.UpstreamThrottledTotal.WithLabelValues("429")); v != 1 {
+		t.Errorf("UpstreamThrottledTotal after recovery: got %f, want 1", v)
+	}
+}
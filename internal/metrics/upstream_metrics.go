@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"npan/internal/npan"
+)
+
+// UpstreamMetrics holds Prometheus metrics for the Npan API circuit breakers.
+type UpstreamMetrics struct {
+	CircuitState            prometheus.Gauge
+	CircuitTransitionsTotal *prometheus.CounterVec
+
+	mu sync.Mutex
+	// states holds the last state of each breaker by upstream; CircuitState reports the worst one.
+	states map[string]npan.CircuitState
+}
+
+// NewUpstreamMetrics creates and registers upstream metrics with the given registerer.
+func NewUpstreamMetrics(reg prometheus.Registerer) *UpstreamMetrics {
+	m := &UpstreamMetrics{
+		CircuitState: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "npan_upstream_circuit_state",
+			Help: "Npan API circuit breaker state: 0 closed, 1 half-open, 2 open.",
+		}),
+		CircuitTransitionsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "npan_upstream_circuit_transitions_total",
+			Help: "Total number of Npan API circuit breaker state transitions by target state.",
+		}, []string{"state"}),
+		states: make(map[string]npan.CircuitState),
+	}
+	reg.MustRegister(
+		m.CircuitState,
+		m.CircuitTransitionsTotal,
+	)
+	return m
+}
+
+// ObserveCircuitTransition records a state change of the default upstream's circuit breaker.
+// It matches npan.CircuitBreakerOptions.OnStateChange.
+func (m *UpstreamMetrics) ObserveCircuitTransition(_ npan.CircuitState, to npan.CircuitState) {
+	m.observe("", to)
+}
+
+// CircuitObserver returns an OnStateChange callback for the breaker of another upstream.
+// CircuitState reports the worst state across all observed breakers.
+func (m *UpstreamMetrics) CircuitObserver(upstream string) func(from npan.CircuitState, to npan.CircuitState) {
+	return func(_ npan.CircuitState, to npan.CircuitState) {
+		m.observe(upstream, to)
+	}
+}
+
+func (m *UpstreamMetrics) observe(upstream string, to npan.CircuitState) {
+	m.mu.Lock()
+	m.states[upstream] = to
+	worst := 0.0
+	for _, state := range m.states {
+		worst = max(worst, circuitStateValue(state))
+	}
+	m.CircuitState.Set(worst)
+	m.mu.Unlock()
+	m.CircuitTransitionsTotal.WithLabelValues(string(to)).Inc()
+}
+
+func circuitStateValue(state npan.CircuitState) float64 {
+	switch state {
+	case npan.CircuitHalfOpen:
+		return 1
+	case npan.CircuitOpen:
+		return 2
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,52 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"npan/internal/metrics"
+	"npan/internal/npan"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestUpstreamMetrics_ObserveCircuitTransition(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := metrics.NewUpstreamMetrics(reg)
+
+	m.ObserveCircuitTransition(npan.CircuitClosed, npan.CircuitOpen)
+	if v := testutil.ToFloat64(m.CircuitState); v != 2 {
+		t.Errorf("CircuitState after open: got %f, want 2", v)
+	}
+	m.ObserveCircuitTransition(npan.CircuitOpen, npan.CircuitHalfOpen)
+	if v := testutil.ToFloat64(m.CircuitState); v != 1 {
+		t.Errorf("CircuitState after half-open: got %f, want 1", v)
+	}
+	m.ObserveCircuitTransition(npan.CircuitHalfOpen, npan.CircuitClosed)
+	if v := testutil.ToFloat64(m.CircuitState); v != 0 {
+		t.Errorf("CircuitState after close: got %f, want 0", v)
+	}
+	if v := testutil.ToFloat64(m.CircuitTransitionsTotal.WithLabelValues("open")); v != 1 {
+		t.Errorf("CircuitTransitionsTotal open: got %f, want 1", v)
+	}
+}
+
+func TestUpstreamMetrics_CircuitStateReportsWorstUpstream(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := metrics.NewUpstreamMetrics(reg)
+	tenant := m.CircuitObserver("https://acme.example.com")
+
+	tenant(npan.CircuitClosed, npan.CircuitOpen)
+	m.ObserveCircuitTransition(npan.CircuitOpen, npan.CircuitClosed)
+	if v := testutil.ToFloat64(m.CircuitState); v != 2 {
+		t.Errorf("CircuitState with one open upstream: got %f, want 2", v)
+	}
+	tenant(npan.CircuitOpen, npan.CircuitHalfOpen)
+	if v := testutil.ToFloat64(m.CircuitState); v != 1 {
+		t.Errorf("CircuitState with one half-open upstream: got %f, want 1", v)
+	}
+	tenant(npan.CircuitHalfOpen, npan.CircuitClosed)
+	if v := testutil.ToFloat64(m.CircuitState); v != 0 {
+		t.Errorf("CircuitState with all upstreams closed: got %f, want 0", v)
+	}
+}
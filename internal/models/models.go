@@ -57,6 +57,7 @@ type IndexDocument struct {
 	NameExt         string       `json:"name_ext"`
 	FileCategory    FileCategory `json:"file_category,omitempty"`
 	PathText        string       `json:"path_text"`
+	AncestorIDs     []int64      `json:"ancestor_ids,omitempty"`
 	ParentID        int64        `json:"parent_id"`
 	ModifiedAt      int64        `json:"modified_at"`
 	CreatedAt       int64        `json:"created_at"`
@@ -64,6 +65,9 @@ type IndexDocument struct {
 	SHA1            string       `json:"sha1"`
 	InTrash         bool         `json:"in_trash"`
 	IsDeleted       bool         `json:"is_deleted"`
+	SyncGeneration  int64        `json:"sync_generation,omitempty"`
+	SyncRootID      *int64       `json:"sync_root_id,omitempty"`
+	TenantID        string       `json:"tenant_id,omitempty"`
 	HighlightedName string       `json:"highlighted_name,omitempty"`
 }
 
@@ -105,6 +109,7 @@ type RootSyncProgress struct {
 	CurrentPageID      *int64     `json:"currentPageId,omitempty"`
 	CurrentPageCount   *int64     `json:"currentPageCount,omitempty"`
 	QueueLength        *int64     `json:"queueLength,omitempty"`
+	StaleRemoved       int64      `json:"staleRemoved,omitempty"`
 	UpdatedAt          int64      `json:"updatedAt"`
 	Error              string     `json:"error,omitempty"`
 }
@@ -124,6 +129,22 @@ type IncrementalSyncStats struct {
 	SkippedDeletes int64 `json:"skippedDeletes"`
 	CursorBefore   int64 `json:"cursorBefore"`
 	CursorAfter    int64 `json:"cursorAfter"`
+
+	// 目录改名或移动后改写子孙文档路径的统计；Pending 是留给下次增量继续处理的目录数。
+	FoldersMoved        int64 `json:"foldersMoved,omitempty"`
+	PathsRewritten      int64 `json:"pathsRewritten,omitempty"`
+	PathRewritesPending int64 `json:"pathRewritesPending,omitempty"`
+
+	// 目录删除时级联删除的子孙文档数，以及恢复目录重新爬取的统计。
+	CascadeDeleted  int64 `json:"cascadeDeleted,omitempty"`
+	FoldersRestored int64 `json:"foldersRestored,omitempty"`
+	RestoredDocs    int64 `json:"restoredDocs,omitempty"`
+	RecrawlsPending int64 `json:"recrawlsPending,omitempty"`
+
+	// 时间窗口二分的统计：WindowsSplit 是因截断或结果过多而二分的次数，WindowsPending 是尚未拉取的子窗口数。
+	WindowsFetched int64 `json:"windowsFetched,omitempty"`
+	WindowsSplit   int64 `json:"windowsSplit,omitempty"`
+	WindowsPending int64 `json:"windowsPending,omitempty"`
 }
 
 type SyncVerification struct {
@@ -131,8 +152,10 @@ type SyncVerification struct {
 	CrawledDocCount    int64    `json:"crawledDocCount"`
 	DiscoveredDocCount int64    `json:"discoveredDocCount"`
 	SkippedCount       int64    `json:"skippedCount"`
+	StaleRemoved       int64    `json:"staleRemoved"`
 	Verified           bool     `json:"verified"`
 	Warnings           []string `json:"warnings,omitempty"`
+	DeadLetterCount    int64    `json:"deadLetterCount"`
 }
 
 type SyncProgressState struct {
@@ -148,6 +171,7 @@ type SyncProgressState struct {
 	CompletedRoots      []int64                      `json:"completedRoots"`
 	ActiveRoot          *int64                       `json:"activeRoot,omitempty"`
 	AggregateStats      CrawlStats                   `json:"aggregateStats"`
+	StaleRemoved        int64                        `json:"staleRemoved,omitempty"`
 	RootProgress        map[string]*RootSyncProgress `json:"rootProgress"`
 	CatalogRoots        []int64                      `json:"catalogRoots,omitempty"`
 	CatalogRootNames    map[int64]string             `json:"catalogRootNames,omitempty"`
@@ -155,27 +179,238 @@ type SyncProgressState struct {
 	IncrementalStats    *IncrementalSyncStats        `json:"incrementalStats,omitempty"`
 	LastError           string                       `json:"lastError,omitempty"`
 	Verification        *SyncVerification            `json:"verification,omitempty"`
+	Rebuild             *IndexRebuildState           `json:"rebuild,omitempty"`
+	PathRewrites        []FolderPathRewrite          `json:"pathRewrites,omitempty"`
+	SubtreeRecrawls     []int64                      `json:"subtreeRecrawls,omitempty"`
+	DryRun              *DryRunReport                `json:"dryRun,omitempty"`
+	RateControl         *RateControlState            `json:"rateControl,omitempty"`
+
+	// SyncGeneration 是本次全量同步的代次，所有根目录共用，续爬时沿用，嵌套根目录的清理才不会互相误删。
+	SyncGeneration int64 `json:"syncGeneration,omitempty"`
+
+	// PausedAt 是同步暂停的开始时间（毫秒），只在暂停期间出现，不落盘。
+	PausedAt int64 `json:"pausedAt,omitempty"`
+
+	// IncrementalPlan 是增量拉取尚未完成的子窗口计划，中断后下一次增量从剩余窗口继续。
+	IncrementalPlan *IncrementalWindowPlan `json:"incrementalPlan,omitempty"`
+}
+
+// IncrementalWindow 是增量拉取的一个 updated_time_range 时间窗口，单位秒，两端都包含。
+// Start 为 0 表示不设下界，End 为 0 表示不设上界。
+type IncrementalWindow struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end,omitempty"`
+}
+
+// IncrementalWindowPlan 记录一次增量按时间顺序待拉取的子窗口。CursorBefore 与当前增量游标不一致时计划作废。
+type IncrementalWindowPlan struct {
+	CursorBefore int64               `json:"cursorBefore"`
+	Windows      []IncrementalWindow `json:"windows"`
+}
+
+// RateControlState 是同步请求限速器的自适应状态。速率单位为每秒请求数，0 表示不限速。
+type RateControlState struct {
+	BaseRate           float64 `json:"baseRate"`
+	EffectiveRate      float64 `json:"effectiveRate"`
+	Backoff            bool    `json:"backoff"`
+	PausedUntil        int64   `json:"pausedUntil,omitempty"`
+	ThrottleEvents     int64   `json:"throttleEvents"`
+	LastThrottleAt     int64   `json:"lastThrottleAt,omitempty"`
+	LastThrottleStatus int     `json:"lastThrottleStatus,omitempty"`
+}
+
+// FolderPathRewrite 是路径改写队列中的一项：FolderID 的直接子项需要按 Path 重新计算路径与祖先。
+type FolderPathRewrite struct {
+	FolderID int64      `json:"folderId"`
+	Path     FolderPath `json:"path"`
+}
+
+// DryRunReport 是演练同步的差异报告：按根目录统计一次真实同步将新增、更新、删除的文档。
+// 演练不写索引、不保存断点，也不推进增量游标。
+type DryRunReport struct {
+	Mode       string           `json:"mode"`
+	StartedAt  int64            `json:"startedAt"`
+	FinishedAt int64            `json:"finishedAt,omitempty"`
+	Roots      []DryRunRootDiff `json:"roots"`
+	Adds       int64            `json:"adds"`
+	Updates    int64            `json:"updates"`
+	Deletes    int64            `json:"deletes"`
+
+	// Status、LastError 与 ActiveRoot 是演练自身的状态，进度顶层字段保留上一次真实同步的结果。
+	Status     string `json:"status,omitempty"`
+	LastError  string `json:"lastError,omitempty"`
+	ActiveRoot *int64 `json:"activeRoot,omitempty"`
+}
+
+type DryRunRootDiff struct {
+	RootFolderID  int64          `json:"rootFolderId"`
+	RootName      string         `json:"rootName,omitempty"`
+	Adds          int64          `json:"adds"`
+	Updates       int64          `json:"updates"`
+	Deletes       int64          `json:"deletes"`
+	Unchanged     int64          `json:"unchanged"`
+	SampleAdds    []DryRunSample `json:"sampleAdds,omitempty"`
+	SampleUpdates []DryRunSample `json:"sampleUpdates,omitempty"`
+	SampleDeletes []DryRunSample `json:"sampleDeletes,omitempty"`
+}
+
+// DryRunSample 是差异样例；更新样例的 ChangedFields 列出与索引不一致的字段。
+type DryRunSample struct {
+	DocID         string   `json:"docId"`
+	SourceID      int64    `json:"sourceId"`
+	Type          ItemType `json:"type"`
+	Path          string   `json:"path"`
+	ChangedFields []string `json:"changedFields,omitempty"`
+}
+
+type FolderResyncMode string
+
+const (
+	FolderResyncModeMerge   FolderResyncMode = "merge"
+	FolderResyncModeRebuild FolderResyncMode = "rebuild"
+)
+
+// FolderResyncProgress 是单个目录重同步的进度，独立于全量/增量同步进度，只保存在内存中。
+// merge 只覆盖写入子树文档；rebuild 先删除索引中该子树的文档再重新爬取。
+type FolderResyncProgress struct {
+	FolderID        int64            `json:"folderId"`
+	FolderName      string           `json:"folderName,omitempty"`
+	Mode            FolderResyncMode `json:"mode"`
+	Status          string           `json:"status"`
+	StartedAt       int64            `json:"startedAt"`
+	UpdatedAt       int64            `json:"updatedAt"`
+	FinishedAt      int64            `json:"finishedAt,omitempty"`
+	DocsDeleted     int64            `json:"docsDeleted"`
+	DocsWritten     int64            `json:"docsWritten"`
+	FoldersVisited  int64            `json:"foldersVisited"`
+	CurrentFolderID *int64           `json:"currentFolderId,omitempty"`
+	LastError       string           `json:"lastError,omitempty"`
+}
+
+// ReconciliationReport 是一次逐目录对账：上游 GetFolderInfo.ItemCount 与索引中子树文档数逐个比对。
+// SampleSize 为 0 表示检查全部目录，否则每个根目录最多抽查 SampleSize 个目录。
+type ReconciliationReport struct {
+	ID             int64               `json:"id"`
+	Status         string              `json:"status"`
+	Roots          []int64             `json:"roots"`
+	SampleSize     int64               `json:"sampleSize"`
+	StartedAt      int64               `json:"startedAt"`
+	FinishedAt     int64               `json:"finishedAt,omitempty"`
+	FoldersChecked int64               `json:"foldersChecked"`
+	FoldersDrifted int64               `json:"foldersDrifted"`
+	Error          string              `json:"error,omitempty"`
+	Rows           []ReconciliationRow `json:"rows,omitempty"`
+}
+
+// ReconciliationRow 是对账表中的一个目录。Drift 为索引数减上游数，正数表示索引残留，负数表示索引缺失。
+// 上游查询失败时只记录 Error，不计入漂移。
+type ReconciliationRow struct {
+	RootFolderID  int64  `json:"rootFolderId"`
+	FolderID      int64  `json:"folderId"`
+	Path          string `json:"path"`
+	UpstreamItems int64  `json:"upstreamItems"`
+	IndexedItems  int64  `json:"indexedItems"`
+	Drift         int64  `json:"drift"`
+	Error         string `json:"error,omitempty"`
+}
+
+const (
+	RebuildStatusBuilding   = "building"
+	RebuildStatusSwapped    = "swapped"
+	RebuildStatusRolledBack = "rolled_back"
+)
+
+// IndexRebuildState 记录蓝绿重建：building 时全量同步写入影子索引，swapped 后影子索引已接管线上名称，
+// rolled_back 表示已切回上一代。
+type IndexRebuildState struct {
+	Status       string `json:"status"`
+	LiveIndex    string `json:"liveIndex"`
+	ShadowIndex  string `json:"shadowIndex"`
+	StartedAt    int64  `json:"startedAt"`
+	SwappedAt    int64  `json:"swappedAt,omitempty"`
+	RolledBackAt int64  `json:"rolledBackAt,omitempty"`
+	LastError    string `json:"lastError,omitempty"`
 }
 
+// CrawlCheckpoint 记录全量爬取的断点。Queue 为尚未开始的目录，InFlight 为并发处理中的目录及其下一页；
+// 旧版断点只有 CurrentFolderID/CurrentPageID，且当前目录位于 Queue 首位。
 type CrawlCheckpoint struct {
-	Queue           []int64 `json:"queue"`
-	CurrentFolderID *int64  `json:"currentFolderId,omitempty"`
-	CurrentPageID   *int64  `json:"currentPageId,omitempty"`
+	Queue           []int64              `json:"queue"`
+	CurrentFolderID *int64               `json:"currentFolderId,omitempty"`
+	CurrentPageID   *int64               `json:"currentPageId,omitempty"`
+	InFlight        []FolderCursor       `json:"inFlight,omitempty"`
+	FolderPaths     map[int64]FolderPath `json:"folderPaths,omitempty"`
+	SyncGeneration  int64                `json:"syncGeneration,omitempty"`
+}
+
+// CrawlFrontierState 是断点中单个目录的爬取状态。
+type CrawlFrontierState string
+
+const (
+	CrawlFrontierPending    CrawlFrontierState = "pending"
+	CrawlFrontierInProgress CrawlFrontierState = "in_progress"
+	CrawlFrontierDone       CrawlFrontierState = "done"
+)
+
+// CrawlFrontierChange 是断点中一个目录的状态变化。PageID 是 in_progress 目录的续爬页码；
+// Path 为空时保留已保存的路径。
+type CrawlFrontierChange struct {
+	FolderID int64
+	State    CrawlFrontierState
+	PageID   int64
+	Path     *FolderPath
+}
+
+// FolderCursor 是处理中目录的续爬位置。
+type FolderCursor struct {
+	FolderID int64 `json:"folderId"`
+	PageID   int64 `json:"pageId"`
+}
+
+// FolderPath 是目录的面包屑路径与从同步根到该目录（含自身）的目录 ID 链。
+type FolderPath struct {
+	PathText string  `json:"pathText"`
+	Lineage  []int64 `json:"lineage"`
 }
 
 type SyncState struct {
 	LastSyncTime int64 `json:"lastSyncTime"`
 }
 
+// DuplicateSHA1Group 是一组 SHA1 相同的文件的统计，Size 取组内最大的文件大小。
+type DuplicateSHA1Group struct {
+	SHA1   string
+	Copies int64
+	Size   int64
+}
+
+// WastedBytes 是保留一份之外的副本占用。
+func (g DuplicateSHA1Group) WastedBytes() int64 {
+	return g.Size * (g.Copies - 1)
+}
+
+// DuplicateSHA1Summary 是范围内重复文件的统计：Groups 按浪费空间从大到小最多列出 limit 组，
+// TotalGroups 与 WastedBytes 覆盖范围内的全部重复分组。
+type DuplicateSHA1Summary struct {
+	Groups      []DuplicateSHA1Group
+	TotalGroups int64
+	WastedBytes int64
+}
+
 type LocalSearchParams struct {
 	Query          string
 	Type           string
 	Page           int64
 	PageSize       int64
 	ParentID       *int64
+	WithinFolderID *int64
 	UpdatedAfter   *int64
 	UpdatedBefore  *int64
 	IncludeDeleted bool
+	SHA1           string
+	MinSize        int64
+	// TenantID 非空时只检索该租户的文档。
+	TenantID string
 }
 
 type RemoteSearchParams struct {
@@ -201,3 +436,86 @@ type RemoteSearchResponse struct {
 	PageCapacity int64              `json:"page_capacity"`
 	PageCount    int64              `json:"page_count"`
 }
+
+// SyncRun 是一次全量或增量同步的历史记录。全量续爬时 Stats 包含此前中断运行已完成的部分。
+type SyncRun struct {
+	ID               int64                 `json:"id"`
+	Mode             SyncMode              `json:"mode"`
+	Status           string                `json:"status"`
+	Roots            []int64               `json:"roots"`
+	StartedAt        int64                 `json:"startedAt"`
+	EndedAt          int64                 `json:"endedAt,omitempty"`
+	Stats            CrawlStats            `json:"stats"`
+	IncrementalStats *IncrementalSyncStats `json:"incrementalStats,omitempty"`
+	Verification     *SyncVerification     `json:"verification,omitempty"`
+	Error            string                `json:"error,omitempty"`
+}
+
+// DeadLetter 是一批重试后仍写入失败的索引文档，保留原始文档以便重放。
+type DeadLetter struct {
+	ID           int64           `json:"id"`
+	RunID        int64           `json:"runId,omitempty"`
+	RootFolderID int64           `json:"rootFolderId"`
+	Documents    []IndexDocument `json:"documents"`
+	Error        string          `json:"error"`
+	Attempts     int64           `json:"attempts"`
+	CreatedAt    int64           `json:"createdAt"`
+	UpdatedAt    int64           `json:"updatedAt"`
+}
+
+type IndexChangeOp string
+
+const (
+	IndexChangeUpsert IndexChangeOp = "upsert"
+	IndexChangeDelete IndexChangeOp = "delete"
+	// IndexChangeSweep 汇总某个根目录的过期文档清理，被清理的文档已逐条记为 delete，Removed 是清理总数。
+	IndexChangeSweep IndexChangeOp = "sweep"
+	// IndexChangeReset 表示线上索引整体被清空或替换（强制重建、蓝绿切换与回滚），消费方应全量重新对账。
+	IndexChangeReset IndexChangeOp = "reset"
+)
+
+// IndexChange 是变更日志中的一条索引写入，Seq 单调递增且不会复用。
+type IndexChange struct {
+	Seq          int64          `json:"seq"`
+	Op           IndexChangeOp  `json:"op"`
+	DocID        string         `json:"docId,omitempty"`
+	Document     *IndexDocument `json:"document,omitempty"`
+	RootFolderID int64          `json:"rootFolderId,omitempty"`
+	RunID        int64          `json:"runId,omitempty"`
+	Removed      int64          `json:"removed,omitempty"`
+	OccurredAt   int64          `json:"occurredAt"`
+}
+
+type SyncSchedule struct {
+	ID            int64    `json:"id"`
+	Name          string   `json:"name"`
+	CronExpr      string   `json:"cronExpr"`
+	Mode          SyncMode `json:"mode"`
+	JitterSeconds int64    `json:"jitterSeconds"`
+	Paused        bool     `json:"paused"`
+	NextRunAt     int64    `json:"nextRunAt"`
+	LastRunAt     int64    `json:"lastRunAt,omitempty"`
+	LastRunStatus string   `json:"lastRunStatus,omitempty"`
+	LastError     string   `json:"lastError,omitempty"`
+	CreatedAt     int64    `json:"createdAt"`
+	UpdatedAt     int64    `json:"updatedAt"`
+}
+
+// StoredOAuthToken 是持久化到状态库的 OAuth token，Ciphertext 为加密后的 access_token。
+type StoredOAuthToken struct {
+	Key        string `json:"key"`
+	Ciphertext []byte `json:"-"`
+	ExpiresAt  int64  `json:"expiresAt"`
+	UpdatedAt  int64  `json:"updatedAt"`
+}
+
+// SyncLease 是状态库中的跨进程同步租约。OwnerID 唯一标识持有进程，Owner 是给人看的描述；
+// 时间均为毫秒时间戳，过了 ExpiresAt 仍未续约的租约可被其他进程接管。
+type SyncLease struct {
+	Key         string `json:"key"`
+	OwnerID     string `json:"ownerId"`
+	Owner       string `json:"owner"`
+	AcquiredAt  int64  `json:"acquiredAt"`
+	HeartbeatAt int64  `json:"heartbeatAt"`
+	ExpiresAt   int64  `json:"expiresAt"`
+}
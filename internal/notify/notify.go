@@ -0,0 +1,214 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"npan/internal/models"
+)
+
+type EventType string
+
+const (
+	EventSyncStarted         EventType = "sync_started"
+	EventSyncFinished        EventType = "sync_finished"
+	EventSyncFailed          EventType = "sync_failed"
+	EventSyncCancelled       EventType = "sync_cancelled"
+	EventVerificationWarning EventType = "verification_warning"
+	EventTest                EventType = "test"
+)
+
+const defaultSendTimeout = 30 * time.Second
+
+var (
+	ErrNoSinks      = errors.New("未配置通知渠道")
+	ErrSinkNotFound = errors.New("通知渠道不存在")
+)
+
+// Event 是一次同步生命周期事件，各渠道按 JSON 原样投递。
+type Event struct {
+	Type             EventType                    `json:"type"`
+	Mode             models.SyncMode              `json:"mode,omitempty"`
+	RunID            int64                        `json:"runId,omitempty"`
+	Status           string                       `json:"status,omitempty"`
+	OccurredAt       int64                        `json:"occurredAt"`
+	DurationMS       int64                        `json:"durationMs,omitempty"`
+	Stats            *models.CrawlStats           `json:"stats,omitempty"`
+	IncrementalStats *models.IncrementalSyncStats `json:"incrementalStats,omitempty"`
+	Error            string                       `json:"error,omitempty"`
+	Warnings         []string                     `json:"warnings,omitempty"`
+
+	// TenantID 是事件所属的租户，未启用多租户时为空。
+	TenantID string `json:"tenantId,omitempty"`
+}
+
+// Summary 返回一行中文摘要，用作邮件标题；多租户时标题带上租户 ID。
+func (e Event) Summary() string {
+	prefix := "[npan]"
+	if e.TenantID != "" {
+		prefix = fmt.Sprintf("[npan/%s]", e.TenantID)
+	}
+	mode := "同步"
+	switch e.Mode {
+	case models.SyncModeFull:
+		mode = "全量同步"
+	case models.SyncModeIncremental:
+		mode = "增量同步"
+	}
+
+	switch e.Type {
+	case EventSyncStarted:
+		return fmt.Sprintf("%s %s已开始", prefix, mode)
+	case EventSyncFinished:
+		return fmt.Sprintf("%s %s已完成", prefix, mode)
+	case EventSyncFailed:
+		return fmt.Sprintf("%s %s失败: %s", prefix, mode, e.Error)
+	case EventSyncCancelled:
+		return fmt.Sprintf("%s %s已取消", prefix, mode)
+	case EventVerificationWarning:
+		return fmt.Sprintf("%s %s校验告警: %s", prefix, mode, strings.Join(e.Warnings, "; "))
+	case EventTest:
+		return prefix + " 测试通知"
+	default:
+		return fmt.Sprintf("%s %s", prefix, e.Type)
+	}
+}
+
+// Sink 是一个通知渠道。Send 失败时由渠道自己决定是否重试，返回的错误只用于记录。
+type Sink interface {
+	Name() string
+	Send(ctx context.Context, event Event) error
+}
+
+// Notifier 接收同步生命周期事件。可以为 nil（未启用）。
+type Notifier interface {
+	Notify(event Event)
+}
+
+type Options struct {
+	// Events 限定投递的事件类型，为空时投递全部事件。
+	Events   []string
+	Webhook  WebhookConfig
+	SMTP     SMTPConfig
+	FilePath string
+	// SendTimeout 是单个渠道投递一次事件（含重试）的超时。
+	SendTimeout time.Duration
+}
+
+// SinkResult 是一次测试投递在某个渠道上的结果。
+type SinkResult struct {
+	Sink string
+	Err  error
+}
+
+// Dispatcher 把事件异步分发到所有渠道，渠道之间互不阻塞。
+type Dispatcher struct {
+	sinks   []Sink
+	events  map[EventType]struct{}
+	timeout time.Duration
+	wg      sync.WaitGroup
+}
+
+// NewDispatcher 按配置创建已启用的渠道：配置了 URL 的 webhook、配置了主机的 SMTP 与配置了路径的 NDJSON 文件。
+func NewDispatcher(opts Options) *Dispatcher {
+	var sinks []Sink
+	if strings.TrimSpace(opts.Webhook.URL) != "" {
+		sinks = append(sinks, NewWebhookSink(opts.Webhook))
+	}
+	if strings.TrimSpace(opts.SMTP.Host) != "" {
+		sinks = append(sinks, NewSMTPSink(opts.SMTP))
+	}
+	if strings.TrimSpace(opts.FilePath) != "" {
+		sinks = append(sinks, NewFileSink(opts.FilePath))
+	}
+	return NewDispatcherWithSinks(sinks, opts.Events, opts.SendTimeout)
+}
+
+func NewDispatcherWithSinks(sinks []Sink, events []string, timeout time.Duration) *Dispatcher {
+	if timeout <= 0 {
+		timeout = defaultSendTimeout
+	}
+	d := &Dispatcher{sinks: sinks, timeout: timeout}
+	for _, raw := range events {
+		name := strings.TrimSpace(raw)
+		if name == "" {
+			continue
+		}
+		if d.events == nil {
+			d.events = map[EventType]struct{}{}
+		}
+		d.events[EventType(name)] = struct{}{}
+	}
+	return d
+}
+
+// SinkNames 返回已启用渠道的名称。
+func (d *Dispatcher) SinkNames() []string {
+	names := make([]string, 0, len(d.sinks))
+	for _, sink := range d.sinks {
+		names = append(names, sink.Name())
+	}
+	return names
+}
+
+func (d *Dispatcher) accepts(eventType EventType) bool {
+	if d.events == nil {
+		return true
+	}
+	_, ok := d.events[eventType]
+	return ok
+}
+
+// Notify 在后台投递事件，不阻塞同步流程；投递失败只记录日志。
+func (d *Dispatcher) Notify(event Event) {
+	if len(d.sinks) == 0 || !d.accepts(event.Type) {
+		return
+	}
+	if event.OccurredAt == 0 {
+		event.OccurredAt = time.Now().UnixMilli()
+	}
+	for _, sink := range d.sinks {
+		d.wg.Add(1)
+		go func(sink Sink) {
+			defer d.wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+			defer cancel()
+			if err := sink.Send(ctx, event); err != nil {
+				slog.Warn("发送同步通知失败", "sink", sink.Name(), "event", event.Type, "error", err)
+			}
+		}(sink)
+	}
+}
+
+// Wait 等待已提交的事件投递结束，用于进程退出前。
+func (d *Dispatcher) Wait() {
+	d.wg.Wait()
+}
+
+// SendTest 同步地向指定渠道（sinkName 为空时为全部渠道）投递一条测试事件，不受事件类型过滤影响。
+func (d *Dispatcher) SendTest(ctx context.Context, sinkName string) ([]SinkResult, error) {
+	if len(d.sinks) == 0 {
+		return nil, ErrNoSinks
+	}
+
+	event := Event{Type: EventTest, OccurredAt: time.Now().UnixMilli()}
+	var results []SinkResult
+	for _, sink := range d.sinks {
+		if sinkName != "" && sink.Name() != sinkName {
+			continue
+		}
+		sendCtx, cancel := context.WithTimeout(ctx, d.timeout)
+		err := sink.Send(sendCtx, event)
+		cancel()
+		results = append(results, SinkResult{Sink: sink.Name(), Err: err})
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrSinkNotFound, sinkName)
+	}
+	return results, nil
+}
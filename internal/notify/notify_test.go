@@ -0,0 +1,117 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+type recordingSink struct {
+	name string
+	err  error
+
+	mu     sync.Mutex
+	events []Event
+}
+
+func (s *recordingSink) Name() string { return s.name }
+
+func (s *recordingSink) Send(_ context.Context, event Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return s.err
+}
+
+func TestDispatcher_FiltersEventTypes(t *testing.T) {
+	t.Parallel()
+
+	sink := &recordingSink{name: "rec"}
+	d := NewDispatcherWithSinks([]Sink{sink}, []string{"sync_failed", " verification_warning "}, 0)
+
+	d.Notify(Event{Type: EventSyncStarted})
+	d.Notify(Event{Type: EventSyncFailed, Error: "boom"})
+	d.Notify(Event{Type: EventVerificationWarning})
+	d.Wait()
+
+	if len(sink.events) != 2 {
+		t.Fatalf("expected only filtered events to be delivered, got %+v", sink.events)
+	}
+	for _, event := range sink.events {
+		if event.OccurredAt == 0 {
+			t.Fatalf("expected OccurredAt to be stamped, got %+v", event)
+		}
+	}
+}
+
+func TestDispatcher_SendTestReportsPerSinkResults(t *testing.T) {
+	t.Parallel()
+
+	ok := &recordingSink{name: "ok"}
+	broken := &recordingSink{name: "broken", err: errors.New("unreachable")}
+	d := NewDispatcherWithSinks([]Sink{ok, broken}, []string{"sync_failed"}, 0)
+
+	results, err := d.SendTest(context.Background(), "")
+	if err != nil {
+		t.Fatalf("SendTest returned error: %v", err)
+	}
+	if len(results) != 2 || results[0].Err != nil || results[1].Err == nil {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	if ok.events[0].Type != EventTest {
+		t.Fatalf("expected test event regardless of filter, got %+v", ok.events)
+	}
+
+	if _, err := d.SendTest(context.Background(), "missing"); !errors.Is(err, ErrSinkNotFound) {
+		t.Fatalf("expected ErrSinkNotFound, got %v", err)
+	}
+	if _, err := NewDispatcher(Options{}).SendTest(context.Background(), ""); !errors.Is(err, ErrNoSinks) {
+		t.Fatalf("expected ErrNoSinks, got %v", err)
+	}
+}
+
+func TestFileSink_AppendsNDJSON(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "events", "sync.ndjson")
+	sink := NewFileSink(path)
+	for _, eventType := range []EventType{EventSyncStarted, EventSyncFinished} {
+		if err := sink.Send(context.Background(), Event{Type: eventType, RunID: 7}); err != nil {
+			t.Fatalf("Send returned error: %v", err)
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer file.Close()
+	var got []EventType
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("invalid line %q: %v", scanner.Text(), err)
+		}
+		got = append(got, event.Type)
+	}
+	if len(got) != 2 || got[0] != EventSyncStarted || got[1] != EventSyncFinished {
+		t.Fatalf("unexpected events %v", got)
+	}
+}
+
+func TestEventSummary_IncludesTenant(t *testing.T) {
+	event := Event{Type: EventSyncFailed, Mode: "full", Error: "boom"}
+	if got := event.Summary(); got != "[npan] 全量同步失败: boom" {
+		t.Fatalf("unexpected single-tenant summary %q", got)
+	}
+	event.TenantID = "acme"
+	if got := event.Summary(); got != "[npan/acme] 全量同步失败: boom" {
+		t.Fatalf("unexpected tenant summary %q", got)
+	}
+}
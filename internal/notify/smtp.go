@@ -0,0 +1,98 @@
+package notify
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	To       []string
+}
+
+// SMTPSink 以纯文本邮件投递事件，正文附带事件 JSON。
+type SMTPSink struct {
+	cfg      SMTPConfig
+	sendMail func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewSMTPSink(cfg SMTPConfig) *SMTPSink {
+	if cfg.Port <= 0 {
+		cfg.Port = 587
+	}
+	return &SMTPSink{cfg: cfg, sendMail: smtp.SendMail}
+}
+
+func (s *SMTPSink) Name() string { return "smtp" }
+
+// Send 在后台 goroutine 中发信；net/smtp 不支持 context，超时后直接返回，连接由 SMTP 服务端关闭。
+func (s *SMTPSink) Send(ctx context.Context, event Event) error {
+	if len(s.cfg.To) == 0 {
+		return fmt.Errorf("未配置收件人")
+	}
+	msg, err := buildMailMessage(s.cfg.From, s.cfg.To, event, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if s.cfg.Username != "" {
+		auth = smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)
+	}
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.sendMail(addr, auth, s.cfg.From, s.cfg.To, msg)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func buildMailMessage(from string, to []string, event Event, now time.Time) ([]byte, error) {
+	payload, err := json.MarshalIndent(event, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	var body strings.Builder
+	body.WriteString(event.Summary())
+	body.WriteString("\r\n\r\n")
+	body.WriteString(strings.ReplaceAll(string(payload), "\n", "\r\n"))
+	body.WriteString("\r\n")
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", from)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", event.Summary()))
+	fmt.Fprintf(&msg, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(body.String()))
+	for len(encoded) > 76 {
+		msg.WriteString(encoded[:76])
+		msg.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	msg.WriteString(encoded)
+	msg.WriteString("\r\n")
+	return []byte(msg.String()), nil
+}
@@ -0,0 +1,41 @@
+package notify
+
+import (
+	"context"
+	"encoding/base64"
+	"net/smtp"
+	"strings"
+	"testing"
+)
+
+func TestSMTPSink_SendsEncodedMessage(t *testing.T) {
+	t.Parallel()
+
+	sink := NewSMTPSink(SMTPConfig{Host: "mail.example.com", From: "npan@example.com", To: []string{"ops@example.com"}})
+	var gotAddr string
+	var gotMsg []byte
+	sink.sendMail = func(addr string, _ smtp.Auth, _ string, _ []string, msg []byte) error {
+		gotAddr = addr
+		gotMsg = msg
+		return nil
+	}
+
+	if err := sink.Send(context.Background(), Event{Type: EventSyncFinished, Mode: "full"}); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if gotAddr != "mail.example.com:587" {
+		t.Fatalf("expected default submission port, got %q", gotAddr)
+	}
+
+	header, body, ok := strings.Cut(string(gotMsg), "\r\n\r\n")
+	if !ok || !strings.Contains(header, "Subject: =?UTF-8?b?") || !strings.Contains(header, "To: ops@example.com") {
+		t.Fatalf("unexpected headers:\n%s", header)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(body, "\r\n", ""))
+	if err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if !strings.HasPrefix(string(decoded), "[npan] 全量同步已完成") || !strings.Contains(string(decoded), `"type": "sync_finished"`) {
+		t.Fatalf("unexpected body:\n%s", decoded)
+	}
+}
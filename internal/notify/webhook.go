@@ -0,0 +1,122 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultWebhookRetries    = 3
+	defaultWebhookRetryDelay = time.Second
+
+	HeaderEvent     = "X-Npan-Event"
+	HeaderTimestamp = "X-Npan-Timestamp"
+	HeaderSignature = "X-Npan-Signature"
+)
+
+type WebhookConfig struct {
+	URL string
+	// Secret 非空时请求带 HMAC-SHA256 签名，签名内容为 "<timestamp>.<body>"。
+	Secret     string
+	MaxRetries int
+	// RetryDelay 是首次重试前的等待，之后每次翻倍。
+	RetryDelay time.Duration
+	Client     *http.Client
+}
+
+// WebhookSink 以 JSON POST 投递事件。网络错误、408、429 与 5xx 会重试，其余 4xx 直接失败。
+type WebhookSink struct {
+	cfg WebhookConfig
+}
+
+func NewWebhookSink(cfg WebhookConfig) *WebhookSink {
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	} else if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = defaultWebhookRetries
+	}
+	if cfg.RetryDelay <= 0 {
+		cfg.RetryDelay = defaultWebhookRetryDelay
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &WebhookSink{cfg: cfg}
+}
+
+func (s *WebhookSink) Name() string { return "webhook" }
+
+// Sign 计算 webhook 签名，接收方用同一密钥按相同方式校验。
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *WebhookSink) Send(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	delay := s.cfg.RetryDelay
+	var lastErr error
+	for attempt := 0; attempt <= s.cfg.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("%w（最后一次错误: %v）", ctx.Err(), lastErr)
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+
+		retry, err := s.post(ctx, event, body)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !retry {
+			break
+		}
+	}
+	return lastErr
+}
+
+func (s *WebhookSink) post(ctx context.Context, event Event, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(event.Type))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	if s.cfg.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(s.cfg.Secret, timestamp, body))
+	}
+
+	resp, err := s.cfg.Client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry := resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retry, fmt.Errorf("webhook 返回状态码 %d", resp.StatusCode)
+}
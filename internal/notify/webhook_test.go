@@ -0,0 +1,62 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWebhookSink_SignsPayloadAndRetriesServerErrors(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	var gotEvent Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if want := Sign("s3cret", r.Header.Get(HeaderTimestamp), body); r.Header.Get(HeaderSignature) != want {
+			t.Errorf("signature mismatch: got %q want %q", r.Header.Get(HeaderSignature), want)
+		}
+		if r.Header.Get(HeaderEvent) != string(EventSyncFailed) {
+			t.Errorf("unexpected event header %q", r.Header.Get(HeaderEvent))
+		}
+		_ = json.Unmarshal(body, &gotEvent)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(WebhookConfig{URL: server.URL, Secret: "s3cret", MaxRetries: 3, RetryDelay: time.Millisecond})
+	if err := sink.Send(context.Background(), Event{Type: EventSyncFailed, Error: "boom"}); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if attempts.Load() != 3 || gotEvent.Error != "boom" {
+		t.Fatalf("expected delivery on the third attempt, attempts=%d event=%+v", attempts.Load(), gotEvent)
+	}
+}
+
+func TestWebhookSink_DoesNotRetryClientErrors(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(WebhookConfig{URL: server.URL, MaxRetries: 3, RetryDelay: time.Millisecond})
+	if err := sink.Send(context.Background(), Event{Type: EventTest}); err == nil {
+		t.Fatal("expected error for 400 response")
+	}
+	if attempts.Load() != 1 {
+		t.Fatalf("expected a single attempt, got %d", attempts.Load())
+	}
+}
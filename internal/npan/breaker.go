@@ -0,0 +1,315 @@
+package npan
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"npan/internal/models"
+)
+
+// ErrCircuitOpen 表示熔断器处于打开状态，请求未发往上游。
+var ErrCircuitOpen = errors.New("Npan 服务暂不可用，请稍后重试")
+
+// CircuitState 是熔断器状态。
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "closed"
+	CircuitOpen     CircuitState = "open"
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+const (
+	defaultCircuitFailureThreshold = 5
+	defaultCircuitOpenTimeout      = 30 * time.Second
+)
+
+type CircuitBreakerOptions struct {
+	// FailureThreshold 是连续失败多少次后打开熔断器。
+	FailureThreshold int
+	// OpenTimeout 是打开后等待多久放行一次半开探测。
+	OpenTimeout time.Duration
+	// OnStateChange 在状态切换后回调，用于更新指标。
+	OnStateChange func(from CircuitState, to CircuitState)
+}
+
+// CircuitSnapshot 是熔断器当前状态的快照。
+type CircuitSnapshot struct {
+	State               CircuitState
+	ConsecutiveFailures int
+	OpenedAt            time.Time
+	RetryAt             time.Time
+	LastError           string
+}
+
+// CircuitBreaker 在多个 API 客户端之间共享上游健康状态：连续失败达到阈值后打开，
+// 打开期间请求直接返回 ErrCircuitOpen；超时后进入半开，只放行一个探测请求，
+// 探测成功则关闭，失败则重新打开。
+type CircuitBreaker struct {
+	threshold     int
+	openTimeout   time.Duration
+	onStateChange func(from CircuitState, to CircuitState)
+	now           func() time.Time
+
+	mu        sync.Mutex
+	state     CircuitState
+	failures  int
+	openedAt  time.Time
+	retryAt   time.Time
+	probing   bool
+	lastError string
+	changed   chan struct{}
+}
+
+func NewCircuitBreaker(options CircuitBreakerOptions) *CircuitBreaker {
+	threshold := options.FailureThreshold
+	if threshold <= 0 {
+		threshold = defaultCircuitFailureThreshold
+	}
+	openTimeout := options.OpenTimeout
+	if openTimeout <= 0 {
+		openTimeout = defaultCircuitOpenTimeout
+	}
+	return &CircuitBreaker{
+		threshold:     threshold,
+		openTimeout:   openTimeout,
+		onStateChange: options.OnStateChange,
+		now:           time.Now,
+		state:         CircuitClosed,
+		changed:       make(chan struct{}),
+	}
+}
+
+// Wrap 返回经过熔断器的 API；breaker 为 nil 或 api 已经过同一熔断器时原样返回。
+func (b *CircuitBreaker) Wrap(api API) API {
+	if b == nil || api == nil {
+		return api
+	}
+	if wrapped, ok := api.(*circuitAPI); ok && wrapped.breaker == b {
+		return api
+	}
+	return &circuitAPI{api: api, breaker: b}
+}
+
+// Snapshot 返回当前状态。
+func (b *CircuitBreaker) Snapshot() CircuitSnapshot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advanceLocked(b.now())
+	return CircuitSnapshot{
+		State:               b.state,
+		ConsecutiveFailures: b.failures,
+		OpenedAt:            b.openedAt,
+		RetryAt:             b.retryAt,
+		LastError:           b.lastError,
+	}
+}
+
+// Wait 阻塞到熔断器可以放行请求：打开期间等待到半开，半开且已有探测时等待探测结束。
+// 同步任务在请求前调用它，上游故障时暂停而不是逐个失败。
+func (b *CircuitBreaker) Wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := b.now()
+		b.advanceLocked(now)
+		ready := b.state == CircuitClosed || (b.state == CircuitHalfOpen && !b.probing)
+		changed := b.changed
+		wait := time.Duration(0)
+		if b.state == CircuitOpen {
+			wait = b.retryAt.Sub(now)
+		}
+		b.mu.Unlock()
+		if ready {
+			return nil
+		}
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			timeout = timer.C
+		}
+		select {
+		case <-ctx.Done():
+			err := ctx.Err()
+			if timer != nil {
+				timer.Stop()
+			}
+			return err
+		case <-changed:
+		case <-timeout:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// AbsorbOutage 判断失败的请求是否应当等熔断器放行后重发，而不是交给调用方的重试逻辑。
+// 熔断器已打开（包括本次失败触发打开、或本次是失败的探测）时总是重发；熔断器仍关闭时，
+// 同一请求最多重发阈值次，仍未触发熔断说明故障只出现在这个请求上，交回调用方按重试策略处理。
+// absorbed 是该请求已经重发的次数。
+func (b *CircuitBreaker) AbsorbOutage(err error, absorbed int) bool {
+	if errors.Is(err, ErrCircuitOpen) {
+		return true
+	}
+	if errors.Is(err, context.Canceled) || !isCircuitFailure(err) {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state != CircuitClosed {
+		return true
+	}
+	return absorbed < b.threshold
+}
+
+// advanceLocked 在打开超时后切到半开。
+func (b *CircuitBreaker) advanceLocked(now time.Time) {
+	if b.state == CircuitOpen && !now.Before(b.retryAt) {
+		b.transitionLocked(CircuitHalfOpen)
+	}
+}
+
+func (b *CircuitBreaker) transitionLocked(to CircuitState) {
+	from := b.state
+	if from == to {
+		return
+	}
+	b.state = to
+	b.notifyLocked()
+	if b.onStateChange != nil {
+		b.onStateChange(from, to)
+	}
+}
+
+func (b *CircuitBreaker) notifyLocked() {
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+// allow 判断本次调用能否发往上游；probe 为 true 表示这是半开状态下的探测请求。
+func (b *CircuitBreaker) allow() (probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advanceLocked(b.now())
+	switch b.state {
+	case CircuitClosed:
+		return false, nil
+	case CircuitHalfOpen:
+		if b.probing {
+			return false, ErrCircuitOpen
+		}
+		b.probing = true
+		return true, nil
+	default:
+		return false, ErrCircuitOpen
+	}
+}
+
+func (b *CircuitBreaker) record(probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		b.probing = false
+		b.notifyLocked()
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		// 调用方取消不代表上游故障。
+		return
+	case !isCircuitFailure(err):
+		b.failures = 0
+		if probe {
+			b.transitionLocked(CircuitClosed)
+			slog.Info("Npan 服务已恢复，熔断器关闭")
+		}
+		return
+	}
+
+	b.failures++
+	b.lastError = err.Error()
+	if probe || (b.state == CircuitClosed && b.failures >= b.threshold) {
+		now := b.now()
+		b.openedAt = now
+		b.retryAt = now.Add(b.openTimeout)
+		b.transitionLocked(CircuitOpen)
+		slog.Warn("Npan 服务连续失败，熔断器打开", "failures", b.failures, "retry_at", b.retryAt, "error", err)
+	}
+}
+
+// isCircuitFailure 判断错误是否说明上游不可用：网络错误、超时和 5xx 计入失败，
+// 4xx（包括 429 限流）和带 Retry-After 的 503 说明上游仍在响应，不计入。
+func isCircuitFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Status >= 500 && statusErr.RetryAfter <= 0
+	}
+	return true
+}
+
+type circuitAPI struct {
+	api     API
+	breaker *CircuitBreaker
+}
+
+func guard[T any](b *CircuitBreaker, call func() (T, error)) (T, error) {
+	probe, err := b.allow()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	result, err := call()
+	b.record(probe, err)
+	return result, err
+}
+
+func (c *circuitAPI) ListFolderChildren(ctx context.Context, folderID int64, pageID int64) (models.FolderChildrenPage, error) {
+	return guard(c.breaker, func() (models.FolderChildrenPage, error) {
+		return c.api.ListFolderChildren(ctx, folderID, pageID)
+	})
+}
+
+func (c *circuitAPI) GetFolderInfo(ctx context.Context, folderID int64) (models.NpanFolder, error) {
+	return guard(c.breaker, func() (models.NpanFolder, error) {
+		return c.api.GetFolderInfo(ctx, folderID)
+	})
+}
+
+func (c *circuitAPI) GetDownloadURL(ctx context.Context, fileID int64, validPeriod *int64) (models.DownloadURLResult, error) {
+	return guard(c.breaker, func() (models.DownloadURLResult, error) {
+		return c.api.GetDownloadURL(ctx, fileID, validPeriod)
+	})
+}
+
+func (c *circuitAPI) SearchUpdatedWindow(ctx context.Context, queryWords string, start *int64, end *int64, pageID int64) (map[string]any, error) {
+	return guard(c.breaker, func() (map[string]any, error) {
+		return c.api.SearchUpdatedWindow(ctx, queryWords, start, end, pageID)
+	})
+}
+
+func (c *circuitAPI) ListUserDepartments(ctx context.Context) ([]models.NpanDepartment, error) {
+	return guard(c.breaker, func() ([]models.NpanDepartment, error) {
+		return c.api.ListUserDepartments(ctx)
+	})
+}
+
+func (c *circuitAPI) ListDepartmentFolders(ctx context.Context, departmentID int64) ([]models.NpanFolder, error) {
+	return guard(c.breaker, func() ([]models.NpanFolder, error) {
+		return c.api.ListDepartmentFolders(ctx, departmentID)
+	})
+}
+
+func (c *circuitAPI) SearchItems(ctx context.Context, params models.RemoteSearchParams) (models.RemoteSearchResponse, error) {
+	return guard(c.breaker, func() (models.RemoteSearchResponse, error) {
+		return c.api.SearchItems(ctx, params)
+	})
+}
@@ -0,0 +1,193 @@
+package npan
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"npan/internal/models"
+)
+
+type breakerStubAPI struct {
+	API
+	mu    sync.Mutex
+	calls int
+	err   error
+}
+
+func (s *breakerStubAPI) GetFolderInfo(_ context.Context, folderID int64) (models.NpanFolder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	if s.err != nil {
+		return models.NpanFolder{}, s.err
+	}
+	return models.NpanFolder{ID: folderID}, nil
+}
+
+func (s *breakerStubAPI) setErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func (s *breakerStubAPI) callCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+func newTestBreaker(threshold int, now *time.Time) (*CircuitBreaker, *[]CircuitState) {
+	transitions := &[]CircuitState{}
+	breaker := NewCircuitBreaker(CircuitBreakerOptions{
+		FailureThreshold: threshold,
+		OpenTimeout:      10 * time.Second,
+		OnStateChange: func(_ CircuitState, to CircuitState) {
+			*transitions = append(*transitions, to)
+		},
+	})
+	breaker.now = func() time.Time { return *now }
+	return breaker, transitions
+}
+
+func TestCircuitBreaker_OpensAfterThresholdAndFailsFast(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	breaker, transitions := newTestBreaker(3, &now)
+	stub := &breakerStubAPI{err: &StatusError{Status: http.StatusBadGateway, Message: "bad gateway"}}
+	api := breaker.Wrap(stub)
+
+	for i := 0; i < 3; i++ {
+		if _, err := api.GetFolderInfo(context.Background(), 1); errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("call %d should reach upstream, got %v", i, err)
+		}
+	}
+	if state := breaker.Snapshot().State; state != CircuitOpen {
+		t.Fatalf("expected open after 3 failures, got %s", state)
+	}
+
+	if _, err := api.GetFolderInfo(context.Background(), 1); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if stub.callCount() != 3 {
+		t.Fatalf("open breaker must not call upstream, calls=%d", stub.callCount())
+	}
+	if len(*transitions) != 1 || (*transitions)[0] != CircuitOpen {
+		t.Fatalf("unexpected transitions: %v", *transitions)
+	}
+}
+
+func TestCircuitBreaker_IgnoresClientErrorsAndThrottling(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	breaker, _ := newTestBreaker(2, &now)
+	stub := &breakerStubAPI{}
+	api := breaker.Wrap(stub)
+
+	for _, err := range []error{
+		&StatusError{Status: http.StatusNotFound, Message: "missing"},
+		&StatusError{Status: http.StatusTooManyRequests, Message: "slow down"},
+		&StatusError{Status: http.StatusServiceUnavailable, Message: "busy", RetryAfter: time.Second},
+		context.Canceled,
+	} {
+		stub.setErr(err)
+		_, _ = api.GetFolderInfo(context.Background(), 1)
+	}
+	if state := breaker.Snapshot().State; state != CircuitClosed {
+		t.Fatalf("expected closed, got %s", state)
+	}
+}
+
+func TestCircuitBreaker_HalfOpenProbe(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	breaker, transitions := newTestBreaker(1, &now)
+	stub := &breakerStubAPI{err: errors.New("connection refused")}
+	api := breaker.Wrap(stub)
+
+	_, _ = api.GetFolderInfo(context.Background(), 1)
+	if state := breaker.Snapshot().State; state != CircuitOpen {
+		t.Fatalf("expected open, got %s", state)
+	}
+
+	// 探测失败重新打开，重新计时。
+	now = now.Add(10 * time.Second)
+	if _, err := api.GetFolderInfo(context.Background(), 1); errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("half-open probe should reach upstream")
+	}
+	snapshot := breaker.Snapshot()
+	if snapshot.State != CircuitOpen || !snapshot.RetryAt.Equal(now.Add(10*time.Second)) {
+		t.Fatalf("failed probe should reopen, got %+v", snapshot)
+	}
+
+	// 探测期间其他请求仍被拒绝，探测成功后关闭。
+	now = now.Add(10 * time.Second)
+	probe, err := breaker.allow()
+	if err != nil || !probe {
+		t.Fatalf("expected probe slot, probe=%v err=%v", probe, err)
+	}
+	if _, err := api.GetFolderInfo(context.Background(), 1); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("concurrent call during probe should fail fast, got %v", err)
+	}
+	breaker.record(true, nil)
+	if state := breaker.Snapshot().State; state != CircuitClosed {
+		t.Fatalf("expected closed after successful probe, got %s", state)
+	}
+
+	want := []CircuitState{CircuitOpen, CircuitHalfOpen, CircuitOpen, CircuitHalfOpen, CircuitClosed}
+	if len(*transitions) != len(want) {
+		t.Fatalf("transitions = %v, want %v", *transitions, want)
+	}
+	for i := range want {
+		if (*transitions)[i] != want[i] {
+			t.Fatalf("transitions = %v, want %v", *transitions, want)
+		}
+	}
+}
+
+func TestCircuitBreaker_WaitBlocksUntilRecovered(t *testing.T) {
+	t.Parallel()
+
+	breaker := NewCircuitBreaker(CircuitBreakerOptions{FailureThreshold: 1, OpenTimeout: 30 * time.Millisecond})
+	stub := &breakerStubAPI{err: errors.New("connection refused")}
+	api := breaker.Wrap(stub)
+	_, _ = api.GetFolderInfo(context.Background(), 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if err := breaker.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected Wait to block while open, got %v", err)
+	}
+
+	started := time.Now()
+	if err := breaker.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if elapsed := time.Since(started); elapsed < 10*time.Millisecond {
+		t.Fatalf("Wait returned before open timeout: %v", elapsed)
+	}
+	if state := breaker.Snapshot().State; state != CircuitHalfOpen {
+		t.Fatalf("expected half-open after timeout, got %s", state)
+	}
+}
+
+func TestCircuitBreaker_WrapIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	breaker := NewCircuitBreaker(CircuitBreakerOptions{})
+	stub := &breakerStubAPI{}
+	wrapped := breaker.Wrap(stub)
+	if breaker.Wrap(wrapped) != wrapped {
+		t.Fatal("wrapping twice with the same breaker should be a no-op")
+	}
+	var nilBreaker *CircuitBreaker
+	if nilBreaker.Wrap(stub) != API(stub) {
+		t.Fatal("nil breaker should return api unchanged")
+	}
+}
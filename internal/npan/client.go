@@ -20,6 +20,9 @@ type HTTPClientOptions struct {
 	Token          string
 	TokenRefresher func(ctx context.Context) (string, error)
 	Client         *http.Client
+
+	// TokenSource 非空时每次请求从中取 token，401 后由它刷新，Token 与 TokenRefresher 不再使用。
+	TokenSource TokenSource
 }
 
 type HTTPClient struct {
@@ -29,6 +32,8 @@ type HTTPClient struct {
 	client         *http.Client
 	mu             sync.RWMutex
 	refreshMu      sync.Mutex
+
+	tokenSource TokenSource
 }
 
 func NewHTTPClient(options HTTPClientOptions) *HTTPClient {
@@ -42,6 +47,8 @@ func NewHTTPClient(options HTTPClientOptions) *HTTPClient {
 		token:          strings.TrimSpace(options.Token),
 		tokenRefresher: options.TokenRefresher,
 		client:         httpClient,
+
+		tokenSource: options.TokenSource,
 	}
 }
 
@@ -88,7 +95,31 @@ func readStatusError(resp *http.Response, fallback string) error {
 	if message == "" {
 		message = fallback
 	}
-	return &StatusError{Status: resp.StatusCode, Message: fmt.Sprintf("HTTP %d: %s", resp.StatusCode, message)}
+	return &StatusError{
+		Status:     resp.StatusCode,
+		Message:    fmt.Sprintf("HTTP %d: %s", resp.StatusCode, message),
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+}
+
+// parseRetryAfter 解析秒数或 HTTP 日期两种格式的 Retry-After，无法解析或已过期时返回 0。
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := at.Sub(now); wait > 0 {
+			return wait
+		}
+	}
+	return 0
 }
 
 func toInt64(input any, fallback int64) int64 {
@@ -135,6 +166,13 @@ func (c *HTTPClient) request(ctx context.Context, method string, path string, qu
 	attempt := 0
 	for {
 		token := c.getToken()
+		if c.tokenSource != nil {
+			sourced, err := c.tokenSource.Token(ctx)
+			if err != nil {
+				return fmt.Errorf("获取 token 失败: %w", err)
+			}
+			token = sourced
+		}
 
 		req, err := http.NewRequestWithContext(ctx, method, fullURL, nil)
 		if err != nil {
@@ -149,6 +187,15 @@ func (c *HTTPClient) request(ctx context.Context, method string, path string, qu
 			return err
 		}
 
+		if resp.StatusCode == http.StatusUnauthorized && attempt == 0 && c.tokenSource != nil {
+			_ = resp.Body.Close()
+			if _, refreshErr := c.tokenSource.Invalidate(ctx, token); refreshErr != nil {
+				return fmt.Errorf("请求返回 401，且刷新 token 失败: %w", refreshErr)
+			}
+			attempt++
+			continue
+		}
+
 		if resp.StatusCode == http.StatusUnauthorized && attempt == 0 && c.tokenRefresher != nil {
 			_ = resp.Body.Close()
 			if _, refreshErr := c.refreshToken(ctx, token); refreshErr != nil {
@@ -0,0 +1,396 @@
+package npan
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"npan/internal/models"
+)
+
+// TokenSource 为 HTTPClient 提供当前有效的 token，并在 token 被上游拒绝后换取新 token。
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+	Invalidate(ctx context.Context, rejected string) (string, error)
+}
+
+// TokenStore 持久化加密后的 token，供服务重启与 CLI 复用。Load 在没有记录时返回 nil。
+type TokenStore interface {
+	Load(key string) (*models.StoredOAuthToken, error)
+	Save(token models.StoredOAuthToken) error
+}
+
+// TokenState 是托管 token 的状态。
+type TokenState string
+
+const (
+	TokenStateMissing  TokenState = "missing"
+	TokenStateValid    TokenState = "valid"
+	TokenStateExpiring TokenState = "expiring"
+	TokenStateExpired  TokenState = "expired"
+)
+
+const (
+	defaultTokenRefreshBefore = 5 * time.Minute
+	// 刷新失败后的重试间隔。
+	tokenRefreshRetryInterval = 30 * time.Second
+	// 上游未返回 expires_in 时无法提前刷新，只定期检查。
+	tokenUnknownExpiryCheck = time.Hour
+)
+
+type TokenManagerOptions struct {
+	Auth   AuthResolverOptions
+	Store  TokenStore
+	Client *http.Client
+	// RefreshBefore 是过期前多久开始刷新。
+	RefreshBefore time.Duration
+	// EncryptionKey 用于加密持久化的 token，为空时使用 client_secret。
+	EncryptionKey string
+}
+
+// TokenStatus 是 token 管理器的状态快照。
+type TokenStatus struct {
+	State        TokenState
+	ExpiresAt    time.Time
+	RefreshedAt  time.Time
+	Source       string
+	RefreshCount int64
+	LastError    string
+	LastErrorAt  time.Time
+}
+
+// TokenManager 缓存服务端凭据换取的 token 及其过期时间，在过期前由后台提前刷新，
+// 避免长时间同步每次都先吃一个 401 再抢刷新锁。
+type TokenManager struct {
+	request       TokenRequestOptions
+	store         TokenStore
+	client        *http.Client
+	refreshBefore time.Duration
+	key           string
+	aead          cipher.AEAD
+	now           func() time.Time
+
+	refreshMu sync.Mutex
+
+	mu           sync.Mutex
+	token        string
+	expiresAt    time.Time
+	refreshedAt  time.Time
+	source       string
+	refreshCount int64
+	lastError    string
+	lastErrorAt  time.Time
+	changed      chan struct{}
+}
+
+func NewTokenManager(options TokenManagerOptions) (*TokenManager, error) {
+	if !CanAutoRefresh(options.Auth) {
+		return nil, fmt.Errorf("缺少认证参数: client_id/client_secret/sub_id")
+	}
+	request := TokenRequestOptions{
+		OAuthHost:    normalizeOAuthHost(options.Auth.OAuthHost),
+		ClientID:     strings.TrimSpace(options.Auth.ClientID),
+		ClientSecret: strings.TrimSpace(options.Auth.ClientSecret),
+		SubID:        options.Auth.SubID,
+		SubType:      normalizeSubType(options.Auth.SubType),
+	}
+	refreshBefore := options.RefreshBefore
+	if refreshBefore <= 0 {
+		refreshBefore = defaultTokenRefreshBefore
+	}
+	encryptionKey := options.EncryptionKey
+	if strings.TrimSpace(encryptionKey) == "" {
+		encryptionKey = request.ClientSecret
+	}
+	aead, err := newTokenAEAD(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &TokenManager{
+		request:       request,
+		store:         options.Store,
+		client:        options.Client,
+		refreshBefore: refreshBefore,
+		key:           tokenStoreKey(request),
+		aead:          aead,
+		now:           time.Now,
+		changed:       make(chan struct{}),
+	}
+	m.loadStored()
+	return m, nil
+}
+
+// Matches 判断认证参数是否与托管凭据一致；调用方显式提供 token 时不使用托管 token。
+func (m *TokenManager) Matches(options AuthResolverOptions) bool {
+	if m == nil || strings.TrimSpace(options.Token) != "" {
+		return false
+	}
+	return normalizeOAuthHost(options.OAuthHost) == m.request.OAuthHost &&
+		strings.TrimSpace(options.ClientID) == m.request.ClientID &&
+		strings.TrimSpace(options.ClientSecret) == m.request.ClientSecret &&
+		options.SubID == m.request.SubID &&
+		normalizeSubType(options.SubType) == m.request.SubType
+}
+
+// Token 返回缓存的 token；没有 token 或已进入提前刷新窗口时同步刷新。
+func (m *TokenManager) Token(ctx context.Context) (string, error) {
+	m.mu.Lock()
+	token := m.token
+	fresh := token != "" && !m.dueLocked(m.now())
+	m.mu.Unlock()
+	if fresh {
+		return token, nil
+	}
+	return m.refresh(ctx, token)
+}
+
+// Invalidate 在 token 被上游拒绝后刷新；其他调用方已经换过 token 时直接返回新 token。
+func (m *TokenManager) Invalidate(ctx context.Context, rejected string) (string, error) {
+	return m.refresh(ctx, rejected)
+}
+
+// Run 在后台按过期时间提前刷新 token，直到 ctx 结束。
+func (m *TokenManager) Run(ctx context.Context) {
+	for {
+		m.mu.Lock()
+		delay := m.refreshDelayLocked(m.now())
+		changed := m.changed
+		m.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-changed:
+			timer.Stop()
+			continue
+		case <-timer.C:
+		}
+
+		m.mu.Lock()
+		token := m.token
+		due := token == "" || m.dueLocked(m.now())
+		m.mu.Unlock()
+		if !due {
+			continue
+		}
+		if _, err := m.refresh(ctx, token); err != nil && ctx.Err() == nil {
+			slog.Warn("后台刷新 token 失败", "error", err)
+		}
+	}
+}
+
+// Status 返回当前 token 状态。
+func (m *TokenManager) Status() TokenStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	state := TokenStateValid
+	switch {
+	case m.token == "":
+		state = TokenStateMissing
+	case !m.expiresAt.IsZero() && !now.Before(m.expiresAt):
+		state = TokenStateExpired
+	case m.dueLocked(now):
+		state = TokenStateExpiring
+	}
+	return TokenStatus{
+		State:        state,
+		ExpiresAt:    m.expiresAt,
+		RefreshedAt:  m.refreshedAt,
+		Source:       m.source,
+		RefreshCount: m.refreshCount,
+		LastError:    m.lastError,
+		LastErrorAt:  m.lastErrorAt,
+	}
+}
+
+// dueLocked 判断 token 是否已进入提前刷新窗口；未知过期时间的 token 只在被拒绝后刷新。
+func (m *TokenManager) dueLocked(now time.Time) bool {
+	if m.expiresAt.IsZero() {
+		return false
+	}
+	return !now.Before(m.refreshAtLocked())
+}
+
+// refreshAtLocked 返回开始提前刷新的时间；有效期短于两倍提前量时在有效期过半时刷新。
+func (m *TokenManager) refreshAtLocked() time.Time {
+	lead := m.refreshBefore
+	if lifetime := m.expiresAt.Sub(m.refreshedAt); lifetime > 0 && lead > lifetime/2 {
+		lead = lifetime / 2
+	}
+	return m.expiresAt.Add(-lead)
+}
+
+func (m *TokenManager) refreshDelayLocked(now time.Time) time.Duration {
+	switch {
+	case m.token == "" && m.lastErrorAt.IsZero():
+		// 启动后还没有 token 时立即获取。
+		return 0
+	case m.token == "" || m.lastErrorAt.After(m.refreshedAt):
+		return tokenRefreshRetryInterval
+	case m.expiresAt.IsZero():
+		return tokenUnknownExpiryCheck
+	}
+	delay := m.refreshAtLocked().Sub(now)
+	if delay < time.Second {
+		delay = time.Second
+	}
+	return delay
+}
+
+// refresh 串行换取新 token；stale 是调用方认为已失效的 token，等锁期间已被换掉时直接复用新 token。
+func (m *TokenManager) refresh(ctx context.Context, stale string) (string, error) {
+	m.refreshMu.Lock()
+	defer m.refreshMu.Unlock()
+
+	m.mu.Lock()
+	current := m.token
+	if current != "" && current != stale && !m.dueLocked(m.now()) {
+		m.mu.Unlock()
+		return current, nil
+	}
+	m.mu.Unlock()
+
+	resp, err := RequestAccessToken(ctx, m.client, m.request)
+	if err == nil && strings.TrimSpace(resp.AccessToken) == "" {
+		err = fmt.Errorf("刷新 token 失败: access_token 为空")
+	}
+	if err != nil {
+		m.mu.Lock()
+		m.lastError = err.Error()
+		m.lastErrorAt = m.now()
+		m.mu.Unlock()
+		return "", err
+	}
+
+	now := m.now()
+	token := strings.TrimSpace(resp.AccessToken)
+	var expiresAt time.Time
+	if resp.ExpiresIn > 0 {
+		expiresAt = now.Add(time.Duration(resp.ExpiresIn) * time.Second)
+	}
+
+	m.mu.Lock()
+	m.token = token
+	m.expiresAt = expiresAt
+	m.refreshedAt = now
+	m.source = "oauth"
+	m.refreshCount++
+	m.lastError = ""
+	close(m.changed)
+	m.changed = make(chan struct{})
+	m.mu.Unlock()
+
+	m.saveStored(token, expiresAt, now)
+	return token, nil
+}
+
+func (m *TokenManager) loadStored() {
+	if m.store == nil {
+		return
+	}
+	stored, err := m.store.Load(m.key)
+	if err != nil {
+		slog.Warn("读取已保存的 token 失败", "error", err)
+		return
+	}
+	if stored == nil {
+		return
+	}
+	token, err := m.decrypt(stored.Ciphertext)
+	if err != nil {
+		// 密钥变更后无法解密，忽略旧记录，下次刷新时覆盖。
+		slog.Warn("已保存的 token 无法解密，将重新获取", "error", err)
+		return
+	}
+	var expiresAt time.Time
+	if stored.ExpiresAt > 0 {
+		expiresAt = time.UnixMilli(stored.ExpiresAt)
+		if !m.now().Before(expiresAt) {
+			return
+		}
+	}
+
+	m.mu.Lock()
+	m.token = token
+	m.expiresAt = expiresAt
+	m.refreshedAt = time.UnixMilli(stored.UpdatedAt)
+	m.source = "store"
+	m.mu.Unlock()
+}
+
+func (m *TokenManager) saveStored(token string, expiresAt time.Time, now time.Time) {
+	if m.store == nil {
+		return
+	}
+	ciphertext, err := m.encrypt(token)
+	if err != nil {
+		slog.Warn("加密 token 失败", "error", err)
+		return
+	}
+	record := models.StoredOAuthToken{
+		Key:        m.key,
+		Ciphertext: ciphertext,
+		UpdatedAt:  now.UnixMilli(),
+	}
+	if !expiresAt.IsZero() {
+		record.ExpiresAt = expiresAt.UnixMilli()
+	}
+	if err := m.store.Save(record); err != nil {
+		slog.Warn("保存 token 失败", "error", err)
+	}
+}
+
+// tokenStoreKey 由凭据中不含密钥的部分生成，凭据变更后不会读到旧 token。
+func tokenStoreKey(request TokenRequestOptions) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		request.OAuthHost,
+		request.ClientID,
+		string(request.SubType),
+		fmt.Sprintf("%d", request.SubID),
+	}, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+func newTokenAEAD(secret string) (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte("npan-oauth-token:" + secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (m *TokenManager) encrypt(token string) ([]byte, error) {
+	nonce := make([]byte, m.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return m.aead.Seal(nonce, nonce, []byte(token), []byte(m.key)), nil
+}
+
+func (m *TokenManager) decrypt(ciphertext []byte) (string, error) {
+	size := m.aead.NonceSize()
+	if len(ciphertext) < size {
+		return "", errors.New("密文长度不足")
+	}
+	plaintext, err := m.aead.Open(nil, ciphertext[:size], ciphertext[size:], []byte(m.key))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
@@ -0,0 +1,243 @@
+package npan
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"npan/internal/models"
+)
+
+type memoryTokenStore struct {
+	mu     sync.Mutex
+	tokens map[string]models.StoredOAuthToken
+}
+
+func (s *memoryTokenStore) Load(key string) (*models.StoredOAuthToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token, ok := s.tokens[key]
+	if !ok {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+func (s *memoryTokenStore) Save(token models.StoredOAuthToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tokens == nil {
+		s.tokens = map[string]models.StoredOAuthToken{}
+	}
+	s.tokens[token.Key] = token
+	return nil
+}
+
+func newOAuthTestServer(t *testing.T, expiresIn int64) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var issued atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/oauth/token" {
+			http.NotFound(w, r)
+			return
+		}
+		n := issued.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":%d}`, n, expiresIn)
+	}))
+	t.Cleanup(server.Close)
+	return server, &issued
+}
+
+func testTokenAuth(oauthHost string) AuthResolverOptions {
+	return AuthResolverOptions{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		SubID:        42,
+		SubType:      TokenSubjectUser,
+		OAuthHost:    oauthHost,
+	}
+}
+
+func TestTokenManager_CachesUntilRefreshWindow(t *testing.T) {
+	t.Parallel()
+
+	server, issued := newOAuthTestServer(t, 3600)
+	manager, err := NewTokenManager(TokenManagerOptions{Auth: testTokenAuth(server.URL), RefreshBefore: 5 * time.Minute})
+	if err != nil {
+		t.Fatalf("NewTokenManager: %v", err)
+	}
+	now := time.Unix(10_000, 0)
+	manager.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		token, err := manager.Token(context.Background())
+		if err != nil || token != "token-1" {
+			t.Fatalf("Token() = %q, %v", token, err)
+		}
+	}
+	if issued.Load() != 1 {
+		t.Fatalf("expected cached token to be reused, issued=%d", issued.Load())
+	}
+	status := manager.Status()
+	if status.State != TokenStateValid || !status.ExpiresAt.Equal(now.Add(time.Hour)) || status.Source != "oauth" {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+
+	now = now.Add(56 * time.Minute)
+	if got := manager.Status().State; got != TokenStateExpiring {
+		t.Fatalf("expected expiring state inside refresh window, got %s", got)
+	}
+	token, err := manager.Token(context.Background())
+	if err != nil || token != "token-2" {
+		t.Fatalf("expected proactive refresh, got %q, %v", token, err)
+	}
+}
+
+func TestTokenManager_InvalidateSkipsWhenAlreadyRefreshed(t *testing.T) {
+	t.Parallel()
+
+	server, issued := newOAuthTestServer(t, 3600)
+	manager, err := NewTokenManager(TokenManagerOptions{Auth: testTokenAuth(server.URL)})
+	if err != nil {
+		t.Fatalf("NewTokenManager: %v", err)
+	}
+
+	first, _ := manager.Token(context.Background())
+	second, err := manager.Invalidate(context.Background(), first)
+	if err != nil || second == first {
+		t.Fatalf("expected rejected token to be replaced, got %q, %v", second, err)
+	}
+	again, err := manager.Invalidate(context.Background(), first)
+	if err != nil || again != second {
+		t.Fatalf("expected stale rejection to reuse current token, got %q, %v", again, err)
+	}
+	if issued.Load() != 2 {
+		t.Fatalf("expected 2 token requests, got %d", issued.Load())
+	}
+}
+
+func TestTokenManager_PersistsEncryptedToken(t *testing.T) {
+	t.Parallel()
+
+	server, issued := newOAuthTestServer(t, 3600)
+	store := &memoryTokenStore{}
+	first, err := NewTokenManager(TokenManagerOptions{Auth: testTokenAuth(server.URL), Store: store})
+	if err != nil {
+		t.Fatalf("NewTokenManager: %v", err)
+	}
+	if _, err := first.Token(context.Background()); err != nil {
+		t.Fatalf("Token: %v", err)
+	}
+	for _, stored := range store.tokens {
+		if bytes.Contains(stored.Ciphertext, []byte("token-1")) {
+			t.Fatal("stored token must be encrypted")
+		}
+	}
+
+	restarted, err := NewTokenManager(TokenManagerOptions{Auth: testTokenAuth(server.URL), Store: store})
+	if err != nil {
+		t.Fatalf("NewTokenManager: %v", err)
+	}
+	token, err := restarted.Token(context.Background())
+	if err != nil || token != "token-1" || restarted.Status().Source != "store" {
+		t.Fatalf("expected stored token to be reused, got %q, %v, %+v", token, err, restarted.Status())
+	}
+	if issued.Load() != 1 {
+		t.Fatalf("expected restart to skip token request, issued=%d", issued.Load())
+	}
+
+	rekeyed, err := NewTokenManager(TokenManagerOptions{Auth: testTokenAuth(server.URL), Store: store, EncryptionKey: "another-key"})
+	if err != nil {
+		t.Fatalf("NewTokenManager: %v", err)
+	}
+	if got := rekeyed.Status().State; got != TokenStateMissing {
+		t.Fatalf("expected undecryptable token to be ignored, got %s", got)
+	}
+}
+
+func TestTokenManager_RunRefreshesBeforeExpiry(t *testing.T) {
+	t.Parallel()
+
+	server, issued := newOAuthTestServer(t, 2)
+	manager, err := NewTokenManager(TokenManagerOptions{Auth: testTokenAuth(server.URL), RefreshBefore: 2 * time.Second})
+	if err != nil {
+		t.Fatalf("NewTokenManager: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go manager.Run(ctx)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for issued.Load() < 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected background refresh, issued=%d", issued.Load())
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if status := manager.Status(); status.RefreshCount < 2 {
+		t.Fatalf("expected refresh count to grow, got %+v", status)
+	}
+}
+
+func TestHTTPClient_TokenSourceRefreshesAfter401(t *testing.T) {
+	t.Parallel()
+
+	oauth, _ := newOAuthTestServer(t, 3600)
+	manager, err := NewTokenManager(TokenManagerOptions{Auth: testTokenAuth(oauth.URL)})
+	if err != nil {
+		t.Fatalf("NewTokenManager: %v", err)
+	}
+
+	var seen []string
+	var mu sync.Mutex
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen = append(seen, r.Header.Get("Authorization"))
+		mu.Unlock()
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":7,"name":"root"}`))
+	}))
+	defer api.Close()
+
+	client := NewHTTPClient(HTTPClientOptions{BaseURL: api.URL, TokenSource: manager})
+	folder, err := client.GetFolderInfo(context.Background(), 7)
+	if err != nil || folder.ID != 7 {
+		t.Fatalf("GetFolderInfo = %+v, %v", folder, err)
+	}
+	if len(seen) != 2 || seen[1] != "Bearer token-2" {
+		t.Fatalf("expected retry with refreshed token, got %v", seen)
+	}
+}
+
+func TestTokenManager_Matches(t *testing.T) {
+	t.Parallel()
+
+	manager, err := NewTokenManager(TokenManagerOptions{Auth: testTokenAuth("")})
+	if err != nil {
+		t.Fatalf("NewTokenManager: %v", err)
+	}
+	options := testTokenAuth(DefaultOAuthHost)
+	if !manager.Matches(options) {
+		t.Fatal("expected config credentials to match")
+	}
+	options.Token = "explicit"
+	if manager.Matches(options) {
+		t.Fatal("explicit token must not use the managed token")
+	}
+	options = testTokenAuth("")
+	options.SubID = 7
+	if manager.Matches(options) {
+		t.Fatal("different subject must not match")
+	}
+}
@@ -2,6 +2,7 @@ package npan
 
 import (
 	"context"
+	"time"
 
 	"npan/internal/models"
 )
@@ -19,6 +20,8 @@ type API interface {
 type StatusError struct {
 	Status  int
 	Message string
+	// RetryAfter 是响应 Retry-After 头给出的等待时长，未提供时为 0。
+	RetryAfter time.Duration
 }
 
 func (e *StatusError) Error() string {
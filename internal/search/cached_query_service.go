@@ -35,6 +35,9 @@ func cacheKey(p models.LocalSearchParams) string {
   if p.ParentID != nil {
     fmt.Fprintf(&b, "|p%d", *p.ParentID)
   }
+  if p.WithinFolderID != nil {
+    fmt.Fprintf(&b, "|w%d", *p.WithinFolderID)
+  }
   if p.UpdatedAfter != nil {
     fmt.Fprintf(&b, "|a%d", *p.UpdatedAfter)
   }
@@ -44,6 +47,9 @@ func cacheKey(p models.LocalSearchParams) string {
   if p.IncludeDeleted {
     b.WriteString("|d")
   }
+  if p.TenantID != "" {
+    fmt.Fprintf(&b, "|t%s", p.TenantID)
+  }
 
   return b.String()
 }
@@ -0,0 +1,48 @@
+package search
+
+import (
+	"sort"
+
+	"npan/internal/models"
+)
+
+// duplicateCounter 按 SHA1 累计文档数与最大文件大小。
+type duplicateCounter map[string]*models.DuplicateSHA1Group
+
+func (c duplicateCounter) add(doc models.IndexDocument) {
+	if doc.SHA1 == "" {
+		return
+	}
+	group := c[doc.SHA1]
+	if group == nil {
+		group = &models.DuplicateSHA1Group{SHA1: doc.SHA1}
+		c[doc.SHA1] = group
+	}
+	group.Copies++
+	group.Size = max(group.Size, doc.Size)
+}
+
+// summary 只保留出现不止一次的 SHA1，按浪费空间从大到小取前 limit 组；limit <= 0 时不截断。
+// 分组总数与浪费空间总量按截断前的全部分组计算。
+func (c duplicateCounter) summary(limit int) models.DuplicateSHA1Summary {
+	summary := models.DuplicateSHA1Summary{Groups: []models.DuplicateSHA1Group{}}
+	for _, group := range c {
+		if group.Copies <= 1 {
+			continue
+		}
+		summary.Groups = append(summary.Groups, *group)
+		summary.WastedBytes += group.WastedBytes()
+	}
+	sort.Slice(summary.Groups, func(i, j int) bool {
+		left, right := summary.Groups[i], summary.Groups[j]
+		if left.WastedBytes() != right.WastedBytes() {
+			return left.WastedBytes() > right.WastedBytes()
+		}
+		return left.SHA1 < right.SHA1
+	})
+	summary.TotalGroups = int64(len(summary.Groups))
+	if limit > 0 && len(summary.Groups) > limit {
+		summary.Groups = summary.Groups[:limit]
+	}
+	return summary
+}
@@ -20,16 +20,35 @@ type IndexOperator interface {
 	UpsertDocuments(ctx context.Context, docs []models.IndexDocument) error
 	DeleteDocuments(ctx context.Context, docIDs []string) error
 	DeleteAllDocuments(ctx context.Context) error
+	DeleteStaleDocuments(ctx context.Context, rootFolderID int64, generation int64) (int64, error)
+	// ListStaleDocumentIDs 返回 DeleteStaleDocuments 会删除的文档 ID，供变更日志记录逐文档的删除。
+	ListStaleDocumentIDs(ctx context.Context, rootFolderID int64, generation int64) ([]string, error)
 	Search(params models.LocalSearchParams) ([]models.IndexDocument, int64, error)
+	// GetDocuments 按文档 ID 读取文档，不存在的 ID 直接跳过。
+	GetDocuments(ctx context.Context, docIDs []string) ([]models.IndexDocument, error)
+	// UpdateDocumentPaths 只改写文档的 path_text 与 ancestor_ids，其余字段保持不变。
+	UpdateDocumentPaths(ctx context.Context, docs []models.IndexDocument) error
+	// CountDuplicateSHA1 统计 params 过滤范围内出现不止一次的 SHA1，按浪费空间从大到小最多列出 limit 组；
+	// 分组总数大于列出数量说明结果被截断。
+	CountDuplicateSHA1(ctx context.Context, params models.LocalSearchParams, limit int) (models.DuplicateSHA1Summary, error)
 	Ping() error
 	DocumentCount(ctx context.Context) (int64, error)
 }
 
 type MeiliIndex struct {
-	index meilisearch.IndexManager
+	index  meilisearch.IndexManager
+	client meilisearch.ServiceManager
+	name   string
+
+	// tenantID 非空时是 ForTenant 返回的租户视图。
+	tenantID string
 }
 
-const defaultTaskPollInterval = 100 * time.Millisecond
+const (
+	defaultTaskPollInterval = 100 * time.Millisecond
+	// meiliScanPageSize 是按文档接口遍历索引时每页读取的文档数。
+	meiliScanPageSize = 1000
+)
 
 func NewMeiliIndex(host string, apiKey string, indexName string) *MeiliIndex {
 	client := meilisearch.New(host,
@@ -37,28 +56,40 @@ func NewMeiliIndex(host string, apiKey string, indexName string) *MeiliIndex {
 		meilisearch.WithCustomJsonMarshaler(sonic.Marshal),
 		meilisearch.WithCustomJsonUnmarshaler(sonic.Unmarshal),
 	)
-	return &MeiliIndex{index: client.Index(indexName)}
+	return &MeiliIndex{index: client.Index(indexName), client: client, name: indexName}
 }
 
 func NewMeiliIndexFromManager(index meilisearch.IndexManager) *MeiliIndex {
 	return &MeiliIndex{index: index}
 }
 
+// ForTenant 返回只读写 tenantID 文档的视图，与原索引共享连接。
+func (m *MeiliIndex) ForTenant(tenantID string) IndexOperator {
+	scoped := *m
+	scoped.tenantID = tenantID
+	return &scoped
+}
+
 func (m *MeiliIndex) waitTask(ctx context.Context, taskInfo *meilisearch.TaskInfo) error {
+	_, err := m.waitTaskResult(ctx, taskInfo)
+	return err
+}
+
+func (m *MeiliIndex) waitTaskResult(ctx context.Context, taskInfo *meilisearch.TaskInfo) (*meilisearch.Task, error) {
 	if taskInfo == nil {
-		return fmt.Errorf("meilisearch 未返回 task 信息")
+		return nil, fmt.Errorf("meilisearch 未返回 task 信息")
 	}
 
 	task, err := m.index.WaitForTaskWithContext(ctx, taskInfo.TaskUID, defaultTaskPollInterval)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if task.Status == meilisearch.TaskStatusSucceeded {
-		return nil
+		return task, nil
 	}
 
-	return fmt.Errorf(
+	return nil, fmt.Errorf(
 		"meilisearch task 执行失败: task_uid=%d status=%s code=%s message=%s",
 		taskInfo.TaskUID,
 		task.Status,
@@ -71,9 +102,9 @@ func (m *MeiliIndex) EnsureSettings(ctx context.Context) error {
 	taskInfo, err := m.index.UpdateSettingsWithContext(ctx, &meilisearch.Settings{
 		RankingRules:         []string{"words", "typo", "exactness", "proximity", "attribute", "modified_at:desc"},
 		SearchableAttributes: []string{"name_base", "name_ext", "name", "path_text"},
-		FilterableAttributes: []string{"type", "file_category", "parent_id", "modified_at", "in_trash", "is_deleted"},
+		FilterableAttributes: []string{"type", "file_category", "parent_id", "ancestor_ids", "modified_at", "in_trash", "is_deleted", "sync_root_id", "sync_generation", "sha1", "size", "tenant_id"},
 		SortableAttributes:   []string{"modified_at", "size", "created_at"},
-		DisplayedAttributes:  []string{"doc_id", "source_id", "type", "name", "name_base", "name_ext", "file_category", "path_text", "parent_id", "modified_at", "created_at", "size"},
+		DisplayedAttributes:  []string{"doc_id", "source_id", "type", "name", "name_base", "name_ext", "file_category", "path_text", "ancestor_ids", "parent_id", "modified_at", "created_at", "size", "tenant_id"},
 		StopWords:            []string{"的", "了", "在", "是", "和", "就", "都", "而", "及", "与"},
 		NonSeparatorTokens:   []string{"."},
 		TypoTolerance: &meilisearch.TypoTolerance{
@@ -99,7 +130,7 @@ func (m *MeiliIndex) UpsertDocuments(ctx context.Context, docs []models.IndexDoc
 	}
 
 	primaryKey := "doc_id"
-	taskInfo, err := m.index.AddDocumentsWithContext(ctx, docs, &meilisearch.DocumentOptions{PrimaryKey: &primaryKey})
+	taskInfo, err := m.index.AddDocumentsWithContext(ctx, tenantDocs(m.tenantID, docs), &meilisearch.DocumentOptions{PrimaryKey: &primaryKey})
 	if err != nil {
 		return err
 	}
@@ -111,14 +142,22 @@ func (m *MeiliIndex) DeleteDocuments(ctx context.Context, docIDs []string) error
 		return nil
 	}
 
-	taskInfo, err := m.index.DeleteDocumentsWithContext(ctx, docIDs, nil)
+	taskInfo, err := m.index.DeleteDocumentsWithContext(ctx, tenantDocIDs(m.tenantID, docIDs), nil)
 	if err != nil {
 		return err
 	}
 	return m.waitTask(ctx, taskInfo)
 }
 
+// DeleteAllDocuments 清空索引；租户视图只删除该租户的文档。
 func (m *MeiliIndex) DeleteAllDocuments(ctx context.Context) error {
+	if m.tenantID != "" {
+		taskInfo, err := m.index.DeleteDocumentsByFilterWithContext(ctx, meiliTenantFilter(m.tenantID), nil)
+		if err != nil {
+			return err
+		}
+		return m.waitTask(ctx, taskInfo)
+	}
 	taskInfo, err := m.index.DeleteAllDocumentsWithContext(ctx, nil)
 	if err != nil {
 		return err
@@ -126,6 +165,169 @@ func (m *MeiliIndex) DeleteAllDocuments(ctx context.Context) error {
 	return m.waitTask(ctx, taskInfo)
 }
 
+func (m *MeiliIndex) GetDocuments(ctx context.Context, docIDs []string) ([]models.IndexDocument, error) {
+	if len(docIDs) == 0 {
+		return []models.IndexDocument{}, nil
+	}
+
+	var result meilisearch.DocumentsResult
+	if err := m.index.GetDocumentsWithContext(ctx, &meilisearch.DocumentsQuery{
+		Ids:   tenantDocIDs(m.tenantID, docIDs),
+		Limit: int64(len(docIDs)),
+	}, &result); err != nil {
+		return nil, err
+	}
+	docs := make([]models.IndexDocument, 0, len(result.Results))
+	if err := result.Results.DecodeInto(&docs); err != nil {
+		return nil, err
+	}
+	stripTenantDocIDs(docs)
+	return docs, nil
+}
+
+// UpdateDocumentPaths 以部分更新写入路径字段。搜索结果不含 sha1、同步代次等未展示字段，
+// 整文档覆盖会把它们清空。
+func (m *MeiliIndex) UpdateDocumentPaths(ctx context.Context, docs []models.IndexDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	patches := make([]map[string]any, 0, len(docs))
+	for _, doc := range docs {
+		patches = append(patches, map[string]any{
+			"doc_id":       TenantDocID(m.tenantID, doc.DocID),
+			"path_text":    doc.PathText,
+			"ancestor_ids": doc.AncestorIDs,
+		})
+	}
+	primaryKey := "doc_id"
+	taskInfo, err := m.index.UpdateDocumentsWithContext(ctx, patches, &meilisearch.DocumentOptions{PrimaryKey: &primaryKey})
+	if err != nil {
+		return err
+	}
+	return m.waitTask(ctx, taskInfo)
+}
+
+// CountDuplicateSHA1 按页遍历范围内文档的 sha1 逐个计数。分面分布受 maxValuesPerFacet 限制，
+// 搜索分页受 maxTotalHits 限制，文档接口两者都不受，能覆盖全部分组。
+func (m *MeiliIndex) CountDuplicateSHA1(ctx context.Context, params models.LocalSearchParams, limit int) (models.DuplicateSHA1Summary, error) {
+	filters := append(buildMeiliFilters(tenantParams(m.tenantID, params)), "sha1 EXISTS", "sha1 != ''")
+	counter := duplicateCounter{}
+	for offset := int64(0); ; offset += meiliScanPageSize {
+		var result meilisearch.DocumentsResult
+		if err := m.index.GetDocumentsWithContext(ctx, &meilisearch.DocumentsQuery{
+			Filter: filters,
+			Fields: []string{"sha1", "size"},
+			Limit:  meiliScanPageSize,
+			Offset: offset,
+		}, &result); err != nil {
+			return models.DuplicateSHA1Summary{}, err
+		}
+		docs := make([]models.IndexDocument, 0, len(result.Results))
+		if err := result.Results.DecodeInto(&docs); err != nil {
+			return models.DuplicateSHA1Summary{}, err
+		}
+		for _, doc := range docs {
+			counter.add(doc)
+		}
+		if len(docs) < meiliScanPageSize {
+			break
+		}
+	}
+	return counter.summary(limit), nil
+}
+
+// DeleteStaleDocuments 删除某个同步根下代次早于 generation 或没有代次的文档，返回删除数量。
+// 按 ancestor_ids 覆盖整棵子树：旧版本、增量同步、路径改写等写入的文档没有代次字段，
+// 全量爬取没有再写到它们说明上游已不存在。
+func (m *MeiliIndex) DeleteStaleDocuments(ctx context.Context, rootFolderID int64, generation int64) (int64, error) {
+	taskInfo, err := m.index.DeleteDocumentsByFilterWithContext(ctx, meiliStaleFilter(rootFolderID, generation, m.tenantID), nil)
+	if err != nil {
+		return 0, err
+	}
+	task, err := m.waitTaskResult(ctx, taskInfo)
+	if err != nil {
+		return 0, err
+	}
+	return task.Details.DeletedDocuments, nil
+}
+
+func (m *MeiliIndex) ListStaleDocumentIDs(ctx context.Context, rootFolderID int64, generation int64) ([]string, error) {
+	filter := meiliStaleFilter(rootFolderID, generation, m.tenantID)
+	docIDs := []string{}
+	for offset := int64(0); ; offset += meiliScanPageSize {
+		var result meilisearch.DocumentsResult
+		if err := m.index.GetDocumentsWithContext(ctx, &meilisearch.DocumentsQuery{
+			Filter: filter,
+			Fields: []string{"doc_id"},
+			Limit:  meiliScanPageSize,
+			Offset: offset,
+		}, &result); err != nil {
+			return nil, err
+		}
+		docs := make([]models.IndexDocument, 0, len(result.Results))
+		if err := result.Results.DecodeInto(&docs); err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			docIDs = append(docIDs, stripTenantDocID(m.tenantID, doc.DocID))
+		}
+		if len(docs) < meiliScanPageSize {
+			return docIDs, nil
+		}
+	}
+}
+
+func meiliStaleFilter(rootFolderID int64, generation int64, tenantID string) string {
+	filter := fmt.Sprintf("(sync_root_id = %d OR ancestor_ids = %d) AND (sync_generation NOT EXISTS OR sync_generation < %d)", rootFolderID, rootFolderID, generation)
+	if tenantID != "" {
+		filter += " AND " + meiliTenantFilter(tenantID)
+	}
+	return filter
+}
+
+// ShadowIndex 返回蓝绿重建使用的影子索引 <name>_shadow。索引不存在时由设置更新自动创建，
+// reset 为 true 时清空其中的上一代文档。
+func (m *MeiliIndex) ShadowIndex(ctx context.Context, reset bool) (IndexOperator, string, error) {
+	if m.client == nil || m.tenantID != "" {
+		return nil, "", ErrRebuildUnsupported
+	}
+	name := m.name + shadowIndexSuffix
+	shadow := &MeiliIndex{index: m.client.Index(name), client: m.client, name: name}
+	if err := shadow.EnsureSettings(ctx); err != nil {
+		return nil, "", err
+	}
+	if reset {
+		if err := shadow.DeleteAllDocuments(ctx); err != nil {
+			return nil, "", err
+		}
+	}
+	return shadow, name, nil
+}
+
+// SwapShadow 通过 swapIndexes 原子交换线上索引与影子索引，交换后上一代文档留在影子索引中。
+func (m *MeiliIndex) SwapShadow(ctx context.Context) error {
+	return m.swapShadow(ctx)
+}
+
+// RollbackSwap 再次交换两个索引，让上一代文档重新接管线上索引名。
+func (m *MeiliIndex) RollbackSwap(ctx context.Context) error {
+	return m.swapShadow(ctx)
+}
+
+func (m *MeiliIndex) swapShadow(ctx context.Context) error {
+	if m.client == nil || m.tenantID != "" {
+		return ErrRebuildUnsupported
+	}
+	taskInfo, err := m.client.SwapIndexesWithContext(ctx, []*meilisearch.SwapIndexesParams{
+		{Indexes: []string{m.name, m.name + shadowIndexSuffix}},
+	})
+	if err != nil {
+		return err
+	}
+	return m.waitTask(ctx, taskInfo)
+}
+
 // knownExtensions 是常见文件扩展名集合，用于查询预处理。
 var knownExtensions = map[string]bool{
 	"pdf": true, "docx": true, "xlsx": true, "pptx": true,
@@ -199,7 +401,7 @@ func preprocessQuery(query string) string {
 	return reorderQuery(strings.Join(expanded, " "))
 }
 
-func (m *MeiliIndex) Search(params models.LocalSearchParams) ([]models.IndexDocument, int64, error) {
+func buildMeiliFilters(params models.LocalSearchParams) []string {
 	filters := make([]string, 0, 8)
 
 	if params.Type != "" && params.Type != "all" {
@@ -208,16 +410,37 @@ func (m *MeiliIndex) Search(params models.LocalSearchParams) ([]models.IndexDocu
 	if params.ParentID != nil {
 		filters = append(filters, fmt.Sprintf("parent_id = %d", *params.ParentID))
 	}
+	if params.WithinFolderID != nil && *params.WithinFolderID > 0 {
+		filters = append(filters, fmt.Sprintf("ancestor_ids = %d", *params.WithinFolderID))
+	}
 	if params.UpdatedAfter != nil {
 		filters = append(filters, fmt.Sprintf("modified_at >= %d", *params.UpdatedAfter))
 	}
 	if params.UpdatedBefore != nil {
 		filters = append(filters, fmt.Sprintf("modified_at <= %d", *params.UpdatedBefore))
 	}
+	if params.SHA1 != "" {
+		filters = append(filters, fmt.Sprintf("sha1 = '%s'", strings.ReplaceAll(params.SHA1, "'", "")))
+	}
+	if params.MinSize > 0 {
+		filters = append(filters, fmt.Sprintf("size >= %d", params.MinSize))
+	}
 	if !params.IncludeDeleted {
 		filters = append(filters, "is_deleted = false")
 		filters = append(filters, "in_trash = false")
 	}
+	if params.TenantID != "" {
+		filters = append(filters, meiliTenantFilter(params.TenantID))
+	}
+	return filters
+}
+
+func meiliTenantFilter(tenantID string) string {
+	return fmt.Sprintf("tenant_id = '%s'", strings.ReplaceAll(tenantID, "'", ""))
+}
+
+func (m *MeiliIndex) Search(params models.LocalSearchParams) ([]models.IndexDocument, int64, error) {
+	filters := buildMeiliFilters(tenantParams(m.tenantID, params))
 
 	page := params.Page
 	if page <= 0 {
@@ -237,8 +460,8 @@ func (m *MeiliIndex) Search(params models.LocalSearchParams) ([]models.IndexDocu
 			HitsPerPage:      pageSize,
 			MatchingStrategy: strategy,
 			AttributesToRetrieve: []string{
-				"doc_id", "source_id", "type", "name", "path_text",
-				"parent_id", "modified_at", "created_at", "size",
+				"doc_id", "source_id", "type", "name", "path_text", "ancestor_ids",
+				"parent_id", "modified_at", "created_at", "size", "tenant_id",
 			},
 			AttributesToHighlight: []string{"name"},
 			HighlightPreTag:       "<mark>",
@@ -300,6 +523,7 @@ func parseSearchResponse(response *meilisearch.SearchResponse) ([]models.IndexDo
 		total = int64(len(docs))
 	}
 
+	stripTenantDocIDs(docs)
 	return docs, total, nil
 }
 
@@ -309,8 +533,20 @@ func (m *MeiliIndex) Ping() error {
 	return err
 }
 
-// DocumentCount 返回索引中的文档总数。
+// DocumentCount 返回索引中的文档总数；租户视图按过滤统计该租户的文档数。
+// 租户计数走文档接口：搜索的命中总数受 maxTotalHits 限制，超过上限后不准。
 func (m *MeiliIndex) DocumentCount(ctx context.Context) (int64, error) {
+	if m.tenantID != "" {
+		var result meilisearch.DocumentsResult
+		if err := m.index.GetDocumentsWithContext(ctx, &meilisearch.DocumentsQuery{
+			Filter: meiliTenantFilter(m.tenantID),
+			Fields: []string{"doc_id"},
+			Limit:  1,
+		}, &result); err != nil {
+			return 0, err
+		}
+		return result.Total, nil
+	}
 	stats, err := m.index.GetStatsWithContext(ctx)
 	if err != nil {
 		return 0, err
@@ -678,3 +678,25 @@ func TestMeiliIndex_DocumentCount_Error(t *testing.T) {
     t.Errorf("DocumentCount error = %v, want %v", err, sentinel)
   }
 }
+
+func TestMeiliIndex_DocumentCount_TenantUsesDocumentsTotal(t *testing.T) {
+  t.Parallel()
+
+  // 总数超过 Meilisearch 默认的 maxTotalHits (1000)，搜索命中数会被截断。
+  index := &tenantDocumentsIndex{docIDs: []string{"acme__file_1"}, total: 2500}
+  idx, err := ForTenant(NewMeiliIndexFromManager(index), "acme")
+  if err != nil {
+    t.Fatalf("ForTenant returned error: %v", err)
+  }
+
+  count, err := idx.DocumentCount(context.Background())
+  if err != nil {
+    t.Fatalf("DocumentCount returned unexpected error: %v", err)
+  }
+  if count != 2500 {
+    t.Errorf("DocumentCount = %d, want 2500", count)
+  }
+  if len(index.queries) != 1 || index.queries[0].Filter != "tenant_id = 'acme'" || index.queries[0].Limit != 1 {
+    t.Errorf("expected one tenant-filtered document query, got %+v", index.queries)
+  }
+}
@@ -3,7 +3,10 @@ package search
 import (
   "context"
   "encoding/json"
+  "fmt"
   "io"
+  "slices"
+  "strings"
   "testing"
   "time"
 
@@ -654,6 +657,31 @@ func TestSearch_RequestIncludesHighlightParams(t *testing.T) {
   }
 }
 
+func TestSearch_WithinFolderFiltersByAncestorIDs(t *testing.T) {
+  mock := newSearchCaptureIndex()
+  idx := NewMeiliIndexFromManager(mock)
+
+  folderID := int64(42)
+  if _, _, err := idx.Search(models.LocalSearchParams{Query: "file", WithinFolderID: &folderID}); err != nil {
+    t.Fatalf("Search returned error: %v", err)
+  }
+  filters, ok := mock.capturedRequest.Filter.([]string)
+  if !ok || !containsString(filters, "ancestor_ids = 42") {
+    t.Fatalf("expected ancestor_ids filter, got %#v", mock.capturedRequest.Filter)
+  }
+
+  root := int64(0)
+  if _, _, err := idx.Search(models.LocalSearchParams{Query: "file", WithinFolderID: &root}); err != nil {
+    t.Fatalf("Search returned error: %v", err)
+  }
+  filters, _ = mock.capturedRequest.Filter.([]string)
+  for _, filter := range filters {
+    if strings.HasPrefix(filter, "ancestor_ids") {
+      t.Fatalf("root folder should not add ancestor filter, got %v", filters)
+    }
+  }
+}
+
 func TestSearch_RequestIncludesAttributesToRetrieve(t *testing.T) {
   mock := newSearchCaptureIndex()
   idx := NewMeiliIndexFromManager(mock)
@@ -888,3 +916,97 @@ func TestPreprocessQuery(t *testing.T) {
     }
   }
 }
+
+func TestMeiliStaleFilter_MatchesUnstampedDocsInRootSubtree(t *testing.T) {
+  got := meiliStaleFilter(100, 1700000000000, "")
+  want := "(sync_root_id = 100 OR ancestor_ids = 100) AND (sync_generation NOT EXISTS OR sync_generation < 1700000000000)"
+  if got != want {
+    t.Fatalf("expected filter %q, got %q", want, got)
+  }
+
+  tenant := meiliStaleFilter(100, 1700000000000, "acme")
+  if !strings.HasPrefix(tenant, want+" AND ") {
+    t.Fatalf("expected tenant filter appended to %q, got %q", want, tenant)
+  }
+}
+
+// documentPagesIndex 按 offset/limit 返回预置的文档，记录每次文档接口请求。
+type documentPagesIndex struct {
+  meilisearch.IndexManager
+  docs    []string
+  queries []meilisearch.DocumentsQuery
+}
+
+func (d *documentPagesIndex) GetDocumentsWithContext(_ context.Context, query *meilisearch.DocumentsQuery, resp *meilisearch.DocumentsResult) error {
+  d.queries = append(d.queries, *query)
+  end := min(query.Offset+query.Limit, int64(len(d.docs)))
+  for _, sha1 := range d.docs[query.Offset:end] {
+    encoded, _ := json.Marshal(sha1)
+    resp.Results = append(resp.Results, meilisearch.Hit{"sha1": encoded})
+  }
+  return nil
+}
+
+func TestMeiliCountDuplicateSHA1_PagesThroughAllDocuments(t *testing.T) {
+  docs := make([]string, 0, meiliScanPageSize+3)
+  for i := 0; i < meiliScanPageSize; i++ {
+    docs = append(docs, fmt.Sprintf("unique-%d", i))
+  }
+  // 重复的 sha1 位于第二页，分面或搜索分页截断时会漏掉。
+  docs = append(docs, "dup-a", "dup-a", "dup-b")
+  docs[0] = "dup-b"
+  index := &documentPagesIndex{docs: docs}
+
+  summary, err := NewMeiliIndexFromManager(index).CountDuplicateSHA1(context.Background(), models.LocalSearchParams{Type: "file"}, 1)
+  if err != nil {
+    t.Fatalf("CountDuplicateSHA1 returned error: %v", err)
+  }
+  if len(index.queries) != 2 || index.queries[1].Offset != meiliScanPageSize {
+    t.Fatalf("expected two document pages, got %+v", index.queries)
+  }
+  if !slices.Equal(index.queries[0].Fields, []string{"sha1", "size"}) {
+    t.Fatalf("expected only sha1 and size to be fetched, got %v", index.queries[0].Fields)
+  }
+  if summary.TotalGroups != 2 || len(summary.Groups) != 1 || summary.Groups[0].SHA1 != "dup-a" || summary.Groups[0].Copies != 2 {
+    t.Fatalf("expected 2 duplicate groups truncated to 1, got %+v", summary)
+  }
+}
+
+// tenantDocumentsIndex 按 offset/limit 返回预置的原始文档 ID，并报告 total，记录每次文档接口请求。
+type tenantDocumentsIndex struct {
+  meilisearch.IndexManager
+  docIDs  []string
+  total   int64
+  queries []meilisearch.DocumentsQuery
+}
+
+func (d *tenantDocumentsIndex) GetDocumentsWithContext(_ context.Context, query *meilisearch.DocumentsQuery, resp *meilisearch.DocumentsResult) error {
+  d.queries = append(d.queries, *query)
+  start := min(query.Offset, int64(len(d.docIDs)))
+  end := min(query.Offset+query.Limit, int64(len(d.docIDs)))
+  for _, docID := range d.docIDs[start:end] {
+    encoded, _ := json.Marshal(docID)
+    resp.Results = append(resp.Results, meilisearch.Hit{"doc_id": encoded})
+  }
+  resp.Total = d.total
+  return nil
+}
+
+func TestMeiliListStaleDocumentIDs_TenantViewStripsPrefix(t *testing.T) {
+  index := &tenantDocumentsIndex{docIDs: []string{"acme__file_1", "acme__folder_2"}}
+  idx, err := ForTenant(NewMeiliIndexFromManager(index), "acme")
+  if err != nil {
+    t.Fatalf("ForTenant returned error: %v", err)
+  }
+
+  docIDs, err := idx.ListStaleDocumentIDs(context.Background(), 100, 1700000000000)
+  if err != nil {
+    t.Fatalf("ListStaleDocumentIDs returned error: %v", err)
+  }
+  if !slices.Equal(docIDs, []string{"file_1", "folder_2"}) {
+    t.Fatalf("expected tenant prefix to be stripped, got %v", docIDs)
+  }
+  if len(index.queries) != 1 || !strings.Contains(index.queries[0].Filter.(string), "tenant_id = 'acme'") {
+    t.Fatalf("expected stale scan scoped to acme, got %+v", index.queries)
+  }
+}
@@ -196,6 +196,17 @@ func TestEnsureSettings_DisplayedAttributes_ExposeFileCategory(t *testing.T) {
   }
 }
 
+func TestEnsureSettings_AncestorIDsFilterableAndDisplayed(t *testing.T) {
+  s := callEnsureSettings(t)
+
+  if !containsString(s.FilterableAttributes, "ancestor_ids") {
+    t.Fatalf("FilterableAttributes should contain %q, got %v", "ancestor_ids", s.FilterableAttributes)
+  }
+  if !containsString(s.DisplayedAttributes, "ancestor_ids") {
+    t.Fatalf("DisplayedAttributes should contain %q, got %v", "ancestor_ids", s.DisplayedAttributes)
+  }
+}
+
 // ---------- remaining IndexManager stubs (unused, panic on call) ----------
 
 func (m *settingsCaptureIndex) UpdateSettings(s *meilisearch.Settings) (*meilisearch.TaskInfo, error) {
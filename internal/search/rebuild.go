@@ -0,0 +1,20 @@
+package search
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrRebuildUnsupported 表示当前后端实例无法执行蓝绿重建（例如测试中直接注入的索引）。
+var ErrRebuildUnsupported = errors.New("当前搜索后端不支持蓝绿重建")
+
+const shadowIndexSuffix = "_shadow"
+
+// IndexRebuilder 是支持蓝绿重建的搜索后端：全量同步先写入影子索引，完成后原子切换到线上名称。
+// 切换后上一代数据仍然保留，RollbackSwap 可以把线上名称切回上一代。
+type IndexRebuilder interface {
+	// ShadowIndex 返回影子索引及其名称。reset 为 true 时清空影子索引，续爬时传 false。
+	ShadowIndex(ctx context.Context, reset bool) (IndexOperator, string, error)
+	SwapShadow(ctx context.Context) error
+	RollbackSwap(ctx context.Context) error
+}
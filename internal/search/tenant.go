@@ -0,0 +1,92 @@
+package search
+
+import (
+	"errors"
+	"strings"
+
+	"npan/internal/models"
+)
+
+// ErrTenantUnsupported 表示搜索后端不支持按租户隔离文档。
+var ErrTenantUnsupported = errors.New("当前搜索后端不支持多租户")
+
+// tenantDocIDSeparator 分隔文档 ID 中的租户前缀；租户 ID 不含下划线，不会与原 ID 混淆。
+const tenantDocIDSeparator = "__"
+
+// TenantScoper 由支持多租户的索引实现，返回只读写某个租户文档的视图。
+type TenantScoper interface {
+	ForTenant(tenantID string) IndexOperator
+}
+
+// ForTenant 返回租户视图：写入的文档带上 tenant_id 且文档 ID 加租户前缀，
+// 查询、删除与计数都限定在该租户内。tenantID 为空时原样返回。
+func ForTenant(index IndexOperator, tenantID string) (IndexOperator, error) {
+	if tenantID == "" {
+		return index, nil
+	}
+	scoper, ok := index.(TenantScoper)
+	if !ok {
+		return nil, ErrTenantUnsupported
+	}
+	return scoper.ForTenant(tenantID), nil
+}
+
+// TenantDocID 返回文档在索引中的实际 ID。
+func TenantDocID(tenantID string, docID string) string {
+	if tenantID == "" {
+		return docID
+	}
+	return tenantID + tenantDocIDSeparator + docID
+}
+
+func tenantDocIDs(tenantID string, docIDs []string) []string {
+	if tenantID == "" {
+		return docIDs
+	}
+	scoped := make([]string, 0, len(docIDs))
+	for _, docID := range docIDs {
+		scoped = append(scoped, TenantDocID(tenantID, docID))
+	}
+	return scoped
+}
+
+// tenantDocs 复制文档并写入租户字段与带前缀的 ID，不修改调用方的切片。
+func tenantDocs(tenantID string, docs []models.IndexDocument) []models.IndexDocument {
+	if tenantID == "" {
+		return docs
+	}
+	scoped := make([]models.IndexDocument, len(docs))
+	for i, doc := range docs {
+		doc.TenantID = tenantID
+		doc.DocID = TenantDocID(tenantID, doc.DocID)
+		scoped[i] = doc
+	}
+	return scoped
+}
+
+// stripTenantDocIDs 去掉读出文档 ID 的租户前缀，让上层看到的 ID 与单租户时一致。
+func stripTenantDocIDs(docs []models.IndexDocument) {
+	for i := range docs {
+		if docs[i].TenantID == "" {
+			continue
+		}
+		docs[i].DocID = strings.TrimPrefix(docs[i].DocID, docs[i].TenantID+tenantDocIDSeparator)
+	}
+}
+
+// stripTenantDocID 去掉租户视图读出的文档 ID 前缀；只取了 doc_id 字段时文档里没有 tenant_id，
+// 需要用视图自己的租户 ID。
+func stripTenantDocID(tenantID string, docID string) string {
+	if tenantID == "" {
+		return docID
+	}
+	return strings.TrimPrefix(docID, tenantID+tenantDocIDSeparator)
+}
+
+// tenantParams 把查询限定在租户内；租户视图忽略调用方传入的租户。
+func tenantParams(tenantID string, params models.LocalSearchParams) models.LocalSearchParams {
+	if tenantID != "" {
+		params.TenantID = tenantID
+	}
+	return params
+}
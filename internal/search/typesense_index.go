@@ -21,8 +21,14 @@ type TypesenseIndex struct {
 	apiKey     string
 	collection string
 	client     *http.Client
+
+	// tenantID 非空时是 ForTenant 返回的租户视图。
+	tenantID string
 }
 
+// typesenseScanPageSize 是 Typesense 单页允许的最大结果数。
+const typesenseScanPageSize = 250
+
 func NewTypesenseIndex(host string, apiKey string, collection string) *TypesenseIndex {
 	return &TypesenseIndex{
 		host:       strings.TrimRight(strings.TrimSpace(host), "/"),
@@ -34,6 +40,13 @@ func NewTypesenseIndex(host string, apiKey string, collection string) *Typesense
 	}
 }
 
+// ForTenant 返回只读写 tenantID 文档的视图，与原索引共享连接。
+func (t *TypesenseIndex) ForTenant(tenantID string) IndexOperator {
+	scoped := *t
+	scoped.tenantID = tenantID
+	return &scoped
+}
+
 type typesenseCollectionSchema struct {
 	Name                string                     `json:"name"`
 	DefaultSortingField string                     `json:"default_sorting_field,omitempty"`
@@ -81,13 +94,25 @@ func (t *TypesenseIndex) EnsureSettings(ctx context.Context) error {
 		}
 	}
 	if status == http.StatusOK {
+		var missing []typesenseCollectionField
+		for _, field := range addedTypesenseFields {
+			if !hasTypesenseField(info, field.Name) {
+				missing = append(missing, field)
+			}
+		}
+		if len(missing) > 0 {
+			if err := t.addFields(ctx, missing); err != nil {
+				return err
+			}
+			info.Fields = append(info.Fields, missing...)
+		}
 		return validateTypesenseCollection(info)
 	}
 
 	schema := typesenseCollectionSchema{
 		Name:                t.collection,
 		DefaultSortingField: "modified_at",
-		TokenSeparators:     []string{"-", "_"},
+		TokenSeparators:     []string{"-", "_", "/"},
 		Fields: []typesenseCollectionField{
 			{Name: "doc_id", Type: "string"},
 			{Name: "source_id", Type: "int64", Sort: true},
@@ -97,6 +122,7 @@ func (t *TypesenseIndex) EnsureSettings(ctx context.Context) error {
 			{Name: "name_ext", Type: "string", Optional: true},
 			{Name: "file_category", Type: "string", Facet: true, Optional: true},
 			{Name: "path_text", Type: "string"},
+			ancestorIDsField,
 			{Name: "parent_id", Type: "int64", Facet: true, Sort: true},
 			{Name: "modified_at", Type: "int64", Facet: true, Sort: true},
 			{Name: "created_at", Type: "int64", Sort: true},
@@ -104,6 +130,9 @@ func (t *TypesenseIndex) EnsureSettings(ctx context.Context) error {
 			{Name: "sha1", Type: "string", Optional: true},
 			{Name: "in_trash", Type: "bool", Facet: true},
 			{Name: "is_deleted", Type: "bool", Facet: true},
+			syncRootIDField,
+			syncGenerationField,
+			tenantIDField,
 		},
 	}
 
@@ -117,7 +146,7 @@ func (t *TypesenseIndex) UpsertDocuments(ctx context.Context, docs []models.Inde
 	}
 
 	var body bytes.Buffer
-	for _, doc := range docs {
+	for _, doc := range tenantDocs(t.tenantID, docs) {
 		encoded, err := json.Marshal(doc)
 		if err != nil {
 			return err
@@ -165,6 +194,11 @@ func (t *TypesenseIndex) DeleteDocuments(ctx context.Context, docIDs []string) e
 		return nil
 	}
 
+	return t.deleteRawDocIDs(ctx, tenantDocIDs(t.tenantID, docIDs))
+}
+
+// deleteRawDocIDs 按索引中实际存储的 doc_id（已含租户前缀）删除文档。
+func (t *TypesenseIndex) deleteRawDocIDs(ctx context.Context, docIDs []string) error {
 	parts := make([]string, 0, len(docIDs))
 	for _, docID := range docIDs {
 		parts = append(parts, fmt.Sprintf("doc_id:=%s", quoteTypesenseString(docID)))
@@ -177,14 +211,235 @@ func (t *TypesenseIndex) DeleteDocuments(ctx context.Context, docIDs []string) e
 }
 
 func (t *TypesenseIndex) DeleteAllDocuments(ctx context.Context) error {
+	// 租户视图只删除该租户的文档，不能重建共享的 collection。
+	if t.tenantID != "" {
+		query := url.Values{}
+		query.Set("filter_by", typesenseTenantFilter(t.tenantID))
+		_, err := t.do(ctx, http.MethodDelete, fmt.Sprintf("/collections/%s/documents", url.PathEscape(t.collection)), query, "", nil)
+		return err
+	}
+	// 启用蓝绿重建后 collection 名是别名，需要删除并重建它指向的实际 collection。
+	target := t
+	if aliased, err := t.aliasTarget(ctx); err != nil {
+		return err
+	} else if aliased != "" {
+		target = t.withCollection(aliased)
+	}
+	if err := target.dropCollection(ctx); err != nil {
+		return err
+	}
+	return target.EnsureSettings(ctx)
+}
+
+// DeleteStaleDocuments 删除某个同步根下代次早于 generation 或没有代次的文档，返回删除数量。
+// Typesense 无法按字段缺失过滤，没有代次的文档通过遍历子树找出后按 doc_id 删除。
+func (t *TypesenseIndex) DeleteStaleDocuments(ctx context.Context, rootFolderID int64, generation int64) (int64, error) {
+	query := url.Values{}
+	filterBy := fmt.Sprintf("(sync_root_id:=%d || ancestor_ids:=%d) && sync_generation:<%d", rootFolderID, rootFolderID, generation)
+	if t.tenantID != "" {
+		filterBy += " && " + typesenseTenantFilter(t.tenantID)
+	}
+	query.Set("filter_by", filterBy)
+	var result struct {
+		NumDeleted int64 `json:"num_deleted"`
+	}
+	if err := t.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/collections/%s/documents", url.PathEscape(t.collection)), query, nil, &result); err != nil {
+		return 0, err
+	}
+
+	var unstamped []string
+	params := tenantParams(t.tenantID, models.LocalSearchParams{WithinFolderID: &rootFolderID, IncludeDeleted: true})
+	err := t.scanDocuments(ctx, params, "doc_id,sync_generation", func(docs []models.IndexDocument) error {
+		for _, doc := range docs {
+			if doc.SyncGeneration == 0 {
+				unstamped = append(unstamped, doc.DocID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return result.NumDeleted, err
+	}
+	for start := 0; start < len(unstamped); start += typesenseScanPageSize {
+		batch := unstamped[start:min(start+typesenseScanPageSize, len(unstamped))]
+		if err := t.deleteRawDocIDs(ctx, batch); err != nil {
+			return result.NumDeleted, err
+		}
+		result.NumDeleted += int64(len(batch))
+	}
+	return result.NumDeleted, nil
+}
+
+func (t *TypesenseIndex) ListStaleDocumentIDs(ctx context.Context, rootFolderID int64, generation int64) ([]string, error) {
+	filterBy := fmt.Sprintf("(sync_root_id:=%d || ancestor_ids:=%d) && sync_generation:<%d", rootFolderID, rootFolderID, generation)
+	if t.tenantID != "" {
+		filterBy += " && " + typesenseTenantFilter(t.tenantID)
+	}
+	docIDs := []string{}
+	err := t.scanFiltered(ctx, filterBy, "doc_id", func(docs []models.IndexDocument) error {
+		for _, doc := range docs {
+			docIDs = append(docIDs, stripTenantDocID(t.tenantID, doc.DocID))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	params := tenantParams(t.tenantID, models.LocalSearchParams{WithinFolderID: &rootFolderID, IncludeDeleted: true})
+	err = t.scanDocuments(ctx, params, "doc_id,sync_generation", func(docs []models.IndexDocument) error {
+		for _, doc := range docs {
+			if doc.SyncGeneration == 0 {
+				docIDs = append(docIDs, stripTenantDocID(t.tenantID, doc.DocID))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return docIDs, nil
+}
+
+// ShadowIndex 返回蓝绿重建使用的影子 collection。配置的 collection 名作为别名，
+// 实际数据在 <name>_blue 与 <name>_green 之间轮换，影子是别名当前未指向的那一个。
+func (t *TypesenseIndex) ShadowIndex(ctx context.Context, reset bool) (IndexOperator, string, error) {
+	if t.tenantID != "" {
+		return nil, "", ErrRebuildUnsupported
+	}
+	_, shadowName, err := t.blueGreenCollections(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	shadow := t.withCollection(shadowName)
+	if reset {
+		if err := shadow.dropCollection(ctx); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := shadow.EnsureSettings(ctx); err != nil {
+		return nil, "", err
+	}
+	return shadow, shadowName, nil
+}
+
+// SwapShadow 将别名原子地指向影子 collection，上一代 collection 保留用于回滚。
+// 首次切换时旧数据在与别名同名的普通 collection 中，Typesense 优先解析同名 collection，
+// 因此先把旧数据复制到另一个候选 collection 作为回滚目标，再创建别名，最后删除同名 collection，
+// 删除后请求立即经别名解析到影子 collection，期间不会出现空窗。
+func (t *TypesenseIndex) SwapShadow(ctx context.Context) error {
+	if t.tenantID != "" {
+		return ErrRebuildUnsupported
+	}
+	live, shadowName, err := t.blueGreenCollections(ctx)
+	if err != nil {
+		return err
+	}
+	if live != "" {
+		return t.pointAlias(ctx, shadowName)
+	}
+
+	previous := t.withCollection(t.otherCollection(shadowName))
+	if err := t.copyDocumentsTo(ctx, previous); err != nil {
+		return fmt.Errorf("保留旧 collection %s 用于回滚失败: %w", t.collection, err)
+	}
+	if err := t.pointAlias(ctx, shadowName); err != nil {
+		return err
+	}
+	return t.dropCollection(ctx)
+}
+
+// copyDocumentsTo 清空 target 后把当前 collection 的全部文档（含已删除标记的）复制过去。
+func (t *TypesenseIndex) copyDocumentsTo(ctx context.Context, target *TypesenseIndex) error {
+	if err := target.dropCollection(ctx); err != nil {
+		return err
+	}
+	if err := target.EnsureSettings(ctx); err != nil {
+		return err
+	}
+	return t.scanDocuments(ctx, models.LocalSearchParams{IncludeDeleted: true}, "", func(docs []models.IndexDocument) error {
+		return target.UpsertDocuments(ctx, docs)
+	})
+}
+
+// RollbackSwap 将别名指回上一代 collection。
+func (t *TypesenseIndex) RollbackSwap(ctx context.Context) error {
+	if t.tenantID != "" {
+		return ErrRebuildUnsupported
+	}
+	live, previous, err := t.blueGreenCollections(ctx)
+	if err != nil {
+		return err
+	}
+	if live == "" {
+		return fmt.Errorf("typesense 别名 %s 不存在，无法回滚", t.collection)
+	}
+	if _, status, err := t.withCollection(previous).fetchCollection(ctx); err != nil {
+		if status == http.StatusNotFound {
+			return fmt.Errorf("上一代 collection %s 不存在，无法回滚", previous)
+		}
+		return err
+	}
+	return t.pointAlias(ctx, previous)
+}
+
+// blueGreenCollections 返回别名当前指向的 collection（未启用别名时为空）与另一个候选 collection。
+func (t *TypesenseIndex) blueGreenCollections(ctx context.Context) (string, string, error) {
+	live, err := t.aliasTarget(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if live == t.collection+"_blue" {
+		return live, t.otherCollection(live), nil
+	}
+	return live, t.collection + "_blue", nil
+}
+
+// otherCollection 返回蓝绿两个候选 collection 中的另一个。
+func (t *TypesenseIndex) otherCollection(name string) string {
+	if name == t.collection+"_blue" {
+		return t.collection + "_green"
+	}
+	return t.collection + "_blue"
+}
+
+func (t *TypesenseIndex) aliasTarget(ctx context.Context) (string, error) {
+	var alias struct {
+		CollectionName string `json:"collection_name"`
+	}
+	err := t.doJSON(ctx, http.MethodGet, fmt.Sprintf("/aliases/%s", url.PathEscape(t.collection)), nil, nil, &alias)
+	if isNotFoundError(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return alias.CollectionName, nil
+}
+
+func (t *TypesenseIndex) pointAlias(ctx context.Context, collection string) error {
+	payload := map[string]string{"collection_name": collection}
+	if err := t.doJSON(ctx, http.MethodPut, fmt.Sprintf("/aliases/%s", url.PathEscape(t.collection)), nil, payload, nil); err != nil {
+		return fmt.Errorf("typesense 别名 %s 切换到 %s 失败: %w", t.collection, collection, err)
+	}
+	return nil
+}
+
+func (t *TypesenseIndex) dropCollection(ctx context.Context) error {
 	if _, err := t.do(ctx, http.MethodDelete, fmt.Sprintf("/collections/%s", url.PathEscape(t.collection)), nil, "", nil); err != nil && !isNotFoundError(err) {
 		return err
 	}
-	return t.EnsureSettings(ctx)
+	return nil
+}
+
+func (t *TypesenseIndex) withCollection(collection string) *TypesenseIndex {
+	copied := *t
+	copied.collection = collection
+	return &copied
 }
 
 func (t *TypesenseIndex) Search(params models.LocalSearchParams) ([]models.IndexDocument, int64, error) {
 	ctx := context.Background()
+	params = tenantParams(t.tenantID, params)
 	page := params.Page
 	if page <= 0 {
 		page = 1
@@ -245,15 +500,120 @@ func (t *TypesenseIndex) Search(params models.LocalSearchParams) ([]models.Index
 		docs = append(docs, doc)
 	}
 
+	stripTenantDocIDs(docs)
 	return docs, response.Found, nil
 }
 
+// GetDocuments 通过 doc_id 过滤读取文档；Typesense 的文档 id 由服务端生成，不等于 doc_id。
+func (t *TypesenseIndex) GetDocuments(ctx context.Context, docIDs []string) ([]models.IndexDocument, error) {
+	if len(docIDs) == 0 {
+		return []models.IndexDocument{}, nil
+	}
+
+	quoted := make([]string, 0, len(docIDs))
+	for _, docID := range tenantDocIDs(t.tenantID, docIDs) {
+		quoted = append(quoted, quoteTypesenseString(docID))
+	}
+	query := url.Values{}
+	query.Set("q", "*")
+	query.Set("filter_by", fmt.Sprintf("doc_id:=[%s]", strings.Join(quoted, ",")))
+	query.Set("per_page", fmt.Sprintf("%d", len(docIDs)))
+
+	var response typesenseSearchResponse
+	if err := t.doJSON(ctx, http.MethodGet, fmt.Sprintf("/collections/%s/documents/search", url.PathEscape(t.collection)), query, nil, &response); err != nil {
+		return nil, err
+	}
+	docs := make([]models.IndexDocument, 0, len(response.Hits))
+	for _, hit := range response.Hits {
+		var doc models.IndexDocument
+		if err := json.Unmarshal(hit.Document, &doc); err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+	stripTenantDocIDs(docs)
+	return docs, nil
+}
+
+// UpdateDocumentPaths 直接整文档写入：Typesense 的搜索结果包含全部字段。
+func (t *TypesenseIndex) UpdateDocumentPaths(ctx context.Context, docs []models.IndexDocument) error {
+	return t.UpsertDocuments(ctx, docs)
+}
+
+// CountDuplicateSHA1 遍历范围内的文档逐个计数；sha1 字段未开启分面，无法由服务端聚合。
+func (t *TypesenseIndex) CountDuplicateSHA1(ctx context.Context, params models.LocalSearchParams, limit int) (models.DuplicateSHA1Summary, error) {
+	counter := duplicateCounter{}
+	err := t.scanDocuments(ctx, tenantParams(t.tenantID, params), "sha1,size", func(docs []models.IndexDocument) error {
+		for _, doc := range docs {
+			counter.add(doc)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.DuplicateSHA1Summary{}, err
+	}
+	return counter.summary(limit), nil
+}
+
+// scanDocuments 按页遍历过滤后的全部文档，includeFields 为空时返回全部字段。
+func (t *TypesenseIndex) scanDocuments(ctx context.Context, params models.LocalSearchParams, includeFields string, fn func(docs []models.IndexDocument) error) error {
+	return t.scanFiltered(ctx, buildTypesenseFilter(params), includeFields, fn)
+}
+
+// scanFiltered 按 filter_by 分页遍历文档，filterBy 为空时遍历整个 collection。
+func (t *TypesenseIndex) scanFiltered(ctx context.Context, filterBy string, includeFields string, fn func(docs []models.IndexDocument) error) error {
+	for page := int64(1); ; page++ {
+		query := url.Values{}
+		query.Set("q", "*")
+		if includeFields != "" {
+			query.Set("include_fields", includeFields)
+		}
+		query.Set("page", fmt.Sprintf("%d", page))
+		query.Set("per_page", fmt.Sprintf("%d", typesenseScanPageSize))
+		if filterBy != "" {
+			query.Set("filter_by", filterBy)
+		}
+
+		var response typesenseSearchResponse
+		if err := t.doJSON(ctx, http.MethodGet, fmt.Sprintf("/collections/%s/documents/search", url.PathEscape(t.collection)), query, nil, &response); err != nil {
+			return err
+		}
+		docs := make([]models.IndexDocument, 0, len(response.Hits))
+		for _, hit := range response.Hits {
+			var doc models.IndexDocument
+			if err := json.Unmarshal(hit.Document, &doc); err != nil {
+				return err
+			}
+			docs = append(docs, doc)
+		}
+		if len(docs) > 0 {
+			if err := fn(docs); err != nil {
+				return err
+			}
+		}
+		if len(docs) < typesenseScanPageSize {
+			return nil
+		}
+	}
+}
+
 func (t *TypesenseIndex) Ping() error {
 	_, err := t.do(context.Background(), http.MethodGet, "/health", nil, "", nil)
 	return err
 }
 
 func (t *TypesenseIndex) DocumentCount(ctx context.Context) (int64, error) {
+	if t.tenantID != "" {
+		query := url.Values{}
+		query.Set("q", "*")
+		query.Set("filter_by", typesenseTenantFilter(t.tenantID))
+		query.Set("per_page", "1")
+		var response typesenseSearchResponse
+		if err := t.doJSON(ctx, http.MethodGet, fmt.Sprintf("/collections/%s/documents/search", url.PathEscape(t.collection)), query, nil, &response); err != nil {
+			return 0, err
+		}
+		return response.Found, nil
+	}
 	info, _, err := t.fetchCollection(ctx)
 	if err != nil {
 		if isNotFoundError(err) {
@@ -276,23 +636,60 @@ func (t *TypesenseIndex) fetchCollection(ctx context.Context) (typesenseCollecti
 	return info, status, nil
 }
 
+// 以下是后加字段，旧 collection 通过 PATCH 补齐，因此必须是 optional。
+var (
+	ancestorIDsField    = typesenseCollectionField{Name: "ancestor_ids", Type: "int64[]", Facet: true, Optional: true}
+	syncRootIDField     = typesenseCollectionField{Name: "sync_root_id", Type: "int64", Optional: true}
+	syncGenerationField = typesenseCollectionField{Name: "sync_generation", Type: "int64", Optional: true}
+	tenantIDField       = typesenseCollectionField{Name: "tenant_id", Type: "string", Facet: true, Optional: true}
+
+	addedTypesenseFields = []typesenseCollectionField{ancestorIDsField, syncRootIDField, syncGenerationField, tenantIDField}
+)
+
+func hasTypesenseField(info typesenseCollectionInfo, name string) bool {
+	for _, field := range info.Fields {
+		if field.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *TypesenseIndex) addFields(ctx context.Context, fields []typesenseCollectionField) error {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+	payload := map[string]any{
+		"fields": fields,
+	}
+	if err := t.doJSON(ctx, http.MethodPatch, fmt.Sprintf("/collections/%s", url.PathEscape(t.collection)), nil, payload, nil); err != nil {
+		return fmt.Errorf("typesense collection 添加字段 %s 失败: %w", strings.Join(names, ","), err)
+	}
+	return nil
+}
+
 func validateTypesenseCollection(info typesenseCollectionInfo) error {
 	required := map[string]string{
-		"doc_id":        "string",
-		"source_id":     "int64",
-		"type":          "string",
-		"name":          "string",
-		"name_base":     "string",
-		"name_ext":      "string",
-		"file_category": "string",
-		"path_text":     "string",
-		"parent_id":     "int64",
-		"modified_at":   "int64",
-		"created_at":    "int64",
-		"size":          "int64",
-		"sha1":          "string",
-		"in_trash":      "bool",
-		"is_deleted":    "bool",
+		"doc_id":          "string",
+		"source_id":       "int64",
+		"type":            "string",
+		"name":            "string",
+		"name_base":       "string",
+		"name_ext":        "string",
+		"file_category":   "string",
+		"path_text":       "string",
+		"ancestor_ids":    "int64[]",
+		"sync_root_id":    "int64",
+		"sync_generation": "int64",
+		"tenant_id":       "string",
+		"parent_id":       "int64",
+		"modified_at":     "int64",
+		"created_at":      "int64",
+		"size":            "int64",
+		"sha1":            "string",
+		"in_trash":        "bool",
+		"is_deleted":      "bool",
 	}
 	seen := map[string]string{}
 	for _, field := range info.Fields {
@@ -319,19 +716,35 @@ func buildTypesenseFilter(params models.LocalSearchParams) string {
 	if params.ParentID != nil {
 		filters = append(filters, fmt.Sprintf("parent_id:=%d", *params.ParentID))
 	}
+	if params.WithinFolderID != nil && *params.WithinFolderID > 0 {
+		filters = append(filters, fmt.Sprintf("ancestor_ids:=%d", *params.WithinFolderID))
+	}
 	if params.UpdatedAfter != nil {
 		filters = append(filters, fmt.Sprintf("modified_at:>=%d", *params.UpdatedAfter))
 	}
 	if params.UpdatedBefore != nil {
 		filters = append(filters, fmt.Sprintf("modified_at:<=%d", *params.UpdatedBefore))
 	}
+	if params.SHA1 != "" {
+		filters = append(filters, fmt.Sprintf("sha1:=%s", quoteTypesenseString(params.SHA1)))
+	}
+	if params.MinSize > 0 {
+		filters = append(filters, fmt.Sprintf("size:>=%d", params.MinSize))
+	}
 	if !params.IncludeDeleted {
 		filters = append(filters, "is_deleted:=false")
 		filters = append(filters, "in_trash:=false")
 	}
+	if params.TenantID != "" {
+		filters = append(filters, typesenseTenantFilter(params.TenantID))
+	}
 	return strings.Join(filters, " && ")
 }
 
+func typesenseTenantFilter(tenantID string) string {
+	return fmt.Sprintf("tenant_id:=%s", quoteTypesenseString(tenantID))
+}
+
 func typesenseHighlightName(hit typesenseSearchHit) string {
 	for _, highlight := range hit.Highlights {
 		if highlight.Field != "name" {
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -50,13 +51,75 @@ func TestTypesenseEnsureSettingsCreatesCollectionWhenMissing(t *testing.T) {
 	if !created {
 		t.Fatal("expected collection creation request")
 	}
-	for _, required := range []string{`"name":"doc_id"`, `"name":"name_base"`, `"name":"modified_at"`} {
+	for _, required := range []string{`"name":"doc_id"`, `"name":"name_base"`, `"name":"modified_at"`, `"name":"ancestor_ids"`} {
 		if !strings.Contains(schemaBody, required) {
 			t.Fatalf("expected schema body to contain %s, got %s", required, schemaBody)
 		}
 	}
 }
 
+func TestTypesenseEnsureSettingsAddsAncestorIDsToExistingCollection(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu        sync.Mutex
+		patchBody string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/collections/npan_items":
+			payload := map[string]any{
+				"name":             "npan_items",
+				"token_separators": []string{"-", "_"},
+				"fields": []map[string]any{
+					{"name": "doc_id", "type": "string"},
+					{"name": "source_id", "type": "int64"},
+					{"name": "type", "type": "string"},
+					{"name": "name", "type": "string"},
+					{"name": "name_base", "type": "string"},
+					{"name": "name_ext", "type": "string"},
+					{"name": "file_category", "type": "string"},
+					{"name": "path_text", "type": "string"},
+					{"name": "parent_id", "type": "int64"},
+					{"name": "modified_at", "type": "int64"},
+					{"name": "created_at", "type": "int64"},
+					{"name": "size", "type": "int64"},
+					{"name": "sha1", "type": "string"},
+					{"name": "in_trash", "type": "bool"},
+					{"name": "is_deleted", "type": "bool"},
+				},
+			}
+			encoded, _ := json.Marshal(payload)
+			_, _ = w.Write(encoded)
+		case r.Method == http.MethodPatch && r.URL.Path == "/collections/npan_items":
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			patchBody = string(body)
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"fields":[]}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	idx := NewTypesenseIndex(srv.URL, "typesense-key", "npan_items")
+	if err := idx.EnsureSettings(context.Background()); err != nil {
+		t.Fatalf("EnsureSettings returned error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !strings.Contains(patchBody, `"name":"ancestor_ids"`) || !strings.Contains(patchBody, `"type":"int64[]"`) {
+		t.Fatalf("expected PATCH adding ancestor_ids, got %q", patchBody)
+	}
+	for _, field := range []string{`"name":"sync_root_id"`, `"name":"sync_generation"`} {
+		if !strings.Contains(patchBody, field) {
+			t.Fatalf("expected PATCH to add %s, got %q", field, patchBody)
+		}
+	}
+}
+
 func TestTypesenseUpsertDocumentsUsesImportUpsert(t *testing.T) {
 	t.Parallel()
 
@@ -172,6 +235,16 @@ func TestTypesenseSearchBuildsFiltersAndParsesHighlights(t *testing.T) {
 	}
 }
 
+func TestBuildTypesenseFilterWithinFolder(t *testing.T) {
+	t.Parallel()
+
+	folderID := int64(42)
+	filter := buildTypesenseFilter(models.LocalSearchParams{WithinFolderID: &folderID})
+	if !strings.Contains(filter, "ancestor_ids:=42") {
+		t.Fatalf("expected ancestor_ids filter, got %s", filter)
+	}
+}
+
 func TestTypesenseDocumentCountReadsCollectionStats(t *testing.T) {
 	t.Parallel()
 
@@ -215,6 +288,387 @@ func TestTypesenseDocumentCountReadsCollectionStats(t *testing.T) {
 	}
 }
 
+func TestTypesenseDeleteStaleDocumentsSweepsStampedAndUnstampedDocs(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		deletes []string
+		scan    url.Values
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodDelete && r.URL.Path == "/collections/npan_items/documents":
+			deletes = append(deletes, r.URL.Query().Get("filter_by"))
+			_, _ = w.Write([]byte(`{"num_deleted":7}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/collections/npan_items/documents/search":
+			scan = r.URL.Query()
+			_, _ = w.Write([]byte(`{"found":2,"hits":[
+				{"document":{"doc_id":"file_1","sync_generation":1700000000000}},
+				{"document":{"doc_id":"file_2"}}
+			]}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	idx := NewTypesenseIndex(srv.URL, "typesense-key", "npan_items")
+	removed, err := idx.DeleteStaleDocuments(context.Background(), 100, 1700000000000)
+	if err != nil {
+		t.Fatalf("DeleteStaleDocuments returned error: %v", err)
+	}
+	if removed != 8 {
+		t.Fatalf("expected 8 removed, got %d", removed)
+	}
+	if len(deletes) != 2 || deletes[0] != "(sync_root_id:=100 || ancestor_ids:=100) && sync_generation:<1700000000000" {
+		t.Fatalf("unexpected delete filters %q", deletes)
+	}
+	if deletes[1] != "doc_id:=`file_2`" {
+		t.Fatalf("expected only the unstamped document to be deleted by id, got %q", deletes[1])
+	}
+	if got := scan.Get("filter_by"); got != "ancestor_ids:=100" {
+		t.Fatalf("expected scan over the root subtree including deleted docs, got %q", got)
+	}
+}
+
+func TestTypesenseListStaleDocumentIDsReturnsStampedAndUnstampedDocs(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		filters []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method != http.MethodGet || r.URL.Path != "/collections/npan_items/documents/search" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		filterBy := r.URL.Query().Get("filter_by")
+		filters = append(filters, filterBy)
+		if filterBy == "ancestor_ids:=100" {
+			_, _ = w.Write([]byte(`{"found":2,"hits":[
+				{"document":{"doc_id":"file_1","sync_generation":1700000000000}},
+				{"document":{"doc_id":"file_2"}}
+			]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"found":1,"hits":[{"document":{"doc_id":"file_3"}}]}`))
+	}))
+	defer srv.Close()
+
+	idx := NewTypesenseIndex(srv.URL, "typesense-key", "npan_items")
+	docIDs, err := idx.ListStaleDocumentIDs(context.Background(), 100, 1700000000000)
+	if err != nil {
+		t.Fatalf("ListStaleDocumentIDs returned error: %v", err)
+	}
+	if len(docIDs) != 2 || docIDs[0] != "file_3" || docIDs[1] != "file_2" {
+		t.Fatalf("expected stamped and unstamped stale documents, got %v", docIDs)
+	}
+	if len(filters) != 2 || filters[0] != "(sync_root_id:=100 || ancestor_ids:=100) && sync_generation:<1700000000000" {
+		t.Fatalf("unexpected scan filters %q", filters)
+	}
+}
+
+func TestTypesenseTenantListStaleDocumentIDsStripsPrefix(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		filters []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method != http.MethodGet || r.URL.Path != "/collections/npan_items/documents/search" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		filterBy := r.URL.Query().Get("filter_by")
+		filters = append(filters, filterBy)
+		if strings.HasPrefix(filterBy, "ancestor_ids:=100") {
+			_, _ = w.Write([]byte(`{"found":1,"hits":[{"document":{"doc_id":"acme__file_2"}}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"found":1,"hits":[{"document":{"doc_id":"acme__file_3"}}]}`))
+	}))
+	defer srv.Close()
+
+	idx, err := ForTenant(NewTypesenseIndex(srv.URL, "typesense-key", "npan_items"), "acme")
+	if err != nil {
+		t.Fatalf("ForTenant returned error: %v", err)
+	}
+	docIDs, err := idx.ListStaleDocumentIDs(context.Background(), 100, 1700000000000)
+	if err != nil {
+		t.Fatalf("ListStaleDocumentIDs returned error: %v", err)
+	}
+	if len(docIDs) != 2 || docIDs[0] != "file_3" || docIDs[1] != "file_2" {
+		t.Fatalf("expected tenant prefix to be stripped, got %v", docIDs)
+	}
+	if len(filters) != 2 || !strings.Contains(filters[0], "tenant_id:=`acme`") || !strings.Contains(filters[1], "tenant_id:=`acme`") {
+		t.Fatalf("expected scans scoped to acme, got %q", filters)
+	}
+}
+
+func TestTypesenseShadowIndexRecreatesInactiveCollection(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		requests []string
+		created  string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/aliases/npan_items":
+			_, _ = w.Write([]byte(`{"name":"npan_items","collection_name":"npan_items_blue"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/collections/npan_items_green":
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/collections/npan_items_green":
+			http.NotFound(w, r)
+		case r.Method == http.MethodPost && r.URL.Path == "/collections":
+			var schema typesenseCollectionSchema
+			_ = json.NewDecoder(r.Body).Decode(&schema)
+			mu.Lock()
+			created = schema.Name
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"name":"npan_items_green","num_documents":0}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	idx := NewTypesenseIndex(srv.URL, "typesense-key", "npan_items")
+	shadow, name, err := idx.ShadowIndex(context.Background(), true)
+	if err != nil {
+		t.Fatalf("ShadowIndex returned error: %v", err)
+	}
+	if name != "npan_items_green" || shadow.(*TypesenseIndex).collection != "npan_items_green" {
+		t.Fatalf("expected the collection not behind the alias, got %s", name)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if created != "npan_items_green" {
+		t.Fatalf("expected shadow collection to be created, got %q (requests %v)", created, requests)
+	}
+	if requests[1] != "DELETE /collections/npan_items_green" {
+		t.Fatalf("expected shadow collection to be dropped before reuse, got %v", requests)
+	}
+}
+
+func TestTypesenseSwapShadowMovesAlias(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu     sync.Mutex
+		target string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/aliases/npan_items":
+			_, _ = w.Write([]byte(`{"name":"npan_items","collection_name":"npan_items_blue"}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/aliases/npan_items":
+			var payload map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			target = payload["collection_name"]
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	idx := NewTypesenseIndex(srv.URL, "typesense-key", "npan_items")
+	if err := idx.SwapShadow(context.Background()); err != nil {
+		t.Fatalf("SwapShadow returned error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if target != "npan_items_green" {
+		t.Fatalf("expected alias -> npan_items_green, got %s", target)
+	}
+}
+
+func TestTypesenseFirstSwapKeepsLegacyCollectionForRollback(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		requests []string
+		imported string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/aliases/npan_items":
+			http.NotFound(w, r)
+		case r.Method == http.MethodDelete && r.URL.Path == "/collections/npan_items_green":
+			http.NotFound(w, r)
+		case r.Method == http.MethodGet && r.URL.Path == "/collections/npan_items_green":
+			http.NotFound(w, r)
+		case r.Method == http.MethodPost && r.URL.Path == "/collections":
+			_, _ = w.Write([]byte(`{"name":"npan_items_green","num_documents":0}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/collections/npan_items/documents/search":
+			if r.URL.Query().Get("filter_by") != "" {
+				t.Fatalf("expected legacy collection to be copied in full, got filter %q", r.URL.Query().Get("filter_by"))
+			}
+			_, _ = w.Write([]byte(`{"found":1,"hits":[{"document":{"doc_id":"file_1","name":"a.pdf","is_deleted":true}}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/collections/npan_items_green/documents/import":
+			body, _ := io.ReadAll(r.Body)
+			imported = string(body)
+			_, _ = w.Write([]byte(`{"success":true}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/aliases/npan_items":
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/collections/npan_items":
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	idx := NewTypesenseIndex(srv.URL, "typesense-key", "npan_items")
+	if err := idx.SwapShadow(context.Background()); err != nil {
+		t.Fatalf("SwapShadow returned error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !strings.Contains(imported, `"doc_id":"file_1"`) {
+		t.Fatalf("expected legacy documents copied to the rollback collection, got %q", imported)
+	}
+	// 别名必须在删除同名 collection 之前创建，删除后请求立即经别名解析，不出现空窗。
+	alias := slices.Index(requests, "PUT /aliases/npan_items")
+	drop := slices.Index(requests, "DELETE /collections/npan_items")
+	copied := slices.Index(requests, "POST /collections/npan_items_green/documents/import")
+	if copied < 0 || alias < copied || drop < alias {
+		t.Fatalf("expected copy, alias, then drop of the legacy collection, got %v", requests)
+	}
+}
+
 func urlQueryUnescape(raw string) (string, error) {
 	return url.QueryUnescape(raw)
 }
+
+func TestTypesenseCountDuplicateSHA1CountsScannedDocuments(t *testing.T) {
+	t.Parallel()
+
+	var query url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/collections/npan_items/documents/search" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"found":7,"hits":[
+			{"document":{"sha1":"aaa","size":1024}},
+			{"document":{"sha1":"bbb","size":4096}},
+			{"document":{"sha1":"aaa","size":1024}},
+			{"document":{"sha1":"aaa","size":1024}},
+			{"document":{"sha1":"bbb","size":4096}},
+			{"document":{"sha1":"ccc","size":2048}},
+			{"document":{"sha1":""}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	idx := NewTypesenseIndex(srv.URL, "typesense-key", "npan_items")
+	rootID := int64(100)
+	summary, err := idx.CountDuplicateSHA1(context.Background(), models.LocalSearchParams{Type: "file", WithinFolderID: &rootID, MinSize: 1024}, 1)
+	if err != nil {
+		t.Fatalf("CountDuplicateSHA1 returned error: %v", err)
+	}
+	// bbb 的副本少于 aaa，但浪费空间更大，应排在前面。
+	if len(summary.Groups) != 1 || summary.Groups[0].SHA1 != "bbb" || summary.Groups[0].Copies != 2 || summary.Groups[0].Size != 4096 {
+		t.Fatalf("expected the group wasting the most bytes first, got %+v", summary.Groups)
+	}
+	if summary.TotalGroups != 2 || summary.WastedBytes != 4096+2*1024 {
+		t.Fatalf("expected totals over all groups, got %+v", summary)
+	}
+	if got := query.Get("filter_by"); got != "type:=`file` && ancestor_ids:=100 && size:>=1024 && is_deleted:=false && in_trash:=false" {
+		t.Fatalf("unexpected filter_by %q", got)
+	}
+	if query.Get("include_fields") != "sha1,size" {
+		t.Fatalf("expected scan to fetch only sha1 and size, got %q", query.Get("include_fields"))
+	}
+}
+
+func TestTypesenseTenantViewScopesWritesQueriesAndDeletes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		imported string
+		searched string
+		deleted  []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/collections/npan_items/documents/import":
+			body, _ := io.ReadAll(r.Body)
+			imported = string(body)
+			_, _ = w.Write([]byte("{\"success\":true}\n"))
+		case r.Method == http.MethodGet && r.URL.Path == "/collections/npan_items/documents/search":
+			searched = r.URL.Query().Get("filter_by")
+			_, _ = w.Write([]byte(`{"found":1,"hits":[{"document":{"doc_id":"acme__file_1","source_id":1,"type":"file","name":"a.pdf","tenant_id":"acme"}}]}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/collections/npan_items/documents":
+			deleted = append(deleted, r.URL.Query().Get("filter_by"))
+			_, _ = w.Write([]byte(`{"num_deleted":1}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	idx, err := ForTenant(NewTypesenseIndex(srv.URL, "typesense-key", "npan_items"), "acme")
+	if err != nil {
+		t.Fatalf("ForTenant returned error: %v", err)
+	}
+	docs := []models.IndexDocument{{DocID: "file_1", SourceID: 1, Type: models.ItemTypeFile, Name: "a.pdf"}}
+	if err := idx.UpsertDocuments(context.Background(), docs); err != nil {
+		t.Fatalf("UpsertDocuments returned error: %v", err)
+	}
+	if !strings.Contains(imported, `"doc_id":"acme__file_1"`) || !strings.Contains(imported, `"tenant_id":"acme"`) {
+		t.Fatalf("expected tenant-scoped payload, got %s", imported)
+	}
+	if docs[0].DocID != "file_1" || docs[0].TenantID != "" {
+		t.Fatalf("caller documents must not be modified: %+v", docs[0])
+	}
+
+	results, _, err := idx.Search(models.LocalSearchParams{Query: "a", TenantID: "other"})
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+	if !strings.Contains(searched, "tenant_id:=`acme`") || strings.Contains(searched, "other") {
+		t.Fatalf("expected search to be scoped to acme, got %q", searched)
+	}
+	if len(results) != 1 || results[0].DocID != "file_1" {
+		t.Fatalf("expected tenant prefix to be stripped, got %+v", results)
+	}
+
+	if err := idx.DeleteDocuments(context.Background(), []string{"file_1"}); err != nil {
+		t.Fatalf("DeleteDocuments returned error: %v", err)
+	}
+	if err := idx.DeleteAllDocuments(context.Background()); err != nil {
+		t.Fatalf("DeleteAllDocuments returned error: %v", err)
+	}
+	if len(deleted) != 2 || deleted[0] != "doc_id:=`acme__file_1`" || deleted[1] != "tenant_id:=`acme`" {
+		t.Fatalf("unexpected delete filters: %v", deleted)
+	}
+	if _, _, err := idx.(IndexRebuilder).ShadowIndex(context.Background(), true); err != ErrRebuildUnsupported {
+		t.Fatalf("expected tenant view to reject shadow rebuild, got %v", err)
+	}
+}
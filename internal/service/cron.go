@@ -0,0 +1,175 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule 是标准 5 段 cron 表达式（分 时 日 月 周）的解析结果。
+type cronSchedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+
+	// 日与周同时被限定时按 Vixie cron 语义取并集。
+	dayOfMonthStar bool
+	dayOfWeekStar  bool
+}
+
+type cronField struct {
+	name string
+	min  int
+	max  int
+}
+
+var (
+	cronMinuteField     = cronField{name: "分钟", min: 0, max: 59}
+	cronHourField       = cronField{name: "小时", min: 0, max: 23}
+	cronDayOfMonthField = cronField{name: "日", min: 1, max: 31}
+	cronMonthField      = cronField{name: "月", min: 1, max: 12}
+	cronDayOfWeekField  = cronField{name: "周", min: 0, max: 7}
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// parseCron 解析 cron 表达式，支持 *、列表、区间、步长以及 @daily 等宏。
+func parseCron(expr string) (*cronSchedule, error) {
+	normalized := strings.TrimSpace(expr)
+	if macro, ok := cronMacros[strings.ToLower(normalized)]; ok {
+		normalized = macro
+	}
+
+	fields := strings.Fields(normalized)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron 表达式需要 5 个字段（分 时 日 月 周）: %q", expr)
+	}
+
+	schedule := &cronSchedule{}
+	var err error
+	if schedule.minute, err = parseCronField(fields[0], cronMinuteField); err != nil {
+		return nil, err
+	}
+	if schedule.hour, err = parseCronField(fields[1], cronHourField); err != nil {
+		return nil, err
+	}
+	if schedule.dayOfMonth, err = parseCronField(fields[2], cronDayOfMonthField); err != nil {
+		return nil, err
+	}
+	if schedule.month, err = parseCronField(fields[3], cronMonthField); err != nil {
+		return nil, err
+	}
+	if schedule.dayOfWeek, err = parseCronField(fields[4], cronDayOfWeekField); err != nil {
+		return nil, err
+	}
+	// 周字段中 7 与 0 都表示周日。
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+	schedule.dayOfMonthStar = strings.HasPrefix(fields[2], "*")
+	schedule.dayOfWeekStar = strings.HasPrefix(fields[4], "*")
+	return schedule, nil
+}
+
+func parseCronField(raw string, field cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(raw, ",") {
+		if part == "" {
+			return 0, fmt.Errorf("cron %s字段为空: %q", field.name, raw)
+		}
+
+		rangePart := part
+		step := 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			parsedStep, err := strconv.Atoi(part[idx+1:])
+			if err != nil || parsedStep <= 0 {
+				return 0, fmt.Errorf("cron %s字段步长无效: %q", field.name, part)
+			}
+			step = parsedStep
+			rangePart = part[:idx]
+		}
+
+		start, end := field.min, field.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			lo, errLo := strconv.Atoi(bounds[0])
+			hi, errHi := strconv.Atoi(bounds[1])
+			if errLo != nil || errHi != nil || lo > hi {
+				return 0, fmt.Errorf("cron %s字段区间无效: %q", field.name, part)
+			}
+			start, end = lo, hi
+		default:
+			value, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return 0, fmt.Errorf("cron %s字段取值无效: %q", field.name, part)
+			}
+			start = value
+			if step == 1 {
+				end = value
+			}
+		}
+
+		if start < field.min || end > field.max {
+			return 0, fmt.Errorf("cron %s字段超出范围 %d-%d: %q", field.name, field.min, field.max, part)
+		}
+		for value := start; value <= end; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+	return bits, nil
+}
+
+func cronHas(bits uint64, value int) bool {
+	return bits&(1<<uint(value)) != 0
+}
+
+func (c *cronSchedule) matchesDay(t time.Time) bool {
+	domMatch := cronHas(c.dayOfMonth, t.Day())
+	dowMatch := cronHas(c.dayOfWeek, int(t.Weekday()))
+	if c.dayOfMonthStar || c.dayOfWeekStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// Next 返回严格晚于 after 的下一次触发时间（按 after 所在时区计算）；
+// 五年内无匹配时返回零值。
+func (c *cronSchedule) Next(after time.Time) time.Time {
+	loc := after.Location()
+	t := time.Date(after.Year(), after.Month(), after.Day(), after.Hour(), after.Minute(), 0, 0, loc).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if !cronHas(c.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !cronHas(c.hour, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if !cronHas(c.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
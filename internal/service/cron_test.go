@@ -0,0 +1,63 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron_NextFireTimes(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	base := time.Date(2026, 3, 14, 10, 17, 30, 0, loc) // Saturday
+
+	cases := []struct {
+		expr string
+		want time.Time
+	}{
+		{expr: "*/15 * * * *", want: time.Date(2026, 3, 14, 10, 30, 0, 0, loc)},
+		{expr: "0 3 * * *", want: time.Date(2026, 3, 15, 3, 0, 0, 0, loc)},
+		{expr: "@hourly", want: time.Date(2026, 3, 14, 11, 0, 0, 0, loc)},
+		{expr: "30 9 * * 1-5", want: time.Date(2026, 3, 16, 9, 30, 0, 0, loc)},
+		{expr: "0 0 1 */3 *", want: time.Date(2026, 4, 1, 0, 0, 0, 0, loc)},
+		{expr: "0 12 * * 7", want: time.Date(2026, 3, 15, 12, 0, 0, 0, loc)},
+		{expr: "0 0 13 * 5", want: time.Date(2026, 3, 20, 0, 0, 0, 0, loc)},
+		{expr: "5,45 10 * * *", want: time.Date(2026, 3, 14, 10, 45, 0, 0, loc)},
+	}
+
+	for _, tc := range cases {
+		schedule, err := parseCron(tc.expr)
+		if err != nil {
+			t.Fatalf("parseCron(%q) failed: %v", tc.expr, err)
+		}
+		if got := schedule.Next(base); !got.Equal(tc.want) {
+			t.Fatalf("parseCron(%q).Next = %s, want %s", tc.expr, got, tc.want)
+		}
+	}
+}
+
+func TestParseCron_RejectsInvalidExpressions(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"1,,2 * * * *",
+	} {
+		if _, err := parseCron(expr); err == nil {
+			t.Fatalf("expected parseCron(%q) to fail", expr)
+		}
+	}
+}
+
+func TestParseCron_NoMatchReturnsZero(t *testing.T) {
+	schedule, err := parseCron("0 0 31 2 *")
+	if err != nil {
+		t.Fatalf("parseCron failed: %v", err)
+	}
+	if got := schedule.Next(time.Now()); !got.IsZero() {
+		t.Fatalf("expected zero time for impossible date, got %s", got)
+	}
+}
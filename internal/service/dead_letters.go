@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"npan/internal/indexer"
+	"npan/internal/models"
+	"npan/internal/storage"
+)
+
+var (
+	ErrDeadLettersDisabled = errors.New("死信队列未启用")
+	ErrDeadLetterNotFound  = errors.New("死信记录不存在")
+)
+
+// DeadLetterReplayResult 汇总一次重放：成功的记录已删除，失败的记录保留并累加尝试次数；
+// 已被之后的同步覆盖的记录直接删除而不写入，避免把旧文档写回索引。
+type DeadLetterReplayResult struct {
+	Replayed   []int64 `json:"replayed"`
+	Failed     []int64 `json:"failed"`
+	Superseded []int64 `json:"superseded"`
+}
+
+// deadLetterRecorder 返回全量爬取的写入失败回调，把失败批次写入死信表。
+func (m *SyncManager) deadLetterRecorder(rootID int64) func(docs []models.IndexDocument, err error) {
+	if m.deadLetterStore == nil {
+		return nil
+	}
+	return func(docs []models.IndexDocument, err error) {
+		m.mu.Lock()
+		runID := m.currentRunID
+		m.mu.Unlock()
+
+		letter := &models.DeadLetter{
+			RunID:        runID,
+			RootFolderID: rootID,
+			Documents:    docs,
+			Error:        err.Error(),
+			CreatedAt:    time.Now().UnixMilli(),
+		}
+		if addErr := m.deadLetterStore.Add(letter); addErr != nil {
+			slog.Warn("写入死信记录失败，该批文档需重新爬取", "root_id", rootID, "docs", len(docs), "error", addErr)
+		}
+	}
+}
+
+// appendDeadLetterCount 把未处理的死信数写入校验结果。
+func (m *SyncManager) appendDeadLetterCount(verification *models.SyncVerification) {
+	if verification == nil || m.deadLetterStore == nil {
+		return
+	}
+	count, err := m.deadLetterStore.Count()
+	if err != nil {
+		slog.Warn("统计死信记录失败", "error", err)
+		return
+	}
+	verification.DeadLetterCount = count
+	if count > 0 {
+		verification.Warnings = append(verification.Warnings,
+			fmt.Sprintf("存在 %d 个未处理的写入失败批次，可重放或丢弃", count))
+	}
+}
+
+func (m *SyncManager) ListDeadLetters(filter storage.DeadLetterFilter) ([]models.DeadLetter, error) {
+	if m.deadLetterStore == nil {
+		return nil, ErrDeadLettersDisabled
+	}
+	return m.deadLetterStore.List(filter)
+}
+
+func (m *SyncManager) CountDeadLetters() (int64, error) {
+	if m.deadLetterStore == nil {
+		return 0, ErrDeadLettersDisabled
+	}
+	return m.deadLetterStore.Count()
+}
+
+// ReplayDeadLetters 重新写入指定死信批次，all 为 true 时重放全部。
+// 重放期间占用同步运行状态与租约，避免与本进程或其他进程的同步并发写入同一批文档。
+func (m *SyncManager) ReplayDeadLetters(ctx context.Context, ids []int64, all bool) (*DeadLetterReplayResult, error) {
+	letters, err := m.loadDeadLetters(ids, all)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, finish, err := m.beginExclusiveOperation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
+	laterRuns, err := m.laterFullRuns(letters)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DeadLetterReplayResult{Replayed: []int64{}, Failed: []int64{}, Superseded: []int64{}}
+	for _, letter := range letters {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		docs, err := m.pendingDeadLetterDocs(ctx, letter, laterRuns)
+		if err != nil {
+			return result, err
+		}
+		if len(docs) == 0 {
+			slog.Info("死信批次已被之后的同步覆盖，直接删除", "id", letter.ID, "run_id", letter.RunID)
+			if _, err := m.deadLetterStore.Delete(letter.ID); err != nil {
+				return result, err
+			}
+			result.Superseded = append(result.Superseded, letter.ID)
+			continue
+		}
+		writeErr := indexer.WithRetryVoid(ctx, func() error {
+			return m.index.UpsertDocuments(ctx, docs)
+		}, m.retry)
+		if writeErr != nil {
+			slog.Warn("重放死信批次失败", "id", letter.ID, "error", writeErr)
+			if err := m.deadLetterStore.RecordFailure(letter.ID, writeErr.Error(), time.Now().UnixMilli()); err != nil {
+				return result, err
+			}
+			result.Failed = append(result.Failed, letter.ID)
+			continue
+		}
+		if _, err := m.deadLetterStore.Delete(letter.ID); err != nil {
+			return result, err
+		}
+		result.Replayed = append(result.Replayed, letter.ID)
+	}
+	return result, nil
+}
+
+// laterFullRuns 返回比最早一条死信更晚、成功完成的全量同步记录。未启用运行历史时返回 nil。
+func (m *SyncManager) laterFullRuns(letters []models.DeadLetter) ([]models.SyncRun, error) {
+	if m.runStore == nil || len(letters) == 0 {
+		return nil, nil
+	}
+	oldest := letters[0]
+	for _, letter := range letters[1:] {
+		if letter.CreatedAt < oldest.CreatedAt {
+			oldest = letter
+		}
+	}
+
+	var runs []models.SyncRun
+	beforeID := int64(0)
+	for {
+		page, err := m.runStore.List(storage.SyncRunFilter{Mode: models.SyncModeFull, BeforeID: beforeID})
+		if err != nil {
+			return nil, fmt.Errorf("读取同步历史失败: %w", err)
+		}
+		for _, run := range page {
+			// 同步不会并发执行，开始时间早于死信的只可能是写入死信的那次运行或更早的运行。
+			if run.StartedAt < oldest.CreatedAt {
+				return runs, nil
+			}
+			if run.Status == "done" {
+				runs = append(runs, run)
+			}
+		}
+		if len(page) == 0 {
+			return runs, nil
+		}
+		beforeID = page[len(page)-1].ID
+	}
+}
+
+// deadLetterSuperseded 判断死信所在根目录是否在写入失败之后又成功完成过全量同步：
+// 仍存在的条目已被重新写入，上游已删除的条目已被过期清理，重放只会写回旧文档。
+func deadLetterSuperseded(letter models.DeadLetter, laterRuns []models.SyncRun) bool {
+	for _, run := range laterRuns {
+		later := run.ID > letter.RunID
+		if letter.RunID == 0 {
+			later = run.StartedAt > letter.CreatedAt
+		}
+		if later && containsInt64(run.Roots, letter.RootFolderID) {
+			return true
+		}
+	}
+	return false
+}
+
+// pendingDeadLetterDocs 返回死信中仍需写入的文档：索引中已有更新版本（修改时间更晚）的文档被之后的
+// 增量同步写入过，不能用旧版本覆盖。
+func (m *SyncManager) pendingDeadLetterDocs(ctx context.Context, letter models.DeadLetter, laterRuns []models.SyncRun) ([]models.IndexDocument, error) {
+	if deadLetterSuperseded(letter, laterRuns) {
+		return nil, nil
+	}
+
+	docIDs := make([]string, 0, len(letter.Documents))
+	for _, doc := range letter.Documents {
+		docIDs = append(docIDs, doc.DocID)
+	}
+	current, err := m.index.GetDocuments(ctx, docIDs)
+	if err != nil {
+		return nil, fmt.Errorf("读取索引中的当前文档失败: %w", err)
+	}
+	modifiedAt := make(map[string]int64, len(current))
+	for _, doc := range current {
+		modifiedAt[doc.DocID] = doc.ModifiedAt
+	}
+
+	docs := make([]models.IndexDocument, 0, len(letter.Documents))
+	for _, doc := range letter.Documents {
+		if indexed, ok := modifiedAt[doc.DocID]; ok && indexed > doc.ModifiedAt {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// DiscardDeadLetters 删除指定死信批次而不写入索引，返回删除条数。
+func (m *SyncManager) DiscardDeadLetters(ids []int64, all bool) (int64, error) {
+	letters, err := m.loadDeadLetters(ids, all)
+	if err != nil {
+		return 0, err
+	}
+
+	discarded := int64(0)
+	for _, letter := range letters {
+		deleted, err := m.deadLetterStore.Delete(letter.ID)
+		if err != nil {
+			return discarded, err
+		}
+		if deleted {
+			discarded++
+		}
+	}
+	return discarded, nil
+}
+
+// loadDeadLetters 在处理前确认所有指定 ID 都存在，避免只处理了一部分才报错。
+func (m *SyncManager) loadDeadLetters(ids []int64, all bool) ([]models.DeadLetter, error) {
+	if m.deadLetterStore == nil {
+		return nil, ErrDeadLettersDisabled
+	}
+
+	if all {
+		letters := make([]models.DeadLetter, 0)
+		beforeID := int64(0)
+		for {
+			page, err := m.deadLetterStore.List(storage.DeadLetterFilter{BeforeID: beforeID})
+			if err != nil {
+				return nil, err
+			}
+			letters = append(letters, page...)
+			if len(page) == 0 {
+				break
+			}
+			beforeID = page[len(page)-1].ID
+		}
+		// 按写入顺序重放，同一文档较晚失败的批次覆盖较早的。
+		for i, j := 0, len(letters)-1; i < j; i, j = i+1, j-1 {
+			letters[i], letters[j] = letters[j], letters[i]
+		}
+		return letters, nil
+	}
+
+	letters := make([]models.DeadLetter, 0, len(ids))
+	for _, id := range ids {
+		letter, err := m.deadLetterStore.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		if letter == nil {
+			return nil, fmt.Errorf("%w: id=%d", ErrDeadLetterNotFound, id)
+		}
+		letters = append(letters, *letter)
+	}
+	return letters, nil
+}
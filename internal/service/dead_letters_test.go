@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"npan/internal/models"
+	"npan/internal/storage"
+)
+
+func TestRunFull_RecordsAndReplaysDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "sync-state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer stores.DB.Close()
+
+	_, api := staleSweepFixture()
+	index := &failingUpsertIndex{inMemoryIndexStub: newInMemoryIndexStub(nil), failDoc: "file_1"}
+	mgr, _ := newTestSyncManager(t, index)
+	mgr.deadLetterStore = stores.DeadLetterStore
+
+	disabled := false
+	if err := mgr.run(context.Background(), api, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("run returned error: %v", err)
+	}
+
+	letters, err := mgr.ListDeadLetters(storage.DeadLetterFilter{})
+	if err != nil {
+		t.Fatalf("ListDeadLetters returned error: %v", err)
+	}
+	if len(letters) != 1 || letters[0].RootFolderID != 100 || letters[0].Error == "" {
+		t.Fatalf("expected one dead letter for root 100, got %#v", letters)
+	}
+	var failedDoc *models.IndexDocument
+	for i := range letters[0].Documents {
+		if letters[0].Documents[i].DocID == "file_1" {
+			failedDoc = &letters[0].Documents[i]
+		}
+	}
+	if failedDoc == nil || failedDoc.SyncRootID == nil || *failedDoc.SyncRootID != 100 {
+		t.Fatalf("expected dead letter to keep the stamped documents, got %#v", letters[0].Documents)
+	}
+
+	progress, err := mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.Verification == nil || progress.Verification.DeadLetterCount != 1 || len(progress.Verification.Warnings) == 0 {
+		t.Fatalf("expected verification to report the dead letter, got %+v", progress.Verification)
+	}
+
+	result, err := mgr.ReplayDeadLetters(context.Background(), []int64{letters[0].ID}, false)
+	if err != nil {
+		t.Fatalf("ReplayDeadLetters returned error: %v", err)
+	}
+	if len(result.Replayed) != 0 || len(result.Failed) != 1 {
+		t.Fatalf("expected replay to fail while the index still rejects the batch, got %+v", result)
+	}
+	still, err := stores.DeadLetterStore.Get(letters[0].ID)
+	if err != nil || still == nil || still.Attempts != 2 {
+		t.Fatalf("expected failed replay to keep the record with 2 attempts, got %#v %v", still, err)
+	}
+
+	index.failDoc = ""
+	result, err = mgr.ReplayDeadLetters(context.Background(), nil, true)
+	if err != nil {
+		t.Fatalf("ReplayDeadLetters returned error: %v", err)
+	}
+	if len(result.Replayed) != 1 || len(result.Failed) != 0 {
+		t.Fatalf("expected replay to succeed, got %+v", result)
+	}
+	if _, ok := index.docs["file_1"]; !ok {
+		t.Fatal("expected replayed document to be written to the index")
+	}
+	if count, err := mgr.CountDeadLetters(); err != nil || count != 0 {
+		t.Fatalf("expected no outstanding dead letters, got %d %v", count, err)
+	}
+}
+
+func TestDeadLetters_DiscardAndMissingIDs(t *testing.T) {
+	t.Parallel()
+
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "sync-state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer stores.DB.Close()
+
+	index := newInMemoryIndexStub(nil)
+	mgr, _ := newTestSyncManager(t, index)
+	mgr.deadLetterStore = stores.DeadLetterStore
+
+	letter := &models.DeadLetter{RootFolderID: 100, Documents: []models.IndexDocument{{DocID: "file_1"}}, Error: "bad request", CreatedAt: 1}
+	if err := stores.DeadLetterStore.Add(letter); err != nil {
+		t.Fatalf("add dead letter failed: %v", err)
+	}
+
+	if _, err := mgr.DiscardDeadLetters([]int64{letter.ID, 9999}, false); !errors.Is(err, ErrDeadLetterNotFound) {
+		t.Fatalf("expected ErrDeadLetterNotFound, got %v", err)
+	}
+	if count, _ := mgr.CountDeadLetters(); count != 1 {
+		t.Fatalf("expected nothing discarded when an ID is missing, got count %d", count)
+	}
+
+	discarded, err := mgr.DiscardDeadLetters([]int64{letter.ID}, false)
+	if err != nil || discarded != 1 {
+		t.Fatalf("expected one discarded dead letter, got %d %v", discarded, err)
+	}
+	if len(index.upserts) != 0 {
+		t.Fatalf("expected discard not to write the index, got %d upserts", len(index.upserts))
+	}
+
+	noStore, _ := newTestSyncManager(t, index)
+	if _, err := noStore.ListDeadLetters(storage.DeadLetterFilter{}); !errors.Is(err, ErrDeadLettersDisabled) {
+		t.Fatalf("expected ErrDeadLettersDisabled, got %v", err)
+	}
+}
+
+func TestReplayDeadLetters_RetiresLettersSupersededByLaterSyncs(t *testing.T) {
+	t.Parallel()
+
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "sync-state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer stores.DB.Close()
+
+	failedRun := &models.SyncRun{Mode: models.SyncModeFull, Status: "done", Roots: []int64{100, 300}, StartedAt: 1000, EndedAt: 2000}
+	if err := stores.SyncRunStore.Create(failedRun); err != nil {
+		t.Fatalf("create run failed: %v", err)
+	}
+	rootLetter := &models.DeadLetter{RunID: failedRun.ID, RootFolderID: 100, Documents: []models.IndexDocument{{DocID: "file_1", ModifiedAt: 10}}, Error: "bad request", CreatedAt: 1500}
+	newerLetter := &models.DeadLetter{RunID: failedRun.ID, RootFolderID: 300, Documents: []models.IndexDocument{{DocID: "file_5", ModifiedAt: 10}}, Error: "bad request", CreatedAt: 1500}
+	pendingLetter := &models.DeadLetter{RunID: failedRun.ID, RootFolderID: 300, Documents: []models.IndexDocument{{DocID: "file_7", ModifiedAt: 10}}, Error: "bad request", CreatedAt: 1500}
+	for _, letter := range []*models.DeadLetter{rootLetter, newerLetter, pendingLetter} {
+		if err := stores.DeadLetterStore.Add(letter); err != nil {
+			t.Fatalf("add dead letter failed: %v", err)
+		}
+	}
+	// 之后根目录 100 又成功完成了一次全量同步，file_1 若仍存在已被重新写入，否则已被清理。
+	laterRun := &models.SyncRun{Mode: models.SyncModeFull, Status: "done", Roots: []int64{100}, StartedAt: 3000, EndedAt: 4000}
+	if err := stores.SyncRunStore.Create(laterRun); err != nil {
+		t.Fatalf("create run failed: %v", err)
+	}
+
+	// file_5 之后由增量同步写入了更新的版本。
+	index := newInMemoryIndexStub([]models.IndexDocument{{DocID: "file_5", ModifiedAt: 20}})
+	mgr, _ := newTestSyncManager(t, index)
+	mgr.deadLetterStore = stores.DeadLetterStore
+	mgr.runStore = stores.SyncRunStore
+
+	result, err := mgr.ReplayDeadLetters(context.Background(), nil, true)
+	if err != nil {
+		t.Fatalf("ReplayDeadLetters returned error: %v", err)
+	}
+	if len(result.Superseded) != 2 || result.Superseded[0] != rootLetter.ID || result.Superseded[1] != newerLetter.ID {
+		t.Fatalf("expected letters %d and %d to be retired, got %+v", rootLetter.ID, newerLetter.ID, result)
+	}
+	if len(result.Replayed) != 1 || result.Replayed[0] != pendingLetter.ID {
+		t.Fatalf("expected only letter %d to be replayed, got %+v", pendingLetter.ID, result)
+	}
+	if _, ok := index.docs["file_1"]; ok {
+		t.Fatal("expected superseded document not to be written back")
+	}
+	if index.docs["file_5"].ModifiedAt != 20 {
+		t.Fatalf("expected newer document to be kept, got %+v", index.docs["file_5"])
+	}
+	if _, ok := index.docs["file_7"]; !ok {
+		t.Fatal("expected pending document to be replayed")
+	}
+	if count, err := mgr.CountDeadLetters(); err != nil || count != 0 {
+		t.Fatalf("expected no outstanding dead letters, got %d %v", count, err)
+	}
+}
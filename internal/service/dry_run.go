@@ -0,0 +1,405 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"npan/internal/indexer"
+	"npan/internal/models"
+	"npan/internal/npan"
+	"npan/internal/search"
+)
+
+const (
+	// dryRunSampleLimit 是每个根目录每类差异保留的样例数。
+	dryRunSampleLimit = 20
+	// dryRunLookupBatch 是按 doc_id 批量读取索引文档的批大小。
+	dryRunLookupBatch = 500
+)
+
+var ErrDryRunWithRebuild = errors.New("演练同步不能与 force_rebuild 或 shadow_rebuild 同时使用")
+
+// dryRunDiffer 代替索引写入端接收同步产生的文档，与索引现有文档比对后只记录差异。
+type dryRunDiffer struct {
+	index search.IndexOperator
+	// rootOf 决定一篇文档的差异计入哪个根目录。
+	rootOf func(doc models.IndexDocument) int64
+
+	mu    sync.Mutex
+	roots map[int64]*models.DryRunRootDiff
+	seen  map[string]struct{}
+	err   error
+}
+
+func newDryRunDiffer(index search.IndexOperator, rootOf func(doc models.IndexDocument) int64) *dryRunDiffer {
+	return &dryRunDiffer{
+		index:  index,
+		rootOf: rootOf,
+		roots:  map[int64]*models.DryRunRootDiff{},
+		seen:   map[string]struct{}{},
+	}
+}
+
+// UpsertDocuments 实现 indexer.IndexWriter。读取索引失败会被爬取当作写入失败跳过，
+// 因此同时记下错误，爬取结束后由调用方报告。
+func (d *dryRunDiffer) UpsertDocuments(ctx context.Context, docs []models.IndexDocument) error {
+	err := d.compareUpserts(ctx, docs)
+	if err != nil && ctx.Err() == nil {
+		d.mu.Lock()
+		if d.err == nil {
+			d.err = err
+		}
+		d.mu.Unlock()
+	}
+	return err
+}
+
+func (d *dryRunDiffer) compareUpserts(ctx context.Context, docs []models.IndexDocument) error {
+	for batch := range slices.Chunk(docs, dryRunLookupBatch) {
+		indexed, err := d.lookup(ctx, batch)
+		if err != nil {
+			return err
+		}
+
+		d.mu.Lock()
+		for _, doc := range batch {
+			d.seen[doc.DocID] = struct{}{}
+			diff := d.root(d.rootOf(doc))
+			current, exists := indexed[doc.DocID]
+			switch {
+			case !exists:
+				diff.Adds++
+				diff.SampleAdds = appendDryRunSample(diff.SampleAdds, doc, nil)
+			default:
+				changed := changedIndexFields(current, doc)
+				if len(changed) == 0 {
+					diff.Unchanged++
+					continue
+				}
+				diff.Updates++
+				diff.SampleUpdates = appendDryRunSample(diff.SampleUpdates, doc, changed)
+			}
+		}
+		d.mu.Unlock()
+	}
+	return nil
+}
+
+// recordDeletes 统计仍在索引中、且本次同步没有再写入的文档。
+func (d *dryRunDiffer) recordDeletes(ctx context.Context, docIDs []string) error {
+	d.mu.Lock()
+	pending := make([]models.IndexDocument, 0, len(docIDs))
+	for _, docID := range docIDs {
+		if _, ok := d.seen[docID]; ok {
+			continue
+		}
+		d.seen[docID] = struct{}{}
+		pending = append(pending, models.IndexDocument{DocID: docID})
+	}
+	d.mu.Unlock()
+
+	for batch := range slices.Chunk(pending, dryRunLookupBatch) {
+		indexed, err := d.lookup(ctx, batch)
+		if err != nil {
+			return err
+		}
+
+		d.mu.Lock()
+		for _, doc := range batch {
+			current, exists := indexed[doc.DocID]
+			if !exists {
+				continue
+			}
+			diff := d.root(d.rootOf(current))
+			diff.Deletes++
+			diff.SampleDeletes = appendDryRunSample(diff.SampleDeletes, current, nil)
+		}
+		d.mu.Unlock()
+	}
+	return nil
+}
+
+func (d *dryRunDiffer) lookup(ctx context.Context, docs []models.IndexDocument) (map[string]models.IndexDocument, error) {
+	docIDs := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		docIDs = append(docIDs, doc.DocID)
+	}
+	indexed, err := d.index.GetDocuments(ctx, docIDs)
+	if err != nil {
+		return nil, fmt.Errorf("读取索引文档失败: %w", err)
+	}
+	result := make(map[string]models.IndexDocument, len(indexed))
+	for _, doc := range indexed {
+		result[doc.DocID] = doc
+	}
+	return result, nil
+}
+
+// root 返回根目录的差异统计，调用方需持有 mu。
+func (d *dryRunDiffer) root(rootID int64) *models.DryRunRootDiff {
+	diff, ok := d.roots[rootID]
+	if !ok {
+		diff = &models.DryRunRootDiff{RootFolderID: rootID}
+		d.roots[rootID] = diff
+	}
+	return diff
+}
+
+func (d *dryRunDiffer) lookupErr() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.err
+}
+
+// changedIndexFields 只比较 Meilisearch 可读回的字段，sha1、回收站标记等未展示字段无法比对。
+func changedIndexFields(indexed models.IndexDocument, fresh models.IndexDocument) []string {
+	var changed []string
+	if indexed.Name != fresh.Name {
+		changed = append(changed, "name")
+	}
+	if indexed.PathText != fresh.PathText {
+		changed = append(changed, "path_text")
+	}
+	if indexed.ParentID != fresh.ParentID {
+		changed = append(changed, "parent_id")
+	}
+	if !slices.Equal(indexed.AncestorIDs, fresh.AncestorIDs) {
+		changed = append(changed, "ancestor_ids")
+	}
+	if indexed.Size != fresh.Size {
+		changed = append(changed, "size")
+	}
+	if indexed.ModifiedAt != fresh.ModifiedAt {
+		changed = append(changed, "modified_at")
+	}
+	return changed
+}
+
+func appendDryRunSample(samples []models.DryRunSample, doc models.IndexDocument, changed []string) []models.DryRunSample {
+	if len(samples) >= dryRunSampleLimit {
+		return samples
+	}
+	return append(samples, models.DryRunSample{
+		DocID:         doc.DocID,
+		SourceID:      doc.SourceID,
+		Type:          doc.Type,
+		Path:          doc.PathText,
+		ChangedFields: changed,
+	})
+}
+
+// discardCheckpointStore 让演练爬取总是从根目录开始，且不覆盖真实同步的断点。
+type discardCheckpointStore struct{}
+
+func (discardCheckpointStore) Load() (*models.CrawlCheckpoint, error) { return nil, nil }
+func (discardCheckpointStore) Save(*models.CrawlCheckpoint) error     { return nil }
+func (discardCheckpointStore) Clear() error                           { return nil }
+
+// runDryRun 执行演练同步，演练的状态与差异报告只写入进度的 DryRun 字段。进度的其余部分
+// （包括状态、开始时间与错误）保持上一次真实同步的结果，以免影响续爬与运行记录；不写运行历史，也不上报同步指标。
+// 演练不取同步租约，其他进程可能正在同步，因此每次保存都重新读取进度、只替换 DryRun 字段。
+func (m *SyncManager) runDryRun(ctx context.Context, api npan.API, request SyncStartRequest, mode models.SyncMode) error {
+	existing, err := m.progressStore.Load()
+	if err != nil {
+		return err
+	}
+	rootNames := map[int64]string{}
+	if existing != nil && existing.RootNames != nil {
+		rootNames = existing.RootNames
+	}
+
+	report := &models.DryRunReport{Mode: string(mode), Status: "running", StartedAt: time.Now().UnixMilli(), Roots: []models.DryRunRootDiff{}}
+	if err := m.saveDryRunReport(report); err != nil {
+		return err
+	}
+
+	limiter := m.newRequestLimiter()
+
+	if mode == models.SyncModeIncremental {
+		err = m.dryRunIncremental(ctx, api, report, rootNames, request, limiter)
+	} else {
+		err = m.dryRunFull(ctx, api, report, request, limiter)
+	}
+
+	report.Status = "done"
+	if err != nil {
+		report.Status = "error"
+		if ctx.Err() != nil {
+			report.Status = "cancelled"
+		}
+		report.LastError = err.Error()
+	}
+	report.ActiveRoot = nil
+	report.FinishedAt = time.Now().UnixMilli()
+	if saveErr := m.saveDryRunReport(report); saveErr != nil {
+		return saveErr
+	}
+	return err
+}
+
+// saveDryRunReport 读取最新的进度并只替换其中的演练报告，不覆盖其他进程写入的同步进度。
+func (m *SyncManager) saveDryRunReport(report *models.DryRunReport) error {
+	progress, err := m.progressStore.Load()
+	if err != nil {
+		return err
+	}
+	if progress == nil {
+		progress = &models.SyncProgressState{
+			Roots:          []int64{},
+			CompletedRoots: []int64{},
+			RootNames:      map[int64]string{},
+			RootProgress:   map[string]*models.RootSyncProgress{},
+		}
+	}
+	progress.DryRun = report
+	return m.progressStore.Save(progress)
+}
+
+// dryRunFull 逐个根目录完整爬取并比对；索引中属于该根目录、本次未再爬到的文档计为删除。
+func (m *SyncManager) dryRunFull(ctx context.Context, api npan.API, report *models.DryRunReport, request SyncStartRequest, limiter *indexer.RequestLimiter) error {
+	roots, _, rootNames, err := m.discoverRootFolders(ctx, api, request, limiter)
+	if err != nil {
+		return err
+	}
+	if len(roots) == 0 {
+		return fmt.Errorf("未发现可遍历的根目录")
+	}
+
+	workers := indexer.NewCrawlWorkerPool(m.folderWorkers(request))
+	for _, rootID := range roots {
+		report.ActiveRoot = &rootID
+		if err := m.saveDryRunReport(report); err != nil {
+			return err
+		}
+
+		diff, err := m.dryRunFullRoot(ctx, api, rootID, rootNames, limiter, workers)
+		if err != nil {
+			return fmt.Errorf("演练根目录 %d 失败: %w", rootID, err)
+		}
+		diff.RootName = rootNames[rootID]
+		addDryRunRoot(report, *diff)
+		if err := m.saveDryRunReport(report); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *SyncManager) dryRunFullRoot(ctx context.Context, api npan.API, rootID int64, rootNames map[int64]string, limiter *indexer.RequestLimiter, workers *indexer.CrawlWorkerPool) (*models.DryRunRootDiff, error) {
+	differ := newDryRunDiffer(m.index, func(models.IndexDocument) int64 { return rootID })
+	rootName := ""
+	if rootID != 0 {
+		rootName = rootNames[rootID]
+	}
+
+	_, err := indexer.RunFullCrawl(ctx, indexer.FullCrawlDeps{
+		API:             api,
+		IndexWriter:     differ,
+		Limiter:         limiter,
+		CheckpointStore: discardCheckpointStore{},
+		RootFolderID:    rootID,
+		RootName:        rootName,
+		PathResolver:    m.newFolderPathResolver(api, limiter, rootNames),
+		Workers:         workers,
+		SyncGeneration:  time.Now().UnixMilli(),
+		Retry:           m.retry,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := differ.lookupErr(); err != nil {
+		return nil, err
+	}
+
+	snapshot, err := buildIndexedTreeSnapshot(ctx, m.index, rootID)
+	if err != nil {
+		return nil, err
+	}
+	if err := differ.recordDeletes(ctx, snapshot.collectSubtreeDocIDs(rootID)); err != nil {
+		return nil, err
+	}
+	return differ.root(rootID), nil
+}
+
+// dryRunIncremental 拉取与真实增量同步相同的变更窗口并比对，已删除目录在索引中的子孙文档一并计为删除。
+// 文档按祖先链中的第一个目录（即同步根目录）归组。
+func (m *SyncManager) dryRunIncremental(ctx context.Context, api npan.API, report *models.DryRunReport, rootNames map[int64]string, request SyncStartRequest, limiter *indexer.RequestLimiter) error {
+	syncStateStore := m.effectiveSyncStateStore()
+	var syncState *models.SyncState
+	if syncStateStore != nil {
+		syncState, _ = syncStateStore.Load()
+	}
+	if syncState == nil || syncState.LastSyncTime <= 0 {
+		return fmt.Errorf("增量同步需要先执行一次全量同步")
+	}
+
+	paths := m.newFolderPathResolver(api, limiter, rootNames)
+	changes, err := m.fetchIncrementalChanges(ctx, api, request, incrementalCursor(syncState), limiter, paths)
+	if err != nil {
+		return err
+	}
+
+	differ := newDryRunDiffer(m.index, func(doc models.IndexDocument) int64 {
+		if len(doc.AncestorIDs) > 0 {
+			return doc.AncestorIDs[0]
+		}
+		return doc.ParentID
+	})
+	var upserts []models.IndexDocument
+	var deleteIDs []string
+	for _, item := range changes {
+		if !item.Deleted {
+			upserts = append(upserts, item.Doc)
+			continue
+		}
+		deleteIDs = append(deleteIDs, item.Doc.DocID)
+		if item.Doc.Type != models.ItemTypeFolder {
+			continue
+		}
+		snapshot, err := buildIndexedTreeSnapshot(ctx, m.index, item.Doc.SourceID)
+		if err != nil {
+			return fmt.Errorf("读取已删除目录 %d 的索引子树失败: %w", item.Doc.SourceID, err)
+		}
+		deleteIDs = append(deleteIDs, snapshot.collectSubtreeDocIDs(item.Doc.SourceID)...)
+	}
+
+	if err := differ.compareUpserts(ctx, upserts); err != nil {
+		return err
+	}
+	if err := differ.recordDeletes(ctx, deleteIDs); err != nil {
+		return err
+	}
+
+	rootIDs := make([]int64, 0, len(differ.roots))
+	for rootID := range differ.roots {
+		rootIDs = append(rootIDs, rootID)
+	}
+	slices.Sort(rootIDs)
+	for _, rootID := range rootIDs {
+		diff := *differ.roots[rootID]
+		diff.RootName = rootNames[rootID]
+		addDryRunRoot(report, diff)
+	}
+	return nil
+}
+
+func addDryRunRoot(report *models.DryRunReport, diff models.DryRunRootDiff) {
+	report.Roots = append(report.Roots, diff)
+	report.Adds += diff.Adds
+	report.Updates += diff.Updates
+	report.Deletes += diff.Deletes
+}
+
+func validateDryRun(request SyncStartRequest) error {
+	if !request.DryRun {
+		return nil
+	}
+	if (request.ForceRebuild != nil && *request.ForceRebuild) || (request.ShadowRebuild != nil && *request.ShadowRebuild) {
+		return ErrDryRunWithRebuild
+	}
+	return nil
+}
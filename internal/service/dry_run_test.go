@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"npan/internal/models"
+)
+
+func dryRunTreeAPI(files *[]models.NpanFile) *mockAPI {
+	return &mockAPI{
+		getFolderInfoFn: func(_ context.Context, folderID int64) (models.NpanFolder, error) {
+			return models.NpanFolder{ID: folderID, Name: "Root"}, nil
+		},
+		listFolderChildrenFn: func(_ context.Context, folderID int64, _ int64) (models.FolderChildrenPage, error) {
+			if folderID != 100 {
+				return models.FolderChildrenPage{}, nil
+			}
+			return models.FolderChildrenPage{Files: *files, PageCount: 1}, nil
+		},
+	}
+}
+
+func TestRunDryRun_FullReportsDiffWithoutWriting(t *testing.T) {
+	t.Parallel()
+
+	index := newInMemoryIndexStub(nil)
+	mgr, tmpDir := newTestSyncManager(t, index)
+	files := []models.NpanFile{
+		{ID: 1, Name: "a.pdf", ParentID: 100, Size: 10},
+		{ID: 2, Name: "b.pdf", ParentID: 100, Size: 20},
+		{ID: 3, Name: "c.pdf", ParentID: 100, Size: 30},
+	}
+	api := dryRunTreeAPI(&files)
+	includeDepartments := false
+	request := SyncStartRequest{Mode: models.SyncModeFull, RootFolderIDs: []int64{100}, IncludeDepartments: &includeDepartments}
+	if err := mgr.run(context.Background(), api, request); err != nil {
+		t.Fatalf("initial full sync failed: %v", err)
+	}
+	stateBefore, _ := mgr.syncStateStore.Load()
+	progressBefore, _ := mgr.progressStore.Load()
+	writes := len(index.upserts)
+
+	files = []models.NpanFile{
+		{ID: 1, Name: "a.pdf", ParentID: 100, Size: 10},
+		{ID: 2, Name: "b-renamed.pdf", ParentID: 100, Size: 20},
+		{ID: 4, Name: "d.pdf", ParentID: 100, Size: 40},
+	}
+	request.DryRun = true
+	if err := mgr.runDryRun(context.Background(), api, request, models.SyncModeFull); err != nil {
+		t.Fatalf("runDryRun returned error: %v", err)
+	}
+
+	if len(index.upserts) != writes || len(index.deletes) != 0 {
+		t.Fatalf("dry run must not write the index, upserts=%d deletes=%d", len(index.upserts)-writes, len(index.deletes))
+	}
+	if _, ok := index.docs["file_3"]; !ok {
+		t.Fatal("dry run must not delete stale documents")
+	}
+	stateAfter, _ := mgr.syncStateStore.Load()
+	if stateAfter.LastSyncTime != stateBefore.LastSyncTime {
+		t.Fatalf("dry run must not advance the cursor, before=%d after=%d", stateBefore.LastSyncTime, stateAfter.LastSyncTime)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "checkpoint.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("dry run must not write crawl checkpoints, stat err=%v", err)
+	}
+
+	progress, err := mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	report := progress.DryRun
+	if report == nil || report.Status != "done" || report.ActiveRoot != nil || len(report.Roots) != 1 {
+		t.Fatalf("expected a finished dry run report, got %+v", report)
+	}
+	if progress.Status != "done" || progress.StartedAt != progressBefore.StartedAt || progress.UpdatedAt != progressBefore.UpdatedAt {
+		t.Fatalf("dry run must keep the previous sync status and timestamps, before=%+v after=%+v", progressBefore, progress)
+	}
+	root := report.Roots[0]
+	if root.RootFolderID != 100 || root.Adds != 1 || root.Updates != 1 || root.Deletes != 1 || root.Unchanged != 2 {
+		t.Fatalf("unexpected root diff %+v", root)
+	}
+	if root.SampleAdds[0].DocID != "file_4" || root.SampleDeletes[0].DocID != "file_3" {
+		t.Fatalf("unexpected samples adds=%+v deletes=%+v", root.SampleAdds, root.SampleDeletes)
+	}
+	if update := root.SampleUpdates[0]; update.DocID != "file_2" || update.ChangedFields[0] != "name" {
+		t.Fatalf("unexpected update sample %+v", update)
+	}
+	if progress.RootProgress["100"] == nil || progress.RootProgress["100"].Status != "done" {
+		t.Fatalf("dry run must keep the previous root progress for resume, got %+v", progress.RootProgress["100"])
+	}
+}
+
+func TestRunDryRun_KeepsProgressWrittenByAnotherProcess(t *testing.T) {
+	t.Parallel()
+
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(nil))
+	files := []models.NpanFile{{ID: 1, Name: "a.pdf", ParentID: 100, Size: 10}}
+	api := dryRunTreeAPI(&files)
+	crawl := api.listFolderChildrenFn
+	api.listFolderChildrenFn = func(ctx context.Context, folderID int64, pageID int64) (models.FolderChildrenPage, error) {
+		// 演练不取租约，爬取期间另一个进程开始了真实同步并写入自己的进度。
+		if err := mgr.progressStore.Save(&models.SyncProgressState{Status: "running", StartedAt: 42, Roots: []int64{7}}); err != nil {
+			t.Fatalf("save progress: %v", err)
+		}
+		return crawl(ctx, folderID, pageID)
+	}
+
+	includeDepartments := false
+	request := SyncStartRequest{Mode: models.SyncModeFull, RootFolderIDs: []int64{100}, IncludeDepartments: &includeDepartments, DryRun: true}
+	if err := mgr.runDryRun(context.Background(), api, request, models.SyncModeFull); err != nil {
+		t.Fatalf("runDryRun returned error: %v", err)
+	}
+
+	progress, _ := mgr.progressStore.Load()
+	if progress.Status != "running" || progress.StartedAt != 42 || len(progress.Roots) != 1 || progress.Roots[0] != 7 {
+		t.Fatalf("dry run must not overwrite another process's progress, got %+v", progress)
+	}
+	if progress.DryRun == nil || progress.DryRun.Status != "done" || progress.DryRun.Adds != 2 {
+		t.Fatalf("expected the dry run report to be saved alongside, got %+v", progress.DryRun)
+	}
+}
+
+func TestRunDryRun_IncrementalCountsCascadeDeletes(t *testing.T) {
+	t.Parallel()
+
+	index := movedFolderFixture()
+	mgr, _ := newTestSyncManager(t, index)
+	if err := mgr.syncStateStore.Save(&models.SyncState{LastSyncTime: 1699990000}); err != nil {
+		t.Fatalf("save sync state: %v", err)
+	}
+	api := &mockAPI{
+		searchUpdatedWindowFn: func(_ context.Context, _ string, _ *int64, _ *int64, _ int64) (map[string]any, error) {
+			return makeOnePage(nil, []map[string]any{makeFolderEntry(10, "old", 100, true, false)}), nil
+		},
+	}
+
+	if err := mgr.runDryRun(context.Background(), api, SyncStartRequest{Mode: models.SyncModeIncremental, DryRun: true}, models.SyncModeIncremental); err != nil {
+		t.Fatalf("runDryRun returned error: %v", err)
+	}
+
+	if len(index.deletes) != 0 || len(index.upserts) != 0 {
+		t.Fatalf("dry run must not write the index, upserts=%v deletes=%v", index.upserts, index.deletes)
+	}
+	state, _ := mgr.syncStateStore.Load()
+	if state.LastSyncTime != 1699990000 {
+		t.Fatalf("dry run must not advance the cursor, got %d", state.LastSyncTime)
+	}
+	progress, _ := mgr.progressStore.Load()
+	if progress.DryRun == nil || progress.DryRun.Deletes != 4 || progress.DryRun.Adds != 0 {
+		t.Fatalf("expected the folder and its 3 descendants to be reported as deletes, got %+v", progress.DryRun)
+	}
+}
+
+func TestStart_RejectsDryRunWithRebuild(t *testing.T) {
+	t.Parallel()
+
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(nil))
+	force := true
+	err := mgr.Start(&mockAPI{}, SyncStartRequest{Mode: models.SyncModeFull, ForceRebuild: &force, DryRun: true})
+	if !errors.Is(err, ErrDryRunWithRebuild) {
+		t.Fatalf("expected ErrDryRunWithRebuild, got %v", err)
+	}
+}
+
+func TestGetProgress_MarksRunningDryRunInterruptedAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(nil))
+	activeRoot := int64(100)
+	if err := mgr.progressStore.Save(&models.SyncProgressState{
+		Status:       "done",
+		StartedAt:    1000,
+		UpdatedAt:    2000,
+		RootProgress: map[string]*models.RootSyncProgress{},
+		DryRun:       &models.DryRunReport{Mode: "full", Status: "running", StartedAt: 3000, ActiveRoot: &activeRoot},
+	}); err != nil {
+		t.Fatalf("seed progress: %v", err)
+	}
+
+	progress, err := mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.Status != "done" || progress.LastError != "" {
+		t.Fatalf("interrupted dry run must not change the sync status, got status=%s lastError=%q", progress.Status, progress.LastError)
+	}
+	if progress.DryRun.Status != "interrupted" || progress.DryRun.ActiveRoot != nil || progress.DryRun.LastError == "" {
+		t.Fatalf("expected the dry run to be reported as interrupted, got %+v", progress.DryRun)
+	}
+}
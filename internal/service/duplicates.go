@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"npan/internal/models"
+)
+
+const (
+	defaultDuplicateGroupLimit = 100
+	maxDuplicateGroupLimit     = 1000
+	// duplicateFilesPerGroup 限制单个分组列出的文件数，副本数仍按实际数量统计。
+	duplicateFilesPerGroup int64 = 1000
+)
+
+var ErrUnsupportedExportFormat = errors.New("不支持的导出格式")
+
+// DuplicateFilter 限定重复检测的范围：RootFolderID 为 0 时检测全部根目录，MinSize 以字节计。
+type DuplicateFilter struct {
+	RootFolderID int64
+	MinSize      int64
+	Limit        int
+}
+
+type DuplicateFile struct {
+	DocID    string `json:"docId"`
+	SourceID int64  `json:"sourceId"`
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	Size     int64  `json:"size"`
+}
+
+// DuplicateGroup 是内容相同（SHA1 相同）的一组文件，WastedBytes 为保留一份之外的占用。
+type DuplicateGroup struct {
+	SHA1        string          `json:"sha1"`
+	Size        int64           `json:"size"`
+	Copies      int64           `json:"copies"`
+	WastedBytes int64           `json:"wastedBytes"`
+	Files       []DuplicateFile `json:"files"`
+}
+
+// DuplicateReport 中 TotalGroups 与 WastedBytes 覆盖范围内的全部重复分组，Truncated 表示超出 Limit 的分组未列出。
+type DuplicateReport struct {
+	Groups      []DuplicateGroup `json:"groups"`
+	WastedBytes int64            `json:"wastedBytes"`
+
+	TotalGroups int64 `json:"totalGroups"`
+	Truncated   bool  `json:"truncated"`
+}
+
+// FindDuplicates 按 SHA1 对索引中的文件分组，返回浪费空间最大的 Limit 组，按浪费空间从大到小排序。
+func (m *SyncManager) FindDuplicates(ctx context.Context, filter DuplicateFilter) (*DuplicateReport, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultDuplicateGroupLimit
+	}
+	limit = min(limit, maxDuplicateGroupLimit)
+
+	params := models.LocalSearchParams{Type: string(models.ItemTypeFile), MinSize: filter.MinSize}
+	if filter.RootFolderID > 0 {
+		rootID := filter.RootFolderID
+		params.WithinFolderID = &rootID
+	}
+	summary, err := m.index.CountDuplicateSHA1(ctx, params, limit)
+	if err != nil {
+		return nil, fmt.Errorf("统计重复文件失败: %w", err)
+	}
+
+	report := &DuplicateReport{
+		Groups:      make([]DuplicateGroup, 0, len(summary.Groups)),
+		WastedBytes: summary.WastedBytes,
+		TotalGroups: summary.TotalGroups,
+		Truncated:   summary.TotalGroups > int64(len(summary.Groups)),
+	}
+	for _, counted := range summary.Groups {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		sha1 := counted.SHA1
+		params.Query = "*"
+		params.SHA1 = sha1
+		params.Page = 1
+		params.PageSize = min(counted.Copies, duplicateFilesPerGroup)
+		docs, total, err := m.index.Search(params)
+		if err != nil {
+			return nil, fmt.Errorf("读取 SHA1 %s 的文件失败: %w", sha1, err)
+		}
+		if total < 2 {
+			// 统计后文件已被删除或移出范围。
+			continue
+		}
+
+		group := DuplicateGroup{SHA1: sha1, Copies: total, Files: make([]DuplicateFile, 0, len(docs))}
+		for _, doc := range docs {
+			group.Size = max(group.Size, doc.Size)
+			group.Files = append(group.Files, DuplicateFile{
+				DocID:    doc.DocID,
+				SourceID: doc.SourceID,
+				Name:     doc.Name,
+				Path:     doc.PathText,
+				Size:     doc.Size,
+			})
+		}
+		sort.Slice(group.Files, func(i, j int) bool { return group.Files[i].Path < group.Files[j].Path })
+		group.WastedBytes = group.Size * (group.Copies - 1)
+		report.Groups = append(report.Groups, group)
+	}
+
+	sort.Slice(report.Groups, func(i, j int) bool {
+		if report.Groups[i].WastedBytes != report.Groups[j].WastedBytes {
+			return report.Groups[i].WastedBytes > report.Groups[j].WastedBytes
+		}
+		return report.Groups[i].SHA1 < report.Groups[j].SHA1
+	})
+	return report, nil
+}
+
+// WriteDuplicateReport 导出重复文件报告：csv 每个文件一行，ndjson 每个分组一行。
+func WriteDuplicateReport(w io.Writer, report *DuplicateReport, format string) error {
+	switch format {
+	case "csv":
+		writer := csv.NewWriter(w)
+		if err := writer.Write([]string{"sha1", "size", "copies", "wasted_bytes", "doc_id", "source_id", "path"}); err != nil {
+			return err
+		}
+		for _, group := range report.Groups {
+			for _, file := range group.Files {
+				if err := writer.Write([]string{
+					group.SHA1,
+					strconv.FormatInt(group.Size, 10),
+					strconv.FormatInt(group.Copies, 10),
+					strconv.FormatInt(group.WastedBytes, 10),
+					file.DocID,
+					strconv.FormatInt(file.SourceID, 10),
+					file.Path,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	case "ndjson":
+		encoder := json.NewEncoder(w)
+		for _, group := range report.Groups {
+			if err := encoder.Encode(group); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedExportFormat, format)
+	}
+}
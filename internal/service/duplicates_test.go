@@ -0,0 +1,120 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"npan/internal/models"
+)
+
+func duplicateFixture() *inMemoryIndexStub {
+	return newInMemoryIndexStub([]models.IndexDocument{
+		{DocID: "file_1", SourceID: 1, Type: models.ItemTypeFile, Name: "a.iso", PathText: "DeptA/a.iso", AncestorIDs: []int64{100}, Size: 4096, SHA1: "big"},
+		{DocID: "file_2", SourceID: 2, Type: models.ItemTypeFile, Name: "a-copy.iso", PathText: "DeptB/a-copy.iso", AncestorIDs: []int64{200}, Size: 4096, SHA1: "big"},
+		{DocID: "file_3", SourceID: 3, Type: models.ItemTypeFile, Name: "a.iso", PathText: "DeptB/old/a.iso", AncestorIDs: []int64{200, 20}, Size: 4096, SHA1: "big"},
+		{DocID: "file_4", SourceID: 4, Type: models.ItemTypeFile, Name: "n.txt", PathText: "DeptA/n.txt", AncestorIDs: []int64{100}, Size: 10, SHA1: "small"},
+		{DocID: "file_5", SourceID: 5, Type: models.ItemTypeFile, Name: "n.txt", PathText: "DeptB/n.txt", AncestorIDs: []int64{200}, Size: 10, SHA1: "small"},
+		{DocID: "file_6", SourceID: 6, Type: models.ItemTypeFile, Name: "u.pdf", PathText: "DeptA/u.pdf", AncestorIDs: []int64{100}, Size: 500, SHA1: "unique"},
+	})
+}
+
+func TestFindDuplicates_GroupsBySHA1AndAppliesFilters(t *testing.T) {
+	t.Parallel()
+
+	mgr, _ := newTestSyncManager(t, duplicateFixture())
+
+	report, err := mgr.FindDuplicates(context.Background(), DuplicateFilter{})
+	if err != nil {
+		t.Fatalf("FindDuplicates returned error: %v", err)
+	}
+	if len(report.Groups) != 2 || report.Groups[0].SHA1 != "big" || report.Groups[1].SHA1 != "small" {
+		t.Fatalf("expected groups ordered by wasted bytes, got %+v", report.Groups)
+	}
+	big := report.Groups[0]
+	if big.Copies != 3 || big.WastedBytes != 8192 || len(big.Files) != 3 || big.Files[0].Path != "DeptA/a.iso" {
+		t.Fatalf("unexpected group %+v", big)
+	}
+	if report.WastedBytes != 8192+10 {
+		t.Fatalf("expected total wasted bytes 8202, got %d", report.WastedBytes)
+	}
+	if report.TotalGroups != 2 || report.Truncated {
+		t.Fatalf("expected all 2 groups without truncation, got total=%d truncated=%v", report.TotalGroups, report.Truncated)
+	}
+
+	report, err = mgr.FindDuplicates(context.Background(), DuplicateFilter{Limit: 1})
+	if err != nil {
+		t.Fatalf("FindDuplicates returned error: %v", err)
+	}
+	if len(report.Groups) != 1 || report.TotalGroups != 2 || !report.Truncated {
+		t.Fatalf("expected limited report to be marked truncated, got %+v", report)
+	}
+
+	report, err = mgr.FindDuplicates(context.Background(), DuplicateFilter{RootFolderID: 200, MinSize: 1024})
+	if err != nil {
+		t.Fatalf("FindDuplicates returned error: %v", err)
+	}
+	if len(report.Groups) != 1 || report.Groups[0].Copies != 2 || report.Groups[0].WastedBytes != 4096 {
+		t.Fatalf("expected only the large duplicate inside root 200, got %+v", report.Groups)
+	}
+}
+
+func TestFindDuplicates_RanksByWastedBytesAndTotalsAllGroups(t *testing.T) {
+	t.Parallel()
+
+	docs := []models.IndexDocument{
+		{DocID: "file_1", SourceID: 1, Type: models.ItemTypeFile, PathText: "a/disk.img", Size: 4 << 30, SHA1: "huge"},
+		{DocID: "file_2", SourceID: 2, Type: models.ItemTypeFile, PathText: "b/disk.img", Size: 4 << 30, SHA1: "huge"},
+	}
+	for i := 0; i < 5; i++ {
+		docs = append(docs, models.IndexDocument{
+			DocID: fmt.Sprintf("file_%d", 10+i), SourceID: int64(10 + i), Type: models.ItemTypeFile,
+			PathText: fmt.Sprintf("c/icon-%d.png", i), Size: 1024, SHA1: "tiny",
+		})
+	}
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(docs))
+
+	report, err := mgr.FindDuplicates(context.Background(), DuplicateFilter{Limit: 1})
+	if err != nil {
+		t.Fatalf("FindDuplicates returned error: %v", err)
+	}
+	if len(report.Groups) != 1 || report.Groups[0].SHA1 != "huge" || report.Groups[0].Copies != 2 {
+		t.Fatalf("expected the group with the largest waste despite fewer copies, got %+v", report.Groups)
+	}
+	if want := int64(4<<30) + 4*1024; report.WastedBytes != want || report.TotalGroups != 2 || !report.Truncated {
+		t.Fatalf("expected wasted bytes %d over all 2 groups, got %d total=%d truncated=%v", want, report.WastedBytes, report.TotalGroups, report.Truncated)
+	}
+}
+
+func TestWriteDuplicateReport_Formats(t *testing.T) {
+	t.Parallel()
+
+	report := &DuplicateReport{Groups: []DuplicateGroup{{
+		SHA1: "big", Size: 4096, Copies: 2, WastedBytes: 4096,
+		Files: []DuplicateFile{{DocID: "file_1", SourceID: 1, Path: "DeptA/a,b.iso"}, {DocID: "file_2", SourceID: 2, Path: "DeptB/a.iso"}},
+	}}}
+
+	var csvOut bytes.Buffer
+	if err := WriteDuplicateReport(&csvOut, report, "csv"); err != nil {
+		t.Fatalf("csv export failed: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(csvOut.String()), "\n")
+	if len(lines) != 3 || lines[1] != `big,4096,2,4096,file_1,1,"DeptA/a,b.iso"` {
+		t.Fatalf("unexpected csv output:\n%s", csvOut.String())
+	}
+
+	var ndjsonOut bytes.Buffer
+	if err := WriteDuplicateReport(&ndjsonOut, report, "ndjson"); err != nil {
+		t.Fatalf("ndjson export failed: %v", err)
+	}
+	if got := strings.Count(ndjsonOut.String(), "\n"); got != 1 || !strings.Contains(ndjsonOut.String(), `"wastedBytes":4096`) {
+		t.Fatalf("unexpected ndjson output:\n%s", ndjsonOut.String())
+	}
+
+	if err := WriteDuplicateReport(&bytes.Buffer{}, report, "xml"); !errors.Is(err, ErrUnsupportedExportFormat) {
+		t.Fatalf("expected ErrUnsupportedExportFormat, got %v", err)
+	}
+}
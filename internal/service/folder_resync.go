@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"npan/internal/indexer"
+	"npan/internal/models"
+	"npan/internal/npan"
+)
+
+var (
+	ErrFolderResyncRunning       = errors.New("已有目录重同步任务在运行")
+	ErrFolderResyncNotRunning    = errors.New("当前没有运行中的目录重同步任务")
+	ErrFolderResyncRootFolder    = errors.New("该目录是同步根目录，请通过 StartSync 指定 root_folder_ids 重新同步")
+	ErrFolderResyncDuringRebuild = errors.New("影子索引重建期间不能重同步目录")
+)
+
+type FolderResyncRequest struct {
+	FolderID int64
+	Mode     models.FolderResyncMode
+}
+
+// ParseFolderResyncMode 解析重同步模式，空字符串按 merge 处理。
+func ParseFolderResyncMode(raw string) (models.FolderResyncMode, error) {
+	switch mode := models.FolderResyncMode(strings.ToLower(strings.TrimSpace(raw))); mode {
+	case "", models.FolderResyncModeMerge:
+		return models.FolderResyncModeMerge, nil
+	case models.FolderResyncModeRebuild:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("mode 仅支持 merge 或 rebuild: %s", raw)
+	}
+}
+
+// StartFolderResync 在后台重新爬取单个嵌套目录的子树。它与同步互不占用运行状态，可以与增量同步并行执行，
+// 但全量同步期间直接拒绝：重同步写入的文档没有同步代次，会被全量同步结束时的过期清理删掉。
+// 同一时间只允许一个目录重同步任务；PauseSync 不影响它，熔断器照常生效。
+// 同步根目录应通过 StartSync 重新同步，这里直接拒绝。
+func (m *SyncManager) StartFolderResync(api npan.API, request FolderResyncRequest) error {
+	if request.FolderID <= 0 {
+		return fmt.Errorf("folder_id 必须大于 0")
+	}
+	mode, err := ParseFolderResyncMode(string(request.Mode))
+	if err != nil {
+		return err
+	}
+
+	progress, err := m.progressStore.Load()
+	if err != nil {
+		return fmt.Errorf("load progress: %w", err)
+	}
+	rootNames := map[int64]string{}
+	if progress != nil {
+		if containsInt64(progress.Roots, request.FolderID) || containsInt64(progress.CatalogRoots, request.FolderID) {
+			return ErrFolderResyncRootFolder
+		}
+		if progress.Rebuild != nil && progress.Rebuild.Status == models.RebuildStatusBuilding && m.IsRunning() {
+			return ErrFolderResyncDuringRebuild
+		}
+		for id, name := range progress.CatalogRootNames {
+			rootNames[id] = name
+		}
+		for id, name := range progress.RootNames {
+			rootNames[id] = name
+		}
+	}
+
+	m.mu.Lock()
+	if m.folderResyncRunning {
+		m.mu.Unlock()
+		return ErrFolderResyncRunning
+	}
+	if m.reconciling {
+		m.mu.Unlock()
+		return ErrReconciliationRunning
+	}
+	if m.fullSyncRunning {
+		m.mu.Unlock()
+		return ErrSyncInProgress
+	}
+	if err := m.acquireSyncLease(); err != nil {
+		m.mu.Unlock()
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	now := time.Now().UnixMilli()
+	m.folderResyncRunning = true
+	m.folderResyncCancel = cancel
+	m.folderResync = &models.FolderResyncProgress{
+		FolderID:  request.FolderID,
+		Mode:      mode,
+		Status:    "running",
+		StartedAt: now,
+		UpdatedAt: now,
+	}
+	m.mu.Unlock()
+
+	go func() {
+		defer cancel()
+		releaseLease := m.keepSyncLease(ctx, cancel)
+		defer releaseLease()
+		err := m.runFolderResync(ctx, api, request.FolderID, mode, rootNames)
+		m.finishFolderResync(ctx, err)
+	}()
+	return nil
+}
+
+// GetFolderResyncProgress 返回最近一次目录重同步的进度快照，从未执行过时返回 nil。
+func (m *SyncManager) GetFolderResyncProgress() *models.FolderResyncProgress {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.folderResync == nil {
+		return nil
+	}
+	snapshot := *m.folderResync
+	if snapshot.CurrentFolderID != nil {
+		current := *snapshot.CurrentFolderID
+		snapshot.CurrentFolderID = &current
+	}
+	return &snapshot
+}
+
+// CancelFolderResync 取消运行中的目录重同步，已写入的文档保留。
+func (m *SyncManager) CancelFolderResync() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.folderResyncRunning || m.folderResyncCancel == nil {
+		return ErrFolderResyncNotRunning
+	}
+	m.folderResyncCancel()
+	return nil
+}
+
+func (m *SyncManager) runFolderResync(ctx context.Context, api npan.API, folderID int64, mode models.FolderResyncMode, rootNames map[int64]string) error {
+	limiter := m.newStandaloneLimiter()
+
+	folder, err := m.fetchFolderInfo(ctx, api, folderID, limiter)
+	if err != nil {
+		return fmt.Errorf("get folder info %d: %w", folderID, err)
+	}
+	m.updateFolderResync(func(progress *models.FolderResyncProgress) {
+		progress.FolderName = folder.Name
+	})
+
+	if mode == models.FolderResyncModeRebuild {
+		snapshot, err := buildIndexedTreeSnapshot(ctx, m.index, folderID)
+		if err != nil {
+			return fmt.Errorf("build indexed snapshot for folder %d: %w", folderID, err)
+		}
+		docIDs := snapshot.collectSubtreeDocIDs(folderID)
+		if err := m.deleteSubtreeDocuments(ctx, docIDs); err != nil {
+			return fmt.Errorf("delete subtree docs for folder %d: %w", folderID, err)
+		}
+		m.updateFolderResync(func(progress *models.FolderResyncProgress) {
+			progress.DocsDeleted = int64(len(docIDs))
+		})
+	}
+
+	paths := m.newFolderPathResolver(api, limiter, rootNames)
+	_, err = m.rebuildNestedFolderSubtree(ctx, api, folder, limiter, paths, func(currentFolderID int64, foldersVisited int64, written int64) {
+		m.updateFolderResync(func(progress *models.FolderResyncProgress) {
+			current := currentFolderID
+			progress.CurrentFolderID = &current
+			progress.FoldersVisited = foldersVisited
+			progress.DocsWritten = written
+		})
+	})
+	return err
+}
+
+// newStandaloneLimiter 为独立于同步运行的后台任务（目录重同步、对账）创建限速器：
+// 它只等待熔断器，不挂同步的暂停闸门。
+func (m *SyncManager) newStandaloneLimiter() *indexer.RequestLimiter {
+	limiter := indexer.NewRequestLimiter(m.maxConcurrent, m.minTimeMS)
+	if m.activityChecker != nil {
+		limiter.SetActivityChecker(m.activityChecker)
+	}
+	if m.circuitBreaker != nil {
+		limiter.SetGate(m.circuitBreaker)
+	}
+	return limiter
+}
+
+func (m *SyncManager) updateFolderResync(update func(progress *models.FolderResyncProgress)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.folderResync == nil {
+		return
+	}
+	update(m.folderResync)
+	m.folderResync.UpdatedAt = time.Now().UnixMilli()
+}
+
+func (m *SyncManager) finishFolderResync(ctx context.Context, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.folderResyncRunning = false
+	m.folderResyncCancel = nil
+	progress := m.folderResync
+	if progress == nil {
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	progress.UpdatedAt = now
+	progress.FinishedAt = now
+	progress.CurrentFolderID = nil
+	switch {
+	case err == nil:
+		progress.Status = "done"
+		slog.Info("目录重同步完成", "folder_id", progress.FolderID, "mode", progress.Mode, "docs_written", progress.DocsWritten, "docs_deleted", progress.DocsDeleted)
+	case ctx.Err() != nil:
+		progress.Status = "cancelled"
+		progress.LastError = "目录重同步已取消"
+		slog.Info("目录重同步已取消", "folder_id", progress.FolderID, "docs_written", progress.DocsWritten)
+	default:
+		progress.Status = "error"
+		progress.LastError = err.Error()
+		slog.Warn("目录重同步失败", "folder_id", progress.FolderID, "mode", progress.Mode, "error", err)
+	}
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"npan/internal/models"
+)
+
+func folderResyncFixture() (*inMemoryIndexStub, *mockAPIForRouting) {
+	idx := newInMemoryIndexStub([]models.IndexDocument{
+		{DocID: "folder_200", SourceID: 200, Type: models.ItemTypeFolder, ParentID: 100, Name: "docs"},
+		{DocID: "folder_201", SourceID: 201, Type: models.ItemTypeFolder, ParentID: 200, Name: "old"},
+		{DocID: "file_1", SourceID: 1, Type: models.ItemTypeFile, ParentID: 200, Name: "stale.pdf"},
+		{DocID: "file_2", SourceID: 2, Type: models.ItemTypeFile, ParentID: 201, Name: "stale.docx"},
+		{DocID: "file_9", SourceID: 9, Type: models.ItemTypeFile, ParentID: 100, Name: "outside.pdf"},
+	})
+	api := &mockAPIForRouting{
+		getFolderInfoFn: func(_ context.Context, folderID int64) (models.NpanFolder, error) {
+			return models.NpanFolder{ID: folderID, Name: "docs", ParentID: 100, ItemCount: 3}, nil
+		},
+		listFolderChildrenFn: func(_ context.Context, folderID int64, _ int64) (models.FolderChildrenPage, error) {
+			switch folderID {
+			case 200:
+				return models.FolderChildrenPage{
+					Folders:   []models.NpanFolder{{ID: 202, Name: "new", ParentID: 200}},
+					Files:     []models.NpanFile{{ID: 3, Name: "a.pdf", ParentID: 200}},
+					PageCount: 1,
+				}, nil
+			case 202:
+				return models.FolderChildrenPage{
+					Files:     []models.NpanFile{{ID: 4, Name: "b.pdf", ParentID: 202}},
+					PageCount: 1,
+				}, nil
+			default:
+				return models.FolderChildrenPage{PageCount: 1}, nil
+			}
+		},
+	}
+	return idx, api
+}
+
+func saveFolderResyncRoots(t *testing.T, mgr *SyncManager) {
+	t.Helper()
+	if err := mgr.progressStore.Save(&models.SyncProgressState{
+		Status:    "done",
+		Roots:     []int64{100},
+		RootNames: map[int64]string{100: "root"},
+	}); err != nil {
+		t.Fatalf("save progress: %v", err)
+	}
+}
+
+func waitFolderResyncFinished(t *testing.T, mgr *SyncManager) *models.FolderResyncProgress {
+	t.Helper()
+	waitFor(t, func() bool {
+		progress := mgr.GetFolderResyncProgress()
+		return progress != nil && progress.FinishedAt > 0
+	}, "folder resync to finish")
+	return mgr.GetFolderResyncProgress()
+}
+
+func TestFolderResync_RebuildReplacesSubtree(t *testing.T) {
+	t.Parallel()
+
+	idx, api := folderResyncFixture()
+	mgr, _ := newTestSyncManager(t, idx)
+	saveFolderResyncRoots(t, mgr)
+
+	if err := mgr.StartFolderResync(api, FolderResyncRequest{FolderID: 200, Mode: models.FolderResyncModeRebuild}); err != nil {
+		t.Fatalf("StartFolderResync returned error: %v", err)
+	}
+	progress := waitFolderResyncFinished(t, mgr)
+
+	if progress.Status != "done" || progress.FolderName != "docs" {
+		t.Fatalf("unexpected progress: %+v", progress)
+	}
+	if progress.DocsDeleted != 3 || progress.DocsWritten != 4 || progress.FoldersVisited != 2 {
+		t.Fatalf("expected 3 deleted, 4 written, 2 folders visited, got %+v", progress)
+	}
+	for _, docID := range []string{"folder_201", "file_1", "file_2"} {
+		if _, ok := idx.docs[docID]; ok {
+			t.Fatalf("expected stale doc %s to be removed", docID)
+		}
+	}
+	for _, docID := range []string{"folder_200", "folder_202", "file_3", "file_4", "file_9"} {
+		if _, ok := idx.docs[docID]; !ok {
+			t.Fatalf("expected doc %s to be present", docID)
+		}
+	}
+	if got := idx.docs["file_4"].PathText; got != "root/docs/new/b.pdf" {
+		t.Fatalf("expected path resolved under sync root, got %q", got)
+	}
+}
+
+func TestFolderResync_MergeKeepsExistingDocs(t *testing.T) {
+	t.Parallel()
+
+	idx, api := folderResyncFixture()
+	mgr, _ := newTestSyncManager(t, idx)
+	saveFolderResyncRoots(t, mgr)
+
+	if err := mgr.StartFolderResync(api, FolderResyncRequest{FolderID: 200}); err != nil {
+		t.Fatalf("StartFolderResync returned error: %v", err)
+	}
+	progress := waitFolderResyncFinished(t, mgr)
+
+	if progress.Status != "done" || progress.Mode != models.FolderResyncModeMerge || progress.DocsDeleted != 0 {
+		t.Fatalf("unexpected progress: %+v", progress)
+	}
+	if len(idx.deletes) != 0 {
+		t.Fatalf("expected merge to skip deletes, got %v", idx.deletes)
+	}
+	for _, docID := range []string{"file_1", "file_3", "file_4"} {
+		if _, ok := idx.docs[docID]; !ok {
+			t.Fatalf("expected doc %s to be present", docID)
+		}
+	}
+}
+
+func TestFolderResync_RejectsSyncRootAndInvalidMode(t *testing.T) {
+	t.Parallel()
+
+	idx, api := folderResyncFixture()
+	mgr, _ := newTestSyncManager(t, idx)
+	saveFolderResyncRoots(t, mgr)
+
+	if err := mgr.StartFolderResync(api, FolderResyncRequest{FolderID: 100}); !errors.Is(err, ErrFolderResyncRootFolder) {
+		t.Fatalf("expected ErrFolderResyncRootFolder, got %v", err)
+	}
+	if err := mgr.StartFolderResync(api, FolderResyncRequest{FolderID: 200, Mode: "replace"}); err == nil {
+		t.Fatal("expected invalid mode to be rejected")
+	}
+	if progress := mgr.GetFolderResyncProgress(); progress != nil {
+		t.Fatalf("expected no resync to start, got %+v", progress)
+	}
+}
+
+func TestFolderResync_Cancel(t *testing.T) {
+	t.Parallel()
+
+	idx, api := folderResyncFixture()
+	started := make(chan struct{})
+	api.listFolderChildrenFn = func(ctx context.Context, _ int64, _ int64) (models.FolderChildrenPage, error) {
+		close(started)
+		<-ctx.Done()
+		return models.FolderChildrenPage{}, ctx.Err()
+	}
+	mgr, _ := newTestSyncManager(t, idx)
+
+	if err := mgr.StartFolderResync(api, FolderResyncRequest{FolderID: 200}); err != nil {
+		t.Fatalf("StartFolderResync returned error: %v", err)
+	}
+	<-started
+
+	if err := mgr.StartFolderResync(api, FolderResyncRequest{FolderID: 300}); !errors.Is(err, ErrFolderResyncRunning) {
+		t.Fatalf("expected ErrFolderResyncRunning, got %v", err)
+	}
+	if mgr.IsRunning() {
+		t.Fatal("expected folder resync not to occupy the sync run slot")
+	}
+	if err := mgr.CancelFolderResync(); err != nil {
+		t.Fatalf("CancelFolderResync returned error: %v", err)
+	}
+
+	progress := waitFolderResyncFinished(t, mgr)
+	if progress.Status != "cancelled" || progress.CurrentFolderID != nil {
+		t.Fatalf("expected cancelled progress, got %+v", progress)
+	}
+	if err := mgr.CancelFolderResync(); !errors.Is(err, ErrFolderResyncNotRunning) {
+		t.Fatalf("expected ErrFolderResyncNotRunning, got %v", err)
+	}
+}
+
+func TestFolderResync_RejectedDuringFullSyncAndSweepKeepsSubtree(t *testing.T) {
+	t.Parallel()
+
+	idx, api := folderResyncFixture()
+	mgr, _ := newTestSyncManager(t, idx)
+	saveFolderResyncRoots(t, mgr)
+
+	listing := api.listFolderChildrenFn
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	syncAPI := &mockAPIForRouting{
+		getFolderInfoFn: func(_ context.Context, folderID int64) (models.NpanFolder, error) {
+			return models.NpanFolder{ID: folderID, Name: "root"}, nil
+		},
+		listFolderChildrenFn: func(ctx context.Context, folderID int64, pageID int64) (models.FolderChildrenPage, error) {
+			if folderID == 100 {
+				close(entered)
+				<-release
+				return models.FolderChildrenPage{Folders: []models.NpanFolder{{ID: 200, Name: "docs", ParentID: 100}}, PageCount: 1}, nil
+			}
+			return listing(ctx, folderID, pageID)
+		},
+	}
+	disabled := false
+	if err := mgr.Start(syncAPI, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+	<-entered
+
+	resyncErr := mgr.StartFolderResync(api, FolderResyncRequest{FolderID: 200})
+	close(release)
+	waitFor(t, func() bool { return !mgr.IsRunning() }, "full sync to finish")
+
+	if !errors.Is(resyncErr, ErrSyncInProgress) {
+		t.Fatalf("expected folder resync to be refused during a full sync, got %v", resyncErr)
+	}
+	progress, err := mgr.GetProgress()
+	if err != nil || progress.Status != "done" {
+		t.Fatalf("expected the full sync to finish, got %+v %v", progress, err)
+	}
+	// 子树由全量同步写入并打上代次，过期清理只删除上游已不存在的旧文档。
+	for _, docID := range []string{"folder_200", "folder_202", "file_3", "file_4"} {
+		doc, ok := idx.docs[docID]
+		if !ok {
+			t.Fatalf("expected %s to survive the stale sweep", docID)
+		}
+		if doc.SyncGeneration != progress.SyncGeneration {
+			t.Fatalf("expected %s to carry the sync generation %d, got %d", docID, progress.SyncGeneration, doc.SyncGeneration)
+		}
+	}
+}
+
+func TestStart_RejectsFullSyncDuringFolderResync(t *testing.T) {
+	t.Parallel()
+
+	idx, api := folderResyncFixture()
+	started := make(chan struct{})
+	api.listFolderChildrenFn = func(ctx context.Context, _ int64, _ int64) (models.FolderChildrenPage, error) {
+		close(started)
+		<-ctx.Done()
+		return models.FolderChildrenPage{}, ctx.Err()
+	}
+	mgr, _ := newTestSyncManager(t, idx)
+	saveFolderResyncRoots(t, mgr)
+
+	if err := mgr.StartFolderResync(api, FolderResyncRequest{FolderID: 200}); err != nil {
+		t.Fatalf("StartFolderResync returned error: %v", err)
+	}
+	<-started
+
+	disabled := false
+	err := mgr.Start(&mockAPIForRouting{}, SyncStartRequest{Mode: models.SyncModeFull, RootFolderIDs: []int64{100}, IncludeDepartments: &disabled})
+	if !errors.Is(err, ErrFolderResyncRunning) {
+		t.Fatalf("expected full sync to be refused during a folder resync, got %v", err)
+	}
+	if err := mgr.CancelFolderResync(); err != nil {
+		t.Fatalf("CancelFolderResync returned error: %v", err)
+	}
+	waitFolderResyncFinished(t, mgr)
+}
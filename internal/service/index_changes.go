@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"npan/internal/models"
+	"npan/internal/search"
+)
+
+// staleChangeBatchSize 是确认过期文档是否已删除时每次读取的文档数。
+const staleChangeBatchSize = 1000
+
+var (
+	ErrIndexChangesDisabled = errors.New("索引变更日志未启用")
+	ErrIndexChangesExpired  = errors.New("请求的变更序号已被清理，请全量对账后从最新序号继续")
+)
+
+// changeLogIndex 包装线上索引，在写入成功后把变更追加到变更日志。
+// 只包装 SyncManager 直接写入的线上索引；蓝绿重建的影子索引不记录，切换时记一条 reset。
+type changeLogIndex struct {
+	search.IndexOperator
+	manager *SyncManager
+}
+
+func (c *changeLogIndex) UpsertDocuments(ctx context.Context, docs []models.IndexDocument) error {
+	if err := c.IndexOperator.UpsertDocuments(ctx, docs); err != nil {
+		return err
+	}
+	c.manager.recordUpsertChanges(docs)
+	return nil
+}
+
+func (c *changeLogIndex) UpdateDocumentPaths(ctx context.Context, docs []models.IndexDocument) error {
+	if err := c.IndexOperator.UpdateDocumentPaths(ctx, docs); err != nil {
+		return err
+	}
+	c.manager.recordUpsertChanges(docs)
+	return nil
+}
+
+func (c *changeLogIndex) DeleteDocuments(ctx context.Context, docIDs []string) error {
+	if err := c.IndexOperator.DeleteDocuments(ctx, docIDs); err != nil {
+		return err
+	}
+	changes := make([]models.IndexChange, 0, len(docIDs))
+	for _, docID := range docIDs {
+		changes = append(changes, models.IndexChange{Op: models.IndexChangeDelete, DocID: docID})
+	}
+	c.manager.recordIndexChanges(changes)
+	return nil
+}
+
+func (c *changeLogIndex) DeleteAllDocuments(ctx context.Context) error {
+	if err := c.IndexOperator.DeleteAllDocuments(ctx); err != nil {
+		return err
+	}
+	c.manager.recordIndexChanges([]models.IndexChange{{Op: models.IndexChangeReset}})
+	return nil
+}
+
+// DeleteStaleDocuments 先列出过期文档再按条件删除，删除后仍查不到的文档逐条记为 delete，
+// 下游镜像才能按文档应用清理。列出与删除之间被重新写入的文档仍然存在，不会误记。
+func (c *changeLogIndex) DeleteStaleDocuments(ctx context.Context, rootFolderID int64, generation int64) (int64, error) {
+	candidates, err := c.IndexOperator.ListStaleDocumentIDs(ctx, rootFolderID, generation)
+	if err != nil {
+		return 0, err
+	}
+	removed, err := c.IndexOperator.DeleteStaleDocuments(ctx, rootFolderID, generation)
+	if err != nil || removed == 0 || len(candidates) == 0 {
+		return removed, err
+	}
+
+	changes := make([]models.IndexChange, 0, len(candidates))
+	for start := 0; start < len(candidates); start += staleChangeBatchSize {
+		batch := candidates[start:min(start+staleChangeBatchSize, len(candidates))]
+		remaining, err := c.IndexOperator.GetDocuments(ctx, batch)
+		if err != nil {
+			slog.Warn("确认已清理文档失败，变更日志缺少部分删除记录", "root_id", rootFolderID, "error", err)
+			break
+		}
+		kept := make(map[string]struct{}, len(remaining))
+		for _, doc := range remaining {
+			kept[doc.DocID] = struct{}{}
+		}
+		for _, docID := range batch {
+			if _, ok := kept[docID]; ok {
+				continue
+			}
+			changes = append(changes, models.IndexChange{Op: models.IndexChangeDelete, DocID: docID, RootFolderID: rootFolderID})
+		}
+	}
+	changes = append(changes, models.IndexChange{
+		Op:           models.IndexChangeSweep,
+		RootFolderID: rootFolderID,
+		Removed:      removed,
+	})
+	c.manager.recordIndexChanges(changes)
+	return removed, nil
+}
+
+// unwrapIndex 返回未经变更日志包装的搜索后端，用于类型断言后端能力。
+func unwrapIndex(index search.IndexOperator) search.IndexOperator {
+	if wrapped, ok := index.(*changeLogIndex); ok {
+		return wrapped.IndexOperator
+	}
+	return index
+}
+
+func (m *SyncManager) recordUpsertChanges(docs []models.IndexDocument) {
+	changes := make([]models.IndexChange, 0, len(docs))
+	for i := range docs {
+		doc := docs[i]
+		change := models.IndexChange{Op: models.IndexChangeUpsert, DocID: doc.DocID, Document: &doc}
+		if doc.SyncRootID != nil {
+			change.RootFolderID = *doc.SyncRootID
+		}
+		changes = append(changes, change)
+	}
+	m.recordIndexChanges(changes)
+}
+
+// recordIndexChanges 追加变更日志。索引已写入成功，日志写入失败只记录告警，不让同步失败。
+func (m *SyncManager) recordIndexChanges(changes []models.IndexChange) {
+	if m.indexChangeStore == nil || len(changes) == 0 {
+		return
+	}
+
+	m.mu.Lock()
+	runID := m.currentRunID
+	m.mu.Unlock()
+
+	now := time.Now().UnixMilli()
+	for i := range changes {
+		changes[i].RunID = runID
+		changes[i].OccurredAt = now
+	}
+	if err := m.indexChangeStore.Append(changes); err != nil {
+		slog.Warn("写入索引变更日志失败", "changes", len(changes), "error", err)
+	}
+}
+
+// pruneIndexChanges 删除超出保留期的变更日志，保留期不大于 0 时不清理。
+func (m *SyncManager) pruneIndexChanges() {
+	if m.indexChangeStore == nil || m.indexChangeRetention <= 0 {
+		return
+	}
+	cutoff := time.Now().Add(-m.indexChangeRetention).UnixMilli()
+	removed, err := m.indexChangeStore.PruneBefore(cutoff)
+	if err != nil {
+		slog.Warn("清理索引变更日志失败", "error", err)
+		return
+	}
+	if removed > 0 {
+		slog.Info("已清理过期索引变更日志", "removed", removed)
+	}
+}
+
+// LatestIndexChangeSeq 返回已分配的最大变更序号，新的消费方可从这里开始只读后续变更。
+func (m *SyncManager) LatestIndexChangeSeq() (int64, error) {
+	if m.indexChangeStore == nil {
+		return 0, ErrIndexChangesDisabled
+	}
+	_, latest, err := m.indexChangeStore.Bounds()
+	return latest, err
+}
+
+// ListIndexChanges 返回序号大于 afterSeq 的变更。afterSeq 之后的变更已有部分被清理时返回 ErrIndexChangesExpired，
+// 避免消费方在不知情的情况下漏掉变更。
+func (m *SyncManager) ListIndexChanges(afterSeq int64, limit int) ([]models.IndexChange, error) {
+	if m.indexChangeStore == nil {
+		return nil, ErrIndexChangesDisabled
+	}
+	pruned, _, err := m.indexChangeStore.Bounds()
+	if err != nil {
+		return nil, err
+	}
+	if afterSeq < pruned {
+		return nil, ErrIndexChangesExpired
+	}
+	return m.indexChangeStore.ListAfter(afterSeq, limit)
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"npan/internal/models"
+	"npan/internal/storage"
+)
+
+func newChangeLogTestManager(t *testing.T, index *inMemoryIndexStub) (*SyncManager, storage.IndexChangeStore) {
+	t.Helper()
+
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "sync-state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	t.Cleanup(func() { _ = stores.DB.Close() })
+
+	mgr, _ := newTestSyncManager(t, index)
+	mgr.indexChangeStore = stores.IndexChangeStore
+	mgr.index = &changeLogIndex{IndexOperator: index, manager: mgr}
+	return mgr, stores.IndexChangeStore
+}
+
+func TestRunFull_RecordsIndexChanges(t *testing.T) {
+	t.Parallel()
+
+	docs, api := staleSweepFixture()
+	mgr, _ := newChangeLogTestManager(t, newInMemoryIndexStub(docs))
+	mgr.currentRunID = 42
+
+	disabled := false
+	if err := mgr.run(context.Background(), api, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("run returned error: %v", err)
+	}
+
+	changes, err := mgr.ListIndexChanges(0, 100)
+	if err != nil {
+		t.Fatalf("ListIndexChanges returned error: %v", err)
+	}
+	upserted := map[string]bool{}
+	var sweep *models.IndexChange
+	for i, change := range changes {
+		if change.Seq != int64(i+1) || change.RunID != 42 || change.OccurredAt == 0 {
+			t.Fatalf("unexpected change metadata: %#v", change)
+		}
+		switch change.Op {
+		case models.IndexChangeUpsert:
+			if change.Document == nil || change.RootFolderID != 100 {
+				t.Fatalf("expected upsert to carry the document and root, got %#v", change)
+			}
+			upserted[change.DocID] = true
+		case models.IndexChangeSweep:
+			sweep = &changes[i]
+		}
+	}
+	if !upserted["file_1"] || !upserted["folder_100"] {
+		t.Fatalf("expected crawled documents to be recorded, got %v", upserted)
+	}
+	if sweep == nil || sweep.RootFolderID != 100 || sweep.Removed != 1 || sweep.Seq != int64(len(changes)) {
+		t.Fatalf("expected the stale sweep to be recorded last, got %#v", sweep)
+	}
+
+	latest, err := mgr.LatestIndexChangeSeq()
+	if err != nil || latest != int64(len(changes)) {
+		t.Fatalf("expected latest seq %d, got %d %v", len(changes), latest, err)
+	}
+	rest, err := mgr.ListIndexChanges(latest-1, 100)
+	if err != nil || len(rest) != 1 || rest[0].Op != models.IndexChangeSweep {
+		t.Fatalf("expected to resume after seq %d, got %#v %v", latest-1, rest, err)
+	}
+}
+
+func TestChangeLogIndex_RecordsDeletes(t *testing.T) {
+	t.Parallel()
+
+	index := newInMemoryIndexStub([]models.IndexDocument{{DocID: "file_5", SourceID: 5, Type: models.ItemTypeFile}})
+	mgr, _ := newChangeLogTestManager(t, index)
+
+	if err := mgr.index.DeleteDocuments(context.Background(), []string{"file_5"}); err != nil {
+		t.Fatalf("DeleteDocuments returned error: %v", err)
+	}
+	changes, err := mgr.ListIndexChanges(0, 10)
+	if err != nil {
+		t.Fatalf("ListIndexChanges returned error: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Op != models.IndexChangeDelete || changes[0].DocID != "file_5" || changes[0].Document != nil {
+		t.Fatalf("unexpected delete change: %#v", changes)
+	}
+}
+
+func TestListIndexChanges_RejectsPrunedSequence(t *testing.T) {
+	t.Parallel()
+
+	mgr, store := newChangeLogTestManager(t, newInMemoryIndexStub(nil))
+	if err := store.Append([]models.IndexChange{
+		{Op: models.IndexChangeDelete, DocID: "file_1", OccurredAt: 1_000},
+		{Op: models.IndexChangeDelete, DocID: "file_2", OccurredAt: 2_000},
+		{Op: models.IndexChangeDelete, DocID: "file_3", OccurredAt: 3_000},
+	}); err != nil {
+		t.Fatalf("append failed: %v", err)
+	}
+	if _, err := store.PruneBefore(2_500); err != nil {
+		t.Fatalf("prune failed: %v", err)
+	}
+
+	if _, err := mgr.ListIndexChanges(1, 10); !errors.Is(err, ErrIndexChangesExpired) {
+		t.Fatalf("expected ErrIndexChangesExpired for a pruned sequence, got %v", err)
+	}
+	changes, err := mgr.ListIndexChanges(2, 10)
+	if err != nil || len(changes) != 1 || changes[0].DocID != "file_3" {
+		t.Fatalf("expected resume right before the oldest retained change to work, got %#v %v", changes, err)
+	}
+	if changes, err := mgr.ListIndexChanges(3, 10); err != nil || len(changes) != 0 {
+		t.Fatalf("expected no changes after latest, got %#v %v", changes, err)
+	}
+
+	disabled, _ := newTestSyncManager(t, newInMemoryIndexStub(nil))
+	if _, err := disabled.ListIndexChanges(0, 10); !errors.Is(err, ErrIndexChangesDisabled) {
+		t.Fatalf("expected ErrIndexChangesDisabled, got %v", err)
+	}
+}
+
+// restampedStaleIndex 在列出过期文档时多返回一个随后被重新写入、清理时不会删除的文档。
+type restampedStaleIndex struct {
+	*inMemoryIndexStub
+	restamped string
+}
+
+func (s *restampedStaleIndex) ListStaleDocumentIDs(ctx context.Context, rootFolderID int64, generation int64) ([]string, error) {
+	docIDs, err := s.inMemoryIndexStub.ListStaleDocumentIDs(ctx, rootFolderID, generation)
+	return append(docIDs, s.restamped), err
+}
+
+func TestChangeLogIndex_RecordsSweptDocumentsAsDeletes(t *testing.T) {
+	t.Parallel()
+
+	index := &restampedStaleIndex{
+		inMemoryIndexStub: newInMemoryIndexStub([]models.IndexDocument{
+			{DocID: "file_9", SourceID: 9, Type: models.ItemTypeFile, SyncGeneration: 1, SyncRootID: int64Ptr(100)},
+			{DocID: "file_8", SourceID: 8, Type: models.ItemTypeFile, AncestorIDs: []int64{100}},
+			{DocID: "file_1", SourceID: 1, Type: models.ItemTypeFile, SyncGeneration: 5, SyncRootID: int64Ptr(100)},
+		}),
+		restamped: "file_1",
+	}
+	mgr, _ := newChangeLogTestManager(t, index.inMemoryIndexStub)
+	mgr.index = &changeLogIndex{IndexOperator: index, manager: mgr}
+
+	removed, err := mgr.index.DeleteStaleDocuments(context.Background(), 100, 5)
+	if err != nil || removed != 2 {
+		t.Fatalf("expected 2 stale documents removed, got %d %v", removed, err)
+	}
+	changes, err := mgr.ListIndexChanges(0, 10)
+	if err != nil {
+		t.Fatalf("ListIndexChanges returned error: %v", err)
+	}
+	deleted := map[string]bool{}
+	for _, change := range changes {
+		if change.Op == models.IndexChangeDelete {
+			if change.RootFolderID != 100 {
+				t.Fatalf("expected swept delete to carry the root, got %#v", change)
+			}
+			deleted[change.DocID] = true
+		}
+	}
+	if len(deleted) != 2 || !deleted["file_9"] || !deleted["file_8"] {
+		t.Fatalf("expected swept documents to be recorded as deletes, got %v", deleted)
+	}
+	if last := changes[len(changes)-1]; last.Op != models.IndexChangeSweep || last.Removed != 2 {
+		t.Fatalf("expected the sweep summary to follow the deletes, got %#v", last)
+	}
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"npan/internal/models"
+	"npan/internal/search"
+)
+
+var (
+	ErrSyncInProgress      = errors.New("已有同步任务在运行")
+	ErrNoRebuildToRollback = errors.New("没有可回滚的蓝绿重建")
+)
+
+func (m *SyncManager) indexRebuilder() (search.IndexRebuilder, error) {
+	rebuilder, ok := unwrapIndex(m.index).(search.IndexRebuilder)
+	if !ok {
+		return nil, search.ErrRebuildUnsupported
+	}
+	return rebuilder, nil
+}
+
+// validateShadowRebuild 在启动前拒绝无法执行的蓝绿重建请求。
+func (m *SyncManager) validateShadowRebuild(request SyncStartRequest, mode models.SyncMode) error {
+	if request.ShadowRebuild == nil || !*request.ShadowRebuild {
+		return nil
+	}
+	if mode != models.SyncModeFull {
+		return errors.New("shadow_rebuild 仅支持全量同步")
+	}
+	if request.ForceRebuild != nil && *request.ForceRebuild {
+		return errors.New("shadow_rebuild 不能与 force_rebuild 同时使用")
+	}
+	_, err := m.indexRebuilder()
+	return err
+}
+
+// prepareShadowRebuild 准备影子索引。上次蓝绿重建未完成且允许续爬时沿用影子索引与断点，
+// 否则清空影子索引重新开始。
+func (m *SyncManager) prepareShadowRebuild(ctx context.Context, existing *models.SyncProgressState, resume bool) (search.IndexOperator, *models.IndexRebuildState, bool, error) {
+	rebuilder, err := m.indexRebuilder()
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	continuing := resume && existing != nil && existing.Rebuild != nil && existing.Rebuild.Status == models.RebuildStatusBuilding
+	shadow, name, err := rebuilder.ShadowIndex(ctx, !continuing)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("准备影子索引失败: %w", err)
+	}
+
+	if continuing {
+		state := *existing.Rebuild
+		state.ShadowIndex = name
+		state.LastError = ""
+		return shadow, &state, true, nil
+	}
+	return shadow, &models.IndexRebuildState{
+		Status:      models.RebuildStatusBuilding,
+		LiveIndex:   m.meiliIndex,
+		ShadowIndex: name,
+		StartedAt:   time.Now().UnixMilli(),
+	}, false, nil
+}
+
+// carryRebuildState 让普通同步在运行期间保留上一次蓝绿重建的结果，同步成功后由
+// expireRollbackTarget 放弃回滚目标。未完成的重建会被放弃：普通全量同步与它共用断点，影子索引已无法续爬。
+func carryRebuildState(existing *models.SyncProgressState) *models.IndexRebuildState {
+	if existing == nil || existing.Rebuild == nil {
+		return nil
+	}
+	if existing.Rebuild.Status == models.RebuildStatusBuilding {
+		slog.Warn("普通全量同步放弃未完成的蓝绿重建", "shadow_index", existing.Rebuild.ShadowIndex)
+		return nil
+	}
+	state := *existing.Rebuild
+	return &state
+}
+
+// expireRollbackTarget 在切换后的首次同步成功后放弃回滚目标：此后的变更只写入线上索引，
+// 切回上一代会丢失这些变更。
+func expireRollbackTarget(progress *models.SyncProgressState) {
+	if progress.Rebuild == nil || progress.Rebuild.Status != models.RebuildStatusSwapped {
+		return
+	}
+	slog.Info("切换后的同步已完成，上一代索引不再可回滚", "live_index", progress.Rebuild.ShadowIndex)
+	progress.Rebuild = nil
+}
+
+func (m *SyncManager) swapShadowIndex(ctx context.Context, progress *models.SyncProgressState) error {
+	rebuilder, err := m.indexRebuilder()
+	if err == nil {
+		err = rebuilder.SwapShadow(ctx)
+	}
+	if err != nil {
+		err = fmt.Errorf("切换影子索引失败: %w", err)
+		if progress.Rebuild != nil {
+			progress.Rebuild.LastError = err.Error()
+		}
+		return err
+	}
+	m.recordIndexChanges([]models.IndexChange{{Op: models.IndexChangeReset}})
+
+	if progress.Rebuild != nil {
+		progress.Rebuild.Status = models.RebuildStatusSwapped
+		progress.Rebuild.SwappedAt = time.Now().UnixMilli()
+		progress.Rebuild.LastError = ""
+	}
+	return nil
+}
+
+// RollbackRebuild 将线上索引切回最近一次蓝绿重建之前的上一代。
+func (m *SyncManager) RollbackRebuild(ctx context.Context) (*models.IndexRebuildState, error) {
+	rebuilder, err := m.indexRebuilder()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, finish, err := m.beginExclusiveOperation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
+	progress, err := m.progressStore.Load()
+	if err != nil {
+		return nil, fmt.Errorf("load progress: %w", err)
+	}
+	if progress == nil || progress.Rebuild == nil || progress.Rebuild.Status != models.RebuildStatusSwapped {
+		return nil, ErrNoRebuildToRollback
+	}
+
+	if err := rebuilder.RollbackSwap(ctx); err != nil {
+		return nil, fmt.Errorf("回滚索引失败: %w", err)
+	}
+	m.recordIndexChanges([]models.IndexChange{{Op: models.IndexChangeReset}})
+	progress.Rebuild.Status = models.RebuildStatusRolledBack
+	progress.Rebuild.RolledBackAt = time.Now().UnixMilli()
+	if err := m.progressStore.Save(progress); err != nil {
+		return nil, err
+	}
+
+	state := *progress.Rebuild
+	return &state, nil
+}
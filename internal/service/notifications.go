@@ -0,0 +1,59 @@
+package service
+
+import (
+	"npan/internal/models"
+	"npan/internal/notify"
+)
+
+func (m *SyncManager) notifySyncStarted(mode models.SyncMode, run *models.SyncRun) {
+	if m.notifier == nil {
+		return
+	}
+	event := notify.Event{Type: notify.EventSyncStarted, TenantID: m.tenantID, Mode: mode, Status: "running"}
+	if run != nil {
+		event.RunID = run.ID
+		event.OccurredAt = run.StartedAt
+	}
+	m.notifier.Notify(event)
+}
+
+// notifySyncFinished 按运行结果发送完成、失败或取消事件；校验带告警时额外发送一条校验告警。
+func (m *SyncManager) notifySyncFinished(run *models.SyncRun, outcome models.SyncRun) {
+	if m.notifier == nil {
+		return
+	}
+
+	event := notify.Event{
+		TenantID:         m.tenantID,
+		Mode:             outcome.Mode,
+		Status:           outcome.Status,
+		OccurredAt:       outcome.EndedAt,
+		DurationMS:       outcome.EndedAt - outcome.StartedAt,
+		IncrementalStats: outcome.IncrementalStats,
+		Error:            outcome.Error,
+	}
+	if run != nil {
+		event.RunID = run.ID
+		event.DurationMS = outcome.EndedAt - run.StartedAt
+	}
+	if outcome.Mode != models.SyncModeIncremental {
+		stats := outcome.Stats
+		event.Stats = &stats
+	}
+	switch outcome.Status {
+	case "error":
+		event.Type = notify.EventSyncFailed
+	case "cancelled":
+		event.Type = notify.EventSyncCancelled
+	default:
+		event.Type = notify.EventSyncFinished
+	}
+	m.notifier.Notify(event)
+
+	if outcome.Verification != nil && len(outcome.Verification.Warnings) > 0 {
+		warning := event
+		warning.Type = notify.EventVerificationWarning
+		warning.Warnings = outcome.Verification.Warnings
+		m.notifier.Notify(warning)
+	}
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"npan/internal/models"
+	"npan/internal/notify"
+	"npan/internal/storage"
+)
+
+type recordingNotifier struct {
+	mu     sync.Mutex
+	events []notify.Event
+}
+
+func (n *recordingNotifier) Notify(event notify.Event) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.events = append(n.events, event)
+}
+
+func (n *recordingNotifier) types() []notify.EventType {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	types := make([]notify.EventType, 0, len(n.events))
+	for _, event := range n.events {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+func TestSyncManager_NotifiesLifecycleEvents(t *testing.T) {
+	t.Parallel()
+
+	_, api := staleSweepFixture()
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(nil))
+	notifier := &recordingNotifier{}
+	mgr.notifier = notifier
+
+	disabled := false
+	if err := mgr.Start(api, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("Start full returned error: %v", err)
+	}
+	waitSyncStopped(t, mgr)
+
+	mgr.syncStateStore = storage.NewJSONSyncStateStore(filepath.Join(t.TempDir(), "missing.json"))
+	if err := mgr.Start(api, SyncStartRequest{Mode: models.SyncModeIncremental}); err != nil {
+		t.Fatalf("Start incremental returned error: %v", err)
+	}
+	waitSyncStopped(t, mgr)
+
+	got := notifier.types()
+	want := []notify.EventType{notify.EventSyncStarted, notify.EventSyncFinished, notify.EventSyncStarted, notify.EventSyncFailed}
+	if len(got) != len(want) {
+		t.Fatalf("expected events %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected events %v, got %v", want, got)
+		}
+	}
+
+	finished := notifier.events[1]
+	if finished.Mode != models.SyncModeFull || finished.Stats == nil || finished.Stats.FilesIndexed == 0 {
+		t.Fatalf("expected full sync stats in finished event, got %+v", finished)
+	}
+	failed := notifier.events[3]
+	if failed.Mode != models.SyncModeIncremental || failed.Error == "" {
+		t.Fatalf("expected failure reason in failed event, got %+v", failed)
+	}
+}
+
+func TestNotifySyncFinished_SendsVerificationWarning(t *testing.T) {
+	t.Parallel()
+
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(nil))
+	notifier := &recordingNotifier{}
+	mgr.notifier = notifier
+
+	mgr.notifySyncFinished(nil, models.SyncRun{
+		Mode:         models.SyncModeFull,
+		Status:       "done",
+		Verification: &models.SyncVerification{Warnings: []string{"索引文档数(1) < 爬取写入数(2)"}},
+	})
+
+	if got := notifier.types(); len(got) != 2 || got[0] != notify.EventSyncFinished || got[1] != notify.EventVerificationWarning {
+		t.Fatalf("expected finished and verification warning events, got %v", got)
+	}
+	if notifier.events[1].Warnings[0] != "索引文档数(1) < 爬取写入数(2)" {
+		t.Fatalf("expected warnings to be forwarded, got %+v", notifier.events[1])
+	}
+}
+
+func TestNotifySync_TagsEventsWithTenant(t *testing.T) {
+	t.Parallel()
+
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(nil))
+	notifier := &recordingNotifier{}
+	mgr.notifier = notifier
+	mgr.tenantID = "acme"
+
+	mgr.notifySyncStarted(models.SyncModeFull, nil)
+	mgr.notifySyncFinished(nil, models.SyncRun{
+		Mode:         models.SyncModeFull,
+		Status:       "done",
+		Verification: &models.SyncVerification{Warnings: []string{"索引文档数(1) < 爬取写入数(2)"}},
+	})
+
+	if len(notifier.events) != 3 {
+		t.Fatalf("expected started, finished and warning events, got %v", notifier.types())
+	}
+	for _, event := range notifier.events {
+		if event.TenantID != "acme" {
+			t.Fatalf("expected every event to carry tenant acme, got %+v", event)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"npan/internal/indexer"
+	"npan/internal/models"
+)
+
+const (
+	defaultPathRewriteMaxFolders = 500
+	pathRewriteLookupBatch       = 100
+	pathRewriteUpsertBatch       = 1000
+)
+
+// inspectChangedFolders 在写入增量变更前，把变更中的目录与索引里的旧文档比较：
+// 名称或父目录变化的目录需要改写子孙文档的路径；索引中不存在的目录（新建或从回收站恢复）
+// 需要重新爬取子树。必须在旧文档被覆盖之前调用。
+func (m *SyncManager) inspectChangedFolders(ctx context.Context, upserts []models.IndexDocument) ([]models.FolderPathRewrite, []models.IndexDocument, error) {
+	changed := map[string]models.IndexDocument{}
+	docIDs := make([]string, 0)
+	for _, doc := range upserts {
+		if doc.Type != models.ItemTypeFolder {
+			continue
+		}
+		if _, ok := changed[doc.DocID]; !ok {
+			docIDs = append(docIDs, doc.DocID)
+		}
+		changed[doc.DocID] = doc
+	}
+
+	var rewrites []models.FolderPathRewrite
+	found := map[string]struct{}{}
+	for start := 0; start < len(docIDs); start += pathRewriteLookupBatch {
+		end := min(start+pathRewriteLookupBatch, len(docIDs))
+		indexed, err := indexer.WithRetry(ctx, func() ([]models.IndexDocument, error) {
+			return m.index.GetDocuments(ctx, docIDs[start:end])
+		}, m.retry)
+		if err != nil {
+			return nil, nil, fmt.Errorf("读取已索引目录失败: %w", err)
+		}
+		for _, old := range indexed {
+			found[old.DocID] = struct{}{}
+			current, ok := changed[old.DocID]
+			if !ok || (old.Name == current.Name && old.ParentID == current.ParentID) {
+				continue
+			}
+			rewrites = append(rewrites, models.FolderPathRewrite{
+				FolderID: current.SourceID,
+				Path: models.FolderPath{
+					PathText: current.PathText,
+					Lineage:  append(append([]int64{}, current.AncestorIDs...), current.SourceID),
+				},
+			})
+		}
+	}
+
+	var appeared []models.IndexDocument
+	for _, docID := range docIDs {
+		if _, ok := found[docID]; !ok {
+			appeared = append(appeared, changed[docID])
+		}
+	}
+	return rewrites, appeared, nil
+}
+
+// enqueuePathRewrites 把新发现的改写任务并入队列，同一目录以最新路径为准。
+func enqueuePathRewrites(progress *models.SyncProgressState, rewrites []models.FolderPathRewrite) {
+	for _, rewrite := range rewrites {
+		replaced := false
+		for i := range progress.PathRewrites {
+			if progress.PathRewrites[i].FolderID == rewrite.FolderID {
+				progress.PathRewrites[i] = rewrite
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			progress.PathRewrites = append(progress.PathRewrites, rewrite)
+		}
+	}
+}
+
+// rewriteFolderPaths 按广度优先处理路径改写队列：重新计算每个目录直接子项的路径与祖先，
+// 子目录再入队。每处理完一个目录保存一次进度，单次最多处理 pathRewriteMaxFolders 个目录，
+// 剩余队列留在进度中由下一次增量同步继续。
+func (m *SyncManager) rewriteFolderPaths(ctx context.Context, progress *models.SyncProgressState) error {
+	limit := m.pathRewriteMaxFolders
+	if limit <= 0 {
+		limit = defaultPathRewriteMaxFolders
+	}
+
+	for processed := 0; processed < limit && len(progress.PathRewrites) > 0; processed++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		entry := progress.PathRewrites[0]
+		children, err := searchIndexedChildren(ctx, m.index, entry.FolderID)
+		if err != nil {
+			return fmt.Errorf("改写目录 %d 的子项路径失败: %w", entry.FolderID, err)
+		}
+
+		docs := make([]models.IndexDocument, 0, len(children))
+		next := make([]models.FolderPathRewrite, 0)
+		for _, child := range children {
+			if child.Type == models.ItemTypeFolder && child.SourceID == entry.FolderID {
+				// 同步根目录文档的父目录是它自己。
+				continue
+			}
+			pathText := indexer.JoinPath(entry.Path.PathText, child.Name)
+			if child.Type == models.ItemTypeFolder {
+				// 即使子目录文档已是新路径也要继续下探：中断恢复时它的子孙可能还未改写。
+				next = append(next, models.FolderPathRewrite{
+					FolderID: child.SourceID,
+					Path: models.FolderPath{
+						PathText: pathText,
+						Lineage:  append(append([]int64{}, entry.Path.Lineage...), child.SourceID),
+					},
+				})
+			}
+			if child.PathText == pathText && slices.Equal(child.AncestorIDs, entry.Path.Lineage) {
+				continue
+			}
+			child.PathText = pathText
+			child.AncestorIDs = append([]int64{}, entry.Path.Lineage...)
+			docs = append(docs, child)
+		}
+
+		for start := 0; start < len(docs); start += pathRewriteUpsertBatch {
+			batch := docs[start:min(start+pathRewriteUpsertBatch, len(docs))]
+			if err := indexer.WithRetryVoid(ctx, func() error {
+				return m.index.UpdateDocumentPaths(ctx, batch)
+			}, m.retry); err != nil {
+				return fmt.Errorf("改写目录 %d 的子项路径失败: %w", entry.FolderID, err)
+			}
+		}
+
+		progress.PathRewrites = append(progress.PathRewrites[1:], next...)
+		progress.IncrementalStats.PathsRewritten += int64(len(docs))
+		progress.IncrementalStats.PathRewritesPending = int64(len(progress.PathRewrites))
+		if err := m.progressStore.Save(progress); err != nil {
+			return err
+		}
+	}
+	if len(progress.PathRewrites) == 0 {
+		progress.PathRewrites = nil
+	}
+	progress.IncrementalStats.PathRewritesPending = int64(len(progress.PathRewrites))
+	return nil
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"npan/internal/indexer"
+	"npan/internal/models"
+)
+
+func movedFolderFixture() *inMemoryIndexStub {
+	return newInMemoryIndexStub([]models.IndexDocument{
+		{DocID: "folder_10", SourceID: 10, Type: models.ItemTypeFolder, Name: "old", ParentID: 100, PathText: "Root/old", AncestorIDs: []int64{100}},
+		{DocID: "folder_11", SourceID: 11, Type: models.ItemTypeFolder, Name: "sub", ParentID: 10, PathText: "Root/old/sub", AncestorIDs: []int64{100, 10}},
+		{DocID: "file_12", SourceID: 12, Type: models.ItemTypeFile, Name: "a.pdf", ParentID: 11, PathText: "Root/old/sub/a.pdf", AncestorIDs: []int64{100, 10, 11}, SHA1: "abc"},
+		{DocID: "file_13", SourceID: 13, Type: models.ItemTypeFile, Name: "b.pdf", ParentID: 10, PathText: "Root/old/b.pdf", AncestorIDs: []int64{100, 10}},
+	})
+}
+
+func TestRunIncremental_RewritesSubtreeOfMovedFolderAcrossRuns(t *testing.T) {
+	t.Parallel()
+
+	index := movedFolderFixture()
+	mgr, _ := newTestSyncManager(t, index)
+	mgr.pathRewriteMaxFolders = 1
+	limiter := indexer.NewRequestLimiter(2, 0)
+
+	renamed := true
+	api := &mockAPI{
+		searchUpdatedWindowFn: func(_ context.Context, _ string, _ *int64, _ *int64, _ int64) (map[string]any, error) {
+			if !renamed {
+				return makeOnePage(nil, nil), nil
+			}
+			return makeOnePage(nil, []map[string]any{makeFolderEntry(10, "new", 20, false, false)}), nil
+		},
+		getFolderInfoFn: func(_ context.Context, folderID int64) (models.NpanFolder, error) {
+			return models.NpanFolder{ID: folderID, Name: "Archive", ParentID: 100}, nil
+		},
+	}
+
+	progress := newTestProgress(1699990000)
+	progress.RootNames = map[int64]string{100: "Root"}
+	request := SyncStartRequest{Mode: models.SyncModeIncremental}
+	if err := mgr.runIncremental(context.Background(), api, progress, request, limiter); err != nil {
+		t.Fatalf("runIncremental returned error: %v", err)
+	}
+
+	stats := progress.IncrementalStats
+	if stats.FoldersMoved != 1 || stats.PathsRewritten != 2 || stats.PathRewritesPending != 1 {
+		t.Fatalf("expected one moved folder, 2 rewritten docs and 1 pending folder, got %+v", stats)
+	}
+	if got := index.docs["folder_10"]; got.PathText != "Root/Archive/new" || !slices.Equal(got.AncestorIDs, []int64{100, 20}) {
+		t.Fatalf("expected moved folder itself to be upserted with the new path, got %+v", got)
+	}
+	if got := index.docs["file_13"]; got.PathText != "Root/Archive/new/b.pdf" || !slices.Equal(got.AncestorIDs, []int64{100, 20, 10}) {
+		t.Fatalf("expected direct child to be rewritten, got %+v", got)
+	}
+	if got := index.docs["file_12"]; got.PathText != "Root/old/sub/a.pdf" {
+		t.Fatalf("expected grandchild to wait for the next run, got %+v", got)
+	}
+	saved, err := mgr.progressStore.Load()
+	if err != nil {
+		t.Fatalf("load progress failed: %v", err)
+	}
+	if saved == nil || len(saved.PathRewrites) != 1 || saved.PathRewrites[0].FolderID != 11 {
+		t.Fatalf("expected pending rewrite of folder 11 to be persisted, got %+v", saved)
+	}
+
+	renamed = false
+	next := newTestProgress(1699990100)
+	next.PathRewrites = saved.PathRewrites
+	if err := mgr.runIncremental(context.Background(), api, next, request, limiter); err != nil {
+		t.Fatalf("second runIncremental returned error: %v", err)
+	}
+	if next.IncrementalStats.FoldersMoved != 0 || next.IncrementalStats.PathsRewritten != 1 || next.IncrementalStats.PathRewritesPending != 0 || next.PathRewrites != nil {
+		t.Fatalf("expected resumed run to finish the queue, got stats=%+v queue=%+v", next.IncrementalStats, next.PathRewrites)
+	}
+	got := index.docs["file_12"]
+	if got.PathText != "Root/Archive/new/sub/a.pdf" || !slices.Equal(got.AncestorIDs, []int64{100, 20, 10, 11}) || got.SHA1 != "abc" {
+		t.Fatalf("expected grandchild to be rewritten without losing other fields, got %+v", got)
+	}
+}
+
+func TestRunIncremental_IgnoresFolderWithUnchangedNameAndParent(t *testing.T) {
+	t.Parallel()
+
+	index := movedFolderFixture()
+	mgr, _ := newTestSyncManager(t, index)
+	api := &mockAPI{
+		searchUpdatedWindowFn: func(_ context.Context, _ string, _ *int64, _ *int64, _ int64) (map[string]any, error) {
+			return makeOnePage(nil, []map[string]any{makeFolderEntry(10, "old", 100, false, false)}), nil
+		},
+	}
+
+	progress := newTestProgress(1699990000)
+	progress.RootNames = map[int64]string{100: "Root"}
+	if err := mgr.runIncremental(context.Background(), api, progress, SyncStartRequest{Mode: models.SyncModeIncremental}, indexer.NewRequestLimiter(2, 0)); err != nil {
+		t.Fatalf("runIncremental returned error: %v", err)
+	}
+	if progress.IncrementalStats.FoldersMoved != 0 || progress.IncrementalStats.PathsRewritten != 0 || len(progress.PathRewrites) != 0 {
+		t.Fatalf("expected no path rewrite, got stats=%+v queue=%+v", progress.IncrementalStats, progress.PathRewrites)
+	}
+	if len(index.upserts) != 1 {
+		t.Fatalf("expected only the changed folder to be upserted, got %d upserts", len(index.upserts))
+	}
+}
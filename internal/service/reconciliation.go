@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"time"
+
+	"npan/internal/indexer"
+	"npan/internal/models"
+	"npan/internal/npan"
+	"npan/internal/storage"
+)
+
+var (
+	ErrReconciliationDisabled   = errors.New("对账报告存储未启用")
+	ErrReconciliationRunning    = errors.New("已有对账任务在运行")
+	ErrReconciliationNotRunning = errors.New("当前没有运行中的对账任务")
+	ErrReconciliationNotFound   = errors.New("对账报告不存在")
+	ErrReconciliationNoRoots    = errors.New("没有可对账的根目录，请指定 root_folder_ids 或先完成一次同步")
+)
+
+const (
+	reconciliationRowBatchSize = 500
+	// reconciliationKeepReports 是保留的对账报告份数，更早的报告及其行在新对账结束后删除。
+	reconciliationKeepReports = 10
+)
+
+// ReconciliationRequest 中 RootFolderIDs 为空时使用最近一次同步的根目录；
+// SampleSize 大于 0 时每个根目录最多抽查 SampleSize 个目录，根目录本身总会检查。
+type ReconciliationRequest struct {
+	RootFolderIDs []int64
+	SampleSize    int
+}
+
+// StartReconciliation 创建对账报告并在后台执行，返回报告头供调用方轮询。可以用 CancelReconciliation 取消。
+func (m *SyncManager) StartReconciliation(api npan.API, request ReconciliationRequest) (*models.ReconciliationReport, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	report, rootNames, err := m.beginReconciliation(request, cancel)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	created := *report
+	go func() {
+		defer cancel()
+		_ = m.runReconciliation(ctx, api, report, rootNames)
+	}()
+	return &created, nil
+}
+
+// RunReconciliation 同步执行对账，返回最终的报告头（不含行），供 CLI 使用。
+func (m *SyncManager) RunReconciliation(ctx context.Context, api npan.API, request ReconciliationRequest) (*models.ReconciliationReport, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	report, rootNames, err := m.beginReconciliation(request, cancel)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.runReconciliation(ctx, api, report, rootNames); err != nil {
+		return report, err
+	}
+	return report, nil
+}
+
+// GetReconciliationReport 返回报告及按 filter 过滤的行，id 为 0 时返回最近一次报告。
+func (m *SyncManager) GetReconciliationReport(id int64, filter storage.ReconciliationRowFilter) (*models.ReconciliationReport, error) {
+	if m.reconciliationStore == nil {
+		return nil, ErrReconciliationDisabled
+	}
+	var (
+		report *models.ReconciliationReport
+		err    error
+	)
+	if id > 0 {
+		report, err = m.reconciliationStore.Get(id)
+	} else {
+		report, err = m.reconciliationStore.Latest()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return nil, ErrReconciliationNotFound
+	}
+
+	// 与同步进度一致：记录仍为运行中但本进程没有在对账，说明进程在对账途中退出。
+	if report.Status == "running" && !m.isReconciling() {
+		report.Status = "interrupted"
+	}
+	rows, err := m.reconciliationStore.ListRows(report.ID, filter)
+	if err != nil {
+		return nil, err
+	}
+	report.Rows = rows
+	return report, nil
+}
+
+// CancelReconciliation 取消本进程运行中的对账，报告记为已取消，已检查的目录保留在报告中。
+func (m *SyncManager) CancelReconciliation() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.reconciling || m.reconciliationCancel == nil {
+		return ErrReconciliationNotRunning
+	}
+	m.reconciliationCancel()
+	return nil
+}
+
+func (m *SyncManager) isReconciling() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.reconciling
+}
+
+// beginReconciliation 校验请求、占用对账任务并写入报告头，cancel 供 CancelReconciliation 取消本次对账。
+// 同步运行期间索引仍在变化，对账结果没有意义，因此直接拒绝。
+func (m *SyncManager) beginReconciliation(request ReconciliationRequest, cancel context.CancelFunc) (*models.ReconciliationReport, map[int64]string, error) {
+	if m.reconciliationStore == nil {
+		return nil, nil, ErrReconciliationDisabled
+	}
+	if request.SampleSize < 0 {
+		return nil, nil, fmt.Errorf("sample_size 不能为负数")
+	}
+
+	progress, err := m.progressStore.Load()
+	if err != nil {
+		return nil, nil, fmt.Errorf("load progress: %w", err)
+	}
+	roots := append([]int64{}, request.RootFolderIDs...)
+	rootNames := map[int64]string{}
+	if progress != nil {
+		if len(roots) == 0 {
+			roots = append(roots, progress.Roots...)
+		}
+		for id, name := range progress.RootNames {
+			rootNames[id] = name
+		}
+	}
+	if len(roots) == 0 {
+		return nil, nil, ErrReconciliationNoRoots
+	}
+
+	m.mu.Lock()
+	if m.running {
+		m.mu.Unlock()
+		return nil, nil, ErrSyncInProgress
+	}
+	if m.reconciling {
+		m.mu.Unlock()
+		return nil, nil, ErrReconciliationRunning
+	}
+	// 对账期间其他进程的同步同样会让结果失真，因此也需要租约。
+	if err := m.acquireSyncLease(); err != nil {
+		m.mu.Unlock()
+		return nil, nil, err
+	}
+	m.reconciling = true
+	m.reconciliationCancel = cancel
+	m.mu.Unlock()
+
+	report := &models.ReconciliationReport{
+		Status:     "running",
+		Roots:      roots,
+		SampleSize: int64(request.SampleSize),
+		StartedAt:  time.Now().UnixMilli(),
+	}
+	if err := m.reconciliationStore.Create(report); err != nil {
+		m.releaseSyncLease()
+		m.mu.Lock()
+		m.reconciling = false
+		m.reconciliationCancel = nil
+		m.mu.Unlock()
+		return nil, nil, err
+	}
+	return report, rootNames, nil
+}
+
+func (m *SyncManager) runReconciliation(ctx context.Context, api npan.API, report *models.ReconciliationReport, rootNames map[int64]string) error {
+	defer func() {
+		m.mu.Lock()
+		m.reconciling = false
+		m.reconciliationCancel = nil
+		m.mu.Unlock()
+	}()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	releaseLease := m.keepSyncLease(ctx, cancel)
+	defer releaseLease()
+
+	limiter := m.newStandaloneLimiter()
+	var runErr error
+	for _, rootID := range report.Roots {
+		if runErr = m.reconcileRoot(ctx, api, report, rootID, rootNames[rootID], limiter); runErr != nil {
+			break
+		}
+	}
+
+	report.FinishedAt = time.Now().UnixMilli()
+	switch {
+	case runErr == nil:
+		report.Status = "done"
+		slog.Info("对账完成", "report_id", report.ID, "folders_checked", report.FoldersChecked, "folders_drifted", report.FoldersDrifted)
+	case ctx.Err() != nil:
+		report.Status = "cancelled"
+		report.Error = "对账已取消"
+	default:
+		report.Status = "error"
+		report.Error = runErr.Error()
+		slog.Warn("对账失败", "report_id", report.ID, "error", runErr)
+	}
+	if err := m.reconciliationStore.Update(report); err != nil {
+		slog.Warn("保存对账报告失败", "report_id", report.ID, "error", err)
+	}
+	if _, err := m.reconciliationStore.PruneKeepLatest(reconciliationKeepReports); err != nil {
+		slog.Warn("清理旧对账报告失败", "error", err)
+	}
+	return runErr
+}
+
+// reconcileRoot 比对根目录下每个已索引目录：上游 ItemCount 是子树内的条目总数（不含目录自身），
+// 索引侧同样统计子树内除目录自身外的文档数。上游只在索引中存在的目录上查询，
+// 上游新增而索引缺失的目录体现为其父目录的负漂移。
+func (m *SyncManager) reconcileRoot(ctx context.Context, api npan.API, report *models.ReconciliationReport, rootID int64, rootName string, limiter *indexer.RequestLimiter) error {
+	snapshot, err := buildIndexedTreeSnapshot(ctx, m.index, rootID)
+	if err != nil {
+		return fmt.Errorf("build indexed snapshot for root %d: %w", rootID, err)
+	}
+	folderIDs := sampleReconciliationFolders(snapshot.folderIDsBreadthFirst(), report.SampleSize)
+
+	rows := make([]models.ReconciliationRow, 0, min(len(folderIDs), reconciliationRowBatchSize))
+	flush := func() error {
+		if err := m.reconciliationStore.AddRows(report.ID, rows); err != nil {
+			return fmt.Errorf("save reconciliation rows: %w", err)
+		}
+		rows = rows[:0]
+		return m.reconciliationStore.Update(report)
+	}
+
+	for _, folderID := range folderIDs {
+		row := models.ReconciliationRow{
+			RootFolderID: rootID,
+			FolderID:     folderID,
+			Path:         snapshot.folderPaths[folderID],
+			IndexedItems: snapshot.subtreeItemCount(folderID),
+		}
+		if folderID == rootID && row.Path == "" {
+			row.Path = rootName
+		}
+		folder, err := m.fetchFolderInfo(ctx, api, folderID, limiter)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			row.Error = err.Error()
+		} else {
+			row.UpstreamItems = folder.ItemCount
+			row.Drift = row.IndexedItems - row.UpstreamItems
+			if row.Path == "" {
+				row.Path = folder.Name
+			}
+		}
+
+		report.FoldersChecked++
+		if row.Drift != 0 {
+			report.FoldersDrifted++
+		}
+		rows = append(rows, row)
+		if len(rows) >= reconciliationRowBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+// folderIDsBreadthFirst 按广度优先顺序返回根目录及其下全部已索引目录。
+func (s *indexedTreeSnapshot) folderIDsBreadthFirst() []int64 {
+	ids := []int64{s.rootID}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, s.childFolders[ids[i]]...)
+	}
+	return ids
+}
+
+// subtreeItemCount 返回目录子树内除目录自身外的文档数，与上游 ItemCount 口径一致。
+func (s *indexedTreeSnapshot) subtreeItemCount(folderID int64) int64 {
+	count := s.subtreeDocCount(folderID)
+	if folderID == s.rootID {
+		if s.rootDocID != "" {
+			count--
+		}
+	} else if s.folderDocIDs[folderID] != "" {
+		count--
+	}
+	return count
+}
+
+// sampleReconciliationFolders 在 sampleSize 小于目录数时随机抽查，首个元素（根目录）总会保留。
+func sampleReconciliationFolders(folderIDs []int64, sampleSize int64) []int64 {
+	if sampleSize <= 0 || int64(len(folderIDs)) <= sampleSize {
+		return folderIDs
+	}
+	rest := append([]int64{}, folderIDs[1:]...)
+	rand.Shuffle(len(rest), func(i, j int) { rest[i], rest[j] = rest[j], rest[i] })
+	sampled := append([]int64{folderIDs[0]}, rest[:sampleSize-1]...)
+	slices.Sort(sampled[1:])
+	return sampled
+}
+
+// WriteReconciliationReport 导出对账报告：csv 每个目录一行，json 输出报告头与全部行。
+func WriteReconciliationReport(w io.Writer, report *models.ReconciliationReport, format string) error {
+	switch format {
+	case "csv":
+		writer := csv.NewWriter(w)
+		if err := writer.Write([]string{"root_folder_id", "folder_id", "path", "upstream_items", "indexed_items", "drift", "error"}); err != nil {
+			return err
+		}
+		for _, row := range report.Rows {
+			if err := writer.Write([]string{
+				strconv.FormatInt(row.RootFolderID, 10),
+				strconv.FormatInt(row.FolderID, 10),
+				row.Path,
+				strconv.FormatInt(row.UpstreamItems, 10),
+				strconv.FormatInt(row.IndexedItems, 10),
+				strconv.FormatInt(row.Drift, 10),
+				row.Error,
+			}); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedExportFormat, format)
+	}
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"npan/internal/models"
+	"npan/internal/storage"
+)
+
+func newReconciliationTestManager(t *testing.T) *SyncManager {
+	t.Helper()
+	idx := newInMemoryIndexStub([]models.IndexDocument{
+		{DocID: "folder_200", SourceID: 200, Type: models.ItemTypeFolder, ParentID: 100, Name: "docs", PathText: "root/docs"},
+		{DocID: "folder_201", SourceID: 201, Type: models.ItemTypeFolder, ParentID: 200, Name: "sub", PathText: "root/docs/sub"},
+		{DocID: "file_1", SourceID: 1, Type: models.ItemTypeFile, ParentID: 200, Name: "a.pdf"},
+		{DocID: "file_2", SourceID: 2, Type: models.ItemTypeFile, ParentID: 200, Name: "b.pdf"},
+		{DocID: "file_3", SourceID: 3, Type: models.ItemTypeFile, ParentID: 201, Name: "c.pdf"},
+		{DocID: "file_9", SourceID: 9, Type: models.ItemTypeFile, ParentID: 100, Name: "d.pdf"},
+	})
+	mgr, tmpDir := newTestSyncManager(t, idx)
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(tmpDir, "state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores: %v", err)
+	}
+	t.Cleanup(func() { _ = stores.DB.Close() })
+	mgr.reconciliationStore = stores.ReconciliationStore
+	return mgr
+}
+
+func reconciliationTestAPI() *mockAPIForRouting {
+	return &mockAPIForRouting{
+		getFolderInfoFn: func(_ context.Context, folderID int64) (models.NpanFolder, error) {
+			switch folderID {
+			case 100:
+				return models.NpanFolder{ID: 100, Name: "root", ItemCount: 6}, nil
+			case 200:
+				return models.NpanFolder{ID: 200, Name: "docs", ParentID: 100, ItemCount: 5}, nil
+			default:
+				return models.NpanFolder{}, errors.New("folder not found")
+			}
+		},
+	}
+}
+
+func TestReconciliation_RecordsPerFolderDrift(t *testing.T) {
+	t.Parallel()
+
+	mgr := newReconciliationTestManager(t)
+	saveFolderResyncRoots(t, mgr)
+
+	report, err := mgr.RunReconciliation(context.Background(), reconciliationTestAPI(), ReconciliationRequest{})
+	if err != nil {
+		t.Fatalf("RunReconciliation returned error: %v", err)
+	}
+	if report.Status != "done" || report.FoldersChecked != 3 || report.FoldersDrifted != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	full, err := mgr.GetReconciliationReport(0, storage.ReconciliationRowFilter{})
+	if err != nil {
+		t.Fatalf("GetReconciliationReport returned error: %v", err)
+	}
+	if full.ID != report.ID || len(full.Rows) != 3 {
+		t.Fatalf("expected latest report with 3 rows, got %+v", full)
+	}
+	root := full.Rows[0]
+	if root.FolderID != 100 || root.Path != "root" || root.IndexedItems != 6 || root.Drift != 0 {
+		t.Fatalf("unexpected root row: %+v", root)
+	}
+	docs := full.Rows[1]
+	if docs.FolderID != 200 || docs.Path != "root/docs" || docs.UpstreamItems != 5 || docs.IndexedItems != 4 || docs.Drift != -1 {
+		t.Fatalf("unexpected drift row: %+v", docs)
+	}
+	if full.Rows[2].FolderID != 201 || full.Rows[2].Error == "" || full.Rows[2].Drift != 0 {
+		t.Fatalf("expected lookup failure row, got %+v", full.Rows[2])
+	}
+
+	drifted, err := mgr.GetReconciliationReport(report.ID, storage.ReconciliationRowFilter{OnlyDrift: true})
+	if err != nil {
+		t.Fatalf("GetReconciliationReport returned error: %v", err)
+	}
+	if len(drifted.Rows) != 2 {
+		t.Fatalf("expected drift and error rows, got %+v", drifted.Rows)
+	}
+
+	var out strings.Builder
+	if err := WriteReconciliationReport(&out, drifted, "csv"); err != nil {
+		t.Fatalf("WriteReconciliationReport returned error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 || lines[0] != "root_folder_id,folder_id,path,upstream_items,indexed_items,drift,error" || lines[1] != "100,200,root/docs,5,4,-1," {
+		t.Fatalf("unexpected csv export:\n%s", out.String())
+	}
+	if err := WriteReconciliationReport(&out, drifted, "xml"); !errors.Is(err, ErrUnsupportedExportFormat) {
+		t.Fatalf("expected ErrUnsupportedExportFormat, got %v", err)
+	}
+}
+
+func TestReconciliation_SampleKeepsRootAndRequiresRoots(t *testing.T) {
+	t.Parallel()
+
+	mgr := newReconciliationTestManager(t)
+	if _, err := mgr.RunReconciliation(context.Background(), reconciliationTestAPI(), ReconciliationRequest{}); !errors.Is(err, ErrReconciliationNoRoots) {
+		t.Fatalf("expected ErrReconciliationNoRoots, got %v", err)
+	}
+	if _, err := mgr.GetReconciliationReport(0, storage.ReconciliationRowFilter{}); !errors.Is(err, ErrReconciliationNotFound) {
+		t.Fatalf("expected ErrReconciliationNotFound, got %v", err)
+	}
+
+	report, err := mgr.RunReconciliation(context.Background(), reconciliationTestAPI(), ReconciliationRequest{RootFolderIDs: []int64{100}, SampleSize: 2})
+	if err != nil {
+		t.Fatalf("RunReconciliation returned error: %v", err)
+	}
+	full, err := mgr.GetReconciliationReport(report.ID, storage.ReconciliationRowFilter{})
+	if err != nil {
+		t.Fatalf("GetReconciliationReport returned error: %v", err)
+	}
+	if full.SampleSize != 2 || len(full.Rows) != 2 || full.Rows[0].FolderID != 100 {
+		t.Fatalf("expected sampled report to keep the root, got %+v", full)
+	}
+}
+
+func TestReconciliation_BlocksSyncAndFolderResyncWhileRunning(t *testing.T) {
+	t.Parallel()
+
+	mgr := newReconciliationTestManager(t)
+	saveFolderResyncRoots(t, mgr)
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	var once sync.Once
+	api := reconciliationTestAPI()
+	lookup := api.getFolderInfoFn
+	api.getFolderInfoFn = func(ctx context.Context, folderID int64) (models.NpanFolder, error) {
+		once.Do(func() {
+			close(entered)
+			<-release
+		})
+		return lookup(ctx, folderID)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := mgr.RunReconciliation(context.Background(), api, ReconciliationRequest{})
+		done <- err
+	}()
+	<-entered
+
+	disabled := false
+	syncErr := mgr.Start(&mockAPIForRouting{}, SyncStartRequest{Mode: models.SyncModeFull, RootFolderIDs: []int64{100}, IncludeDepartments: &disabled})
+	resyncErr := mgr.StartFolderResync(&mockAPIForRouting{}, FolderResyncRequest{FolderID: 200, Mode: models.FolderResyncModeMerge})
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("RunReconciliation returned error: %v", err)
+	}
+
+	if !errors.Is(syncErr, ErrReconciliationRunning) {
+		t.Fatalf("expected sync to be refused with ErrReconciliationRunning, got %v", syncErr)
+	}
+	if !errors.Is(resyncErr, ErrReconciliationRunning) {
+		t.Fatalf("expected folder resync to be refused with ErrReconciliationRunning, got %v", resyncErr)
+	}
+	if mgr.IsRunning() {
+		t.Fatal("expected no sync to start during reconciliation")
+	}
+}
+
+func TestReconciliation_CancelStopsBackgroundRun(t *testing.T) {
+	t.Parallel()
+
+	mgr := newReconciliationTestManager(t)
+	saveFolderResyncRoots(t, mgr)
+	if err := mgr.CancelReconciliation(); !errors.Is(err, ErrReconciliationNotRunning) {
+		t.Fatalf("expected ErrReconciliationNotRunning without a running reconciliation, got %v", err)
+	}
+
+	entered := make(chan struct{})
+	var once sync.Once
+	api := reconciliationTestAPI()
+	api.getFolderInfoFn = func(ctx context.Context, _ int64) (models.NpanFolder, error) {
+		// 模拟上游挂起，只有取消才能让对账结束。
+		once.Do(func() { close(entered) })
+		<-ctx.Done()
+		return models.NpanFolder{}, ctx.Err()
+	}
+
+	created, err := mgr.StartReconciliation(api, ReconciliationRequest{})
+	if err != nil {
+		t.Fatalf("StartReconciliation returned error: %v", err)
+	}
+	<-entered
+	if err := mgr.CancelReconciliation(); err != nil {
+		t.Fatalf("CancelReconciliation returned error: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for mgr.isReconciling() {
+		if time.Now().After(deadline) {
+			t.Fatal("reconciliation did not stop after cancel")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	report, err := mgr.GetReconciliationReport(created.ID, storage.ReconciliationRowFilter{})
+	if err != nil {
+		t.Fatalf("GetReconciliationReport returned error: %v", err)
+	}
+	if report.Status != "cancelled" {
+		t.Fatalf("expected cancelled report, got %+v", report)
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"npan/internal/indexer"
+	"npan/internal/models"
+	"npan/internal/npan"
+)
+
+// cascadeFolderDeletes 删除已进回收站或已删除目录在索引中的全部子孙文档，返回删除数。
+// 目录自身的文档由增量删除处理；这里按 parent_id 遍历索引子树，不依赖目录文档是否还在。
+func (m *SyncManager) cascadeFolderDeletes(ctx context.Context, folderIDs []int64) (int64, error) {
+	var removed int64
+	for _, folderID := range folderIDs {
+		snapshot, err := buildIndexedTreeSnapshot(ctx, m.index, folderID)
+		if err != nil {
+			return removed, fmt.Errorf("读取已删除目录 %d 的索引子树失败: %w", folderID, err)
+		}
+		docIDs := snapshot.collectSubtreeDocIDs(folderID)
+		if err := m.deleteSubtreeDocuments(ctx, docIDs); err != nil {
+			return removed, fmt.Errorf("级联删除目录 %d 的子孙文档失败: %w", folderID, err)
+		}
+		removed += int64(len(docIDs))
+	}
+	return removed, nil
+}
+
+// enqueueSubtreeRecrawls 把索引中不存在的目录加入重爬队列。祖先已在队列中的目录会随祖先一起爬取，不再单独入队。
+func enqueueSubtreeRecrawls(progress *models.SyncProgressState, appeared []models.IndexDocument) {
+	queued := make(map[int64]struct{}, len(progress.SubtreeRecrawls)+len(appeared))
+	for _, folderID := range progress.SubtreeRecrawls {
+		queued[folderID] = struct{}{}
+	}
+	for _, doc := range appeared {
+		queued[doc.SourceID] = struct{}{}
+	}
+
+	for _, doc := range appeared {
+		covered := false
+		for _, ancestorID := range doc.AncestorIDs {
+			if _, ok := queued[ancestorID]; ok {
+				covered = true
+				break
+			}
+		}
+		if covered || slices.Contains(progress.SubtreeRecrawls, doc.SourceID) {
+			continue
+		}
+		progress.SubtreeRecrawls = append(progress.SubtreeRecrawls, doc.SourceID)
+	}
+}
+
+// recrawlRestoredSubtrees 逐个重新爬取队列中的目录子树。从回收站恢复的目录，其子项的更新时间不会变化，
+// 增量窗口拉不到，只能整棵重爬。每完成一个目录保存一次进度，失败时队列保留到下一次增量继续。
+func (m *SyncManager) recrawlRestoredSubtrees(ctx context.Context, api npan.API, progress *models.SyncProgressState, limiter *indexer.RequestLimiter, paths *indexer.FolderPathResolver) error {
+	for len(progress.SubtreeRecrawls) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		folderID := progress.SubtreeRecrawls[0]
+		folder, err := m.fetchFolderInfo(ctx, api, folderID, limiter)
+		var statusErr *npan.StatusError
+		switch {
+		case errors.As(err, &statusErr) && statusErr.Status == http.StatusNotFound:
+			slog.Warn("待重爬目录已不存在，跳过", "folder_id", folderID)
+		case err != nil:
+			return fmt.Errorf("读取待重爬目录 %d 失败: %w", folderID, err)
+		case folder.InTrash || folder.IsDeleted:
+			// 又被删除了，留给后续增量的级联删除处理。
+		default:
+			written, err := m.rebuildNestedFolderSubtree(ctx, api, folder, limiter, paths, nil)
+			if err != nil {
+				return fmt.Errorf("重新爬取目录 %d 失败: %w", folderID, err)
+			}
+			progress.IncrementalStats.FoldersRestored++
+			progress.IncrementalStats.RestoredDocs += written
+		}
+
+		progress.SubtreeRecrawls = progress.SubtreeRecrawls[1:]
+		progress.IncrementalStats.RecrawlsPending = int64(len(progress.SubtreeRecrawls))
+		if err := m.progressStore.Save(progress); err != nil {
+			return err
+		}
+	}
+	progress.SubtreeRecrawls = nil
+	progress.IncrementalStats.RecrawlsPending = 0
+	return nil
+}
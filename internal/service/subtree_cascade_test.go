@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"npan/internal/indexer"
+	"npan/internal/models"
+)
+
+func TestRunIncremental_CascadesFolderDeleteToIndexedDescendants(t *testing.T) {
+	t.Parallel()
+
+	index := movedFolderFixture()
+	index.docs["file_99"] = models.IndexDocument{DocID: "file_99", SourceID: 99, Type: models.ItemTypeFile, Name: "keep.pdf", ParentID: 100}
+	mgr, _ := newTestSyncManager(t, index)
+	api := &mockAPI{
+		searchUpdatedWindowFn: func(_ context.Context, _ string, _ *int64, _ *int64, _ int64) (map[string]any, error) {
+			return makeOnePage(nil, []map[string]any{makeFolderEntry(10, "old", 100, true, false)}), nil
+		},
+	}
+
+	progress := newTestProgress(1699990000)
+	progress.RootNames = map[int64]string{100: "Root"}
+	if err := mgr.runIncremental(context.Background(), api, progress, SyncStartRequest{Mode: models.SyncModeIncremental}, indexer.NewRequestLimiter(2, 0)); err != nil {
+		t.Fatalf("runIncremental returned error: %v", err)
+	}
+
+	stats := progress.IncrementalStats
+	if stats.Deleted != 1 || stats.CascadeDeleted != 3 {
+		t.Fatalf("expected the folder and its 3 descendants to be deleted, got %+v", stats)
+	}
+	for _, docID := range []string{"folder_10", "folder_11", "file_12", "file_13"} {
+		if _, ok := index.docs[docID]; ok {
+			t.Fatalf("expected %s to be removed from the index", docID)
+		}
+	}
+	if _, ok := index.docs["file_99"]; !ok {
+		t.Fatal("expected sibling outside the deleted folder to stay indexed")
+	}
+}
+
+func TestRunIncremental_RecrawlsRestoredFolderSubtree(t *testing.T) {
+	t.Parallel()
+
+	index := newInMemoryIndexStub(nil)
+	mgr, _ := newTestSyncManager(t, index)
+	var listed []int64
+	api := &mockAPI{
+		searchUpdatedWindowFn: func(_ context.Context, _ string, _ *int64, _ *int64, _ int64) (map[string]any, error) {
+			return makeOnePage(nil, []map[string]any{
+				makeFolderEntry(30, "restored", 100, false, false),
+				makeFolderEntry(31, "inner", 30, false, false),
+			}), nil
+		},
+		getFolderInfoFn: func(_ context.Context, folderID int64) (models.NpanFolder, error) {
+			return models.NpanFolder{ID: folderID, Name: "restored", ParentID: 100}, nil
+		},
+		listFolderChildrenFn: func(_ context.Context, folderID int64, _ int64) (models.FolderChildrenPage, error) {
+			listed = append(listed, folderID)
+			switch folderID {
+			case 30:
+				return models.FolderChildrenPage{
+					Folders: []models.NpanFolder{{ID: 31, Name: "inner", ParentID: 30}},
+					Files:   []models.NpanFile{{ID: 32, Name: "a.pdf", ParentID: 30}},
+				}, nil
+			case 31:
+				return models.FolderChildrenPage{Files: []models.NpanFile{{ID: 33, Name: "b.pdf", ParentID: 31}}}, nil
+			}
+			return models.FolderChildrenPage{}, nil
+		},
+	}
+
+	progress := newTestProgress(1699990000)
+	progress.RootNames = map[int64]string{100: "Root"}
+	if err := mgr.runIncremental(context.Background(), api, progress, SyncStartRequest{Mode: models.SyncModeIncremental}, indexer.NewRequestLimiter(2, 0)); err != nil {
+		t.Fatalf("runIncremental returned error: %v", err)
+	}
+
+	stats := progress.IncrementalStats
+	if stats.FoldersRestored != 1 || stats.RestoredDocs != 4 || stats.RecrawlsPending != 0 || progress.SubtreeRecrawls != nil {
+		t.Fatalf("expected one restored subtree with 4 docs, got stats=%+v queue=%v", stats, progress.SubtreeRecrawls)
+	}
+	if !slices.Equal(listed, []int64{30, 31}) {
+		t.Fatalf("expected nested restored folder to be crawled once with its parent, got %v", listed)
+	}
+	got := index.docs["file_33"]
+	if got.PathText != "Root/restored/inner/b.pdf" || !slices.Equal(got.AncestorIDs, []int64{100, 30, 31}) {
+		t.Fatalf("expected restored grandchild to be indexed with its path, got %+v", got)
+	}
+}
@@ -36,6 +36,8 @@ type indexedTreeSnapshot struct {
 	folderDocIDs     map[int64]string
 	childFolders     map[int64][]int64
 	directFileDocIDs map[int64][]string
+	// folderPaths 是索引中目录文档的路径，供对账报告展示。
+	folderPaths map[int64]string
 
 	subtreeFolderCounts map[int64]int64
 	subtreeFileCounts   map[int64]int64
@@ -47,6 +49,7 @@ func buildIndexedTreeSnapshot(ctx context.Context, idx search.IndexOperator, roo
 		folderDocIDs:        map[int64]string{},
 		childFolders:        map[int64][]int64{},
 		directFileDocIDs:    map[int64][]string{},
+		folderPaths:         map[int64]string{},
 		subtreeFolderCounts: map[int64]int64{},
 		subtreeFileCounts:   map[int64]int64{},
 	}
@@ -64,6 +67,7 @@ func buildIndexedTreeSnapshot(ctx context.Context, idx search.IndexOperator, roo
 
 		for _, doc := range children {
 			if doc.Type == models.ItemTypeFolder {
+				snapshot.folderPaths[doc.SourceID] = doc.PathText
 				if parentID == rootID && doc.SourceID == rootID {
 					snapshot.rootDocID = doc.DocID
 					continue
@@ -159,15 +163,16 @@ func (s *indexedTreeSnapshot) collectSubtreeDocIDs(folderID int64) []string {
 }
 
 func (m *SyncManager) fetchFolderInfo(ctx context.Context, api npan.API, folderID int64, limiter *indexer.RequestLimiter) (models.NpanFolder, error) {
-	return indexer.WithRetry(ctx, func() (models.NpanFolder, error) {
-		var folder models.NpanFolder
-		err := limiter.Schedule(ctx, func() error {
-			var innerErr error
-			folder, innerErr = api.GetFolderInfo(ctx, folderID)
-			return innerErr
-		})
-		return folder, err
-	}, m.retry)
+	return callUpstream(ctx, limiter, m.retry, func() (models.NpanFolder, error) {
+		return api.GetFolderInfo(ctx, folderID)
+	})
+}
+
+// newFolderPathResolver 以同步根目录为终点，通过 GetFolderInfo 回溯目录路径。
+func (m *SyncManager) newFolderPathResolver(api npan.API, limiter *indexer.RequestLimiter, rootNames map[int64]string) *indexer.FolderPathResolver {
+	return indexer.NewFolderPathResolver(func(ctx context.Context, folderID int64) (models.NpanFolder, error) {
+		return m.fetchFolderInfo(ctx, api, folderID, limiter)
+	}, rootNames)
 }
 
 func (m *SyncManager) listAllFolderChildren(ctx context.Context, api npan.API, folderID int64, limiter *indexer.RequestLimiter) ([]models.NpanFolder, int64, error) {
@@ -264,18 +269,37 @@ func (m *SyncManager) deleteSubtreeDocuments(ctx context.Context, docIDs []strin
 	}, m.retry)
 }
 
-func (m *SyncManager) rebuildNestedFolderSubtree(ctx context.Context, api npan.API, folder models.NpanFolder, limiter *indexer.RequestLimiter) error {
-	rootDoc := search.MapFolderToIndexDoc(folder, fmt.Sprintf("folder/%d/%s", folder.ID, folder.Name))
+// subtreeCrawlReporter 在子树爬取进入新目录和写完每页后回调，参数为当前目录、已访问目录数与已写入文档数。
+type subtreeCrawlReporter func(currentFolderID int64, foldersVisited int64, written int64)
+
+// rebuildNestedFolderSubtree 重新爬取 folder 子树并写入索引，返回写入的文档数（含 folder 自身）。
+// report 可以为空。
+func (m *SyncManager) rebuildNestedFolderSubtree(ctx context.Context, api npan.API, folder models.NpanFolder, limiter *indexer.RequestLimiter, paths *indexer.FolderPathResolver, report subtreeCrawlReporter) (int64, error) {
+	if report == nil {
+		report = func(int64, int64, int64) {}
+	}
+	parentPath, err := paths.Resolve(ctx, folder.ParentID)
+	if err != nil {
+		return 0, fmt.Errorf("resolve path for subtree root folder %d: %w", folder.ID, err)
+	}
+	rootDoc := indexer.FolderDoc(parentPath, folder)
 	if err := indexer.WithRetryVoid(ctx, func() error {
 		return m.index.UpsertDocuments(ctx, []models.IndexDocument{rootDoc})
 	}, m.retry); err != nil {
-		return fmt.Errorf("upsert subtree root folder %d: %w", folder.ID, err)
+		return 0, fmt.Errorf("upsert subtree root folder %d: %w", folder.ID, err)
 	}
+	written := int64(1)
+	var foldersVisited int64
 
+	folderPaths := map[int64]models.FolderPath{folder.ID: indexer.ChildFolderPath(parentPath, folder)}
 	queue := []int64{folder.ID}
 	for len(queue) > 0 {
 		currentFolderID := queue[0]
 		queue = queue[1:]
+		currentPath := folderPaths[currentFolderID]
+		delete(folderPaths, currentFolderID)
+		foldersVisited++
+		report(currentFolderID, foldersVisited, written)
 
 		var pageID int64
 		for {
@@ -289,24 +313,27 @@ func (m *SyncManager) rebuildNestedFolderSubtree(ctx context.Context, api npan.A
 				return page, err
 			}, m.retry)
 			if err != nil {
-				return fmt.Errorf("list children for repair folder %d page %d: %w", currentFolderID, pageID, err)
+				return written, fmt.Errorf("list children for repair folder %d page %d: %w", currentFolderID, pageID, err)
 			}
 
 			docs := make([]models.IndexDocument, 0, len(page.Folders)+len(page.Files))
 			for _, childFolder := range page.Folders {
 				queue = append(queue, childFolder.ID)
-				docs = append(docs, search.MapFolderToIndexDoc(childFolder, fmt.Sprintf("folder/%d/%s", childFolder.ID, childFolder.Name)))
+				folderPaths[childFolder.ID] = indexer.ChildFolderPath(currentPath, childFolder)
+				docs = append(docs, indexer.FolderDoc(currentPath, childFolder))
 			}
 			for _, file := range page.Files {
-				docs = append(docs, search.MapFileToIndexDoc(file, fmt.Sprintf("file/%d/%s", file.ID, file.Name)))
+				docs = append(docs, indexer.FileDoc(currentPath, file))
 			}
 
 			if len(docs) > 0 {
 				if err := indexer.WithRetryVoid(ctx, func() error {
 					return m.index.UpsertDocuments(ctx, docs)
 				}, m.retry); err != nil {
-					return fmt.Errorf("upsert subtree docs for folder %d page %d: %w", currentFolderID, pageID, err)
+					return written, fmt.Errorf("upsert subtree docs for folder %d page %d: %w", currentFolderID, pageID, err)
 				}
+				written += int64(len(docs))
+				report(currentFolderID, foldersVisited, written)
 			}
 
 			pageCount := page.PageCount
@@ -320,7 +347,7 @@ func (m *SyncManager) rebuildNestedFolderSubtree(ctx context.Context, api npan.A
 		}
 	}
 
-	return nil
+	return written, nil
 }
 
 func refreshRootProgressFromSnapshot(progress *models.SyncProgressState, rootID int64, expectedDocs int64, snapshot *indexedTreeSnapshot) {
@@ -408,6 +435,7 @@ func (m *SyncManager) runIncrementalRepairs(ctx context.Context, api npan.API, p
 	}
 
 	progressMu := &sync.Mutex{}
+	paths := m.newFolderPathResolver(api, limiter, progress.RootNames)
 	for _, rootID := range progress.Roots {
 		rootRepairFailed := false
 		rootInfo, err := m.fetchFolderInfo(ctx, api, rootID, limiter)
@@ -482,7 +510,7 @@ func (m *SyncManager) runIncrementalRepairs(ctx context.Context, api npan.API, p
 					rootRepairFailed = true
 					continue
 				}
-				if err := m.runSingleRoot(ctx, api, progress, progressMu, rootID, checkpointFile, progressEvery, limiter, true); err != nil {
+				if err := m.runSingleRoot(ctx, api, m.index, progress, progressMu, rootID, checkpointFile, progressEvery, limiter, indexer.NewCrawlWorkerPool(m.folderWorkers(request)), true); err != nil {
 					slog.Warn("根目录补偿失败，跳过当前根目录补偿", "root_id", rootID, "error", err)
 					progressMu.Lock()
 					markRepairRootError(progress, rootID, fmt.Sprintf("repair skipped: %v", err))
@@ -494,7 +522,7 @@ func (m *SyncManager) runIncrementalRepairs(ctx context.Context, api npan.API, p
 				continue
 			}
 
-			if err := m.rebuildNestedFolderSubtree(ctx, api, target.folder, limiter); err != nil {
+			if _, err := m.rebuildNestedFolderSubtree(ctx, api, target.folder, limiter, paths, nil); err != nil {
 				slog.Warn("嵌套目录补偿失败，跳过当前根目录补偿", "root_id", rootID, "folder_id", target.folder.ID, "error", err)
 				progressMu.Lock()
 				markRepairRootError(progress, rootID, fmt.Sprintf("repair skipped: %v", err))
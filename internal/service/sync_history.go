@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"npan/internal/models"
+	"npan/internal/storage"
+)
+
+var (
+	ErrSyncHistoryDisabled = errors.New("同步历史未启用")
+	ErrSyncRunNotFound     = errors.New("同步运行记录不存在")
+)
+
+// beginSyncRun 在同步开始时写入一条 running 记录。写入失败只记录日志，不影响同步本身。
+func (m *SyncManager) beginSyncRun(mode models.SyncMode, request SyncStartRequest) *models.SyncRun {
+	if m.runStore == nil {
+		return nil
+	}
+
+	run := &models.SyncRun{
+		Mode:      mode,
+		Status:    "running",
+		Roots:     append([]int64{}, request.RootFolderIDs...),
+		StartedAt: time.Now().UnixMilli(),
+	}
+	if err := m.runStore.Create(run); err != nil {
+		slog.Warn("写入同步运行记录失败", "error", err)
+		return nil
+	}
+
+	m.mu.Lock()
+	m.currentRunID = run.ID
+	m.mu.Unlock()
+	return run
+}
+
+// syncRunOutcome 用本次运行最终保存的进度得出运行结果。run 在保存进度之前就失败时
+// （例如未发现根目录），进度仍是上一次运行的，此时只记录错误。
+func (m *SyncManager) syncRunOutcome(ctx context.Context, mode models.SyncMode, request SyncStartRequest, startedAt int64, runErr error) models.SyncRun {
+	outcome := models.SyncRun{
+		Mode:      mode,
+		Status:    "running",
+		Roots:     append([]int64{}, request.RootFolderIDs...),
+		StartedAt: startedAt,
+		EndedAt:   time.Now().UnixMilli(),
+	}
+	progress, err := m.progressStore.Load()
+	if err != nil {
+		slog.Warn("读取同步进度失败，运行记录缺少统计", "error", err)
+	}
+	if progress != nil && progress.UpdatedAt >= startedAt {
+		outcome.Status = progress.Status
+		outcome.Error = progress.LastError
+		outcome.Verification = progress.Verification
+		if mode == models.SyncModeIncremental {
+			outcome.IncrementalStats = progress.IncrementalStats
+		} else {
+			outcome.Roots = append([]int64{}, progress.Roots...)
+			outcome.Stats = progress.AggregateStats
+		}
+	}
+
+	if runErr != nil && outcome.Status != "error" && outcome.Status != "cancelled" {
+		outcome.Status = "error"
+		if ctx.Err() != nil {
+			outcome.Status = "cancelled"
+		}
+		outcome.Error = runErr.Error()
+	}
+	if outcome.Status == "running" {
+		outcome.Status = "done"
+	}
+	return outcome
+}
+
+// finishSyncRun 把运行结果写回 beginSyncRun 创建的记录。
+func (m *SyncManager) finishSyncRun(run *models.SyncRun, outcome models.SyncRun) {
+	if run == nil {
+		return
+	}
+
+	outcome.ID = run.ID
+	outcome.StartedAt = run.StartedAt
+	if err := m.runStore.Update(&outcome); err != nil {
+		slog.Warn("更新同步运行记录失败", "run_id", run.ID, "error", err)
+	}
+}
+
+// ListSyncRuns 按时间倒序返回同步运行记录。
+func (m *SyncManager) ListSyncRuns(filter storage.SyncRunFilter) ([]models.SyncRun, error) {
+	if m.runStore == nil {
+		return nil, ErrSyncHistoryDisabled
+	}
+	runs, err := m.runStore.List(filter)
+	if err != nil {
+		return nil, err
+	}
+	for i := range runs {
+		m.markInterruptedRun(&runs[i])
+	}
+	return runs, nil
+}
+
+func (m *SyncManager) GetSyncRun(id int64) (*models.SyncRun, error) {
+	if m.runStore == nil {
+		return nil, ErrSyncHistoryDisabled
+	}
+	run, err := m.runStore.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, ErrSyncRunNotFound
+	}
+	m.markInterruptedRun(run)
+	return run, nil
+}
+
+// markInterruptedRun 与 GetProgress 一致：记录仍为 running 但本进程并未在执行它时，
+// 说明进程在运行中途退出，仅在返回结果中改为 interrupted。
+func (m *SyncManager) markInterruptedRun(run *models.SyncRun) {
+	if run.Status != "running" {
+		return
+	}
+	m.mu.Lock()
+	current := m.running && m.currentRunID == run.ID
+	m.mu.Unlock()
+	if !current {
+		run.Status = "interrupted"
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"npan/internal/models"
+	"npan/internal/storage"
+)
+
+func waitSyncStopped(t *testing.T, mgr *SyncManager) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for mgr.IsRunning() {
+		if time.Now().After(deadline) {
+			t.Fatal("sync did not finish in time")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestSyncManager_RecordsRunHistory(t *testing.T) {
+	t.Parallel()
+
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "sync-state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer stores.DB.Close()
+
+	_, api := staleSweepFixture()
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(nil))
+	mgr.runStore = stores.SyncRunStore
+
+	disabled := false
+	if err := mgr.Start(api, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("Start full returned error: %v", err)
+	}
+	waitSyncStopped(t, mgr)
+
+	// 增量同步需要游标，先清掉全量写入的游标，让它在保存进度前失败。
+	mgr.syncStateStore = storage.NewJSONSyncStateStore(filepath.Join(t.TempDir(), "missing.json"))
+	if err := mgr.Start(api, SyncStartRequest{Mode: models.SyncModeIncremental}); err != nil {
+		t.Fatalf("Start incremental returned error: %v", err)
+	}
+	waitSyncStopped(t, mgr)
+
+	if err := stores.SyncRunStore.Create(&models.SyncRun{Mode: models.SyncModeFull, Status: "running", StartedAt: 1}); err != nil {
+		t.Fatalf("seed orphan run failed: %v", err)
+	}
+
+	runs, err := mgr.ListSyncRuns(storage.SyncRunFilter{})
+	if err != nil {
+		t.Fatalf("ListSyncRuns returned error: %v", err)
+	}
+	if len(runs) != 3 {
+		t.Fatalf("expected 3 runs, got %#v", runs)
+	}
+
+	if runs[0].Status != "interrupted" {
+		t.Fatalf("expected orphaned running record to be reported as interrupted, got %q", runs[0].Status)
+	}
+
+	incremental := runs[1]
+	if incremental.Mode != models.SyncModeIncremental || incremental.Status != "error" || incremental.Error == "" || incremental.EndedAt == 0 {
+		t.Fatalf("expected failed incremental run, got %#v", incremental)
+	}
+	if incremental.IncrementalStats != nil {
+		t.Fatalf("expected no stats for a run that never saved progress, got %#v", incremental.IncrementalStats)
+	}
+
+	full := runs[2]
+	if full.Mode != models.SyncModeFull || full.Status != "done" || full.EndedAt < full.StartedAt {
+		t.Fatalf("unexpected full run: %#v", full)
+	}
+	if len(full.Roots) != 1 || full.Roots[0] != 100 || full.Stats.FilesIndexed != 1 || full.Verification == nil {
+		t.Fatalf("expected full run to carry roots, stats and verification, got %#v", full)
+	}
+
+	got, err := mgr.GetSyncRun(full.ID)
+	if err != nil || got.ID != full.ID {
+		t.Fatalf("GetSyncRun returned %#v, %v", got, err)
+	}
+	if _, err := mgr.GetSyncRun(9999); err != ErrSyncRunNotFound {
+		t.Fatalf("expected ErrSyncRunNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"npan/internal/models"
+)
+
+var (
+	ErrSyncLeaseHeld     = errors.New("同步租约被其他进程持有")
+	ErrSyncLeaseDisabled = errors.New("同步租约存储未启用")
+)
+
+// defaultSyncLeaseTTL 是未配置时的租约有效期，心跳间隔为有效期的三分之一。
+const defaultSyncLeaseTTL = time.Minute
+
+// SyncLeaseHeldError 说明同步租约当前的持有者，errors.Is 可匹配 ErrSyncLeaseHeld。
+type SyncLeaseHeldError struct {
+	Lease models.SyncLease
+}
+
+func (e *SyncLeaseHeldError) Error() string {
+	return fmt.Sprintf(
+		"同步租约被 %s 持有（取得于 %s，最后心跳 %s，%s 过期）；确认该进程已退出后可强制释放租约",
+		e.Lease.Owner,
+		formatLeaseTime(e.Lease.AcquiredAt),
+		formatLeaseTime(e.Lease.HeartbeatAt),
+		formatLeaseTime(e.Lease.ExpiresAt),
+	)
+}
+
+func (e *SyncLeaseHeldError) Unwrap() error {
+	return ErrSyncLeaseHeld
+}
+
+func formatLeaseTime(ms int64) string {
+	return time.UnixMilli(ms).Format(time.DateTime)
+}
+
+// SyncLeaseOwner 返回租约持有者的描述，形如 "server@host pid 123"，用于提示是谁在同步。
+func SyncLeaseOwner(kind string) string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s@%s pid %d", kind, host, os.Getpid())
+}
+
+// newSyncLeaseOwnerID 生成持有者的唯一标识：同一主机上 pid 可能被复用，描述本身不足以区分进程。
+func newSyncLeaseOwnerID(owner string) string {
+	var buf [8]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return fmt.Sprintf("%s#%d", owner, time.Now().UnixNano())
+	}
+	return owner + "#" + hex.EncodeToString(buf[:])
+}
+
+// acquireSyncLease 为即将开始的同步取得租约，未配置租约存储时直接放行。调用方需持有 m.mu。
+// 同一进程内的目录重同步、对账等操作可与同步同时持有租约，按引用计数在最后一个释放时归还。
+func (m *SyncManager) acquireSyncLease() error {
+	if m.syncLeaseStore == nil {
+		return nil
+	}
+	// 已持有时仍向状态库确认：租约可能已被强制释放并由其他进程接管。
+	requestedAt := time.Now()
+	lease, acquired, err := m.syncLeaseStore.Acquire(m.syncLeaseKey, m.syncLeaseOwnerID, m.syncLeaseOwner, m.syncLeaseTTL)
+	if err != nil {
+		return fmt.Errorf("获取同步租约失败: %w", err)
+	}
+	if !acquired {
+		if lease == nil {
+			return ErrSyncLeaseHeld
+		}
+		return &SyncLeaseHeldError{Lease: *lease}
+	}
+	m.syncLeaseRefs++
+	m.syncLeaseExpiresAt = requestedAt.Add(m.syncLeaseTTL)
+	return nil
+}
+
+// releaseSyncLease 归还一次 acquireSyncLease 取得的租约，最后一个持有者退出时删除租约。
+func (m *SyncManager) releaseSyncLease() {
+	if m.syncLeaseStore == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.syncLeaseRefs--
+	if m.syncLeaseRefs > 0 {
+		return
+	}
+	m.syncLeaseRefs = 0
+	if err := m.syncLeaseStore.Release(m.syncLeaseKey, m.syncLeaseOwnerID); err != nil {
+		slog.Warn("释放同步租约失败", "key", m.syncLeaseKey, "error", err)
+	}
+}
+
+// keepSyncLease 在同步期间定期续约，租约被强制释放、被接管或续约失败直至过期时取消同步，
+// 避免两个进程同时写断点。返回的函数停止心跳并归还租约。
+func (m *SyncManager) keepSyncLease(ctx context.Context, cancel context.CancelFunc) func() {
+	if m.syncLeaseStore == nil {
+		return func() {}
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(m.syncLeaseTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			requestedAt := time.Now()
+			renewed, err := m.syncLeaseStore.Renew(m.syncLeaseKey, m.syncLeaseOwnerID, m.syncLeaseTTL)
+			if err != nil {
+				// 状态库暂时不可用时继续尝试，但租约过期后其他进程可以接管，必须停止。
+				m.mu.Lock()
+				expiresAt := m.syncLeaseExpiresAt
+				m.mu.Unlock()
+				if !time.Now().Before(expiresAt) {
+					slog.Error("同步租约续约失败且已过期，停止同步", "key", m.syncLeaseKey, "error", err)
+					cancel()
+					return
+				}
+				slog.Warn("同步租约续约失败", "key", m.syncLeaseKey, "expires_at", expiresAt, "error", err)
+				continue
+			}
+			if !renewed {
+				slog.Error("同步租约已被释放或接管，停止同步", "key", m.syncLeaseKey, "owner", m.syncLeaseOwner)
+				cancel()
+				return
+			}
+			m.mu.Lock()
+			if expiresAt := requestedAt.Add(m.syncLeaseTTL); expiresAt.After(m.syncLeaseExpiresAt) {
+				m.syncLeaseExpiresAt = expiresAt
+			}
+			m.mu.Unlock()
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-done
+		m.releaseSyncLease()
+	}
+}
+
+// beginExclusiveOperation 为回滚、死信重放等同步执行且不能与同步并发的操作占用运行状态并取得租约。
+// 返回的 ctx 在租约丢失时取消，finish 归还租约与运行状态。
+func (m *SyncManager) beginExclusiveOperation(ctx context.Context) (context.Context, func(), error) {
+	m.mu.Lock()
+	if m.running {
+		m.mu.Unlock()
+		return nil, nil, ErrSyncInProgress
+	}
+	if err := m.acquireSyncLease(); err != nil {
+		m.mu.Unlock()
+		return nil, nil, err
+	}
+	m.running = true
+	m.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	releaseLease := m.keepSyncLease(ctx, cancel)
+	return ctx, func() {
+		releaseLease()
+		cancel()
+		m.mu.Lock()
+		m.running = false
+		m.mu.Unlock()
+	}, nil
+}
+
+// GetSyncLease 返回当前的同步租约，没有租约时返回 nil；返回的租约可能已过期。
+func (m *SyncManager) GetSyncLease() (*models.SyncLease, error) {
+	if m.syncLeaseStore == nil {
+		return nil, ErrSyncLeaseDisabled
+	}
+	return m.syncLeaseStore.Get(m.syncLeaseKey)
+}
+
+// ForceReleaseSyncLease 无视持有者释放同步租约，用于持有进程已崩溃但租约尚未过期的情况。
+// 若持有者仍在运行，它会在下一次心跳时发现租约丢失并停止同步。
+func (m *SyncManager) ForceReleaseSyncLease() (*models.SyncLease, error) {
+	if m.syncLeaseStore == nil {
+		return nil, ErrSyncLeaseDisabled
+	}
+	lease, err := m.syncLeaseStore.ForceRelease(m.syncLeaseKey)
+	if err != nil {
+		return nil, err
+	}
+	if lease != nil {
+		slog.Warn("同步租约已被强制释放", "key", m.syncLeaseKey, "owner", lease.Owner)
+	}
+	return lease, nil
+}
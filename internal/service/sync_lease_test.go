@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"npan/internal/models"
+	"npan/internal/storage"
+)
+
+func newTestLeasedSyncManager(t *testing.T, leases storage.SyncLeaseStore, owner string) *SyncManager {
+	t.Helper()
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(nil))
+	mgr.syncLeaseStore = leases
+	mgr.syncLeaseOwner = owner
+	mgr.syncLeaseOwnerID = newSyncLeaseOwnerID(owner)
+	mgr.syncLeaseTTL = 60 * time.Millisecond
+	return mgr
+}
+
+func TestSyncLease_RefusesSecondProcessUntilForceReleased(t *testing.T) {
+	t.Parallel()
+
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	t.Cleanup(func() { _ = stores.DB.Close() })
+
+	server := newTestLeasedSyncManager(t, stores.SyncLeaseStore, "server@a pid 1")
+	cli := newTestLeasedSyncManager(t, stores.SyncLeaseStore, "cli@b pid 2")
+
+	blocking := &mockAPIForRouting{
+		listFolderChildrenFn: func(ctx context.Context, _ int64, _ int64) (models.FolderChildrenPage, error) {
+			<-ctx.Done()
+			return models.FolderChildrenPage{}, ctx.Err()
+		},
+	}
+	disabled := false
+	request := SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}
+	if err := server.Start(blocking, request); err != nil {
+		t.Fatalf("server Start returned error: %v", err)
+	}
+
+	// 持有者的心跳周期远小于总等待时间，租约在整个过程中都不应过期。
+	time.Sleep(100 * time.Millisecond)
+	err = cli.Start(blocking, request)
+	var held *SyncLeaseHeldError
+	if !errors.Is(err, ErrSyncLeaseHeld) || !errors.As(err, &held) {
+		t.Fatalf("expected ErrSyncLeaseHeld, got %v", err)
+	}
+	if held.Lease.Owner != "server@a pid 1" || !strings.Contains(err.Error(), "server@a pid 1") {
+		t.Fatalf("expected refusal to name the holder, got %v", err)
+	}
+	if cli.IsRunning() {
+		t.Fatal("expected refused manager to stay idle")
+	}
+
+	dryRun := request
+	dryRun.DryRun = true
+	if err := cli.Start(&mockAPIForRouting{}, dryRun); err != nil {
+		t.Fatalf("expected dry run to skip the lease, got %v", err)
+	}
+	waitFor(t, func() bool { return !cli.IsRunning() }, "dry run to finish")
+
+	released, err := cli.ForceReleaseSyncLease()
+	if err != nil || released == nil || released.Owner != "server@a pid 1" {
+		t.Fatalf("expected force release to return server lease, got %#v %v", released, err)
+	}
+	waitFor(t, func() bool { return !server.IsRunning() }, "server sync to stop after losing the lease")
+
+	if err := cli.Start(&mockAPIForRouting{}, request); err != nil {
+		t.Fatalf("cli Start after force release returned error: %v", err)
+	}
+	waitFor(t, func() bool { return !cli.IsRunning() }, "cli sync to finish")
+	if lease, err := cli.GetSyncLease(); err != nil || lease != nil {
+		t.Fatalf("expected lease to be released after sync, got %#v %v", lease, err)
+	}
+}
+
+// unreachableLeaseStore 模拟取得租约后状态库不可用，续约始终报错。
+type unreachableLeaseStore struct {
+	storage.SyncLeaseStore
+	renewals int
+}
+
+func (s *unreachableLeaseStore) Acquire(key string, ownerID string, owner string, ttl time.Duration) (*models.SyncLease, bool, error) {
+	now := time.Now().UnixMilli()
+	return &models.SyncLease{Key: key, OwnerID: ownerID, Owner: owner, AcquiredAt: now, HeartbeatAt: now, ExpiresAt: now + ttl.Milliseconds()}, true, nil
+}
+
+func (s *unreachableLeaseStore) Renew(string, string, time.Duration) (bool, error) {
+	s.renewals++
+	return false, errors.New("database is locked")
+}
+
+func (s *unreachableLeaseStore) Release(string, string) error {
+	return nil
+}
+
+func TestKeepSyncLease_CancelsOnceLeaseExpiresWithoutRenewal(t *testing.T) {
+	t.Parallel()
+
+	store := &unreachableLeaseStore{}
+	mgr := newTestLeasedSyncManager(t, store, "server@a pid 1")
+	mgr.mu.Lock()
+	err := mgr.acquireSyncLease()
+	mgr.mu.Unlock()
+	if err != nil {
+		t.Fatalf("acquireSyncLease returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	startedAt := time.Now()
+	release := mgr.keepSyncLease(ctx, cancel)
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected sync to be cancelled after the lease expired")
+	}
+	release()
+	if elapsed := time.Since(startedAt); elapsed < mgr.syncLeaseTTL/2 {
+		t.Fatalf("expected transient renew errors to be tolerated until expiry, cancelled after %v", elapsed)
+	}
+	if store.renewals < 2 {
+		t.Fatalf("expected renewals to be retried before giving up, got %d", store.renewals)
+	}
+}
+
+func TestSyncLease_SharedByOperationsInTheSameProcess(t *testing.T) {
+	t.Parallel()
+
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	t.Cleanup(func() { _ = stores.DB.Close() })
+
+	server := newTestLeasedSyncManager(t, stores.SyncLeaseStore, "server@a pid 1")
+	cli := newTestLeasedSyncManager(t, stores.SyncLeaseStore, "cli@b pid 2")
+	cli.index = &blueGreenIndexStub{inMemoryIndexStub: newInMemoryIndexStub(nil)}
+
+	if err := server.syncStateStore.Save(&models.SyncState{LastSyncTime: 1700000000}); err != nil {
+		t.Fatalf("save sync state: %v", err)
+	}
+	release := make(chan struct{})
+	blocking := &mockAPI{
+		searchUpdatedWindowFn: func(ctx context.Context, _ string, _ *int64, _ *int64, _ int64) (map[string]any, error) {
+			select {
+			case <-release:
+				return makeOnePage(nil, nil), nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		},
+	}
+	if err := server.Start(blocking, SyncStartRequest{Mode: models.SyncModeIncremental}); err != nil {
+		t.Fatalf("server Start returned error: %v", err)
+	}
+
+	// 同一进程的目录重同步与增量同步共享租约，先结束的一方不能把租约释放掉。
+	if err := server.StartFolderResync(&mockAPIForRouting{}, FolderResyncRequest{FolderID: 200, Mode: models.FolderResyncModeMerge}); err != nil {
+		t.Fatalf("StartFolderResync returned error: %v", err)
+	}
+	waitFor(t, func() bool {
+		progress := server.GetFolderResyncProgress()
+		return progress != nil && progress.Status != "running"
+	}, "folder resync to finish")
+	if lease, err := server.GetSyncLease(); err != nil || lease == nil {
+		t.Fatalf("expected running sync to keep the lease, got %#v %v", lease, err)
+	}
+
+	// 其他进程的回滚与目录重同步都要先取得租约。
+	if _, err := cli.RollbackRebuild(context.Background()); !errors.Is(err, ErrSyncLeaseHeld) {
+		t.Fatalf("expected rollback to be refused while another process holds the lease, got %v", err)
+	}
+	if err := cli.StartFolderResync(&mockAPIForRouting{}, FolderResyncRequest{FolderID: 200, Mode: models.FolderResyncModeMerge}); !errors.Is(err, ErrSyncLeaseHeld) {
+		t.Fatalf("expected folder resync to be refused while another process holds the lease, got %v", err)
+	}
+
+	close(release)
+	waitFor(t, func() bool { return !server.IsRunning() }, "server sync to finish")
+	if lease, err := server.GetSyncLease(); err != nil || lease != nil {
+		t.Fatalf("expected the last holder to release the lease, got %#v %v", lease, err)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"npan/internal/indexer"
 	"npan/internal/metrics"
 	"npan/internal/models"
+	"npan/internal/notify"
 	"npan/internal/npan"
 	"npan/internal/search"
 	"npan/internal/storage"
@@ -26,11 +27,14 @@ type SyncStartRequest struct {
 	DepartmentIDs       []int64         `json:"department_ids"`
 	ResumeProgress      *bool           `json:"resume_progress"`
 	ForceRebuild        *bool           `json:"force_rebuild"`
+	ShadowRebuild       *bool           `json:"shadow_rebuild"`
 	RootWorkers         int             `json:"root_workers"`
+	FolderWorkers       int             `json:"folder_workers"`
 	ProgressEvery       int             `json:"progress_every"`
 	CheckpointTemplate  string          `json:"checkpoint_template"`
 	WindowOverlapMS     int64           `json:"window_overlap_ms"`
 	IncrementalQuery    string          `json:"incremental_query"`
+	DryRun              bool            `json:"dry_run"`
 }
 
 type SyncManager struct {
@@ -43,6 +47,8 @@ type SyncManager struct {
 
 	defaultCheckpointTemplate string
 	defaultRootWorkers        int
+	defaultFolderWorkers      int
+	indexBatch                indexer.BatchWriterOptions
 	defaultProgressEvery      int
 	retry                     models.RetryPolicyOptions
 	maxConcurrent             int
@@ -52,10 +58,59 @@ type SyncManager struct {
 	defaultIncrementalQuery string
 	defaultWindowOverlapMS  int64
 	metricsReporter         metrics.SyncReporter
+	runStore                storage.SyncRunStore
+	deadLetterStore         storage.DeadLetterStore
+	pathRewriteMaxFolders   int
+	notifier                notify.Notifier
+	// tenantID 标记通知事件所属的租户，单租户时为空。
+	tenantID string
+
+	indexChangeStore     storage.IndexChangeStore
+	indexChangeRetention time.Duration
+
+	circuitBreaker *npan.CircuitBreaker
+
+	// incrementalWindowMaxPages / incrementalWindowMaxItems 是增量窗口二分的阈值，0 表示只在截断时二分。
+	incrementalWindowMaxPages int64
+	incrementalWindowMaxItems int64
+
+	// pauseGate 挂在每次同步的限速器上，PauseSync 关闭它让同步停在请求前。
+	pauseGate *indexer.PauseGate
 
 	mu      sync.Mutex
 	running bool
 	cancel  context.CancelFunc
+	// dryRunning 表示当前任务是演练，演练的状态只写在进度的 DryRun 字段，由 mu 保护。
+	dryRunning bool
+	// fullSyncRunning 表示当前任务是写索引的全量同步，此时不允许目录重同步，由 mu 保护。
+	fullSyncRunning bool
+	// currentRunID 是本进程正在执行的运行记录 ID，由 mu 保护。
+	currentRunID int64
+	// pauseFlushers 是暂停时需要立即写入缓冲文档的写入器，由 mu 保护。
+	pauseFlushers    map[int64]func()
+	nextPauseFlusher int64
+
+	// folderResync 是最近一次目录重同步的进度，与 running 相互独立，由 mu 保护。
+	folderResync        *models.FolderResyncProgress
+	folderResyncRunning bool
+	folderResyncCancel  context.CancelFunc
+
+	reconciliationStore storage.ReconciliationStore
+	// reconciling 表示本进程正在执行对账，reconciliationCancel 取消这次对账，均由 mu 保护。
+	reconciling          bool
+	reconciliationCancel context.CancelFunc
+
+	// syncLeaseStore 非空时，同步需要先取得状态库中 syncLeaseKey 的租约，防止多个进程同时同步同一份索引。
+	syncLeaseStore   storage.SyncLeaseStore
+	syncLeaseKey     string
+	syncLeaseOwner   string
+	syncLeaseOwnerID string
+	syncLeaseTTL     time.Duration
+
+	// syncLeaseRefs 是本进程内持有租约的操作数，syncLeaseExpiresAt 是最近一次取得或续约后租约的过期时间，
+	// 均由 mu 保护。
+	syncLeaseRefs      int
+	syncLeaseExpiresAt time.Time
 }
 
 type SyncManagerArgs struct {
@@ -67,6 +122,10 @@ type SyncManagerArgs struct {
 	MeiliIndex         string
 	CheckpointTemplate string
 	RootWorkers        int
+	FolderWorkers      int
+	IndexBatchDocs     int
+	IndexBatchBytes    int
+	IndexMaxInFlight   int
 	ProgressEvery      int
 	Retry              models.RetryPolicyOptions
 	MaxConcurrent      int
@@ -75,10 +134,37 @@ type SyncManagerArgs struct {
 	IncrementalQuery   string
 	WindowOverlapMS    int64
 	MetricsReporter    metrics.SyncReporter
+	RunStore           storage.SyncRunStore
+	DeadLetterStore    storage.DeadLetterStore
+	PathRewriteLimit   int
+	Notifier           notify.Notifier
+	// TenantID 是同步管理器所属的租户，写入通知事件；单租户时为空。
+	TenantID string
+
+	// IndexChangeStore 非空时，对线上索引的写入会追加到变更日志，超过 IndexChangeRetention 的记录在每次同步结束后清理。
+	IndexChangeStore     storage.IndexChangeStore
+	IndexChangeRetention time.Duration
+
+	// CircuitBreaker 非空时，同步请求经过熔断器；熔断期间同步暂停等待上游恢复而不是失败。
+	CircuitBreaker *npan.CircuitBreaker
+
+	// ReconciliationStore 为空时不能执行逐目录对账。
+	ReconciliationStore storage.ReconciliationStore
+
+	// IncrementalWindowMaxPages / IncrementalWindowMaxItems 大于 0 时，增量窗口的页数或条目数超过阈值就二分时间范围。
+	IncrementalWindowMaxPages int64
+	IncrementalWindowMaxItems int64
+
+	// SyncLeaseStore 非空时启用跨进程同步租约；SyncLeaseKey 通常取 storage.SyncLeaseKey(租户 ID)，
+	// SyncLeaseOwner 是展示给其他进程的持有者描述，SyncLeaseTTL 为 0 时使用默认有效期。
+	SyncLeaseStore storage.SyncLeaseStore
+	SyncLeaseKey   string
+	SyncLeaseOwner string
+	SyncLeaseTTL   time.Duration
 }
 
 func NewSyncManager(args SyncManagerArgs) *SyncManager {
-	return &SyncManager{
+	m := &SyncManager{
 		index:                     args.Index,
 		progressStore:             args.ProgressStore,
 		syncStateStore:            args.SyncStateStore,
@@ -87,6 +173,7 @@ func NewSyncManager(args SyncManagerArgs) *SyncManager {
 		meiliIndex:                args.MeiliIndex,
 		defaultCheckpointTemplate: args.CheckpointTemplate,
 		defaultRootWorkers:        args.RootWorkers,
+		defaultFolderWorkers:      args.FolderWorkers,
 		defaultProgressEvery:      args.ProgressEvery,
 		retry:                     args.Retry,
 		maxConcurrent:             args.MaxConcurrent,
@@ -95,7 +182,48 @@ func NewSyncManager(args SyncManagerArgs) *SyncManager {
 		defaultIncrementalQuery:   args.IncrementalQuery,
 		defaultWindowOverlapMS:    args.WindowOverlapMS,
 		metricsReporter:           args.MetricsReporter,
+		runStore:                  args.RunStore,
+		deadLetterStore:           args.DeadLetterStore,
+		pathRewriteMaxFolders:     args.PathRewriteLimit,
+		notifier:                  args.Notifier,
+		tenantID:                  args.TenantID,
+		indexBatch: indexer.BatchWriterOptions{
+			MaxDocs:     args.IndexBatchDocs,
+			MaxBytes:    args.IndexBatchBytes,
+			MaxInFlight: args.IndexMaxInFlight,
+			Retry:       args.Retry,
+		},
+		indexChangeStore:     args.IndexChangeStore,
+		indexChangeRetention: args.IndexChangeRetention,
+
+		circuitBreaker: args.CircuitBreaker,
+
+		pauseGate: indexer.NewPauseGate(),
+
+		reconciliationStore: args.ReconciliationStore,
+
+		incrementalWindowMaxPages: args.IncrementalWindowMaxPages,
+		incrementalWindowMaxItems: args.IncrementalWindowMaxItems,
+
+		syncLeaseStore: args.SyncLeaseStore,
+		syncLeaseKey:   args.SyncLeaseKey,
+		syncLeaseOwner: args.SyncLeaseOwner,
+		syncLeaseTTL:   args.SyncLeaseTTL,
+	}
+	if m.syncLeaseKey == "" {
+		m.syncLeaseKey = storage.SyncLeaseKey("")
+	}
+	if m.syncLeaseOwner == "" {
+		m.syncLeaseOwner = SyncLeaseOwner("npan")
 	}
+	if m.syncLeaseTTL <= 0 {
+		m.syncLeaseTTL = defaultSyncLeaseTTL
+	}
+	m.syncLeaseOwnerID = newSyncLeaseOwnerID(m.syncLeaseOwner)
+	if args.IndexChangeStore != nil && args.Index != nil {
+		m.index = &changeLogIndex{IndexOperator: args.Index, manager: m}
+	}
+	return m
 }
 
 func (m *SyncManager) IsRunning() bool {
@@ -162,10 +290,23 @@ func (m *SyncManager) GetProgress() (*models.SyncProgressState, error) {
 			slog.Warn("保存进度失败", "error", err)
 		}
 	}
+	if progress.DryRun != nil && progress.DryRun.Status == "running" && !isRunning {
+		progress.DryRun.Status = "interrupted"
+		progress.DryRun.LastError = "进程重启，演练中断"
+		progress.DryRun.ActiveRoot = nil
+		if err := m.progressStore.Save(progress); err != nil {
+			slog.Warn("保存进度失败", "error", err)
+		}
+	}
+
+	if isRunning && m.applyPauseState(progress) {
+		return progress, nil
+	}
 
 	// Goroutine is running but hasn't overwritten old progress yet.
 	// Override status in-memory only (don't write to store — goroutine will save real progress).
-	if isRunning && progress.Status != "running" {
+	// 演练不改写顶层状态，保留上一次真实同步的结果。
+	if isRunning && !m.isDryRunning() && progress.Status != "running" {
 		progress.Status = "running"
 		progress.LastError = ""
 	}
@@ -173,6 +314,12 @@ func (m *SyncManager) GetProgress() (*models.SyncProgressState, error) {
 	return progress, nil
 }
 
+func (m *SyncManager) isDryRunning() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.running && m.dryRunning
+}
+
 func (m *SyncManager) Cancel() bool {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -196,19 +343,46 @@ func (m *SyncManager) Start(api npan.API, request SyncStartRequest) error {
 	if err != nil {
 		return err
 	}
+	if err := m.validateShadowRebuild(request, effectiveMode); err != nil {
+		return err
+	}
+	if err := validateDryRun(request); err != nil {
+		return err
+	}
+	api = m.circuitBreaker.Wrap(api)
 
 	m.mu.Lock()
 	if m.running {
 		m.mu.Unlock()
 		return fmt.Errorf("已有全量同步任务在运行")
 	}
+	// 对账期间写索引会让对账报告出现虚假差异；演练不写索引，不受限制。
+	if m.reconciling && !request.DryRun {
+		m.mu.Unlock()
+		return ErrReconciliationRunning
+	}
+	// 目录重同步写入的文档没有同步代次，全量同步结束时的过期清理会把它们删掉。
+	fullSync := effectiveMode == models.SyncModeFull && !request.DryRun
+	if fullSync && m.folderResyncRunning {
+		m.mu.Unlock()
+		return ErrFolderResyncRunning
+	}
+	// 演练不写索引与断点，不需要租约。
+	if !request.DryRun {
+		if err := m.acquireSyncLease(); err != nil {
+			m.mu.Unlock()
+			return err
+		}
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	m.running = true
+	m.dryRunning = request.DryRun
+	m.fullSyncRunning = fullSync
 	m.cancel = cancel
 	m.mu.Unlock()
 
-	if m.metricsReporter != nil {
+	if m.metricsReporter != nil && !request.DryRun {
 		m.metricsReporter.ReportSyncStarted(effectiveMode)
 	}
 
@@ -216,11 +390,32 @@ func (m *SyncManager) Start(api npan.API, request SyncStartRequest) error {
 		defer func() {
 			m.mu.Lock()
 			m.running = false
+			m.dryRunning = false
+			m.fullSyncRunning = false
 			m.cancel = nil
+			m.currentRunID = 0
+			m.pauseGate.Resume()
 			m.mu.Unlock()
 		}()
 
-		_ = m.run(ctx, api, request)
+		if request.DryRun {
+			if err := m.runDryRun(ctx, api, request, effectiveMode); err != nil {
+				slog.Warn("演练同步失败", "error", err)
+			}
+			return
+		}
+
+		releaseLease := m.keepSyncLease(ctx, cancel)
+		defer releaseLease()
+
+		startedAt := time.Now().UnixMilli()
+		run := m.beginSyncRun(effectiveMode, request)
+		m.notifySyncStarted(effectiveMode, run)
+		err := m.run(ctx, api, request)
+		outcome := m.syncRunOutcome(ctx, effectiveMode, request, startedAt, err)
+		m.finishSyncRun(run, outcome)
+		m.notifySyncFinished(run, outcome)
+		m.pruneIndexChanges()
 	}()
 
 	return nil
@@ -390,7 +585,8 @@ func resolveMode(mode models.SyncMode) (models.SyncMode, error) {
 	}
 }
 
-func (m *SyncManager) discoverRootFolders(ctx context.Context, api npan.API, request SyncStartRequest) ([]int64, map[int64]int64, map[int64]string, error) {
+// discoverRootFolders 的上游请求同样经过限速器，熔断期间等待恢复而不是降级或失败。
+func (m *SyncManager) discoverRootFolders(ctx context.Context, api npan.API, request SyncStartRequest, limiter *indexer.RequestLimiter) ([]int64, map[int64]int64, map[int64]string, error) {
 	roots := append([]int64{}, request.RootFolderIDs...)
 	rootEstimateMap := map[int64]int64{}
 	rootNameMap := map[int64]string{}
@@ -402,8 +598,11 @@ func (m *SyncManager) discoverRootFolders(ctx context.Context, api npan.API, req
 			continue
 		}
 
-		folder, err := api.GetFolderInfo(ctx, rootID)
+		folder, err := m.fetchFolderInfo(ctx, api, rootID, limiter)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, nil, nil, ctx.Err()
+			}
 			slog.Warn("获取根目录信息失败，降级继续", "root_id", rootID, "error", err)
 			continue
 		}
@@ -420,7 +619,9 @@ func (m *SyncManager) discoverRootFolders(ctx context.Context, api npan.API, req
 	if includeDepartments {
 		departmentIDs := append([]int64{}, request.DepartmentIDs...)
 		if len(departmentIDs) == 0 {
-			deps, err := api.ListUserDepartments(ctx)
+			deps, err := callUpstream(ctx, limiter, m.retry, func() ([]models.NpanDepartment, error) {
+				return api.ListUserDepartments(ctx)
+			})
 			if err != nil {
 				return nil, nil, nil, fmt.Errorf("list user departments: %w", err)
 			}
@@ -430,7 +631,9 @@ func (m *SyncManager) discoverRootFolders(ctx context.Context, api npan.API, req
 		}
 
 		for _, departmentID := range departmentIDs {
-			folders, err := api.ListDepartmentFolders(ctx, departmentID)
+			folders, err := callUpstream(ctx, limiter, m.retry, func() ([]models.NpanFolder, error) {
+				return api.ListDepartmentFolders(ctx, departmentID)
+			})
 			if err != nil {
 				return nil, nil, nil, fmt.Errorf("list department folders (dept %d): %w", departmentID, err)
 			}
@@ -504,25 +707,40 @@ func updateAggregateFromRoots(progress *models.SyncProgressState) {
 		StartedAt: progress.AggregateStats.StartedAt,
 		EndedAt:   time.Now().UnixMilli(),
 	}
+	staleRemoved := int64(0)
 
 	for _, rootID := range progress.Roots {
 		root := progress.RootProgress[fmt.Sprintf("%d", rootID)]
 		if root == nil {
 			continue
 		}
-		aggregate.FoldersVisited += root.Stats.FoldersVisited
-		aggregate.FilesIndexed += root.Stats.FilesIndexed
-		aggregate.PagesFetched += root.Stats.PagesFetched
-		aggregate.FailedRequests += root.Stats.FailedRequests
+		aggregate = addCrawlCounters(aggregate, root.Stats)
+		staleRemoved += root.StaleRemoved
 	}
 
 	progress.AggregateStats = aggregate
+	progress.StaleRemoved = staleRemoved
 	progress.UpdatedAt = time.Now().UnixMilli()
 }
 
+// addCrawlCounters 累加计数字段，时间字段沿用 base。
+func addCrawlCounters(base models.CrawlStats, delta models.CrawlStats) models.CrawlStats {
+	base.FoldersVisited += delta.FoldersVisited
+	base.FilesIndexed += delta.FilesIndexed
+	base.FilesDiscovered += delta.FilesDiscovered
+	base.SkippedFiles += delta.SkippedFiles
+	base.PagesFetched += delta.PagesFetched
+	base.FailedRequests += delta.FailedRequests
+	return base
+}
+
 func restoreProgress(existing *models.SyncProgressState, roots []int64, rootCheckpointMap map[int64]string, rootEstimateMap map[int64]int64, rootNameMap map[int64]string) *models.SyncProgressState {
 	now := time.Now().UnixMilli()
 	restored := *existing
+	// 上一次同步已完成时，本次新加入的根目录属于新的一轮同步，使用新代次。
+	if existing.Status == "done" {
+		restored.SyncGeneration = 0
+	}
 	restored.Status = "running"
 	restored.UpdatedAt = now
 	restored.ActiveRoot = nil
@@ -530,6 +748,8 @@ func restoreProgress(existing *models.SyncProgressState, roots []int64, rootChec
 	restored.Roots = append([]int64{}, roots...)
 	restored.RootNames = rootNameMap
 	restored.CompletedRoots = []int64{}
+	restored.DryRun = nil
+	restored.RateControl = nil
 	if restored.RootProgress == nil {
 		restored.RootProgress = map[string]*models.RootSyncProgress{}
 	}
@@ -576,7 +796,7 @@ func restoreProgress(existing *models.SyncProgressState, roots []int64, rootChec
 	return &restored
 }
 
-func (m *SyncManager) runSingleRoot(ctx context.Context, api npan.API, progress *models.SyncProgressState, progressMu *sync.Mutex, rootID int64, checkpointFile string, progressEvery int, limiter *indexer.RequestLimiter, resetStats bool) error {
+func (m *SyncManager) runSingleRoot(ctx context.Context, api npan.API, index search.IndexOperator, progress *models.SyncProgressState, progressMu *sync.Mutex, rootID int64, checkpointFile string, progressEvery int, limiter *indexer.RequestLimiter, workers *indexer.CrawlWorkerPool, resetStats bool) error {
 	key := fmt.Sprintf("%d", rootID)
 
 	progressMu.Lock()
@@ -587,6 +807,11 @@ func (m *SyncManager) runSingleRoot(ctx context.Context, api npan.API, progress
 	}
 
 	resumeBase := rp.Stats
+	rootName := ""
+	if rootID != 0 {
+		rootName = progress.RootNames[rootID]
+	}
+	paths := m.newFolderPathResolver(api, limiter, progress.RootNames)
 	rp.Status = "running"
 	rp.Error = ""
 	now := time.Now().UnixMilli()
@@ -596,10 +821,15 @@ func (m *SyncManager) runSingleRoot(ctx context.Context, api npan.API, progress
 			EndedAt:   now,
 		}
 		rp.Stats = resumeBase
+		rp.StaleRemoved = 0
 		progress.CompletedRoots = removeInt64(progress.CompletedRoots, rootID)
 	}
 	rp.UpdatedAt = now
 	progress.ActiveRoot = &rootID
+	generation := progress.SyncGeneration
+	if generation == 0 {
+		generation = now
+	}
 	updateAggregateFromRoots(progress)
 	if err := m.progressStore.Save(progress); err != nil {
 		progressMu.Unlock()
@@ -608,14 +838,26 @@ func (m *SyncManager) runSingleRoot(ctx context.Context, api npan.API, progress
 	progressMu.Unlock()
 
 	checkpointStore := m.effectiveCheckpointStoreFactory().ForKey(checkpointFile)
+	// 续爬沿用断点中的同步代次，断点之前写入的文档才不会被当作过期文档清理。
+	if checkpoint, loadErr := checkpointStore.Load(); loadErr == nil && checkpoint != nil && checkpoint.SyncGeneration > 0 {
+		generation = checkpoint.SyncGeneration
+	}
+
+	writer, releaseWriter := m.newPauseAwareWriter(ctx, indexer.NewBatchIndexWriter(&indexWriter{index: index}, m.indexBatch))
+	defer releaseWriter()
 
 	stats, err := indexer.RunFullCrawl(ctx, indexer.FullCrawlDeps{
 		API:             api,
-		IndexWriter:     &indexWriter{index: m.index},
+		IndexWriter:     writer,
 		Limiter:         limiter,
 		CheckpointStore: checkpointStore,
 		RootFolderID:    rootID,
+		RootName:        rootName,
+		PathResolver:    paths,
+		Workers:         workers,
+		SyncGeneration:  generation,
 		Retry:           m.retry,
+		OnWriteFailure:  m.deadLetterRecorder(rootID),
 		OnProgress: func(event indexer.ProgressEvent) {
 			if progressEvery > 1 && event.Stats.PagesFetched%int64(progressEvery) != 0 {
 				return
@@ -629,25 +871,25 @@ func (m *SyncManager) runSingleRoot(ctx context.Context, api npan.API, progress
 				return
 			}
 
-			root.Stats = models.CrawlStats{
-				FoldersVisited: resumeBase.FoldersVisited + event.Stats.FoldersVisited,
-				FilesIndexed:   resumeBase.FilesIndexed + event.Stats.FilesIndexed,
-				PagesFetched:   resumeBase.PagesFetched + event.Stats.PagesFetched,
-				FailedRequests: resumeBase.FailedRequests + event.Stats.FailedRequests,
-				StartedAt:      resumeBase.StartedAt,
-				EndedAt:        time.Now().UnixMilli(),
-			}
+			root.Stats = addCrawlCounters(resumeBase, event.Stats)
+			root.Stats.EndedAt = time.Now().UnixMilli()
 			root.CurrentFolderID = &event.CurrentFolderID
 			root.CurrentPageID = &event.CurrentPageID
 			root.CurrentPageCount = &event.CurrentPageCount
 			root.QueueLength = &event.QueueLength
 			root.UpdatedAt = time.Now().UnixMilli()
+			recordRateControl(progress, limiter)
 
 			updateAggregateFromRoots(progress)
 			_ = m.progressStore.Save(progress)
 		},
 	})
 
+	staleRemoved := int64(0)
+	if err == nil {
+		staleRemoved = m.sweepStaleDocuments(ctx, index, rootID, generation, addCrawlCounters(resumeBase, stats))
+	}
+
 	progressMu.Lock()
 	defer progressMu.Unlock()
 
@@ -682,14 +924,9 @@ func (m *SyncManager) runSingleRoot(ctx context.Context, api npan.API, progress
 
 	rp.Status = "done"
 	rp.Error = ""
-	rp.Stats = models.CrawlStats{
-		FoldersVisited: resumeBase.FoldersVisited + stats.FoldersVisited,
-		FilesIndexed:   resumeBase.FilesIndexed + stats.FilesIndexed,
-		PagesFetched:   resumeBase.PagesFetched + stats.PagesFetched,
-		FailedRequests: resumeBase.FailedRequests + stats.FailedRequests,
-		StartedAt:      resumeBase.StartedAt,
-		EndedAt:        stats.EndedAt,
-	}
+	rp.Stats = addCrawlCounters(resumeBase, stats)
+	rp.Stats.EndedAt = stats.EndedAt
+	rp.StaleRemoved = staleRemoved
 	rp.CurrentFolderID = nil
 	rp.CurrentPageID = nil
 	rp.CurrentPageCount = nil
@@ -705,25 +942,50 @@ func (m *SyncManager) runSingleRoot(ctx context.Context, api npan.API, progress
 	return m.progressStore.Save(progress)
 }
 
-func (m *SyncManager) runIncremental(ctx context.Context, api npan.API, progress *models.SyncProgressState, request SyncStartRequest, limiter *indexer.RequestLimiter) error {
-	query := request.IncrementalQuery
-	if query == "" {
-		query = m.defaultIncrementalQuery
+// sweepStaleDocuments 在根目录完整爬取后删除本代次未再出现的文档。
+// 有页面写入失败时这些文档未被刷新代次，清理会误删，因此跳过。
+func (m *SyncManager) sweepStaleDocuments(ctx context.Context, index search.IndexOperator, rootID int64, generation int64, stats models.CrawlStats) int64 {
+	if stats.FailedRequests > 0 || stats.SkippedFiles > 0 {
+		slog.Warn("根目录存在写入失败，跳过过期文档清理", "root_id", rootID, "failed_requests", stats.FailedRequests, "skipped_files", stats.SkippedFiles)
+		return 0
 	}
-	if query == "" {
-		query = "* OR *"
+	removed, err := index.DeleteStaleDocuments(ctx, rootID, generation)
+	if err != nil {
+		slog.Warn("清理过期文档失败", "root_id", rootID, "generation", generation, "error", err)
+		return 0
+	}
+	return removed
+}
+
+func (m *SyncManager) folderWorkers(request SyncStartRequest) int {
+	folderWorkers := request.FolderWorkers
+	if folderWorkers <= 0 {
+		folderWorkers = m.defaultFolderWorkers
+	}
+	if folderWorkers <= 0 {
+		folderWorkers = 1
+	}
+	return folderWorkers
+}
+
+// fetchIncrementalChanges 拉取 cursorBefore（秒，含重叠窗口）之后的全部变更，窗口过大时在内存中二分，不记录子窗口计划。
+func (m *SyncManager) fetchIncrementalChanges(ctx context.Context, api npan.API, request SyncStartRequest, cursorBefore int64, limiter *indexer.RequestLimiter, paths *indexer.FolderPathResolver) ([]indexer.IncrementalInputItem, error) {
+	opts := m.incrementalFetchOptions(api, request, limiter, paths)
+	opts.Since = m.incrementalSince(request, cursorBefore)
+	changes, err := indexer.FetchIncrementalChanges(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("fetch incremental changes: %w", err)
 	}
+	return changes, nil
+}
 
+// incrementalSince 返回增量拉取的起点（秒）：游标减去重叠窗口。
+func (m *SyncManager) incrementalSince(request SyncStartRequest, cursorBefore int64) int64 {
 	overlapMS := request.WindowOverlapMS
 	if overlapMS <= 0 {
 		overlapMS = m.defaultWindowOverlapMS
 	}
 
-	if progress.IncrementalStats == nil {
-		progress.IncrementalStats = &models.IncrementalSyncStats{}
-	}
-
-	cursorBefore := progress.IncrementalStats.CursorBefore
 	since := cursorBefore
 	if since > 0 && overlapMS > 0 {
 		overlapSec := overlapMS / 1000
@@ -734,10 +996,19 @@ func (m *SyncManager) runIncremental(ctx context.Context, api npan.API, progress
 			}
 		}
 	}
+	return since
+}
+
+func (m *SyncManager) incrementalFetchOptions(api npan.API, request SyncStartRequest, limiter *indexer.RequestLimiter, paths *indexer.FolderPathResolver) indexer.IncrementalFetchOptions {
+	query := request.IncrementalQuery
+	if query == "" {
+		query = m.defaultIncrementalQuery
+	}
+	if query == "" {
+		query = "* OR *"
+	}
 
-	changes, err := indexer.FetchIncrementalChanges(ctx, indexer.IncrementalFetchOptions{
-		Since: since,
-		Until: 0,
+	return indexer.IncrementalFetchOptions{
 		Retry: m.retry,
 		Fetch: func(ctx context.Context, start *int64, end *int64, pageID int64) (map[string]any, error) {
 			var result map[string]any
@@ -748,22 +1019,108 @@ func (m *SyncManager) runIncremental(ctx context.Context, api npan.API, progress
 			})
 			return result, schedErr
 		},
-	})
-	if err != nil {
-		return fmt.Errorf("fetch incremental changes: %w", err)
+		Paths: paths,
+
+		MaxPagesPerWindow: m.incrementalWindowMaxPages,
+		MaxItemsPerWindow: m.incrementalWindowMaxItems,
 	}
+}
 
+// incrementalWindowPlan 返回本次增量的子窗口计划：上次中断留下的计划基于同一游标时从剩余窗口继续，
+// 否则从重叠窗口起点开始一个不设上界的窗口。
+func (m *SyncManager) incrementalWindowPlan(progress *models.SyncProgressState, request SyncStartRequest) *models.IncrementalWindowPlan {
+	cursorBefore := progress.IncrementalStats.CursorBefore
+	if plan := progress.IncrementalPlan; plan != nil && plan.CursorBefore == cursorBefore && len(plan.Windows) > 0 {
+		slog.Info("从上次中断的子窗口继续增量拉取", "cursor_before", cursorBefore, "windows_pending", len(plan.Windows))
+		return plan
+	}
+	return &models.IncrementalWindowPlan{
+		CursorBefore: cursorBefore,
+		Windows:      []models.IncrementalWindow{{Start: m.incrementalSince(request, cursorBefore)}},
+	}
+}
+
+// runIncremental 按时间顺序逐个子窗口拉取并立即应用变更。每个窗口应用完或被二分后都保存子窗口计划，
+// 中断后下一次增量只需拉取剩余窗口。
+func (m *SyncManager) runIncremental(ctx context.Context, api npan.API, progress *models.SyncProgressState, request SyncStartRequest, limiter *indexer.RequestLimiter) error {
+	if progress.IncrementalStats == nil {
+		progress.IncrementalStats = &models.IncrementalSyncStats{}
+	}
+	stats := progress.IncrementalStats
+
+	paths := m.newFolderPathResolver(api, limiter, progress.RootNames)
+	fetchOpts := m.incrementalFetchOptions(api, request, limiter, paths)
+	plan := m.incrementalWindowPlan(progress, request)
+	progress.IncrementalPlan = plan
+	stats.WindowsPending = int64(len(plan.Windows))
+
+	for len(plan.Windows) > 0 {
+		window := plan.Windows[0]
+		changes, split, err := indexer.FetchIncrementalWindow(ctx, fetchOpts, window)
+		if err != nil {
+			return fmt.Errorf("fetch incremental changes: %w", err)
+		}
+		if len(split) > 0 {
+			slog.Debug("增量窗口结果过多，二分时间范围", "start", window.Start, "end", window.End)
+			plan.Windows = append(split, plan.Windows[1:]...)
+			stats.WindowsSplit++
+		} else {
+			if err := m.applyIncrementalChanges(ctx, progress, changes); err != nil {
+				return err
+			}
+			plan.Windows = plan.Windows[1:]
+			stats.WindowsFetched++
+		}
+		stats.WindowsPending = int64(len(plan.Windows))
+		progress.UpdatedAt = time.Now().UnixMilli()
+		if err := m.progressStore.Save(progress); err != nil {
+			return err
+		}
+	}
+	progress.IncrementalPlan = nil
+
+	if err := m.recrawlRestoredSubtrees(ctx, api, progress, limiter, paths); err != nil {
+		return err
+	}
+	if err := m.rewriteFolderPaths(ctx, progress); err != nil {
+		return err
+	}
+
+	stats.CursorAfter = time.Now().UnixMilli()
+	return nil
+}
+
+// applyIncrementalChanges 把一个窗口的变更写入索引：先识别移动与新出现的目录，再写入、删除并级联删除子树。
+func (m *SyncManager) applyIncrementalChanges(ctx context.Context, progress *models.SyncProgressState, changes []indexer.IncrementalInputItem) error {
 	var upserts []models.IndexDocument
 	var deleteIDs []string
+	var deletedFolders []int64
 	for _, item := range changes {
 		if item.Deleted {
 			deleteIDs = append(deleteIDs, item.Doc.DocID)
+			if item.Doc.Type == models.ItemTypeFolder {
+				deletedFolders = append(deletedFolders, item.Doc.SourceID)
+			}
 		} else {
 			upserts = append(upserts, item.Doc)
 		}
 	}
 
-	progress.IncrementalStats.ChangesFetched = int64(len(changes))
+	progress.IncrementalStats.ChangesFetched += int64(len(changes))
+
+	moved, appeared, err := m.inspectChangedFolders(ctx, upserts)
+	if err != nil {
+		return err
+	}
+	progress.IncrementalStats.FoldersMoved += int64(len(moved))
+	enqueuePathRewrites(progress, moved)
+	enqueueSubtreeRecrawls(progress, appeared)
+	// 旧文档即将被覆盖，先落盘队列，中断后仍能继续改写和重爬。
+	if len(moved) > 0 || len(appeared) > 0 {
+		if err := m.progressStore.Save(progress); err != nil {
+			return err
+		}
+	}
 
 	if len(upserts) > 0 {
 		err := indexer.WithRetryVoid(ctx, func() error {
@@ -787,8 +1144,9 @@ func (m *SyncManager) runIncremental(ctx context.Context, api npan.API, progress
 		}
 	}
 
-	progress.IncrementalStats.CursorAfter = time.Now().UnixMilli()
-	return nil
+	cascaded, err := m.cascadeFolderDeletes(ctx, deletedFolders)
+	progress.IncrementalStats.CascadeDeleted += cascaded
+	return err
 }
 
 func (m *SyncManager) run(ctx context.Context, api npan.API, request SyncStartRequest) error {
@@ -825,7 +1183,8 @@ func (m *SyncManager) run(ctx context.Context, api npan.API, request SyncStartRe
 	}
 
 	fullStartTime := time.Now()
-	roots, rootEstimateMap, rootNameMap, err := m.discoverRootFolders(ctx, api, request)
+	limiter := m.newRequestLimiter()
+	roots, rootEstimateMap, rootNameMap, err := m.discoverRootFolders(ctx, api, request, limiter)
 	if err != nil {
 		return err
 	}
@@ -851,6 +1210,27 @@ func (m *SyncManager) run(ctx context.Context, api npan.API, request SyncStartRe
 		resume = false
 	}
 
+	existing, err := m.progressStore.Load()
+	if err != nil {
+		return err
+	}
+
+	// 蓝绿重建写入影子索引，线上索引在切换前保持不变。
+	shadowRebuild := request.ShadowRebuild != nil && *request.ShadowRebuild
+	writeIndex := m.index
+	rebuild := carryRebuildState(existing)
+	if shadowRebuild {
+		shadow, state, continuing, err := m.prepareShadowRebuild(ctx, existing, resume)
+		if err != nil {
+			return err
+		}
+		if !continuing {
+			resume = false
+		}
+		writeIndex = shadow
+		rebuild = state
+	}
+
 	// Force rebuild and explicit non-resume runs must start from a clean
 	// crawl checkpoint, otherwise full crawl may resume from a stale queue.
 	if forceRebuild || !resume {
@@ -862,11 +1242,6 @@ func (m *SyncManager) run(ctx context.Context, api npan.API, request SyncStartRe
 		}
 	}
 
-	existing, err := m.progressStore.Load()
-	if err != nil {
-		return err
-	}
-
 	var progress *models.SyncProgressState
 	if resume && existing != nil {
 		progress = restoreProgress(existing, roots, rootCheckpointMap, rootEstimateMap, rootNameMap)
@@ -896,6 +1271,10 @@ func (m *SyncManager) run(ctx context.Context, api npan.API, request SyncStartRe
 		mergeHistoricalRootCatalog(progress, existing)
 	}
 	syncCatalogFields(progress)
+	progress.Rebuild = rebuild
+	if progress.SyncGeneration == 0 {
+		progress.SyncGeneration = time.Now().UnixMilli()
+	}
 
 	if err := m.progressStore.Save(progress); err != nil {
 		return err
@@ -918,11 +1297,9 @@ func (m *SyncManager) run(ctx context.Context, api npan.API, request SyncStartRe
 	}
 
 	semaphore := make(chan struct{}, rootWorkers)
+	// 目录 worker 配额在根目录之间共享，先完成的根目录释放的配额由仍在爬取的根目录继续使用。
+	workers := indexer.NewCrawlWorkerPool(rootWorkers * m.folderWorkers(request))
 	progressMu := &sync.Mutex{}
-	limiter := indexer.NewRequestLimiter(m.maxConcurrent, m.minTimeMS)
-	if m.activityChecker != nil {
-		limiter.SetActivityChecker(m.activityChecker)
-	}
 	runCtx, runCancel := context.WithCancel(ctx)
 	defer runCancel()
 
@@ -965,7 +1342,7 @@ func (m *SyncManager) run(ctx context.Context, api npan.API, request SyncStartRe
 			}
 			defer func() { <-semaphore }()
 
-			if err := m.runSingleRoot(runCtx, api, progress, progressMu, currentRoot, rootCheckpointMap[currentRoot], progressEvery, limiter, false); err != nil {
+			if err := m.runSingleRoot(runCtx, api, writeIndex, progress, progressMu, currentRoot, rootCheckpointMap[currentRoot], progressEvery, limiter, workers, false); err != nil {
 				if errors.Is(err, context.Canceled) && ctx.Err() != nil {
 					return
 				}
@@ -978,6 +1355,7 @@ func (m *SyncManager) run(ctx context.Context, api npan.API, request SyncStartRe
 
 	progressMu.Lock()
 	defer progressMu.Unlock()
+	recordRateControl(progress, limiter)
 
 	if firstErr != nil {
 		progress.Status = "error"
@@ -1017,6 +1395,29 @@ func (m *SyncManager) run(ctx context.Context, api npan.API, request SyncStartRe
 		return ctx.Err()
 	}
 
+	if shadowRebuild {
+		if err := m.swapShadowIndex(ctx, progress); err != nil {
+			progress.Status = "error"
+			progress.LastError = err.Error()
+			progress.ActiveRoot = nil
+			updateAggregateFromRoots(progress)
+			if err := m.progressStore.Save(progress); err != nil {
+				slog.Warn("保存进度失败", "error", err)
+			}
+			if m.metricsReporter != nil {
+				m.metricsReporter.ReportSyncFinished(metrics.SyncEvent{
+					Mode:     models.SyncModeFull,
+					Status:   "error",
+					Duration: time.Since(fullStartTime),
+					Stats:    progress.AggregateStats,
+				})
+			}
+			return err
+		}
+	} else {
+		expireRollbackTarget(progress)
+	}
+
 	progress.Status = "done"
 	progress.LastError = ""
 	progress.ActiveRoot = nil
@@ -1031,7 +1432,9 @@ func (m *SyncManager) run(ctx context.Context, api npan.API, request SyncStartRe
 	meiliCount, err := m.index.DocumentCount(ctx)
 	if err == nil {
 		progress.Verification = buildVerification(meiliCount, progress.AggregateStats)
+		progress.Verification.StaleRemoved = progress.StaleRemoved
 		appendRootEstimateWarnings(progress.Verification, progress)
+		m.appendDeadLetterCount(progress.Verification)
 	}
 
 	if m.metricsReporter != nil {
@@ -1050,13 +1453,7 @@ func (m *SyncManager) runIncrementalPath(ctx context.Context, api npan.API, requ
 	incrStartTime := time.Now()
 	now := incrStartTime.UnixMilli()
 
-	cursorBefore := int64(0)
-	if syncState != nil && syncState.LastSyncTime > 0 {
-		cursorBefore = syncState.LastSyncTime
-		if cursorBefore >= 1_000_000_000_000 {
-			cursorBefore = cursorBefore / 1000
-		}
-	}
+	cursorBefore := incrementalCursor(syncState)
 
 	// Preserve roots/rootProgress from the previous full sync so that
 	// the admin UI can still display per-root details after an incremental run.
@@ -1086,6 +1483,10 @@ func (m *SyncManager) runIncrementalPath(ctx context.Context, api npan.API, requ
 		progress.CatalogRoots = existing.CatalogRoots
 		progress.CatalogRootNames = existing.CatalogRootNames
 		progress.CatalogRootProgress = existing.CatalogRootProgress
+		progress.Rebuild = existing.Rebuild
+		progress.PathRewrites = existing.PathRewrites
+		progress.SubtreeRecrawls = existing.SubtreeRecrawls
+		progress.IncrementalPlan = existing.IncrementalPlan
 	}
 	syncCatalogFields(progress)
 
@@ -1093,15 +1494,13 @@ func (m *SyncManager) runIncrementalPath(ctx context.Context, api npan.API, requ
 		return err
 	}
 
-	limiter := indexer.NewRequestLimiter(m.maxConcurrent, m.minTimeMS)
-	if m.activityChecker != nil {
-		limiter.SetActivityChecker(m.activityChecker)
-	}
+	limiter := m.newRequestLimiter()
 
 	err := m.runIncremental(ctx, api, progress, request, limiter)
 	if err == nil {
 		err = m.runIncrementalRepairs(ctx, api, progress, request, limiter)
 	}
+	recordRateControl(progress, limiter)
 
 	if err != nil {
 		if ctx.Err() != nil {
@@ -1113,6 +1512,7 @@ func (m *SyncManager) runIncrementalPath(ctx context.Context, api npan.API, requ
 		}
 	} else {
 		progress.Status = "done"
+		expireRollbackTarget(progress)
 
 		if syncStateStore != nil {
 			_ = syncStateStore.Save(&models.SyncState{
@@ -1123,6 +1523,7 @@ func (m *SyncManager) runIncrementalPath(ctx context.Context, api npan.API, requ
 		meiliCount, verErr := m.index.DocumentCount(ctx)
 		if verErr == nil {
 			progress.Verification = buildVerification(meiliCount, progress.AggregateStats)
+			m.appendDeadLetterCount(progress.Verification)
 		}
 	}
 
@@ -1142,6 +1543,17 @@ func (m *SyncManager) runIncrementalPath(ctx context.Context, api npan.API, requ
 	return m.progressStore.Save(progress)
 }
 
+// incrementalCursor 把增量游标统一为秒；旧版本保存的是毫秒。
+func incrementalCursor(syncState *models.SyncState) int64 {
+	if syncState == nil || syncState.LastSyncTime <= 0 {
+		return 0
+	}
+	if syncState.LastSyncTime >= 1_000_000_000_000 {
+		return syncState.LastSyncTime / 1000
+	}
+	return syncState.LastSyncTime
+}
+
 type indexWriter struct {
 	index search.IndexOperator
 }
@@ -1150,6 +1562,47 @@ func (w *indexWriter) UpsertDocuments(ctx context.Context, docs []models.IndexDo
 	return w.index.UpsertDocuments(ctx, docs)
 }
 
+// newRequestLimiter 创建一次同步共用的上游请求限速器，搜索活跃时降速，状态变化同步到指标。
+func (m *SyncManager) newRequestLimiter() *indexer.RequestLimiter {
+	limiter := indexer.NewRequestLimiter(m.maxConcurrent, m.minTimeMS)
+	if m.activityChecker != nil {
+		limiter.SetActivityChecker(m.activityChecker)
+	}
+	if m.metricsReporter != nil {
+		limiter.SetListener(m.metricsReporter.ReportRateControl)
+	}
+	limiter.SetGate(m.upstreamGates())
+	return limiter
+}
+
+// upstreamGates 返回同步请求前需要等待的闸门：人工暂停，以及熔断器。
+func (m *SyncManager) upstreamGates() indexer.Gates {
+	gates := indexer.Gates{m.pauseGate}
+	if m.circuitBreaker != nil {
+		gates = append(gates, m.circuitBreaker)
+	}
+	return gates
+}
+
+// callUpstream 经限速器发起一次上游请求，并按重试策略重试；熔断期间的失败由限速器等待恢复，不消耗重试次数。
+func callUpstream[T any](ctx context.Context, limiter *indexer.RequestLimiter, retry models.RetryPolicyOptions, call func() (T, error)) (T, error) {
+	return indexer.WithRetry(ctx, func() (T, error) {
+		var result T
+		err := limiter.Schedule(ctx, func() error {
+			var innerErr error
+			result, innerErr = call()
+			return innerErr
+		})
+		return result, err
+	}, retry)
+}
+
+// recordRateControl 把限速器当前状态写入进度，调用方负责持有进度锁。
+func recordRateControl(progress *models.SyncProgressState, limiter *indexer.RequestLimiter) {
+	state := limiter.Snapshot()
+	progress.RateControl = &state
+}
+
 func buildVerification(meiliCount int64, stats models.CrawlStats) *models.SyncVerification {
 	crawled := stats.FilesIndexed + stats.FoldersVisited
 	discovered := stats.FilesDiscovered + stats.FoldersVisited
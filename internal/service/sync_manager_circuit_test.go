@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"npan/internal/models"
+	"npan/internal/npan"
+)
+
+func TestRunFull_PausesWhileCircuitOpen(t *testing.T) {
+	t.Parallel()
+
+	docs, api := staleSweepFixture()
+	list := api.listFolderChildrenFn
+	var failures atomic.Int32
+	api.listFolderChildrenFn = func(ctx context.Context, folderID int64, pageID int64) (models.FolderChildrenPage, error) {
+		if folderID == 100 && failures.Add(1) <= 2 {
+			return models.FolderChildrenPage{}, errors.New("connection refused")
+		}
+		return list(ctx, folderID, pageID)
+	}
+
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(docs))
+	mgr.circuitBreaker = npan.NewCircuitBreaker(npan.CircuitBreakerOptions{
+		FailureThreshold: 2,
+		OpenTimeout:      50 * time.Millisecond,
+	})
+
+	disabled := false
+	started := time.Now()
+	if err := mgr.run(context.Background(), mgr.circuitBreaker.Wrap(api), SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("run returned error: %v", err)
+	}
+	if elapsed := time.Since(started); elapsed < 40*time.Millisecond {
+		t.Fatalf("expected sync to wait for the circuit to half-open, took %v", elapsed)
+	}
+
+	progress, err := mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.Status != "done" || progress.AggregateStats.FailedRequests != 0 {
+		t.Fatalf("expected sync to resume after recovery, got status=%s stats=%+v", progress.Status, progress.AggregateStats)
+	}
+	if state := mgr.circuitBreaker.Snapshot().State; state != npan.CircuitClosed {
+		t.Fatalf("expected successful probe to close the circuit, got %s", state)
+	}
+}
+
+func TestRunFull_SurvivesOutageLongerThanRetryBudget(t *testing.T) {
+	t.Parallel()
+
+	docs, api := staleSweepFixture()
+	// 重试 2 次、阈值 3：连续 20 次失败远超 (重试次数+1) × 阈值，只有等熔断器恢复才能完成。
+	const outage = 20
+	var calls atomic.Int32
+	down := func() error {
+		if calls.Add(1) <= outage {
+			return &npan.StatusError{Status: http.StatusBadGateway, Message: "HTTP 502"}
+		}
+		return nil
+	}
+	list := api.listFolderChildrenFn
+	api.listFolderChildrenFn = func(ctx context.Context, folderID int64, pageID int64) (models.FolderChildrenPage, error) {
+		if err := down(); err != nil {
+			return models.FolderChildrenPage{}, err
+		}
+		return list(ctx, folderID, pageID)
+	}
+	api.getFolderInfoFn = func(_ context.Context, folderID int64) (models.NpanFolder, error) {
+		if err := down(); err != nil {
+			return models.NpanFolder{}, err
+		}
+		return models.NpanFolder{ID: folderID, Name: "root-100", ItemCount: 1}, nil
+	}
+
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(docs))
+	mgr.circuitBreaker = npan.NewCircuitBreaker(npan.CircuitBreakerOptions{
+		FailureThreshold: 3,
+		OpenTimeout:      5 * time.Millisecond,
+	})
+
+	disabled := false
+	if err := mgr.run(context.Background(), mgr.circuitBreaker.Wrap(api), SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("expected sync to wait out the outage, got %v", err)
+	}
+	if got := calls.Load(); got <= outage {
+		t.Fatalf("expected requests after the outage, got %d calls", got)
+	}
+
+	progress, err := mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.Status != "done" || progress.AggregateStats.FailedRequests != 0 {
+		t.Fatalf("expected sync to finish without failed requests, got status=%s stats=%+v", progress.Status, progress.AggregateStats)
+	}
+	// 根目录发现同样等熔断器恢复，而不是降级跳过根目录信息。
+	if got := progress.RootNames[100]; got != "root-100" {
+		t.Fatalf("expected root discovery to wait for recovery and record the root name, got %q", got)
+	}
+}
@@ -95,7 +95,7 @@ func TestDiscoverRootFolders_ExplicitRootsUseFolderInfoEstimate(t *testing.T) {
 	roots, estimates, names, err := mgr.discoverRootFolders(context.Background(), api, SyncStartRequest{
 		RootFolderIDs:      []int64{123456},
 		IncludeDepartments: &includeDepartments,
-	})
+	}, mgr.newRequestLimiter())
 	if err != nil {
 		t.Fatalf("discoverRootFolders returned error: %v", err)
 	}
@@ -128,7 +128,7 @@ func TestDiscoverRootFolders_FolderInfoFailureDegradesGracefully(t *testing.T) {
 	roots, estimates, names, err := mgr.discoverRootFolders(context.Background(), api, SyncStartRequest{
 		RootFolderIDs:      []int64{123456},
 		IncludeDepartments: &includeDepartments,
-	})
+	}, mgr.newRequestLimiter())
 	if err != nil {
 		t.Fatalf("discoverRootFolders should degrade gracefully, got error: %v", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"path/filepath"
+	"slices"
 	"sort"
 	"sync/atomic"
 	"testing"
@@ -89,6 +90,17 @@ func (s *incrementalStubIndex) AddDocumentsWithContext(ctx context.Context, docs
 	return s.routingStubIndex.AddDocumentsWithContext(ctx, docs, opts)
 }
 
+// GetDocumentsWithContext 返回空结果：这些用例中的目录在索引里都还不存在。
+func (s *incrementalStubIndex) GetDocumentsWithContext(_ context.Context, _ *meilisearch.DocumentsQuery, resp *meilisearch.DocumentsResult) error {
+	*resp = meilisearch.DocumentsResult{}
+	return nil
+}
+
+// Search 返回空结果：被删除目录在索引里没有子孙文档需要级联删除。
+func (s *incrementalStubIndex) Search(_ string, _ *meilisearch.SearchRequest) (*meilisearch.SearchResponse, error) {
+	return &meilisearch.SearchResponse{}, nil
+}
+
 func (s *incrementalStubIndex) DeleteDocumentsWithContext(ctx context.Context, ids []string, opts *meilisearch.DocumentOptions) (*meilisearch.TaskInfo, error) {
 	if s.deleteDocsFn != nil {
 		return s.deleteDocsFn(ctx, ids, opts)
@@ -137,6 +149,85 @@ func (s *inMemoryIndexStub) DeleteAllDocuments(_ context.Context) error {
 	return nil
 }
 
+func (s *inMemoryIndexStub) GetDocuments(_ context.Context, docIDs []string) ([]models.IndexDocument, error) {
+	docs := make([]models.IndexDocument, 0, len(docIDs))
+	for _, docID := range docIDs {
+		if doc, ok := s.docs[docID]; ok {
+			docs = append(docs, doc)
+		}
+	}
+	return docs, nil
+}
+
+func (s *inMemoryIndexStub) UpdateDocumentPaths(_ context.Context, docs []models.IndexDocument) error {
+	for _, doc := range docs {
+		current, ok := s.docs[doc.DocID]
+		if !ok {
+			continue
+		}
+		current.PathText = doc.PathText
+		current.AncestorIDs = doc.AncestorIDs
+		s.docs[doc.DocID] = current
+	}
+	return nil
+}
+
+func (s *inMemoryIndexStub) CountDuplicateSHA1(_ context.Context, params models.LocalSearchParams, limit int) (models.DuplicateSHA1Summary, error) {
+	groups := map[string]*models.DuplicateSHA1Group{}
+	for _, doc := range s.docs {
+		if doc.SHA1 == "" || doc.Type != models.ItemTypeFile || doc.Size < params.MinSize {
+			continue
+		}
+		if params.WithinFolderID != nil && !slices.Contains(doc.AncestorIDs, *params.WithinFolderID) {
+			continue
+		}
+		group := groups[doc.SHA1]
+		if group == nil {
+			group = &models.DuplicateSHA1Group{SHA1: doc.SHA1}
+			groups[doc.SHA1] = group
+		}
+		group.Copies++
+		group.Size = max(group.Size, doc.Size)
+	}
+	summary := models.DuplicateSHA1Summary{}
+	for _, group := range groups {
+		if group.Copies <= 1 {
+			continue
+		}
+		summary.Groups = append(summary.Groups, *group)
+		summary.WastedBytes += group.WastedBytes()
+	}
+	sort.Slice(summary.Groups, func(i, j int) bool {
+		return summary.Groups[i].WastedBytes() > summary.Groups[j].WastedBytes()
+	})
+	summary.TotalGroups = int64(len(summary.Groups))
+	if limit > 0 && len(summary.Groups) > limit {
+		summary.Groups = summary.Groups[:limit]
+	}
+	return summary, nil
+}
+
+func (s *inMemoryIndexStub) DeleteStaleDocuments(ctx context.Context, rootFolderID int64, generation int64) (int64, error) {
+	docIDs, _ := s.ListStaleDocumentIDs(ctx, rootFolderID, generation)
+	for _, docID := range docIDs {
+		delete(s.docs, docID)
+	}
+	return int64(len(docIDs)), nil
+}
+
+func (s *inMemoryIndexStub) ListStaleDocumentIDs(_ context.Context, rootFolderID int64, generation int64) ([]string, error) {
+	docIDs := []string{}
+	for docID, doc := range s.docs {
+		inRoot := (doc.SyncRootID != nil && *doc.SyncRootID == rootFolderID) || slices.Contains(doc.AncestorIDs, rootFolderID)
+		if !inRoot || (doc.SyncGeneration != 0 && doc.SyncGeneration >= generation) {
+			continue
+		}
+		docIDs = append(docIDs, docID)
+	}
+	sort.Strings(docIDs)
+	return docIDs, nil
+}
+
 func (s *inMemoryIndexStub) Search(params models.LocalSearchParams) ([]models.IndexDocument, int64, error) {
 	items := make([]models.IndexDocument, 0, len(s.docs))
 	for _, doc := range s.docs {
@@ -149,6 +240,12 @@ func (s *inMemoryIndexStub) Search(params models.LocalSearchParams) ([]models.In
 		if !params.IncludeDeleted && (doc.InTrash || doc.IsDeleted) {
 			continue
 		}
+		if params.WithinFolderID != nil && !slices.Contains(doc.AncestorIDs, *params.WithinFolderID) {
+			continue
+		}
+		if (params.SHA1 != "" && doc.SHA1 != params.SHA1) || doc.Size < params.MinSize {
+			continue
+		}
 		items = append(items, doc)
 	}
 	sort.Slice(items, func(i, j int) bool {
@@ -954,3 +1051,78 @@ func TestRunIncrementalPath_SkipsRepairWhenChangesFetched(t *testing.T) {
 		t.Fatalf("expected repair crawl to be skipped when incremental changes were fetched")
 	}
 }
+
+func TestRunIncremental_ResumesRemainingSubWindows(t *testing.T) {
+	t.Parallel()
+
+	idx := newInMemoryIndexStub(nil)
+	mgr, _ := newTestSyncManager(t, idx)
+	mgr.incrementalWindowMaxItems = 2
+	limiter := indexer.NewRequestLimiter(2, 0)
+
+	updatedAt := map[int64]int64{1: 1699999996, 2: 1699999997, 3: 1700000100}
+	inWindow := func(ts int64, start *int64, end *int64) bool {
+		return (start == nil || ts >= *start) && (end == nil || ts <= *end)
+	}
+	var failIsolated atomic.Bool
+	failIsolated.Store(true)
+	var requestedStarts []int64
+	api := &mockAPI{
+		searchUpdatedWindowFn: func(_ context.Context, _ string, start *int64, end *int64, _ int64) (map[string]any, error) {
+			requestedStarts = append(requestedStarts, *start)
+			if failIsolated.Load() && inWindow(updatedAt[3], start, end) && !inWindow(updatedAt[2], start, end) {
+				return nil, errors.New("bad request")
+			}
+			var files []map[string]any
+			for _, id := range []int64{1, 2, 3} {
+				if inWindow(updatedAt[id], start, end) {
+					files = append(files, makeFileEntry(id, "f.pdf", 100, 1, false, false))
+				}
+			}
+			page := makeOnePage(files, nil)
+			page["total_count"] = float64(len(files))
+			return page, nil
+		},
+	}
+
+	progress := newTestProgress(1700000000)
+	request := SyncStartRequest{Mode: models.SyncModeIncremental, WindowOverlapMS: 5000}
+	if err := mgr.runIncremental(context.Background(), api, progress, request, limiter); err == nil {
+		t.Fatal("expected the isolated window to fail")
+	}
+	if _, ok := idx.docs["file_1"]; !ok {
+		t.Fatal("expected the earlier sub-window to be applied before the failure")
+	}
+	if _, ok := idx.docs["file_3"]; ok {
+		t.Fatal("file_3 should not be written before its window succeeds")
+	}
+
+	saved, err := mgr.progressStore.Load()
+	if err != nil || saved == nil || saved.IncrementalPlan == nil {
+		t.Fatalf("expected saved sub-window plan, got %+v err=%v", saved, err)
+	}
+	plan := saved.IncrementalPlan
+	if plan.CursorBefore != 1700000000 || len(plan.Windows) == 0 || !inWindow(updatedAt[3], &plan.Windows[0].Start, nil) || plan.Windows[0].Start <= updatedAt[2] {
+		t.Fatalf("unexpected saved plan: %+v", plan)
+	}
+	if saved.IncrementalStats.WindowsSplit == 0 || saved.IncrementalStats.WindowsPending != int64(len(plan.Windows)) {
+		t.Fatalf("unexpected window stats: %+v", saved.IncrementalStats)
+	}
+
+	failIsolated.Store(false)
+	requestedStarts = nil
+	resumeStart := plan.Windows[0].Start
+	resumed := newTestProgress(1700000000)
+	resumed.IncrementalPlan = plan
+	if err := mgr.runIncremental(context.Background(), api, resumed, request, limiter); err != nil {
+		t.Fatalf("resume failed: %v", err)
+	}
+	for _, start := range requestedStarts {
+		if start < resumeStart {
+			t.Fatalf("resumed run re-fetched an applied window starting at %d", start)
+		}
+	}
+	if _, ok := idx.docs["file_3"]; !ok || resumed.IncrementalPlan != nil || resumed.IncrementalStats.WindowsPending != 0 {
+		t.Fatalf("expected resumed run to finish the plan, plan=%+v stats=%+v", resumed.IncrementalPlan, resumed.IncrementalStats)
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"npan/internal/models"
+	"npan/internal/storage"
+)
+
+func TestPauseSync_HoldsCrawlAndFlushesCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	mgr, tmpDir := newTestSyncManager(t, newInMemoryIndexStub(nil))
+	var childCalls atomic.Int32
+	api := &mockAPIForRouting{
+		listFolderChildrenFn: func(_ context.Context, folderID int64, _ int64) (models.FolderChildrenPage, error) {
+			if folderID == 100 {
+				// 根目录页面已发出请求后才暂停：该页照常完成，子目录请求停在限速器前。
+				if err := mgr.Pause(); err != nil {
+					t.Errorf("Pause returned error: %v", err)
+				}
+				return models.FolderChildrenPage{
+					Folders:   []models.NpanFolder{{ID: 101, Name: "sub", ParentID: 100}},
+					Files:     []models.NpanFile{{ID: 1, Name: "a.pdf", ParentID: 100}},
+					PageCount: 1,
+				}, nil
+			}
+			childCalls.Add(1)
+			return models.FolderChildrenPage{PageCount: 1}, nil
+		},
+	}
+
+	disabled := false
+	if err := mgr.Start(api, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+
+	checkpointStore := storage.NewJSONCheckpointStoreFactory().ForKey(filepath.Join(tmpDir, "checkpoint.json"))
+	waitFor(t, func() bool {
+		checkpoint, err := checkpointStore.Load()
+		return err == nil && checkpoint != nil && len(checkpoint.Queue) == 1 && checkpoint.Queue[0] == 101
+	}, "checkpoint to advance past the paused page")
+
+	progress, err := mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.Status != "paused" || progress.PausedAt == 0 {
+		t.Fatalf("expected paused progress, got status=%s paused_at=%d", progress.Status, progress.PausedAt)
+	}
+	time.Sleep(30 * time.Millisecond)
+	if got := childCalls.Load(); got != 0 {
+		t.Fatalf("expected no upstream requests while paused, got %d", got)
+	}
+	if err := mgr.Pause(); !errors.Is(err, ErrSyncAlreadyPaused) {
+		t.Fatalf("expected ErrSyncAlreadyPaused, got %v", err)
+	}
+
+	if err := mgr.Resume(); err != nil {
+		t.Fatalf("Resume returned error: %v", err)
+	}
+	waitFor(t, func() bool { return !mgr.IsRunning() }, "sync to finish after resume")
+
+	progress, err = mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.Status != "done" || childCalls.Load() != 1 {
+		t.Fatalf("expected resumed sync to finish, got status=%s child_calls=%d", progress.Status, childCalls.Load())
+	}
+	if err := mgr.Resume(); !errors.Is(err, ErrSyncNotRunning) {
+		t.Fatalf("expected ErrSyncNotRunning after finish, got %v", err)
+	}
+}
+
+func TestPauseSync_CancelWhilePaused(t *testing.T) {
+	t.Parallel()
+
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(nil))
+	api := &mockAPIForRouting{
+		listFolderChildrenFn: func(_ context.Context, folderID int64, _ int64) (models.FolderChildrenPage, error) {
+			if folderID == 100 {
+				_ = mgr.Pause()
+				return models.FolderChildrenPage{
+					Folders:   []models.NpanFolder{{ID: 101, Name: "sub", ParentID: 100}},
+					PageCount: 1,
+				}, nil
+			}
+			return models.FolderChildrenPage{PageCount: 1}, nil
+		},
+	}
+
+	disabled := false
+	if err := mgr.Start(api, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+	waitFor(t, mgr.IsPaused, "sync to pause")
+
+	if !mgr.Cancel() {
+		t.Fatal("expected Cancel to stop the paused sync")
+	}
+	waitFor(t, func() bool { return !mgr.IsRunning() }, "paused sync to stop after cancel")
+	if mgr.IsPaused() {
+		t.Fatal("expected pause state to be cleared when the run ends")
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool, what string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
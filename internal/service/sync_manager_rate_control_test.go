@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"npan/internal/models"
+	"npan/internal/npan"
+)
+
+func TestRunFull_RecordsRateControlAfterThrottle(t *testing.T) {
+	t.Parallel()
+
+	docs, api := staleSweepFixture()
+	list := api.listFolderChildrenFn
+	var throttled atomic.Bool
+	api.listFolderChildrenFn = func(ctx context.Context, folderID int64, pageID int64) (models.FolderChildrenPage, error) {
+		if folderID == 100 && throttled.CompareAndSwap(false, true) {
+			return models.FolderChildrenPage{}, &npan.StatusError{Status: http.StatusTooManyRequests, Message: "HTTP 429", RetryAfter: 10 * time.Millisecond}
+		}
+		return list(ctx, folderID, pageID)
+	}
+
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(docs))
+	disabled := false
+	if err := mgr.run(context.Background(), api, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("run returned error: %v", err)
+	}
+
+	progress, err := mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.Status != "done" || progress.AggregateStats.FailedRequests != 0 {
+		t.Fatalf("expected throttled page to be retried, got status=%s stats=%+v", progress.Status, progress.AggregateStats)
+	}
+	state := progress.RateControl
+	if state == nil || state.ThrottleEvents != 1 || state.LastThrottleStatus != http.StatusTooManyRequests {
+		t.Fatalf("expected progress to record the throttle, got %+v", state)
+	}
+	// 基础速率不限速时，降速后应给出具体速率。
+	if !state.Backoff || state.BaseRate != 0 || state.EffectiveRate <= 0 {
+		t.Fatalf("expected limiter to still be backing off with a concrete rate, got %+v", state)
+	}
+}
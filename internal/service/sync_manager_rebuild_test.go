@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"npan/internal/models"
+	"npan/internal/search"
+)
+
+// blueGreenIndexStub 用两个内存索引模拟蓝绿重建，交换时对调线上索引与影子索引。
+type blueGreenIndexStub struct {
+	*inMemoryIndexStub
+	shadow   *inMemoryIndexStub
+	resets   []bool
+	failSwap error
+}
+
+func (s *blueGreenIndexStub) ShadowIndex(_ context.Context, reset bool) (search.IndexOperator, string, error) {
+	s.resets = append(s.resets, reset)
+	if reset || s.shadow == nil {
+		s.shadow = newInMemoryIndexStub(nil)
+	}
+	return s.shadow, "npan_items_shadow", nil
+}
+
+func (s *blueGreenIndexStub) SwapShadow(context.Context) error {
+	if s.failSwap != nil {
+		return s.failSwap
+	}
+	s.inMemoryIndexStub, s.shadow = s.shadow, s.inMemoryIndexStub
+	return nil
+}
+
+func (s *blueGreenIndexStub) RollbackSwap(context.Context) error {
+	s.inMemoryIndexStub, s.shadow = s.shadow, s.inMemoryIndexStub
+	return nil
+}
+
+func shadowRebuildFixture(index *blueGreenIndexStub, crawls *int) *mockAPIForRouting {
+	return &mockAPIForRouting{
+		listFolderChildrenFn: func(_ context.Context, folderID int64, _ int64) (models.FolderChildrenPage, error) {
+			*crawls++
+			if _, ok := index.docs["file_old"]; !ok {
+				return models.FolderChildrenPage{}, errors.New("live index changed during shadow rebuild")
+			}
+			if folderID == 100 {
+				return models.FolderChildrenPage{
+					Files:     []models.NpanFile{{ID: 1, Name: "a.pdf", ParentID: 100}},
+					PageCount: 1,
+				}, nil
+			}
+			return models.FolderChildrenPage{PageCount: 1}, nil
+		},
+	}
+}
+
+func TestRunFull_ShadowRebuildSwapsAndRollsBack(t *testing.T) {
+	t.Parallel()
+
+	index := &blueGreenIndexStub{inMemoryIndexStub: newInMemoryIndexStub([]models.IndexDocument{{DocID: "file_old", SourceID: 99, Type: models.ItemTypeFile}})}
+	crawls := 0
+	api := shadowRebuildFixture(index, &crawls)
+	mgr, _ := newTestSyncManager(t, index)
+
+	disabled := false
+	enabled := true
+	if err := mgr.run(context.Background(), api, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ShadowRebuild:      &enabled,
+	}); err != nil {
+		t.Fatalf("run returned error: %v", err)
+	}
+
+	if _, ok := index.docs["file_1"]; !ok {
+		t.Fatal("expected rebuilt documents to be live after the swap")
+	}
+	if _, ok := index.docs["file_old"]; ok {
+		t.Fatal("expected previous generation to leave the live index")
+	}
+	if index.deleteAll != 0 {
+		t.Fatalf("expected live index not to be cleared, got %d DeleteAllDocuments calls", index.deleteAll)
+	}
+
+	progress, err := mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.Rebuild == nil || progress.Rebuild.Status != models.RebuildStatusSwapped || progress.Rebuild.ShadowIndex != "npan_items_shadow" || progress.Rebuild.SwappedAt == 0 {
+		t.Fatalf("expected swapped rebuild state, got %+v", progress.Rebuild)
+	}
+	if progress.Verification == nil || progress.Verification.MeiliDocCount != int64(len(index.docs)) {
+		t.Fatalf("expected verification to count the swapped index, got %+v", progress.Verification)
+	}
+
+	state, err := mgr.RollbackRebuild(context.Background())
+	if err != nil {
+		t.Fatalf("RollbackRebuild returned error: %v", err)
+	}
+	if state.Status != models.RebuildStatusRolledBack || state.RolledBackAt == 0 {
+		t.Fatalf("expected rolled back state, got %+v", state)
+	}
+	if _, ok := index.docs["file_old"]; !ok {
+		t.Fatal("expected previous generation to be live after rollback")
+	}
+	if _, err := mgr.RollbackRebuild(context.Background()); !errors.Is(err, ErrNoRebuildToRollback) {
+		t.Fatalf("expected second rollback to be rejected, got %v", err)
+	}
+}
+
+func TestRunFull_ShadowRebuildResumesAfterFailedSwap(t *testing.T) {
+	t.Parallel()
+
+	index := &blueGreenIndexStub{
+		inMemoryIndexStub: newInMemoryIndexStub([]models.IndexDocument{{DocID: "file_old", SourceID: 99, Type: models.ItemTypeFile}}),
+		failSwap:          errors.New("swap rejected"),
+	}
+	crawls := 0
+	api := shadowRebuildFixture(index, &crawls)
+	mgr, _ := newTestSyncManager(t, index)
+
+	disabled := false
+	enabled := true
+	request := SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ShadowRebuild:      &enabled,
+	}
+	if err := mgr.run(context.Background(), api, request); err == nil {
+		t.Fatal("expected run to fail when the swap is rejected")
+	}
+
+	progress, err := mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.Rebuild == nil || progress.Rebuild.Status != models.RebuildStatusBuilding || progress.Rebuild.LastError == "" {
+		t.Fatalf("expected building rebuild with error, got %+v", progress.Rebuild)
+	}
+	if _, ok := index.docs["file_old"]; !ok {
+		t.Fatal("expected live index to stay untouched after a failed swap")
+	}
+
+	index.failSwap = nil
+	crawlsBefore := crawls
+	if err := mgr.run(context.Background(), api, request); err != nil {
+		t.Fatalf("resumed run returned error: %v", err)
+	}
+
+	if len(index.resets) != 2 || !index.resets[0] || index.resets[1] {
+		t.Fatalf("expected shadow index to be reset only on the first run, got %v", index.resets)
+	}
+	if crawls != crawlsBefore {
+		t.Fatalf("expected finished root not to be crawled again, got %d extra requests", crawls-crawlsBefore)
+	}
+	if _, ok := index.docs["file_1"]; !ok {
+		t.Fatal("expected resumed rebuild to swap in the shadow index")
+	}
+	progress, err = mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.Rebuild == nil || progress.Rebuild.Status != models.RebuildStatusSwapped || progress.Rebuild.LastError != "" {
+		t.Fatalf("expected swapped rebuild state, got %+v", progress.Rebuild)
+	}
+}
+
+func TestSyncManagerStart_RejectsShadowRebuildWithoutRebuilder(t *testing.T) {
+	t.Parallel()
+
+	mgr, _ := newTestSyncManager(t, newInMemoryIndexStub(nil))
+	enabled := true
+	err := mgr.Start(&mockAPIForRouting{}, SyncStartRequest{Mode: models.SyncModeFull, ShadowRebuild: &enabled})
+	if !errors.Is(err, search.ErrRebuildUnsupported) {
+		t.Fatalf("expected ErrRebuildUnsupported, got %v", err)
+	}
+	if mgr.IsRunning() {
+		t.Fatal("expected rejected request not to start a sync")
+	}
+}
+
+func TestRunFull_SyncAfterSwapExpiresRollbackTarget(t *testing.T) {
+	t.Parallel()
+
+	index := &blueGreenIndexStub{inMemoryIndexStub: newInMemoryIndexStub([]models.IndexDocument{{DocID: "file_old", SourceID: 99, Type: models.ItemTypeFile}})}
+	crawls := 0
+	mgr, _ := newTestSyncManager(t, index)
+
+	disabled := false
+	enabled := true
+	if err := mgr.run(context.Background(), shadowRebuildFixture(index, &crawls), SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ShadowRebuild:      &enabled,
+	}); err != nil {
+		t.Fatalf("shadow rebuild returned error: %v", err)
+	}
+
+	// 切换后的普通同步只写入线上索引，上一代已落后，不能再回滚过去。
+	api := &mockAPIForRouting{
+		listFolderChildrenFn: func(_ context.Context, folderID int64, _ int64) (models.FolderChildrenPage, error) {
+			if folderID == 100 {
+				return models.FolderChildrenPage{
+					Files:     []models.NpanFile{{ID: 1, Name: "a.pdf", ParentID: 100}, {ID: 2, Name: "b.pdf", ParentID: 100}},
+					PageCount: 1,
+				}, nil
+			}
+			return models.FolderChildrenPage{PageCount: 1}, nil
+		},
+	}
+	if err := mgr.run(context.Background(), api, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("full sync after swap returned error: %v", err)
+	}
+
+	progress, err := mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.Rebuild != nil {
+		t.Fatalf("expected rollback target to expire after the next successful sync, got %+v", progress.Rebuild)
+	}
+	if _, err := mgr.RollbackRebuild(context.Background()); !errors.Is(err, ErrNoRebuildToRollback) {
+		t.Fatalf("expected rollback to be rejected, got %v", err)
+	}
+	if _, ok := index.docs["file_2"]; !ok {
+		t.Fatal("expected live index to keep documents written after the swap")
+	}
+}
@@ -66,6 +66,7 @@ func (m *mockAPIForRouting) GetFolderInfo(ctx context.Context, folderID int64) (
 //   - AddDocumentsWithContext  (UpsertDocuments path)
 //   - WaitForTaskWithContext   (waitTask path)
 //   - GetStatsWithContext      (DocumentCount path)
+//   - DeleteDocumentsByFilterWithContext (stale sweep path)
 //
 // All other methods panic to detect unexpected calls.
 // ---------------------------------------------------------------------------
@@ -88,6 +89,10 @@ func (s *routingStubIndex) WaitForTaskWithContext(_ context.Context, _ int64, _
 	return &meilisearch.Task{Status: meilisearch.TaskStatusSucceeded}, nil
 }
 
+func (s *routingStubIndex) DeleteDocumentsByFilterWithContext(_ context.Context, _ any, _ *meilisearch.DocumentOptions) (*meilisearch.TaskInfo, error) {
+	return &meilisearch.TaskInfo{TaskUID: 1}, nil
+}
+
 func (s *routingStubIndex) GetStatsWithContext(_ context.Context) (*meilisearch.StatsIndex, error) {
 	return &meilisearch.StatsIndex{NumberOfDocuments: s.docCount}, nil
 }
@@ -258,9 +263,6 @@ func (s *routingStubIndex) DeleteDocumentsWithContext(context.Context, []string,
 func (s *routingStubIndex) DeleteDocumentsByFilter(any, *meilisearch.DocumentOptions) (*meilisearch.TaskInfo, error) {
 	panic("unexpected call: DeleteDocumentsByFilter")
 }
-func (s *routingStubIndex) DeleteDocumentsByFilterWithContext(context.Context, any, *meilisearch.DocumentOptions) (*meilisearch.TaskInfo, error) {
-	panic("unexpected call: DeleteDocumentsByFilterWithContext")
-}
 func (s *routingStubIndex) DeleteAllDocuments(*meilisearch.DocumentOptions) (*meilisearch.TaskInfo, error) {
 	panic("unexpected call: DeleteAllDocuments")
 }
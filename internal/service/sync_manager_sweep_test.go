@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"npan/internal/models"
+)
+
+func int64Ptr(v int64) *int64 { return &v }
+
+// failingUpsertIndex 在写入包含指定文档的批次时返回错误。
+type failingUpsertIndex struct {
+	*inMemoryIndexStub
+	failDoc string
+}
+
+func (s *failingUpsertIndex) UpsertDocuments(ctx context.Context, docs []models.IndexDocument) error {
+	for _, doc := range docs {
+		if doc.DocID == s.failDoc {
+			return errors.New("index rejected batch")
+		}
+	}
+	return s.inMemoryIndexStub.UpsertDocuments(ctx, docs)
+}
+
+func staleSweepFixture() ([]models.IndexDocument, *mockAPIForRouting) {
+	docs := []models.IndexDocument{
+		{DocID: "file_9", SourceID: 9, Type: models.ItemTypeFile, SyncGeneration: 1, SyncRootID: int64Ptr(100)},
+		{DocID: "file_8", SourceID: 8, Type: models.ItemTypeFile},
+		{DocID: "file_7", SourceID: 7, Type: models.ItemTypeFile, SyncGeneration: 1, SyncRootID: int64Ptr(200)},
+	}
+	api := &mockAPIForRouting{
+		listFolderChildrenFn: func(_ context.Context, folderID int64, _ int64) (models.FolderChildrenPage, error) {
+			if folderID == 100 {
+				return models.FolderChildrenPage{
+					Files:     []models.NpanFile{{ID: 1, Name: "a.pdf", ParentID: 100}},
+					PageCount: 1,
+				}, nil
+			}
+			return models.FolderChildrenPage{PageCount: 1}, nil
+		},
+	}
+	return docs, api
+}
+
+func TestRunFull_SweepsStaleDocumentsOfFinishedRoot(t *testing.T) {
+	t.Parallel()
+
+	docs, api := staleSweepFixture()
+	index := newInMemoryIndexStub(docs)
+	mgr, _ := newTestSyncManager(t, index)
+
+	disabled := false
+	if err := mgr.run(context.Background(), api, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("run returned error: %v", err)
+	}
+
+	if _, ok := index.docs["file_9"]; ok {
+		t.Fatal("expected stale document of root 100 to be removed")
+	}
+	for _, kept := range []string{"file_8", "file_7", "file_1", "folder_100"} {
+		if _, ok := index.docs[kept]; !ok {
+			t.Fatalf("expected %s to be kept", kept)
+		}
+	}
+	fresh := index.docs["file_1"]
+	if fresh.SyncGeneration <= 1 || fresh.SyncRootID == nil || *fresh.SyncRootID != 100 {
+		t.Fatalf("expected crawled document to carry the new generation, got gen=%d root=%v", fresh.SyncGeneration, fresh.SyncRootID)
+	}
+
+	progress, err := mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.StaleRemoved != 1 || progress.RootProgress["100"].StaleRemoved != 1 {
+		t.Fatalf("expected 1 stale document removed in progress, got %d / %d", progress.StaleRemoved, progress.RootProgress["100"].StaleRemoved)
+	}
+	if progress.Verification == nil || progress.Verification.StaleRemoved != 1 {
+		t.Fatalf("expected verification to report 1 stale document removed, got %+v", progress.Verification)
+	}
+}
+
+func TestRunFull_SkipsSweepWhenWritesFailed(t *testing.T) {
+	t.Parallel()
+
+	docs, api := staleSweepFixture()
+	index := &failingUpsertIndex{inMemoryIndexStub: newInMemoryIndexStub(docs), failDoc: "file_1"}
+	mgr, _ := newTestSyncManager(t, index)
+
+	disabled := false
+	if err := mgr.run(context.Background(), api, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("run returned error: %v", err)
+	}
+
+	if _, ok := index.docs["file_9"]; !ok {
+		t.Fatal("expected stale sweep to be skipped when a batch failed")
+	}
+	progress, err := mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.StaleRemoved != 0 || progress.AggregateStats.SkippedFiles != 1 {
+		t.Fatalf("expected no sweep and 1 skipped file, got removed=%d stats=%+v", progress.StaleRemoved, progress.AggregateStats)
+	}
+}
+
+func TestRunFull_SweepsUnstampedDocumentsInRootSubtree(t *testing.T) {
+	t.Parallel()
+
+	_, api := staleSweepFixture()
+	index := newInMemoryIndexStub([]models.IndexDocument{
+		// 旧版本或增量同步写入的文档没有代次，上游已删除后也要被清理。
+		{DocID: "file_6", SourceID: 6, Type: models.ItemTypeFile, ParentID: 100, AncestorIDs: []int64{100}},
+		{DocID: "file_5", SourceID: 5, Type: models.ItemTypeFile, ParentID: 300, AncestorIDs: []int64{300}},
+	})
+	mgr, _ := newTestSyncManager(t, index)
+
+	disabled := false
+	if err := mgr.run(context.Background(), api, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+	}); err != nil {
+		t.Fatalf("run returned error: %v", err)
+	}
+
+	if _, ok := index.docs["file_6"]; ok {
+		t.Fatal("expected unstamped stale document under root 100 to be removed")
+	}
+	for _, kept := range []string{"file_5", "file_1"} {
+		if _, ok := index.docs[kept]; !ok {
+			t.Fatalf("expected %s to be kept", kept)
+		}
+	}
+}
+
+// nestedRootsIndex 让内层根目录的清理等到外层根目录写入重叠文档之后再执行，复现嵌套根目录并发爬取的交错。
+type nestedRootsIndex struct {
+	*inMemoryIndexStub
+	mu         sync.Mutex
+	outerWrote chan struct{}
+	once       sync.Once
+}
+
+func (s *nestedRootsIndex) UpsertDocuments(ctx context.Context, docs []models.IndexDocument) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, doc := range docs {
+		if doc.DocID == "file_2" && doc.SyncRootID != nil && *doc.SyncRootID == 100 {
+			s.once.Do(func() { close(s.outerWrote) })
+		}
+	}
+	return s.inMemoryIndexStub.UpsertDocuments(ctx, docs)
+}
+
+func (s *nestedRootsIndex) DeleteStaleDocuments(ctx context.Context, rootFolderID int64, generation int64) (int64, error) {
+	if rootFolderID == 200 {
+		select {
+		case <-s.outerWrote:
+		case <-time.After(5 * time.Second):
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.inMemoryIndexStub.DeleteStaleDocuments(ctx, rootFolderID, generation)
+}
+
+func TestRunFull_NestedRootsShareGenerationAndKeepOverlappingDocuments(t *testing.T) {
+	t.Parallel()
+
+	index := &nestedRootsIndex{inMemoryIndexStub: newInMemoryIndexStub(nil), outerWrote: make(chan struct{})}
+	mgr, _ := newTestSyncManager(t, index)
+	api := &mockAPIForRouting{
+		listFolderChildrenFn: func(_ context.Context, folderID int64, _ int64) (models.FolderChildrenPage, error) {
+			switch folderID {
+			case 100:
+				return models.FolderChildrenPage{
+					Folders:   []models.NpanFolder{{ID: 200, Name: "inner", ParentID: 100}},
+					Files:     []models.NpanFile{{ID: 1, Name: "a.pdf", ParentID: 100}},
+					PageCount: 1,
+				}, nil
+			case 200:
+				return models.FolderChildrenPage{
+					Files:     []models.NpanFile{{ID: 2, Name: "b.pdf", ParentID: 200}},
+					PageCount: 1,
+				}, nil
+			}
+			return models.FolderChildrenPage{PageCount: 1}, nil
+		},
+	}
+
+	disabled := false
+	if err := mgr.run(context.Background(), api, SyncStartRequest{
+		Mode:               models.SyncModeFull,
+		RootFolderIDs:      []int64{100, 200},
+		IncludeDepartments: &disabled,
+		ResumeProgress:     &disabled,
+		RootWorkers:        2,
+	}); err != nil {
+		t.Fatalf("run returned error: %v", err)
+	}
+
+	progress, err := mgr.GetProgress()
+	if err != nil {
+		t.Fatalf("GetProgress returned error: %v", err)
+	}
+	if progress.SyncGeneration == 0 {
+		t.Fatal("expected the run to record one sync generation")
+	}
+	for _, docID := range []string{"file_1", "file_2"} {
+		doc, ok := index.docs[docID]
+		if !ok {
+			t.Fatalf("expected %s to survive the sweep of the nested root", docID)
+		}
+		if doc.SyncGeneration != progress.SyncGeneration {
+			t.Fatalf("expected %s to carry the run generation %d, got %d", docID, progress.SyncGeneration, doc.SyncGeneration)
+		}
+	}
+	if progress.StaleRemoved != 0 {
+		t.Fatalf("expected no stale documents removed, got %d", progress.StaleRemoved)
+	}
+}
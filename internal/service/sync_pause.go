@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"npan/internal/indexer"
+	"npan/internal/models"
+)
+
+var (
+	ErrSyncNotRunning    = errors.New("当前没有运行中的同步任务")
+	ErrSyncAlreadyPaused = errors.New("同步已处于暂停状态")
+	ErrSyncNotPaused     = errors.New("同步未暂停")
+)
+
+// Pause 暂停运行中的同步：后续上游请求停在限速器前，已发出的请求照常完成；
+// 缓冲中的文档立即写入，使断点推进到已抓取的最后一页。取消与进程退出不受暂停影响。
+func (m *SyncManager) Pause() error {
+	m.mu.Lock()
+	if !m.running {
+		m.mu.Unlock()
+		return ErrSyncNotRunning
+	}
+	if !m.pauseGate.Pause() {
+		m.mu.Unlock()
+		return ErrSyncAlreadyPaused
+	}
+	flushers := make([]func(), 0, len(m.pauseFlushers))
+	for _, flush := range m.pauseFlushers {
+		flushers = append(flushers, flush)
+	}
+	m.mu.Unlock()
+
+	for _, flush := range flushers {
+		flush()
+	}
+	slog.Info("同步已暂停")
+	return nil
+}
+
+// Resume 恢复已暂停的同步。
+func (m *SyncManager) Resume() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.running {
+		return ErrSyncNotRunning
+	}
+	if !m.pauseGate.Resume() {
+		return ErrSyncNotPaused
+	}
+	slog.Info("同步已恢复")
+	return nil
+}
+
+// IsPaused 判断运行中的同步是否处于暂停状态。
+func (m *SyncManager) IsPaused() bool {
+	_, paused := m.pauseGate.PausedAt()
+	return paused
+}
+
+// applyPauseState 在内存中把运行中进度标记为暂停，调用方已确认同步在运行。
+// 暂停不落盘：进程重启后运行中的进度照常显示为中断，可以续爬。
+func (m *SyncManager) applyPauseState(progress *models.SyncProgressState) bool {
+	pausedAt, paused := m.pauseGate.PausedAt()
+	if !paused {
+		return false
+	}
+	progress.Status = "paused"
+	progress.PausedAt = pausedAt.UnixMilli()
+	return true
+}
+
+// registerPauseFlusher 登记暂停时需要执行的写入刷新，返回的函数用于注销。
+func (m *SyncManager) registerPauseFlusher(flush func()) func() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.pauseFlushers == nil {
+		m.pauseFlushers = map[int64]func(){}
+	}
+	m.nextPauseFlusher++
+	id := m.nextPauseFlusher
+	m.pauseFlushers[id] = flush
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.pauseFlushers, id)
+	}
+}
+
+// pauseAwareWriter 在暂停期间不再缓冲文档：暂停后才抓取完成的页面也会立即写入并推进断点。
+type pauseAwareWriter struct {
+	*indexer.BatchIndexWriter
+	gate *indexer.PauseGate
+}
+
+func (m *SyncManager) newPauseAwareWriter(ctx context.Context, writer *indexer.BatchIndexWriter) (*pauseAwareWriter, func()) {
+	release := m.registerPauseFlusher(func() {
+		if err := writer.SubmitPending(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("暂停时写入缓冲文档失败", "error", err)
+		}
+	})
+	return &pauseAwareWriter{BatchIndexWriter: writer, gate: m.pauseGate}, release
+}
+
+func (w *pauseAwareWriter) Enqueue(ctx context.Context, docs []models.IndexDocument, done func(err error)) error {
+	if err := w.BatchIndexWriter.Enqueue(ctx, docs, done); err != nil {
+		return err
+	}
+	if _, paused := w.gate.PausedAt(); paused {
+		return w.BatchIndexWriter.SubmitPending(ctx)
+	}
+	return nil
+}
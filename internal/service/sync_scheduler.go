@@ -0,0 +1,360 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"npan/internal/models"
+	"npan/internal/npan"
+	"npan/internal/storage"
+)
+
+const (
+	ScheduleRunStarted = "started"
+	ScheduleRunSkipped = "skipped"
+	ScheduleRunError   = "error"
+)
+
+var ErrSyncScheduleNotFound = errors.New("同步计划不存在")
+
+const defaultSchedulerTickInterval = 15 * time.Second
+
+// maxScheduleJitterSeconds 限制抖动窗口，避免抖动超过一个常见的 cron 周期。
+const maxScheduleJitterSeconds = 3600
+
+// syncStarter 是调度器对 SyncManager 的依赖。
+type syncStarter interface {
+	IsRunning() bool
+	Start(api npan.API, request SyncStartRequest) error
+}
+
+type SyncSchedulerArgs struct {
+	Store       storage.SyncScheduleStore
+	SyncManager syncStarter
+	APIFactory  func(ctx context.Context) (npan.API, error)
+	// PrepareRequest 非空时在每次计划同步启动前补全请求，例如填入租户配置的同步范围。
+	PrepareRequest func(request *SyncStartRequest)
+	TickInterval   time.Duration
+	Location       *time.Location
+	Now            func() time.Time
+	Jitter         func(max time.Duration) time.Duration
+}
+
+// SyncScheduler 按持久化的 cron 规则在服务进程内触发同步。
+type SyncScheduler struct {
+	store          storage.SyncScheduleStore
+	syncManager    syncStarter
+	apiFactory     func(ctx context.Context) (npan.API, error)
+	prepareRequest func(request *SyncStartRequest)
+	tickInterval   time.Duration
+	location       *time.Location
+	now            func() time.Time
+	jitter         func(max time.Duration) time.Duration
+
+	mu sync.Mutex
+}
+
+type SyncScheduleInput struct {
+	Name          string
+	CronExpr      string
+	Mode          models.SyncMode
+	JitterSeconds int64
+	Paused        bool
+}
+
+func NewSyncScheduler(args SyncSchedulerArgs) *SyncScheduler {
+	s := &SyncScheduler{
+		store:          args.Store,
+		syncManager:    args.SyncManager,
+		apiFactory:     args.APIFactory,
+		prepareRequest: args.PrepareRequest,
+		tickInterval:   args.TickInterval,
+		location:       args.Location,
+		now:            args.Now,
+		jitter:         args.Jitter,
+	}
+	if s.tickInterval <= 0 {
+		s.tickInterval = defaultSchedulerTickInterval
+	}
+	if s.location == nil {
+		s.location = time.Local
+	}
+	if s.now == nil {
+		s.now = time.Now
+	}
+	if s.jitter == nil {
+		s.jitter = func(max time.Duration) time.Duration {
+			if max <= 0 {
+				return 0
+			}
+			return rand.N(max + 1)
+		}
+	}
+	return s
+}
+
+// Run 阻塞运行调度循环，直到 ctx 被取消。
+func (s *SyncScheduler) Run(ctx context.Context) {
+	if err := s.rescheduleMissed(); err != nil {
+		slog.Warn("初始化同步计划失败", "error", err)
+	}
+
+	ticker := time.NewTicker(s.tickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runDue(ctx)
+		}
+	}
+}
+
+// rescheduleMissed 在启动时跳过停机期间错过的触发点，不做补跑。
+func (s *SyncScheduler) rescheduleMissed() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedules, err := s.store.List()
+	if err != nil {
+		return err
+	}
+	now := s.now().In(s.location)
+	for i := range schedules {
+		schedule := &schedules[i]
+		if schedule.Paused || schedule.NextRunAt > now.UnixMilli() {
+			continue
+		}
+		if schedule.NextRunAt > 0 {
+			slog.Info("跳过停机期间错过的计划同步", "schedule_id", schedule.ID, "name", schedule.Name)
+		}
+		if err := s.advance(schedule, now); err != nil {
+			return err
+		}
+		if err := s.store.Update(schedule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runDue 在锁内认领到期的计划并推进下次触发时间，触发本身（获取凭据可能要走 OAuth 网络请求）在锁外执行，
+// 不阻塞计划的增删改查。
+func (s *SyncScheduler) runDue(ctx context.Context) {
+	now := s.now().In(s.location)
+	for _, schedule := range s.claimDue(now) {
+		s.fire(ctx, &schedule, now)
+		s.recordRun(schedule)
+	}
+}
+
+func (s *SyncScheduler) claimDue(now time.Time) []models.SyncSchedule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedules, err := s.store.List()
+	if err != nil {
+		slog.Warn("读取同步计划失败", "error", err)
+		return nil
+	}
+
+	due := make([]models.SyncSchedule, 0, len(schedules))
+	for i := range schedules {
+		schedule := &schedules[i]
+		if schedule.Paused || schedule.NextRunAt <= 0 || schedule.NextRunAt > now.UnixMilli() {
+			continue
+		}
+
+		if err := s.advance(schedule, now); err != nil {
+			slog.Warn("计算下次触发时间失败", "schedule_id", schedule.ID, "error", err)
+		}
+		if err := s.store.Update(schedule); err != nil {
+			slog.Warn("保存同步计划失败", "schedule_id", schedule.ID, "error", err)
+			continue
+		}
+		due = append(due, *schedule)
+	}
+	return due
+}
+
+// recordRun 只写回触发结果；触发期间计划可能已被修改或删除，其余字段以存储中的为准。
+func (s *SyncScheduler) recordRun(fired models.SyncSchedule) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, err := s.store.Get(fired.ID)
+	if err != nil {
+		slog.Warn("读取同步计划失败", "schedule_id", fired.ID, "error", err)
+		return
+	}
+	if schedule == nil {
+		return
+	}
+	schedule.LastRunAt = fired.LastRunAt
+	schedule.LastRunStatus = fired.LastRunStatus
+	schedule.LastError = fired.LastError
+	if err := s.store.Update(schedule); err != nil {
+		slog.Warn("保存同步计划失败", "schedule_id", schedule.ID, "error", err)
+	}
+}
+
+func (s *SyncScheduler) fire(ctx context.Context, schedule *models.SyncSchedule, now time.Time) {
+	schedule.LastRunAt = now.UnixMilli()
+	schedule.LastError = ""
+
+	if s.syncManager.IsRunning() {
+		schedule.LastRunStatus = ScheduleRunSkipped
+		schedule.LastError = "已有同步任务在运行"
+		slog.Info("已有同步任务在运行，跳过本次计划同步", "schedule_id", schedule.ID, "name", schedule.Name)
+		return
+	}
+
+	if s.apiFactory == nil {
+		schedule.LastRunStatus = ScheduleRunError
+		schedule.LastError = "未配置调度器使用的 API 凭据"
+		return
+	}
+	api, err := s.apiFactory(ctx)
+	if err != nil {
+		schedule.LastRunStatus = ScheduleRunError
+		schedule.LastError = fmt.Sprintf("获取 API 凭据失败: %v", err)
+		slog.Warn("计划同步获取凭据失败", "schedule_id", schedule.ID, "error", err)
+		return
+	}
+
+	request := SyncStartRequest{Mode: schedule.Mode}
+	if s.prepareRequest != nil {
+		s.prepareRequest(&request)
+	}
+	if err := s.syncManager.Start(api, request); err != nil {
+		if s.syncManager.IsRunning() {
+			schedule.LastRunStatus = ScheduleRunSkipped
+			schedule.LastError = "已有同步任务在运行"
+			return
+		}
+		// 多副本部署时由其他进程持有租约是常态，按跳过记录；对账与目录重同步期间同样跳过。
+		if errors.Is(err, ErrSyncLeaseHeld) || errors.Is(err, ErrReconciliationRunning) || errors.Is(err, ErrFolderResyncRunning) {
+			schedule.LastRunStatus = ScheduleRunSkipped
+			schedule.LastError = err.Error()
+			slog.Info("同步任务被占用，跳过本次计划同步", "schedule_id", schedule.ID, "name", schedule.Name, "error", err)
+			return
+		}
+		schedule.LastRunStatus = ScheduleRunError
+		schedule.LastError = err.Error()
+		slog.Warn("计划同步启动失败", "schedule_id", schedule.ID, "error", err)
+		return
+	}
+
+	schedule.LastRunStatus = ScheduleRunStarted
+	slog.Info("计划同步已启动", "schedule_id", schedule.ID, "name", schedule.Name, "mode", schedule.Mode)
+}
+
+// advance 根据 cron 规则与抖动计算下一次触发时间。
+func (s *SyncScheduler) advance(schedule *models.SyncSchedule, now time.Time) error {
+	cron, err := parseCron(schedule.CronExpr)
+	if err != nil {
+		schedule.NextRunAt = 0
+		return err
+	}
+	next := cron.Next(now.In(s.location))
+	if next.IsZero() {
+		schedule.NextRunAt = 0
+		return fmt.Errorf("cron 表达式没有可用的触发时间: %q", schedule.CronExpr)
+	}
+	next = next.Add(s.jitter(time.Duration(schedule.JitterSeconds) * time.Second))
+	schedule.NextRunAt = next.UnixMilli()
+	return nil
+}
+
+func (s *SyncScheduler) List() ([]models.SyncSchedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.store.List()
+}
+
+func (s *SyncScheduler) Create(input SyncScheduleInput) (*models.SyncSchedule, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, fmt.Errorf("计划名称不能为空")
+	}
+	mode, err := resolveMode(input.Mode)
+	if err != nil {
+		return nil, err
+	}
+	if input.JitterSeconds < 0 || input.JitterSeconds > maxScheduleJitterSeconds {
+		return nil, fmt.Errorf("jitter_seconds 必须在 0-%d 之间", maxScheduleJitterSeconds)
+	}
+	cronExpr := strings.TrimSpace(input.CronExpr)
+	if _, err := parseCron(cronExpr); err != nil {
+		return nil, err
+	}
+
+	schedule := &models.SyncSchedule{
+		Name:          name,
+		CronExpr:      cronExpr,
+		Mode:          mode,
+		JitterSeconds: input.JitterSeconds,
+		Paused:        input.Paused,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.advance(schedule, s.now()); err != nil {
+		return nil, err
+	}
+	if err := s.store.Create(schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// SetPaused 暂停或恢复计划；恢复时从当前时间重新计算下次触发时间。
+func (s *SyncScheduler) SetPaused(id int64, paused bool) (*models.SyncSchedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, err := s.store.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if schedule == nil {
+		return nil, ErrSyncScheduleNotFound
+	}
+	if schedule.Paused == paused {
+		return schedule, nil
+	}
+
+	schedule.Paused = paused
+	if !paused {
+		if err := s.advance(schedule, s.now()); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.store.Update(schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func (s *SyncScheduler) Delete(id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deleted, err := s.store.Delete(id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrSyncScheduleNotFound
+	}
+	return nil
+}
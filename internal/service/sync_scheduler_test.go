@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"npan/internal/models"
+	"npan/internal/npan"
+	"npan/internal/storage"
+)
+
+type fakeSyncStarter struct {
+	mu       sync.Mutex
+	running  bool
+	startErr error
+	requests []SyncStartRequest
+}
+
+func (f *fakeSyncStarter) IsRunning() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.running
+}
+
+func (f *fakeSyncStarter) Start(_ npan.API, request SyncStartRequest) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.startErr != nil {
+		return f.startErr
+	}
+	f.requests = append(f.requests, request)
+	return nil
+}
+
+type schedulerClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *schedulerClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *schedulerClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+func newTestSyncScheduler(t *testing.T, starter *fakeSyncStarter, clock *schedulerClock, jitter time.Duration) *SyncScheduler {
+	t.Helper()
+	stores, err := storage.NewSQLiteStateStores(storage.SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	t.Cleanup(func() { _ = stores.DB.Close() })
+
+	return NewSyncScheduler(SyncSchedulerArgs{
+		Store:       stores.ScheduleStore,
+		SyncManager: starter,
+		APIFactory: func(context.Context) (npan.API, error) {
+			return &mockAPI{}, nil
+		},
+		Location: time.UTC,
+		Now:      clock.Now,
+		Jitter: func(max time.Duration) time.Duration {
+			if jitter > max {
+				return max
+			}
+			return jitter
+		},
+	})
+}
+
+func TestSyncScheduler_CreateComputesNextRunWithJitter(t *testing.T) {
+	clock := &schedulerClock{now: time.Date(2026, 5, 1, 2, 10, 0, 0, time.UTC)}
+	scheduler := newTestSyncScheduler(t, &fakeSyncStarter{}, clock, 45*time.Second)
+
+	schedule, err := scheduler.Create(SyncScheduleInput{
+		Name:          "nightly",
+		CronExpr:      "0 3 * * *",
+		Mode:          models.SyncModeFull,
+		JitterSeconds: 60,
+	})
+	if err != nil {
+		t.Fatalf("create schedule failed: %v", err)
+	}
+
+	want := time.Date(2026, 5, 1, 3, 0, 45, 0, time.UTC).UnixMilli()
+	if schedule.NextRunAt != want {
+		t.Fatalf("expected next run %d, got %d", want, schedule.NextRunAt)
+	}
+}
+
+func TestSyncScheduler_CreateRejectsInvalidInput(t *testing.T) {
+	clock := &schedulerClock{now: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)}
+	scheduler := newTestSyncScheduler(t, &fakeSyncStarter{}, clock, 0)
+
+	inputs := []SyncScheduleInput{
+		{Name: "", CronExpr: "@daily"},
+		{Name: "bad-cron", CronExpr: "61 * * * *"},
+		{Name: "bad-mode", CronExpr: "@daily", Mode: "auto"},
+		{Name: "bad-jitter", CronExpr: "@daily", JitterSeconds: -1},
+	}
+	for _, input := range inputs {
+		if _, err := scheduler.Create(input); err == nil {
+			t.Fatalf("expected create to fail for %#v", input)
+		}
+	}
+}
+
+func TestSyncScheduler_RunDueStartsSyncAndAdvances(t *testing.T) {
+	clock := &schedulerClock{now: time.Date(2026, 5, 1, 2, 59, 0, 0, time.UTC)}
+	starter := &fakeSyncStarter{}
+	scheduler := newTestSyncScheduler(t, starter, clock, 0)
+
+	schedule, err := scheduler.Create(SyncScheduleInput{
+		Name:     "hourly",
+		CronExpr: "@hourly",
+		Mode:     models.SyncModeIncremental,
+	})
+	if err != nil {
+		t.Fatalf("create schedule failed: %v", err)
+	}
+
+	scheduler.runDue(context.Background())
+	if len(starter.requests) != 0 {
+		t.Fatalf("expected no sync before due time, got %d", len(starter.requests))
+	}
+
+	clock.Set(time.Date(2026, 5, 1, 3, 0, 5, 0, time.UTC))
+	scheduler.runDue(context.Background())
+	if len(starter.requests) != 1 || starter.requests[0].Mode != models.SyncModeIncremental {
+		t.Fatalf("expected one incremental sync, got %#v", starter.requests)
+	}
+
+	schedules, err := scheduler.List()
+	if err != nil {
+		t.Fatalf("list schedules failed: %v", err)
+	}
+	got := schedules[0]
+	if got.ID != schedule.ID || got.LastRunStatus != ScheduleRunStarted {
+		t.Fatalf("unexpected schedule after run: %#v", got)
+	}
+	if want := time.Date(2026, 5, 1, 4, 0, 0, 0, time.UTC).UnixMilli(); got.NextRunAt != want {
+		t.Fatalf("expected next run %d, got %d", want, got.NextRunAt)
+	}
+}
+
+func TestSyncScheduler_PrepareRequestFillsScope(t *testing.T) {
+	clock := &schedulerClock{now: time.Date(2026, 5, 1, 2, 59, 0, 0, time.UTC)}
+	starter := &fakeSyncStarter{}
+	scheduler := newTestSyncScheduler(t, starter, clock, 0)
+	scheduler.prepareRequest = func(request *SyncStartRequest) {
+		request.RootFolderIDs = []int64{100}
+	}
+
+	if _, err := scheduler.Create(SyncScheduleInput{Name: "hourly", CronExpr: "@hourly", Mode: models.SyncModeFull}); err != nil {
+		t.Fatalf("create schedule failed: %v", err)
+	}
+	clock.Set(time.Date(2026, 5, 1, 3, 0, 5, 0, time.UTC))
+	scheduler.runDue(context.Background())
+
+	if len(starter.requests) != 1 || starter.requests[0].Mode != models.SyncModeFull || len(starter.requests[0].RootFolderIDs) != 1 || starter.requests[0].RootFolderIDs[0] != 100 {
+		t.Fatalf("expected the prepared scope to be used, got %#v", starter.requests)
+	}
+}
+
+func TestSyncScheduler_SkipsWhenSyncAlreadyRunning(t *testing.T) {
+	clock := &schedulerClock{now: time.Date(2026, 5, 1, 2, 59, 0, 0, time.UTC)}
+	starter := &fakeSyncStarter{running: true}
+	scheduler := newTestSyncScheduler(t, starter, clock, 0)
+
+	if _, err := scheduler.Create(SyncScheduleInput{Name: "hourly", CronExpr: "@hourly"}); err != nil {
+		t.Fatalf("create schedule failed: %v", err)
+	}
+
+	clock.Set(time.Date(2026, 5, 1, 3, 0, 0, 0, time.UTC))
+	scheduler.runDue(context.Background())
+
+	if len(starter.requests) != 0 {
+		t.Fatalf("expected sync to be skipped, got %d starts", len(starter.requests))
+	}
+	schedules, _ := scheduler.List()
+	if schedules[0].LastRunStatus != ScheduleRunSkipped {
+		t.Fatalf("expected skipped status, got %#v", schedules[0])
+	}
+	if want := time.Date(2026, 5, 1, 4, 0, 0, 0, time.UTC).UnixMilli(); schedules[0].NextRunAt != want {
+		t.Fatalf("expected skipped schedule to advance to %d, got %d", want, schedules[0].NextRunAt)
+	}
+}
+
+func TestSyncScheduler_RecordsStartError(t *testing.T) {
+	clock := &schedulerClock{now: time.Date(2026, 5, 1, 2, 59, 0, 0, time.UTC)}
+	starter := &fakeSyncStarter{startErr: errors.New("boom")}
+	scheduler := newTestSyncScheduler(t, starter, clock, 0)
+
+	if _, err := scheduler.Create(SyncScheduleInput{Name: "hourly", CronExpr: "@hourly"}); err != nil {
+		t.Fatalf("create schedule failed: %v", err)
+	}
+	clock.Set(time.Date(2026, 5, 1, 3, 0, 0, 0, time.UTC))
+	scheduler.runDue(context.Background())
+
+	schedules, _ := scheduler.List()
+	if schedules[0].LastRunStatus != ScheduleRunError || schedules[0].LastError != "boom" {
+		t.Fatalf("expected error status, got %#v", schedules[0])
+	}
+}
+
+func TestSyncScheduler_SkipsWhenLeaseHeldByAnotherProcess(t *testing.T) {
+	clock := &schedulerClock{now: time.Date(2026, 5, 1, 2, 59, 0, 0, time.UTC)}
+	starter := &fakeSyncStarter{startErr: &SyncLeaseHeldError{Lease: models.SyncLease{Owner: "server@b pid 2"}}}
+	scheduler := newTestSyncScheduler(t, starter, clock, 0)
+
+	if _, err := scheduler.Create(SyncScheduleInput{Name: "hourly", CronExpr: "@hourly"}); err != nil {
+		t.Fatalf("create schedule failed: %v", err)
+	}
+	clock.Set(time.Date(2026, 5, 1, 3, 0, 0, 0, time.UTC))
+	scheduler.runDue(context.Background())
+
+	schedules, _ := scheduler.List()
+	if schedules[0].LastRunStatus != ScheduleRunSkipped || !strings.Contains(schedules[0].LastError, "server@b pid 2") {
+		t.Fatalf("expected skipped status naming the lease holder, got %#v", schedules[0])
+	}
+}
+
+func TestSyncScheduler_PausedSchedulesDoNotFire(t *testing.T) {
+	clock := &schedulerClock{now: time.Date(2026, 5, 1, 2, 59, 0, 0, time.UTC)}
+	starter := &fakeSyncStarter{}
+	scheduler := newTestSyncScheduler(t, starter, clock, 0)
+
+	schedule, err := scheduler.Create(SyncScheduleInput{Name: "hourly", CronExpr: "@hourly"})
+	if err != nil {
+		t.Fatalf("create schedule failed: %v", err)
+	}
+	if _, err := scheduler.SetPaused(schedule.ID, true); err != nil {
+		t.Fatalf("pause schedule failed: %v", err)
+	}
+
+	clock.Set(time.Date(2026, 5, 1, 5, 30, 0, 0, time.UTC))
+	scheduler.runDue(context.Background())
+	if len(starter.requests) != 0 {
+		t.Fatalf("expected paused schedule not to fire")
+	}
+
+	resumed, err := scheduler.SetPaused(schedule.ID, false)
+	if err != nil {
+		t.Fatalf("resume schedule failed: %v", err)
+	}
+	if want := time.Date(2026, 5, 1, 6, 0, 0, 0, time.UTC).UnixMilli(); resumed.NextRunAt != want {
+		t.Fatalf("expected resumed next run %d, got %d", want, resumed.NextRunAt)
+	}
+
+	if _, err := scheduler.SetPaused(9999, true); !errors.Is(err, ErrSyncScheduleNotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	if err := scheduler.Delete(schedule.ID); err != nil {
+		t.Fatalf("delete schedule failed: %v", err)
+	}
+	if err := scheduler.Delete(schedule.ID); !errors.Is(err, ErrSyncScheduleNotFound) {
+		t.Fatalf("expected not found on second delete, got %v", err)
+	}
+}
+
+func TestSyncScheduler_RescheduleMissedSkipsCatchUp(t *testing.T) {
+	clock := &schedulerClock{now: time.Date(2026, 5, 1, 2, 59, 0, 0, time.UTC)}
+	starter := &fakeSyncStarter{}
+	scheduler := newTestSyncScheduler(t, starter, clock, 0)
+
+	if _, err := scheduler.Create(SyncScheduleInput{Name: "hourly", CronExpr: "@hourly"}); err != nil {
+		t.Fatalf("create schedule failed: %v", err)
+	}
+
+	clock.Set(time.Date(2026, 5, 1, 9, 20, 0, 0, time.UTC))
+	if err := scheduler.rescheduleMissed(); err != nil {
+		t.Fatalf("reschedule failed: %v", err)
+	}
+	scheduler.runDue(context.Background())
+
+	if len(starter.requests) != 0 {
+		t.Fatalf("expected missed runs not to be replayed, got %d", len(starter.requests))
+	}
+	schedules, _ := scheduler.List()
+	if want := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC).UnixMilli(); schedules[0].NextRunAt != want {
+		t.Fatalf("expected next run %d, got %d", want, schedules[0].NextRunAt)
+	}
+}
+
+func TestSyncScheduler_FireDoesNotBlockScheduleRPCs(t *testing.T) {
+	clock := &schedulerClock{now: time.Date(2026, 5, 1, 2, 59, 0, 0, time.UTC)}
+	starter := &fakeSyncStarter{}
+	scheduler := newTestSyncScheduler(t, starter, clock, 0)
+	if _, err := scheduler.Create(SyncScheduleInput{Name: "nightly", CronExpr: "0 3 * * *", Mode: models.SyncModeFull}); err != nil {
+		t.Fatalf("create schedule failed: %v", err)
+	}
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	scheduler.apiFactory = func(context.Context) (npan.API, error) {
+		close(entered)
+		<-release
+		return &mockAPI{}, nil
+	}
+
+	clock.Set(time.Date(2026, 5, 1, 3, 0, 0, 0, time.UTC))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scheduler.runDue(context.Background())
+	}()
+	<-entered
+
+	// 获取凭据期间计划仍可读写。
+	listed := make(chan error, 1)
+	go func() {
+		_, err := scheduler.List()
+		listed <- err
+	}()
+	select {
+	case err := <-listed:
+		if err != nil {
+			t.Fatalf("list schedules failed: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("List blocked while the scheduler was fetching credentials")
+	}
+
+	close(release)
+	<-done
+	schedules, err := scheduler.List()
+	if err != nil {
+		t.Fatalf("list schedules failed: %v", err)
+	}
+	if len(schedules) != 1 || schedules[0].LastRunStatus != ScheduleRunStarted || len(starter.requests) != 1 {
+		t.Fatalf("expected schedule to fire once, got %#v requests=%d", schedules, len(starter.requests))
+	}
+}
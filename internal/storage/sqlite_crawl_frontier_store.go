@@ -0,0 +1,230 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"npan/internal/models"
+)
+
+// Load 从 crawl_frontier 目录行还原断点：待处理目录按入队顺序组成 Queue，处理中目录连同续爬页码组成 InFlight。
+// 还没有目录行时，把旧版整体保存在 state_entries（或 legacy JSON 文件）中的断点迁移为目录行。
+func (s *SQLiteCheckpointStore) Load() (*models.CrawlCheckpoint, error) {
+	checkpoint, ok, err := s.loadFrontier()
+	if err != nil || ok {
+		return checkpoint, err
+	}
+
+	legacy, err := loadStateWithFallback(
+		s.stateStore,
+		stateNamespaceCheckpoint,
+		s.key,
+		s.legacyFile,
+		func(filePath string) (*models.CrawlCheckpoint, error) {
+			return NewJSONCheckpointStore(filePath).Load()
+		},
+	)
+	if err != nil || legacy == nil {
+		return legacy, err
+	}
+	if err := s.Save(legacy); err != nil {
+		return nil, err
+	}
+	checkpoint, _, err = s.loadFrontier()
+	return checkpoint, err
+}
+
+// Save 用完整断点整体替换目录行，供不按目录行增量保存的调用方使用。
+func (s *SQLiteCheckpointStore) Save(checkpoint *models.CrawlCheckpoint) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		if err := s.deleteRows(tx); err != nil {
+			return err
+		}
+		if err := s.upsertMeta(tx, checkpoint.SyncGeneration, checkpoint.CurrentFolderID, checkpoint.CurrentPageID); err != nil {
+			return err
+		}
+
+		changes := make([]models.CrawlFrontierChange, 0, len(checkpoint.InFlight)+len(checkpoint.Queue))
+		for _, cursor := range checkpoint.InFlight {
+			changes = append(changes, frontierChange(checkpoint, cursor.FolderID, models.CrawlFrontierInProgress, cursor.PageID))
+		}
+		for _, folderID := range checkpoint.Queue {
+			changes = append(changes, frontierChange(checkpoint, folderID, models.CrawlFrontierPending, 0))
+		}
+		return s.applyChanges(tx, changes)
+	})
+}
+
+// SaveFrontier 按顺序应用目录状态变化，同一目录以最后一次变化为准。
+func (s *SQLiteCheckpointStore) SaveFrontier(generation int64, changes []models.CrawlFrontierChange) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		if err := s.upsertMeta(tx, generation, nil, nil); err != nil {
+			return err
+		}
+		return s.applyChanges(tx, changes)
+	})
+}
+
+func (s *SQLiteCheckpointStore) Clear() error {
+	return s.withTx(func(tx *sql.Tx) error {
+		if err := s.deleteRows(tx); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`DELETE FROM state_entries WHERE namespace = ? AND key = ?`, stateNamespaceCheckpoint, s.key)
+		return err
+	})
+}
+
+func (s *SQLiteCheckpointStore) loadFrontier() (*models.CrawlCheckpoint, bool, error) {
+	checkpoint := &models.CrawlCheckpoint{}
+	var currentFolderID, currentPageID sql.NullInt64
+	err := s.stateStore.db.QueryRow(
+		`SELECT sync_generation, current_folder_id, current_page_id FROM crawl_checkpoints WHERE key = ?`,
+		s.key,
+	).Scan(&checkpoint.SyncGeneration, &currentFolderID, &currentPageID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if currentFolderID.Valid {
+		checkpoint.CurrentFolderID = &currentFolderID.Int64
+	}
+	if currentPageID.Valid {
+		checkpoint.CurrentPageID = &currentPageID.Int64
+	}
+
+	rows, err := s.stateStore.db.Query(
+		`SELECT folder_id, state, page_id, path_json FROM crawl_frontier
+WHERE checkpoint_key = ? AND state != ?
+ORDER BY seq`,
+		s.key,
+		string(models.CrawlFrontierDone),
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			folderID int64
+			state    string
+			pageID   int64
+			pathJSON string
+		)
+		if err := rows.Scan(&folderID, &state, &pageID, &pathJSON); err != nil {
+			return nil, false, err
+		}
+		if models.CrawlFrontierState(state) == models.CrawlFrontierInProgress {
+			checkpoint.InFlight = append(checkpoint.InFlight, models.FolderCursor{FolderID: folderID, PageID: pageID})
+		} else {
+			checkpoint.Queue = append(checkpoint.Queue, folderID)
+		}
+		if pathJSON == "" {
+			continue
+		}
+		var path models.FolderPath
+		if err := json.Unmarshal([]byte(pathJSON), &path); err != nil {
+			return nil, false, err
+		}
+		if checkpoint.FolderPaths == nil {
+			checkpoint.FolderPaths = map[int64]models.FolderPath{}
+		}
+		checkpoint.FolderPaths[folderID] = path
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	return checkpoint, true, nil
+}
+
+func (s *SQLiteCheckpointStore) withTx(fn func(tx *sql.Tx) error) error {
+	tx, err := s.stateStore.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// deleteRows 删除该断点的目录行、元数据以及旧版 state_entries 断点。
+func (s *SQLiteCheckpointStore) deleteRows(tx *sql.Tx) error {
+	if _, err := tx.Exec(`DELETE FROM crawl_frontier WHERE checkpoint_key = ?`, s.key); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM crawl_checkpoints WHERE key = ?`, s.key); err != nil {
+		return err
+	}
+	_, err := tx.Exec(`DELETE FROM state_entries WHERE namespace = ? AND key = ?`, stateNamespaceCheckpoint, s.key)
+	return err
+}
+
+// upsertMeta 写入断点元数据。currentFolderID / currentPageID 是旧版单目录断点的字段，只为 Save 的往返保留。
+func (s *SQLiteCheckpointStore) upsertMeta(tx *sql.Tx, generation int64, currentFolderID *int64, currentPageID *int64) error {
+	_, err := tx.Exec(
+		`INSERT INTO crawl_checkpoints(key, sync_generation, current_folder_id, current_page_id, updated_at_ms)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(key) DO UPDATE SET
+  sync_generation = excluded.sync_generation,
+  current_folder_id = excluded.current_folder_id,
+  current_page_id = excluded.current_page_id,
+  updated_at_ms = excluded.updated_at_ms`,
+		s.key,
+		generation,
+		currentFolderID,
+		currentPageID,
+		time.Now().UnixMilli(),
+	)
+	return err
+}
+
+// applyChanges 逐个写入目录行。已完成的目录清空路径；变化不带路径时保留已保存的路径。
+func (s *SQLiteCheckpointStore) applyChanges(tx *sql.Tx, changes []models.CrawlFrontierChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	stmt, err := tx.Prepare(`INSERT INTO crawl_frontier(checkpoint_key, folder_id, state, page_id, path_json)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(checkpoint_key, folder_id) DO UPDATE SET
+  state = excluded.state,
+  page_id = excluded.page_id,
+  path_json = CASE
+    WHEN excluded.state = 'done' THEN ''
+    WHEN excluded.path_json = '' THEN crawl_frontier.path_json
+    ELSE excluded.path_json
+  END`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, change := range changes {
+		pathJSON := ""
+		if change.Path != nil && change.State != models.CrawlFrontierDone {
+			payload, err := json.Marshal(change.Path)
+			if err != nil {
+				return err
+			}
+			pathJSON = string(payload)
+		}
+		if _, err := stmt.Exec(s.key, change.FolderID, string(change.State), change.PageID, pathJSON); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func frontierChange(checkpoint *models.CrawlCheckpoint, folderID int64, state models.CrawlFrontierState, pageID int64) models.CrawlFrontierChange {
+	change := models.CrawlFrontierChange{FolderID: folderID, State: state, PageID: pageID}
+	if path, ok := checkpoint.FolderPaths[folderID]; ok {
+		change.Path = &path
+	}
+	return change
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"npan/internal/models"
+)
+
+func TestSQLiteCheckpointStore_MigratesBlobAndAppliesFrontierChanges(t *testing.T) {
+	stores, err := NewSQLiteStateStores(SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "sync-state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	t.Cleanup(func() { _ = stores.DB.Close() })
+
+	// 旧版断点整体保存在 state_entries 中。
+	legacy := &models.CrawlCheckpoint{
+		Queue:    []int64{3, 5},
+		InFlight: []models.FolderCursor{{FolderID: 2, PageID: 1}},
+		FolderPaths: map[int64]models.FolderPath{
+			2: {PathText: "资料/a", Lineage: []int64{1, 2}},
+			3: {PathText: "资料/b", Lineage: []int64{1, 3}},
+			5: {PathText: "资料/d", Lineage: []int64{1, 5}},
+		},
+		SyncGeneration: 42,
+	}
+	stateStore := &sqliteStateStore{db: stores.DB}
+	if err := saveStateEntry(stateStore, stateNamespaceCheckpoint, "root-1", legacy); err != nil {
+		t.Fatalf("seed legacy checkpoint failed: %v", err)
+	}
+
+	store := stores.CheckpointStoreFactory.ForKey("root-1").(*SQLiteCheckpointStore)
+	got, err := store.Load()
+	if err != nil {
+		t.Fatalf("load checkpoint failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, legacy) {
+		t.Fatalf("unexpected migrated checkpoint: %#v", got)
+	}
+	if _, ok, _ := stateStore.loadEntry(stateNamespaceCheckpoint, "root-1"); ok {
+		t.Fatal("expected legacy checkpoint blob to be removed after migration")
+	}
+
+	pathD := models.FolderPath{PathText: "资料/a/c", Lineage: []int64{1, 2, 4}}
+	if err := store.SaveFrontier(42, []models.CrawlFrontierChange{
+		{FolderID: 3, State: models.CrawlFrontierInProgress},
+		{FolderID: 2, State: models.CrawlFrontierInProgress, PageID: 2},
+		{FolderID: 4, State: models.CrawlFrontierPending, Path: &pathD},
+		{FolderID: 2, State: models.CrawlFrontierDone},
+	}); err != nil {
+		t.Fatalf("save frontier failed: %v", err)
+	}
+
+	got, err = store.Load()
+	if err != nil {
+		t.Fatalf("reload checkpoint failed: %v", err)
+	}
+	want := &models.CrawlCheckpoint{
+		Queue:    []int64{5, 4},
+		InFlight: []models.FolderCursor{{FolderID: 3, PageID: 0}},
+		FolderPaths: map[int64]models.FolderPath{
+			3: {PathText: "资料/b", Lineage: []int64{1, 3}},
+			4: pathD,
+			5: {PathText: "资料/d", Lineage: []int64{1, 5}},
+		},
+		SyncGeneration: 42,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected checkpoint after frontier changes: %#v", got)
+	}
+
+	if err := store.Clear(); err != nil {
+		t.Fatalf("clear checkpoint failed: %v", err)
+	}
+	if got, err := store.Load(); err != nil || got != nil {
+		t.Fatalf("expected cleared checkpoint, got %#v err=%v", got, err)
+	}
+}
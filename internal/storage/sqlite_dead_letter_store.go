@@ -0,0 +1,181 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"npan/internal/models"
+)
+
+// DeadLetterStore 保存重试后仍写入失败的索引批次，供人工重放或丢弃。
+type DeadLetterStore interface {
+	Add(letter *models.DeadLetter) error
+	Get(id int64) (*models.DeadLetter, error)
+	List(filter DeadLetterFilter) ([]models.DeadLetter, error)
+	// RecordFailure 记录一次失败的重放：尝试次数加一并更新错误信息。
+	RecordFailure(id int64, message string, now int64) error
+	Delete(id int64) (bool, error)
+	Count() (int64, error)
+}
+
+// DeadLetterFilter 按 ID 倒序分页：BeforeID 大于 0 时只返回更早的记录。
+type DeadLetterFilter struct {
+	RootFolderID int64
+	Limit        int
+	BeforeID     int64
+}
+
+// SQLiteDeadLetterStore 只读写 tenantID 对应租户的死信，默认租户为空字符串。
+type SQLiteDeadLetterStore struct {
+	db       *sql.DB
+	tenantID string
+}
+
+const defaultDeadLetterListLimit = 50
+
+const deadLetterColumns = `id, run_id, root_folder_id, documents_json, error, attempts, created_at_ms, updated_at_ms`
+
+func scanDeadLetter(row rowScanner) (models.DeadLetter, error) {
+	var letter models.DeadLetter
+	var documentsJSON string
+	err := row.Scan(
+		&letter.ID,
+		&letter.RunID,
+		&letter.RootFolderID,
+		&documentsJSON,
+		&letter.Error,
+		&letter.Attempts,
+		&letter.CreatedAt,
+		&letter.UpdatedAt,
+	)
+	if err != nil {
+		return letter, err
+	}
+	if err := json.Unmarshal([]byte(documentsJSON), &letter.Documents); err != nil {
+		return letter, err
+	}
+	return letter, nil
+}
+
+// Add 插入死信记录，并把生成的 ID 回写到 letter。
+func (s *SQLiteDeadLetterStore) Add(letter *models.DeadLetter) error {
+	documents := letter.Documents
+	if documents == nil {
+		documents = []models.IndexDocument{}
+	}
+	encoded, err := json.Marshal(documents)
+	if err != nil {
+		return err
+	}
+	if letter.Attempts <= 0 {
+		letter.Attempts = 1
+	}
+	if letter.UpdatedAt == 0 {
+		letter.UpdatedAt = letter.CreatedAt
+	}
+
+	result, err := s.db.Exec(
+		`INSERT INTO dead_letters(tenant_id, run_id, root_folder_id, doc_count, documents_json, error, attempts, created_at_ms, updated_at_ms)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		s.tenantID,
+		letter.RunID,
+		letter.RootFolderID,
+		len(documents),
+		string(encoded),
+		letter.Error,
+		letter.Attempts,
+		letter.CreatedAt,
+		letter.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	letter.ID = id
+	return nil
+}
+
+func (s *SQLiteDeadLetterStore) Get(id int64) (*models.DeadLetter, error) {
+	letter, err := scanDeadLetter(s.db.QueryRow(
+		`SELECT `+deadLetterColumns+` FROM dead_letters WHERE id = ? AND tenant_id = ?`,
+		id,
+		s.tenantID,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &letter, nil
+}
+
+// List 按 ID 倒序返回死信记录，最新的在前。
+func (s *SQLiteDeadLetterStore) List(filter DeadLetterFilter) ([]models.DeadLetter, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultDeadLetterListLimit
+	}
+
+	query := `SELECT ` + deadLetterColumns + ` FROM dead_letters WHERE tenant_id = ?`
+	args := []any{s.tenantID}
+	if filter.RootFolderID > 0 {
+		query += ` AND root_folder_id = ?`
+		args = append(args, filter.RootFolderID)
+	}
+	if filter.BeforeID > 0 {
+		query += ` AND id < ?`
+		args = append(args, filter.BeforeID)
+	}
+	query += ` ORDER BY id DESC LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	letters := make([]models.DeadLetter, 0)
+	for rows.Next() {
+		letter, err := scanDeadLetter(rows)
+		if err != nil {
+			return nil, err
+		}
+		letters = append(letters, letter)
+	}
+	return letters, rows.Err()
+}
+
+func (s *SQLiteDeadLetterStore) RecordFailure(id int64, message string, now int64) error {
+	_, err := s.db.Exec(
+		`UPDATE dead_letters SET attempts = attempts + 1, error = ?, updated_at_ms = ? WHERE id = ? AND tenant_id = ?`,
+		message,
+		now,
+		id,
+		s.tenantID,
+	)
+	return err
+}
+
+func (s *SQLiteDeadLetterStore) Delete(id int64) (bool, error) {
+	result, err := s.db.Exec(`DELETE FROM dead_letters WHERE id = ? AND tenant_id = ?`, id, s.tenantID)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (s *SQLiteDeadLetterStore) Count() (int64, error) {
+	var count int64
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM dead_letters WHERE tenant_id = ?`, s.tenantID).Scan(&count)
+	return count, err
+}
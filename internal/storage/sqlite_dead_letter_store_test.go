@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"npan/internal/models"
+)
+
+func TestSQLiteDeadLetterStore_AddReplayFailureAndDelete(t *testing.T) {
+	stores, err := NewSQLiteStateStores(SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "sync-state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer stores.DB.Close()
+
+	store := stores.DeadLetterStore
+	first := &models.DeadLetter{
+		RunID:        7,
+		RootFolderID: 100,
+		Documents:    []models.IndexDocument{{DocID: "file_1", SourceID: 1, Type: models.ItemTypeFile, Name: "a.pdf"}},
+		Error:        "bad request",
+		CreatedAt:    1_710_000_000_000,
+	}
+	if err := store.Add(first); err != nil {
+		t.Fatalf("add dead letter failed: %v", err)
+	}
+	second := &models.DeadLetter{RootFolderID: 200, Documents: []models.IndexDocument{{DocID: "file_2"}}, Error: "timeout", CreatedAt: 1_710_000_000_100}
+	if err := store.Add(second); err != nil {
+		t.Fatalf("add dead letter failed: %v", err)
+	}
+
+	got, err := store.Get(first.ID)
+	if err != nil {
+		t.Fatalf("get dead letter failed: %v", err)
+	}
+	if got == nil || got.RunID != 7 || got.Attempts != 1 || got.UpdatedAt != first.CreatedAt || len(got.Documents) != 1 || got.Documents[0].Name != "a.pdf" {
+		t.Fatalf("unexpected dead letter: %#v", got)
+	}
+	if missing, err := store.Get(9999); err != nil || missing != nil {
+		t.Fatalf("expected missing dead letter to return nil,nil, got %#v %v", missing, err)
+	}
+
+	if err := store.RecordFailure(first.ID, "still failing", 1_710_000_000_500); err != nil {
+		t.Fatalf("record failure failed: %v", err)
+	}
+	got, err = store.Get(first.ID)
+	if err != nil {
+		t.Fatalf("get dead letter failed: %v", err)
+	}
+	if got.Attempts != 2 || got.Error != "still failing" || got.UpdatedAt != 1_710_000_000_500 {
+		t.Fatalf("expected replay failure to be recorded, got %#v", got)
+	}
+
+	byRoot, err := store.List(DeadLetterFilter{RootFolderID: 200})
+	if err != nil {
+		t.Fatalf("list dead letters failed: %v", err)
+	}
+	if len(byRoot) != 1 || byRoot[0].ID != second.ID {
+		t.Fatalf("expected only root 200 dead letter, got %#v", byRoot)
+	}
+	page, err := store.List(DeadLetterFilter{Limit: 1})
+	if err != nil {
+		t.Fatalf("list dead letters failed: %v", err)
+	}
+	if len(page) != 1 || page[0].ID != second.ID {
+		t.Fatalf("expected newest dead letter first, got %#v", page)
+	}
+
+	deleted, err := store.Delete(second.ID)
+	if err != nil || !deleted {
+		t.Fatalf("expected delete to remove the record, got %v %v", deleted, err)
+	}
+	deleted, err = store.Delete(second.ID)
+	if err != nil || deleted {
+		t.Fatalf("expected second delete to report nothing removed, got %v %v", deleted, err)
+	}
+	count, err := store.Count()
+	if err != nil || count != 1 {
+		t.Fatalf("expected one outstanding dead letter, got %d %v", count, err)
+	}
+}
@@ -0,0 +1,191 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"npan/internal/models"
+)
+
+// IndexChangeStore 是索引变更日志，按写入顺序分配递增序号，供下游断线后按序号续读。
+// 序号在所有租户间全局递增，因此同一租户的序号可能不连续。
+type IndexChangeStore interface {
+	// Append 在一个事务内追加变更，并把分配的序号回写到 changes。
+	Append(changes []models.IndexChange) error
+	// ListAfter 按序号升序返回大于 afterSeq 的变更，最多 limit 条。
+	ListAfter(afterSeq int64, limit int) ([]models.IndexChange, error)
+	// Bounds 返回已清理变更中的最大序号与已分配过的最大序号；从未清理时 pruned 为 0。
+	Bounds() (pruned int64, latest int64, err error)
+	// PruneBefore 删除早于 cutoff（毫秒）的变更，返回删除条数。
+	PruneBefore(cutoff int64) (int64, error)
+}
+
+// SQLiteIndexChangeStore 只读写 tenantID 对应租户的变更，默认租户为空字符串。
+type SQLiteIndexChangeStore struct {
+	db       *sql.DB
+	tenantID string
+}
+
+const defaultIndexChangeListLimit = 500
+
+func (s *SQLiteIndexChangeStore) Append(changes []models.IndexChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	stmt, err := tx.Prepare(`INSERT INTO index_changes(tenant_id, op, doc_id, document_json, root_folder_id, run_id, removed, occurred_at_ms)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	seqs := make([]int64, len(changes))
+	for i := range changes {
+		change := &changes[i]
+		documentJSON := ""
+		if change.Document != nil {
+			encoded, err := json.Marshal(change.Document)
+			if err != nil {
+				return err
+			}
+			documentJSON = string(encoded)
+		}
+		result, err := stmt.Exec(
+			s.tenantID,
+			string(change.Op),
+			change.DocID,
+			documentJSON,
+			change.RootFolderID,
+			change.RunID,
+			change.Removed,
+			change.OccurredAt,
+		)
+		if err != nil {
+			return err
+		}
+		if seqs[i], err = result.LastInsertId(); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	for i := range changes {
+		changes[i].Seq = seqs[i]
+	}
+	return nil
+}
+
+func (s *SQLiteIndexChangeStore) ListAfter(afterSeq int64, limit int) ([]models.IndexChange, error) {
+	if limit <= 0 {
+		limit = defaultIndexChangeListLimit
+	}
+
+	rows, err := s.db.Query(
+		`SELECT seq, op, doc_id, document_json, root_folder_id, run_id, removed, occurred_at_ms
+FROM index_changes WHERE tenant_id = ? AND seq > ? ORDER BY seq ASC LIMIT ?`,
+		s.tenantID,
+		afterSeq,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := make([]models.IndexChange, 0)
+	for rows.Next() {
+		var change models.IndexChange
+		var op string
+		var documentJSON string
+		if err := rows.Scan(
+			&change.Seq,
+			&op,
+			&change.DocID,
+			&documentJSON,
+			&change.RootFolderID,
+			&change.RunID,
+			&change.Removed,
+			&change.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		change.Op = models.IndexChangeOp(op)
+		if documentJSON != "" {
+			var doc models.IndexDocument
+			if err := json.Unmarshal([]byte(documentJSON), &doc); err != nil {
+				return nil, err
+			}
+			change.Document = &doc
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
+
+// Bounds 的 latest 取自 sqlite_sequence，日志被清空后序号仍然延续；pruned 是本租户的清理水位，
+// 消费方的序号小于 pruned 时说明有变更已被清理。
+func (s *SQLiteIndexChangeStore) Bounds() (int64, int64, error) {
+	var pruned int64
+	err := s.db.QueryRow(`SELECT pruned_seq FROM index_change_prunes WHERE tenant_id = ?`, s.tenantID).Scan(&pruned)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, 0, err
+	}
+
+	var latest int64
+	err = s.db.QueryRow(`SELECT seq FROM sqlite_sequence WHERE name = 'index_changes'`).Scan(&latest)
+	if errors.Is(err, sql.ErrNoRows) {
+		return pruned, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	return pruned, latest, nil
+}
+
+// PruneBefore 在删除的同一事务内推进清理水位，水位只增不减。
+func (s *SQLiteIndexChangeStore) PruneBefore(cutoff int64) (int64, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var maxSeq sql.NullInt64
+	if err := tx.QueryRow(
+		`SELECT MAX(seq) FROM index_changes WHERE tenant_id = ? AND occurred_at_ms < ?`,
+		s.tenantID,
+		cutoff,
+	).Scan(&maxSeq); err != nil {
+		return 0, err
+	}
+	if !maxSeq.Valid {
+		return 0, nil
+	}
+
+	result, err := tx.Exec(`DELETE FROM index_changes WHERE tenant_id = ? AND occurred_at_ms < ?`, s.tenantID, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if _, err := tx.Exec(
+		`INSERT INTO index_change_prunes(tenant_id, pruned_seq) VALUES (?, ?)
+ON CONFLICT(tenant_id) DO UPDATE SET pruned_seq = MAX(pruned_seq, excluded.pruned_seq)`,
+		s.tenantID,
+		maxSeq.Int64,
+	); err != nil {
+		return 0, err
+	}
+	return removed, tx.Commit()
+}
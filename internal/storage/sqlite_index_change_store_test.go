@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"npan/internal/models"
+)
+
+func TestSQLiteIndexChangeStore_AppendListAndPrune(t *testing.T) {
+	stores, err := NewSQLiteStateStores(SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "sync-state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer stores.DB.Close()
+
+	store := stores.IndexChangeStore
+	if pruned, latest, err := store.Bounds(); err != nil || pruned != 0 || latest != 0 {
+		t.Fatalf("expected empty bounds, got %d %d %v", pruned, latest, err)
+	}
+
+	changes := []models.IndexChange{
+		{Op: models.IndexChangeUpsert, DocID: "file_1", Document: &models.IndexDocument{DocID: "file_1", Name: "a.pdf"}, RootFolderID: 100, RunID: 3, OccurredAt: 1_000},
+		{Op: models.IndexChangeDelete, DocID: "file_2", OccurredAt: 2_000},
+		{Op: models.IndexChangeSweep, RootFolderID: 100, Removed: 4, OccurredAt: 3_000},
+	}
+	if err := store.Append(changes); err != nil {
+		t.Fatalf("append changes failed: %v", err)
+	}
+	if changes[0].Seq != 1 || changes[2].Seq != 3 {
+		t.Fatalf("expected sequences to be written back, got %#v", changes)
+	}
+
+	listed, err := store.ListAfter(1, 10)
+	if err != nil {
+		t.Fatalf("list changes failed: %v", err)
+	}
+	if len(listed) != 2 || listed[0].Seq != 2 || listed[0].Op != models.IndexChangeDelete || listed[1].Removed != 4 {
+		t.Fatalf("unexpected listed changes: %#v", listed)
+	}
+	first, err := store.ListAfter(0, 1)
+	if err != nil {
+		t.Fatalf("list changes failed: %v", err)
+	}
+	if len(first) != 1 || first[0].Document == nil || first[0].Document.Name != "a.pdf" || first[0].RunID != 3 {
+		t.Fatalf("unexpected first change: %#v", first)
+	}
+
+	removed, err := store.PruneBefore(3_000)
+	if err != nil || removed != 2 {
+		t.Fatalf("expected two pruned changes, got %d %v", removed, err)
+	}
+	if pruned, latest, err := store.Bounds(); err != nil || pruned != 2 || latest != 3 {
+		t.Fatalf("unexpected bounds after prune: %d %d %v", pruned, latest, err)
+	}
+
+	if _, err := store.PruneBefore(10_000); err != nil {
+		t.Fatalf("prune all failed: %v", err)
+	}
+	if pruned, latest, err := store.Bounds(); err != nil || pruned != 3 || latest != 3 {
+		t.Fatalf("expected sequence to survive empty log, got %d %d %v", pruned, latest, err)
+	}
+	more := []models.IndexChange{{Op: models.IndexChangeReset, OccurredAt: 11_000}}
+	if err := store.Append(more); err != nil {
+		t.Fatalf("append after prune failed: %v", err)
+	}
+	if more[0].Seq != 4 {
+		t.Fatalf("expected sequence not to be reused, got %d", more[0].Seq)
+	}
+}
@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+
+	"npan/internal/models"
+)
+
+// OAuthTokenStore 持久化加密后的 OAuth token，按凭据键覆盖写入。
+type OAuthTokenStore interface {
+	// Load 返回 key 对应的 token，没有记录时返回 nil。
+	Load(key string) (*models.StoredOAuthToken, error)
+	Save(token models.StoredOAuthToken) error
+}
+
+type SQLiteOAuthTokenStore struct {
+	db *sql.DB
+}
+
+func (s *SQLiteOAuthTokenStore) Load(key string) (*models.StoredOAuthToken, error) {
+	token := models.StoredOAuthToken{Key: key}
+	err := s.db.QueryRow(
+		`SELECT ciphertext, expires_at_ms, updated_at_ms FROM oauth_tokens WHERE key = ?`,
+		key,
+	).Scan(&token.Ciphertext, &token.ExpiresAt, &token.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (s *SQLiteOAuthTokenStore) Save(token models.StoredOAuthToken) error {
+	_, err := s.db.Exec(
+		`INSERT INTO oauth_tokens(key, ciphertext, expires_at_ms, updated_at_ms)
+VALUES (?, ?, ?, ?)
+ON CONFLICT(key) DO UPDATE SET
+  ciphertext = excluded.ciphertext,
+  expires_at_ms = excluded.expires_at_ms,
+  updated_at_ms = excluded.updated_at_ms`,
+		token.Key,
+		token.Ciphertext,
+		token.ExpiresAt,
+		token.UpdatedAt,
+	)
+	return err
+}
@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"npan/internal/models"
+)
+
+func TestSQLiteOAuthTokenStore_SaveAndLoad(t *testing.T) {
+	stores, err := NewSQLiteStateStores(SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "sync-state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer stores.DB.Close()
+
+	store := stores.OAuthTokenStore
+	if token, err := store.Load("missing"); err != nil || token != nil {
+		t.Fatalf("expected no token, got %#v %v", token, err)
+	}
+
+	if err := store.Save(models.StoredOAuthToken{Key: "k", Ciphertext: []byte{1, 2, 3}, ExpiresAt: 2_000, UpdatedAt: 1_000}); err != nil {
+		t.Fatalf("save token failed: %v", err)
+	}
+	if err := store.Save(models.StoredOAuthToken{Key: "k", Ciphertext: []byte{4, 5}, ExpiresAt: 4_000, UpdatedAt: 3_000}); err != nil {
+		t.Fatalf("overwrite token failed: %v", err)
+	}
+
+	token, err := store.Load("k")
+	if err != nil {
+		t.Fatalf("load token failed: %v", err)
+	}
+	if token == nil || !bytes.Equal(token.Ciphertext, []byte{4, 5}) || token.ExpiresAt != 4_000 || token.UpdatedAt != 3_000 {
+		t.Fatalf("expected overwritten token, got %#v", token)
+	}
+}
@@ -0,0 +1,257 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"npan/internal/models"
+)
+
+// ReconciliationStore 保存逐目录对账报告及其漂移表。
+type ReconciliationStore interface {
+	// Create 插入报告头，并把生成的 ID 回写到 report。
+	Create(report *models.ReconciliationReport) error
+	// Update 更新报告状态与计数，不修改已写入的行。
+	Update(report *models.ReconciliationReport) error
+	// AddRows 在一个事务内追加报告行。
+	AddRows(reportID int64, rows []models.ReconciliationRow) error
+	// Get 返回报告头，不含行；没有记录时返回 nil。
+	Get(id int64) (*models.ReconciliationReport, error)
+	// Latest 返回最近一次报告头，没有记录时返回 nil。
+	Latest() (*models.ReconciliationReport, error)
+	// ListRows 按根目录、目录 ID 升序返回报告行，limit 不大于 0 时返回全部。
+	ListRows(reportID int64, filter ReconciliationRowFilter) ([]models.ReconciliationRow, error)
+	// PruneKeepLatest 只保留最近 keep 份报告，返回删除的报告数。
+	PruneKeepLatest(keep int) (int64, error)
+}
+
+// ReconciliationRowFilter 中 OnlyDrift 为 true 时只返回有漂移或查询失败的目录。
+type ReconciliationRowFilter struct {
+	OnlyDrift bool
+	Limit     int
+}
+
+// SQLiteReconciliationStore 只读写 tenantID 对应租户的报告，默认租户为空字符串；报告行随报告头按报告 ID 归属租户。
+type SQLiteReconciliationStore struct {
+	db       *sql.DB
+	tenantID string
+}
+
+const reconciliationReportColumns = `id, status, roots_json, sample_size, started_at_ms, finished_at_ms,
+  folders_checked, folders_drifted, error`
+
+func scanReconciliationReport(row rowScanner) (models.ReconciliationReport, error) {
+	var report models.ReconciliationReport
+	var rootsJSON string
+	err := row.Scan(
+		&report.ID,
+		&report.Status,
+		&rootsJSON,
+		&report.SampleSize,
+		&report.StartedAt,
+		&report.FinishedAt,
+		&report.FoldersChecked,
+		&report.FoldersDrifted,
+		&report.Error,
+	)
+	if err != nil {
+		return report, err
+	}
+	if err := json.Unmarshal([]byte(rootsJSON), &report.Roots); err != nil {
+		return report, err
+	}
+	return report, nil
+}
+
+func (s *SQLiteReconciliationStore) Create(report *models.ReconciliationReport) error {
+	roots := report.Roots
+	if roots == nil {
+		roots = []int64{}
+	}
+	rootsJSON, err := json.Marshal(roots)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.db.Exec(
+		`INSERT INTO reconciliation_reports(tenant_id, status, roots_json, sample_size, started_at_ms, finished_at_ms, folders_checked, folders_drifted, error)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		s.tenantID,
+		report.Status,
+		string(rootsJSON),
+		report.SampleSize,
+		report.StartedAt,
+		report.FinishedAt,
+		report.FoldersChecked,
+		report.FoldersDrifted,
+		report.Error,
+	)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	report.ID = id
+	return nil
+}
+
+func (s *SQLiteReconciliationStore) Update(report *models.ReconciliationReport) error {
+	_, err := s.db.Exec(
+		`UPDATE reconciliation_reports SET status = ?, finished_at_ms = ?, folders_checked = ?, folders_drifted = ?, error = ?
+WHERE id = ? AND tenant_id = ?`,
+		report.Status,
+		report.FinishedAt,
+		report.FoldersChecked,
+		report.FoldersDrifted,
+		report.Error,
+		report.ID,
+		s.tenantID,
+	)
+	return err
+}
+
+func (s *SQLiteReconciliationStore) AddRows(reportID int64, rows []models.ReconciliationRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	stmt, err := tx.Prepare(`INSERT INTO reconciliation_rows(report_id, root_folder_id, folder_id, path, upstream_items, indexed_items, drift, error)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, row := range rows {
+		if _, err := stmt.Exec(
+			reportID,
+			row.RootFolderID,
+			row.FolderID,
+			row.Path,
+			row.UpstreamItems,
+			row.IndexedItems,
+			row.Drift,
+			row.Error,
+		); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *SQLiteReconciliationStore) Get(id int64) (*models.ReconciliationReport, error) {
+	report, err := scanReconciliationReport(s.db.QueryRow(
+		`SELECT `+reconciliationReportColumns+` FROM reconciliation_reports WHERE id = ? AND tenant_id = ?`,
+		id,
+		s.tenantID,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+func (s *SQLiteReconciliationStore) Latest() (*models.ReconciliationReport, error) {
+	report, err := scanReconciliationReport(s.db.QueryRow(
+		`SELECT `+reconciliationReportColumns+` FROM reconciliation_reports WHERE tenant_id = ? ORDER BY id DESC LIMIT 1`,
+		s.tenantID,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+func (s *SQLiteReconciliationStore) ListRows(reportID int64, filter ReconciliationRowFilter) ([]models.ReconciliationRow, error) {
+	query := `SELECT root_folder_id, folder_id, path, upstream_items, indexed_items, drift, error
+FROM reconciliation_rows WHERE report_id = ?`
+	args := []any{reportID}
+	if filter.OnlyDrift {
+		query += ` AND (drift != 0 OR error != '')`
+	}
+	query += ` ORDER BY root_folder_id, folder_id`
+	if filter.Limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]models.ReconciliationRow, 0)
+	for rows.Next() {
+		var row models.ReconciliationRow
+		if err := rows.Scan(
+			&row.RootFolderID,
+			&row.FolderID,
+			&row.Path,
+			&row.UpstreamItems,
+			&row.IndexedItems,
+			&row.Drift,
+			&row.Error,
+		); err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+func (s *SQLiteReconciliationStore) PruneKeepLatest(keep int) (int64, error) {
+	if keep <= 0 {
+		return 0, nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var cutoff int64
+	err = tx.QueryRow(
+		`SELECT id FROM reconciliation_reports WHERE tenant_id = ? ORDER BY id DESC LIMIT 1 OFFSET ?`,
+		s.tenantID,
+		keep-1,
+	).Scan(&cutoff)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if _, err := tx.Exec(
+		`DELETE FROM reconciliation_rows WHERE report_id IN (SELECT id FROM reconciliation_reports WHERE tenant_id = ? AND id < ?)`,
+		s.tenantID,
+		cutoff,
+	); err != nil {
+		return 0, err
+	}
+	result, err := tx.Exec(`DELETE FROM reconciliation_reports WHERE tenant_id = ? AND id < ?`, s.tenantID, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return removed, tx.Commit()
+}
@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"npan/internal/models"
+)
+
+func TestSQLiteReconciliationStore_RowsAndPrune(t *testing.T) {
+	stores, err := NewSQLiteStateStores(SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "sync-state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer stores.DB.Close()
+
+	store := stores.ReconciliationStore
+	if latest, err := store.Latest(); err != nil || latest != nil {
+		t.Fatalf("expected empty store to return nil,nil, got %#v %v", latest, err)
+	}
+
+	report := &models.ReconciliationReport{Status: "running", Roots: []int64{100}, SampleSize: 50, StartedAt: 1_710_000_000_000}
+	if err := store.Create(report); err != nil {
+		t.Fatalf("create report failed: %v", err)
+	}
+	if err := store.AddRows(report.ID, []models.ReconciliationRow{
+		{RootFolderID: 100, FolderID: 300, Path: "root/b", UpstreamItems: 4, IndexedItems: 4},
+		{RootFolderID: 100, FolderID: 200, Path: "root/a", UpstreamItems: 5, IndexedItems: 3, Drift: -2},
+		{RootFolderID: 100, FolderID: 400, Path: "root/c", Error: "not found"},
+	}); err != nil {
+		t.Fatalf("add rows failed: %v", err)
+	}
+	report.Status = "done"
+	report.FinishedAt = 1_710_000_000_500
+	report.FoldersChecked = 3
+	report.FoldersDrifted = 1
+	if err := store.Update(report); err != nil {
+		t.Fatalf("update report failed: %v", err)
+	}
+
+	got, err := store.Get(report.ID)
+	if err != nil {
+		t.Fatalf("get report failed: %v", err)
+	}
+	if got == nil || got.Status != "done" || got.SampleSize != 50 || got.FoldersDrifted != 1 || len(got.Roots) != 1 || got.Roots[0] != 100 {
+		t.Fatalf("unexpected report: %#v", got)
+	}
+
+	all, err := store.ListRows(report.ID, ReconciliationRowFilter{})
+	if err != nil {
+		t.Fatalf("list rows failed: %v", err)
+	}
+	if len(all) != 3 || all[0].FolderID != 200 || all[2].FolderID != 400 {
+		t.Fatalf("expected rows ordered by folder id, got %#v", all)
+	}
+	drifted, err := store.ListRows(report.ID, ReconciliationRowFilter{OnlyDrift: true})
+	if err != nil {
+		t.Fatalf("list drift rows failed: %v", err)
+	}
+	if len(drifted) != 2 || drifted[0].Drift != -2 || drifted[1].Error != "not found" {
+		t.Fatalf("expected drift and error rows only, got %#v", drifted)
+	}
+	if limited, err := store.ListRows(report.ID, ReconciliationRowFilter{Limit: 1}); err != nil || len(limited) != 1 {
+		t.Fatalf("expected limit to apply, got %#v %v", limited, err)
+	}
+
+	newer := &models.ReconciliationReport{Status: "done", StartedAt: 1_710_000_001_000}
+	if err := store.Create(newer); err != nil {
+		t.Fatalf("create newer report failed: %v", err)
+	}
+	removed, err := store.PruneKeepLatest(1)
+	if err != nil || removed != 1 {
+		t.Fatalf("expected one report pruned, got %d %v", removed, err)
+	}
+	if old, err := store.Get(report.ID); err != nil || old != nil {
+		t.Fatalf("expected pruned report to be gone, got %#v %v", old, err)
+	}
+	if rows, err := store.ListRows(report.ID, ReconciliationRowFilter{}); err != nil || len(rows) != 0 {
+		t.Fatalf("expected pruned rows to be gone, got %#v %v", rows, err)
+	}
+	latest, err := store.Latest()
+	if err != nil || latest == nil || latest.ID != newer.ID {
+		t.Fatalf("expected latest report %d, got %#v %v", newer.ID, latest, err)
+	}
+}
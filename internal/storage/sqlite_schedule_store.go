@@ -0,0 +1,165 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"npan/internal/models"
+)
+
+// SyncScheduleStore 持久化内置调度器的同步计划。
+type SyncScheduleStore interface {
+	List() ([]models.SyncSchedule, error)
+	Get(id int64) (*models.SyncSchedule, error)
+	Create(schedule *models.SyncSchedule) error
+	Update(schedule *models.SyncSchedule) error
+	Delete(id int64) (bool, error)
+}
+
+// SQLiteSyncScheduleStore 只读写 tenantID 对应租户的计划，默认租户为空字符串。
+type SQLiteSyncScheduleStore struct {
+	db       *sql.DB
+	tenantID string
+}
+
+const syncScheduleColumns = `id, name, cron_expr, mode, jitter_seconds, paused, next_run_at_ms,
+  last_run_at_ms, last_run_status, last_error, created_at_ms, updated_at_ms`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSyncSchedule(row rowScanner) (models.SyncSchedule, error) {
+	var schedule models.SyncSchedule
+	var mode string
+	var paused int64
+	err := row.Scan(
+		&schedule.ID,
+		&schedule.Name,
+		&schedule.CronExpr,
+		&mode,
+		&schedule.JitterSeconds,
+		&paused,
+		&schedule.NextRunAt,
+		&schedule.LastRunAt,
+		&schedule.LastRunStatus,
+		&schedule.LastError,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+	)
+	schedule.Mode = models.SyncMode(mode)
+	schedule.Paused = paused != 0
+	return schedule, err
+}
+
+func boolToInt(value bool) int64 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+func (s *SQLiteSyncScheduleStore) List() ([]models.SyncSchedule, error) {
+	rows, err := s.db.Query(`SELECT `+syncScheduleColumns+` FROM sync_schedules WHERE tenant_id = ? ORDER BY id`, s.tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schedules := make([]models.SyncSchedule, 0)
+	for rows.Next() {
+		schedule, err := scanSyncSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, rows.Err()
+}
+
+func (s *SQLiteSyncScheduleStore) Get(id int64) (*models.SyncSchedule, error) {
+	schedule, err := scanSyncSchedule(s.db.QueryRow(
+		`SELECT `+syncScheduleColumns+` FROM sync_schedules WHERE id = ? AND tenant_id = ?`,
+		id,
+		s.tenantID,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// Create 插入新计划，并把生成的 ID 与时间戳回写到 schedule。
+func (s *SQLiteSyncScheduleStore) Create(schedule *models.SyncSchedule) error {
+	now := time.Now().UnixMilli()
+	if schedule.CreatedAt == 0 {
+		schedule.CreatedAt = now
+	}
+	schedule.UpdatedAt = now
+
+	result, err := s.db.Exec(
+		`INSERT INTO sync_schedules(tenant_id, name, cron_expr, mode, jitter_seconds, paused, next_run_at_ms,
+  last_run_at_ms, last_run_status, last_error, created_at_ms, updated_at_ms)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		s.tenantID,
+		schedule.Name,
+		schedule.CronExpr,
+		string(schedule.Mode),
+		schedule.JitterSeconds,
+		boolToInt(schedule.Paused),
+		schedule.NextRunAt,
+		schedule.LastRunAt,
+		schedule.LastRunStatus,
+		schedule.LastError,
+		schedule.CreatedAt,
+		schedule.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	schedule.ID = id
+	return nil
+}
+
+func (s *SQLiteSyncScheduleStore) Update(schedule *models.SyncSchedule) error {
+	schedule.UpdatedAt = time.Now().UnixMilli()
+	_, err := s.db.Exec(
+		`UPDATE sync_schedules SET
+  name = ?, cron_expr = ?, mode = ?, jitter_seconds = ?, paused = ?, next_run_at_ms = ?,
+  last_run_at_ms = ?, last_run_status = ?, last_error = ?, updated_at_ms = ?
+WHERE id = ? AND tenant_id = ?`,
+		schedule.Name,
+		schedule.CronExpr,
+		string(schedule.Mode),
+		schedule.JitterSeconds,
+		boolToInt(schedule.Paused),
+		schedule.NextRunAt,
+		schedule.LastRunAt,
+		schedule.LastRunStatus,
+		schedule.LastError,
+		schedule.UpdatedAt,
+		schedule.ID,
+		s.tenantID,
+	)
+	return err
+}
+
+func (s *SQLiteSyncScheduleStore) Delete(id int64) (bool, error) {
+	result, err := s.db.Exec(`DELETE FROM sync_schedules WHERE id = ? AND tenant_id = ?`, id, s.tenantID)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"npan/internal/models"
+)
+
+func TestSQLiteSyncScheduleStore_CRUD(t *testing.T) {
+	stores, err := NewSQLiteStateStores(SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "sync-state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer stores.DB.Close()
+
+	store := stores.ScheduleStore
+	first := &models.SyncSchedule{
+		Name:          "nightly-full",
+		CronExpr:      "0 3 * * *",
+		Mode:          models.SyncModeFull,
+		JitterSeconds: 120,
+		NextRunAt:     1_710_000_000_000,
+	}
+	second := &models.SyncSchedule{
+		Name:     "hourly-incremental",
+		CronExpr: "@hourly",
+		Mode:     models.SyncModeIncremental,
+		Paused:   true,
+	}
+	if err := store.Create(first); err != nil {
+		t.Fatalf("create first schedule failed: %v", err)
+	}
+	if err := store.Create(second); err != nil {
+		t.Fatalf("create second schedule failed: %v", err)
+	}
+	if first.ID <= 0 || second.ID <= first.ID {
+		t.Fatalf("unexpected generated ids: first=%d second=%d", first.ID, second.ID)
+	}
+
+	listed, err := store.List()
+	if err != nil {
+		t.Fatalf("list schedules failed: %v", err)
+	}
+	if len(listed) != 2 || listed[0].Name != "nightly-full" || listed[1].Name != "hourly-incremental" {
+		t.Fatalf("unexpected schedules: %#v", listed)
+	}
+	if listed[0].JitterSeconds != 120 || listed[0].NextRunAt != 1_710_000_000_000 || listed[0].Paused {
+		t.Fatalf("unexpected first schedule: %#v", listed[0])
+	}
+	if !listed[1].Paused || listed[1].Mode != models.SyncModeIncremental {
+		t.Fatalf("unexpected second schedule: %#v", listed[1])
+	}
+
+	first.Paused = true
+	first.LastRunAt = 1_710_000_100_000
+	first.LastRunStatus = "skipped"
+	first.LastError = "已有同步任务在运行"
+	if err := store.Update(first); err != nil {
+		t.Fatalf("update schedule failed: %v", err)
+	}
+	loaded, err := store.Get(first.ID)
+	if err != nil {
+		t.Fatalf("get schedule failed: %v", err)
+	}
+	if loaded == nil || !loaded.Paused || loaded.LastRunStatus != "skipped" || loaded.LastError != "已有同步任务在运行" {
+		t.Fatalf("unexpected updated schedule: %#v", loaded)
+	}
+
+	deleted, err := store.Delete(first.ID)
+	if err != nil || !deleted {
+		t.Fatalf("delete schedule failed: deleted=%v err=%v", deleted, err)
+	}
+	deleted, err = store.Delete(first.ID)
+	if err != nil || deleted {
+		t.Fatalf("expected second delete to report missing: deleted=%v err=%v", deleted, err)
+	}
+	missing, err := store.Get(first.ID)
+	if err != nil || missing != nil {
+		t.Fatalf("expected missing schedule, got %#v err=%v", missing, err)
+	}
+}
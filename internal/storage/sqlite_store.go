@@ -33,6 +33,13 @@ type SQLiteStateStores struct {
 	ProgressStore          ProgressStore
 	SyncStateStore         SyncStateStore
 	CheckpointStoreFactory CheckpointStoreFactory
+	ScheduleStore          SyncScheduleStore
+	SyncRunStore           SyncRunStore
+	DeadLetterStore        DeadLetterStore
+	IndexChangeStore       IndexChangeStore
+	OAuthTokenStore        OAuthTokenStore
+	ReconciliationStore    ReconciliationStore
+	SyncLeaseStore         SyncLeaseStore
 }
 
 type sqliteStateStore struct {
@@ -42,20 +49,37 @@ type sqliteStateStore struct {
 type SQLiteProgressStore struct {
 	stateStore *sqliteStateStore
 	legacyFile string
+	key        string
 }
 
 type SQLiteSyncStateStore struct {
 	stateStore *sqliteStateStore
 	legacyFile string
+	key        string
 }
 
 type SQLiteCheckpointStoreFactory struct {
 	stateStore *sqliteStateStore
+	// keyPrefix 非空时是租户的 checkpoint，不回退读取 legacy 文件。
+	keyPrefix string
 }
 
 type SQLiteCheckpointStore struct {
 	stateStore *sqliteStateStore
 	key        string
+	legacyFile string
+}
+
+// TenantStateStores 是一个租户独立的同步进度、增量游标、checkpoint，以及按租户隔离的计划、运行历史、死信、变更日志与对账报告。
+type TenantStateStores struct {
+	ProgressStore          ProgressStore
+	SyncStateStore         SyncStateStore
+	CheckpointStoreFactory CheckpointStoreFactory
+	ScheduleStore          SyncScheduleStore
+	SyncRunStore           SyncRunStore
+	DeadLetterStore        DeadLetterStore
+	IndexChangeStore       IndexChangeStore
+	ReconciliationStore    ReconciliationStore
 }
 
 func NewSQLiteStateStores(cfg SQLiteStateStoresConfig) (*SQLiteStateStores, error) {
@@ -81,15 +105,57 @@ func NewSQLiteStateStores(cfg SQLiteStateStoresConfig) (*SQLiteStateStores, erro
 		ProgressStore: &SQLiteProgressStore{
 			stateStore: stateStore,
 			legacyFile: cfg.LegacyProgressFile,
+			key:        stateDefaultKey,
 		},
 		SyncStateStore: &SQLiteSyncStateStore{
 			stateStore: stateStore,
 			legacyFile: cfg.LegacySyncStateFile,
+			key:        stateDefaultKey,
 		},
 		CheckpointStoreFactory: &SQLiteCheckpointStoreFactory{stateStore: stateStore},
+		ScheduleStore:          &SQLiteSyncScheduleStore{db: db},
+		SyncRunStore:           &SQLiteSyncRunStore{db: db},
+		DeadLetterStore:        &SQLiteDeadLetterStore{db: db},
+		IndexChangeStore:       &SQLiteIndexChangeStore{db: db},
+		OAuthTokenStore:        &SQLiteOAuthTokenStore{db: db},
+		ReconciliationStore:    &SQLiteReconciliationStore{db: db},
+		SyncLeaseStore:         &SQLiteSyncLeaseStore{db: db},
 	}, nil
 }
 
+// ForTenant 返回租户的状态存储，与其他租户共享数据库但互不覆盖；tenantID 为空时返回默认存储。
+// 租户状态不导入 legacy JSON 文件，那些文件只属于单租户部署。
+func (s *SQLiteStateStores) ForTenant(tenantID string) TenantStateStores {
+	if tenantID == "" {
+		return TenantStateStores{
+			ProgressStore:          s.ProgressStore,
+			SyncStateStore:         s.SyncStateStore,
+			CheckpointStoreFactory: s.CheckpointStoreFactory,
+			ScheduleStore:          s.ScheduleStore,
+			SyncRunStore:           s.SyncRunStore,
+			DeadLetterStore:        s.DeadLetterStore,
+			IndexChangeStore:       s.IndexChangeStore,
+			ReconciliationStore:    s.ReconciliationStore,
+		}
+	}
+	stateStore := &sqliteStateStore{db: s.DB}
+	key := tenantStateKey(tenantID)
+	return TenantStateStores{
+		ProgressStore:          &SQLiteProgressStore{stateStore: stateStore, key: key},
+		SyncStateStore:         &SQLiteSyncStateStore{stateStore: stateStore, key: key},
+		CheckpointStoreFactory: &SQLiteCheckpointStoreFactory{stateStore: stateStore, keyPrefix: key + ":"},
+		ScheduleStore:          &SQLiteSyncScheduleStore{db: s.DB, tenantID: tenantID},
+		SyncRunStore:           &SQLiteSyncRunStore{db: s.DB, tenantID: tenantID},
+		DeadLetterStore:        &SQLiteDeadLetterStore{db: s.DB, tenantID: tenantID},
+		IndexChangeStore:       &SQLiteIndexChangeStore{db: s.DB, tenantID: tenantID},
+		ReconciliationStore:    &SQLiteReconciliationStore{db: s.DB, tenantID: tenantID},
+	}
+}
+
+func tenantStateKey(tenantID string) string {
+	return "tenant:" + tenantID
+}
+
 func configureSQLiteDB(db *sql.DB) error {
 	db.SetMaxOpenConns(1)
 	db.SetMaxIdleConns(1)
@@ -106,15 +172,229 @@ func configureSQLiteDB(db *sql.DB) error {
 		}
 	}
 
-	_, err := db.Exec(`
+	for _, stmt := range sqliteSchemaStatements {
+		if _, err := db.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	for _, migration := range sqliteColumnMigrations {
+		if err := applyColumnMigration(db, migration); err != nil {
+			return fmt.Errorf("migrate %s.%s: %w", migration.table, migration.column, err)
+		}
+	}
+	for _, stmt := range sqliteTenantIndexStatements {
+		if _, err := db.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sqliteColumnMigration 为旧版本创建的表补充新列；backfill 与补列在同一事务内执行，只在补列时运行一次。
+type sqliteColumnMigration struct {
+	table      string
+	column     string
+	definition string
+	backfill   []string
+}
+
+// 按租户隔离的表在引入多租户前已存在，旧记录补为默认租户（空字符串）。
+var sqliteColumnMigrations = []sqliteColumnMigration{
+	{table: "sync_schedules", column: "tenant_id", definition: "TEXT NOT NULL DEFAULT ''"},
+	{table: "sync_runs", column: "tenant_id", definition: "TEXT NOT NULL DEFAULT ''"},
+	{table: "dead_letters", column: "tenant_id", definition: "TEXT NOT NULL DEFAULT ''"},
+	{
+		table:      "index_changes",
+		column:     "tenant_id",
+		definition: "TEXT NOT NULL DEFAULT ''",
+		// 旧日志以最小保留序号判断是否过期，这里换算成默认租户的清理水位，避免消费方漏读已清理的变更。
+		backfill: []string{`
+INSERT OR IGNORE INTO index_change_prunes(tenant_id, pruned_seq)
+SELECT '', COALESCE(
+  (SELECT MIN(seq) - 1 FROM index_changes),
+  (SELECT seq FROM sqlite_sequence WHERE name = 'index_changes'),
+  0
+)`},
+	},
+	{table: "reconciliation_reports", column: "tenant_id", definition: "TEXT NOT NULL DEFAULT ''"},
+}
+
+var sqliteTenantIndexStatements = []string{
+	`CREATE INDEX IF NOT EXISTS idx_sync_runs_tenant ON sync_runs(tenant_id, id)`,
+	`CREATE INDEX IF NOT EXISTS idx_dead_letters_tenant ON dead_letters(tenant_id, id)`,
+	`CREATE INDEX IF NOT EXISTS idx_index_changes_tenant ON index_changes(tenant_id, seq)`,
+	`CREATE INDEX IF NOT EXISTS idx_reconciliation_reports_tenant ON reconciliation_reports(tenant_id, id)`,
+}
+
+func applyColumnMigration(db *sql.DB, migration sqliteColumnMigration) error {
+	exists, err := sqliteColumnExists(db, migration.table, migration.column)
+	if err != nil || exists {
+		return err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.Exec(`ALTER TABLE ` + migration.table + ` ADD COLUMN ` + migration.column + ` ` + migration.definition); err != nil {
+		return err
+	}
+	for _, stmt := range migration.backfill {
+		if _, err := tx.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func sqliteColumnExists(db *sql.DB, table string, column string) (bool, error) {
+	rows, err := db.Query(`SELECT name FROM pragma_table_info(?)`, table)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return false, err
+		}
+		if name == column {
+			return true, nil
+		}
+	}
+	return false, rows.Err()
+}
+
+var sqliteSchemaStatements = []string{
+	`
 CREATE TABLE IF NOT EXISTS state_entries (
   namespace TEXT NOT NULL,
   key TEXT NOT NULL,
   payload_json TEXT NOT NULL,
   updated_at_ms INTEGER NOT NULL,
   PRIMARY KEY(namespace, key)
-)`)
-	return err
+)`,
+	`
+CREATE TABLE IF NOT EXISTS sync_schedules (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  name TEXT NOT NULL,
+  cron_expr TEXT NOT NULL,
+  mode TEXT NOT NULL,
+  jitter_seconds INTEGER NOT NULL DEFAULT 0,
+  paused INTEGER NOT NULL DEFAULT 0,
+  next_run_at_ms INTEGER NOT NULL DEFAULT 0,
+  last_run_at_ms INTEGER NOT NULL DEFAULT 0,
+  last_run_status TEXT NOT NULL DEFAULT '',
+  last_error TEXT NOT NULL DEFAULT '',
+  created_at_ms INTEGER NOT NULL,
+  updated_at_ms INTEGER NOT NULL
+)`,
+	`
+CREATE TABLE IF NOT EXISTS sync_runs (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  mode TEXT NOT NULL,
+  status TEXT NOT NULL,
+  roots_json TEXT NOT NULL DEFAULT '[]',
+  started_at_ms INTEGER NOT NULL,
+  ended_at_ms INTEGER NOT NULL DEFAULT 0,
+  stats_json TEXT NOT NULL DEFAULT '{}',
+  incremental_stats_json TEXT NOT NULL DEFAULT '',
+  verification_json TEXT NOT NULL DEFAULT '',
+  error TEXT NOT NULL DEFAULT ''
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_sync_runs_mode ON sync_runs(mode, id)`,
+	`
+CREATE TABLE IF NOT EXISTS dead_letters (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  run_id INTEGER NOT NULL DEFAULT 0,
+  root_folder_id INTEGER NOT NULL,
+  doc_count INTEGER NOT NULL,
+  documents_json TEXT NOT NULL,
+  error TEXT NOT NULL DEFAULT '',
+  attempts INTEGER NOT NULL DEFAULT 1,
+  created_at_ms INTEGER NOT NULL,
+  updated_at_ms INTEGER NOT NULL
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_dead_letters_root ON dead_letters(root_folder_id, id)`,
+	`
+CREATE TABLE IF NOT EXISTS index_changes (
+  seq INTEGER PRIMARY KEY AUTOINCREMENT,
+  op TEXT NOT NULL,
+  doc_id TEXT NOT NULL DEFAULT '',
+  document_json TEXT NOT NULL DEFAULT '',
+  root_folder_id INTEGER NOT NULL DEFAULT 0,
+  run_id INTEGER NOT NULL DEFAULT 0,
+  removed INTEGER NOT NULL DEFAULT 0,
+  occurred_at_ms INTEGER NOT NULL
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_index_changes_occurred ON index_changes(occurred_at_ms)`,
+	`
+CREATE TABLE IF NOT EXISTS index_change_prunes (
+  tenant_id TEXT PRIMARY KEY,
+  pruned_seq INTEGER NOT NULL DEFAULT 0
+)`,
+	`
+CREATE TABLE IF NOT EXISTS oauth_tokens (
+  key TEXT PRIMARY KEY,
+  ciphertext BLOB NOT NULL,
+  expires_at_ms INTEGER NOT NULL DEFAULT 0,
+  updated_at_ms INTEGER NOT NULL
+)`,
+	`
+CREATE TABLE IF NOT EXISTS reconciliation_reports (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  status TEXT NOT NULL,
+  roots_json TEXT NOT NULL DEFAULT '[]',
+  sample_size INTEGER NOT NULL DEFAULT 0,
+  started_at_ms INTEGER NOT NULL,
+  finished_at_ms INTEGER NOT NULL DEFAULT 0,
+  folders_checked INTEGER NOT NULL DEFAULT 0,
+  folders_drifted INTEGER NOT NULL DEFAULT 0,
+  error TEXT NOT NULL DEFAULT ''
+)`,
+	`
+CREATE TABLE IF NOT EXISTS reconciliation_rows (
+  report_id INTEGER NOT NULL,
+  root_folder_id INTEGER NOT NULL,
+  folder_id INTEGER NOT NULL,
+  path TEXT NOT NULL DEFAULT '',
+  upstream_items INTEGER NOT NULL DEFAULT 0,
+  indexed_items INTEGER NOT NULL DEFAULT 0,
+  drift INTEGER NOT NULL DEFAULT 0,
+  error TEXT NOT NULL DEFAULT ''
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_reconciliation_rows_report ON reconciliation_rows(report_id, root_folder_id, folder_id)`,
+	`
+CREATE TABLE IF NOT EXISTS crawl_checkpoints (
+  key TEXT PRIMARY KEY,
+  sync_generation INTEGER NOT NULL DEFAULT 0,
+  current_folder_id INTEGER,
+  current_page_id INTEGER,
+  updated_at_ms INTEGER NOT NULL
+)`,
+	`
+CREATE TABLE IF NOT EXISTS crawl_frontier (
+  seq INTEGER PRIMARY KEY AUTOINCREMENT,
+  checkpoint_key TEXT NOT NULL,
+  folder_id INTEGER NOT NULL,
+  state TEXT NOT NULL,
+  page_id INTEGER NOT NULL DEFAULT 0,
+  path_json TEXT NOT NULL DEFAULT '',
+  UNIQUE(checkpoint_key, folder_id)
+)`,
+	`CREATE INDEX IF NOT EXISTS idx_crawl_frontier_state ON crawl_frontier(checkpoint_key, state, seq)`,
+	`
+CREATE TABLE IF NOT EXISTS sync_leases (
+  key TEXT PRIMARY KEY,
+  owner_id TEXT NOT NULL,
+  owner TEXT NOT NULL DEFAULT '',
+  acquired_at_ms INTEGER NOT NULL,
+  heartbeat_at_ms INTEGER NOT NULL,
+  expires_at_ms INTEGER NOT NULL
+)`,
 }
 
 func (s *sqliteStateStore) loadEntry(namespace string, key string) ([]byte, bool, error) {
@@ -170,7 +450,7 @@ func (s *SQLiteProgressStore) Load() (*models.SyncProgressState, error) {
 	state, err := loadStateWithFallback(
 		s.stateStore,
 		stateNamespaceProgress,
-		stateDefaultKey,
+		s.key,
 		s.legacyFile,
 		func(filePath string) (*models.SyncProgressState, error) {
 			return NewJSONProgressStore(filePath).Load()
@@ -183,14 +463,14 @@ func (s *SQLiteProgressStore) Load() (*models.SyncProgressState, error) {
 }
 
 func (s *SQLiteProgressStore) Save(state *models.SyncProgressState) error {
-	return saveStateEntry(s.stateStore, stateNamespaceProgress, stateDefaultKey, state)
+	return saveStateEntry(s.stateStore, stateNamespaceProgress, s.key, state)
 }
 
 func (s *SQLiteSyncStateStore) Load() (*models.SyncState, error) {
 	return loadStateWithFallback(
 		s.stateStore,
 		stateNamespaceSyncState,
-		stateDefaultKey,
+		s.key,
 		s.legacyFile,
 		func(filePath string) (*models.SyncState, error) {
 			return NewJSONSyncStateStore(filePath).Load()
@@ -199,31 +479,14 @@ func (s *SQLiteSyncStateStore) Load() (*models.SyncState, error) {
 }
 
 func (s *SQLiteSyncStateStore) Save(state *models.SyncState) error {
-	return saveStateEntry(s.stateStore, stateNamespaceSyncState, stateDefaultKey, state)
+	return saveStateEntry(s.stateStore, stateNamespaceSyncState, s.key, state)
 }
 
 func (f *SQLiteCheckpointStoreFactory) ForKey(key string) CheckpointStore {
-	return &SQLiteCheckpointStore{stateStore: f.stateStore, key: key}
-}
-
-func (s *SQLiteCheckpointStore) Load() (*models.CrawlCheckpoint, error) {
-	return loadStateWithFallback(
-		s.stateStore,
-		stateNamespaceCheckpoint,
-		s.key,
-		s.key,
-		func(filePath string) (*models.CrawlCheckpoint, error) {
-			return NewJSONCheckpointStore(filePath).Load()
-		},
-	)
-}
-
-func (s *SQLiteCheckpointStore) Save(checkpoint *models.CrawlCheckpoint) error {
-	return saveStateEntry(s.stateStore, stateNamespaceCheckpoint, s.key, checkpoint)
-}
-
-func (s *SQLiteCheckpointStore) Clear() error {
-	return s.stateStore.deleteEntry(stateNamespaceCheckpoint, s.key)
+	if f.keyPrefix != "" {
+		return &SQLiteCheckpointStore{stateStore: f.stateStore, key: f.keyPrefix + key}
+	}
+	return &SQLiteCheckpointStore{stateStore: f.stateStore, key: key, legacyFile: key}
 }
 
 func loadStateWithFallback[T any](stateStore *sqliteStateStore, namespace string, key string, legacyFile string, loadLegacy func(string) (*T, error)) (*T, error) {
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -320,6 +321,204 @@ func TestSQLiteProgressStore_ConcurrentSave_PreservesDecodableState(t *testing.T
 	}
 }
 
+func TestSQLiteStateStores_ForTenantIsolatesState(t *testing.T) {
+	dir := t.TempDir()
+	legacyProgress := filepath.Join(dir, "legacy-progress.json")
+	if err := NewJSONProgressStore(legacyProgress).Save(sampleSyncProgressState(1)); err != nil {
+		t.Fatalf("write legacy progress failed: %v", err)
+	}
+	stores, err := NewSQLiteStateStores(SQLiteStateStoresConfig{
+		StateDBFile:        filepath.Join(dir, "sync-state.sqlite"),
+		LegacyProgressFile: legacyProgress,
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer stores.DB.Close()
+
+	acme := stores.ForTenant("acme")
+	globex := stores.ForTenant("globex")
+
+	loaded, err := acme.ProgressStore.Load()
+	if err != nil {
+		t.Fatalf("load tenant progress failed: %v", err)
+	}
+	if loaded != nil {
+		t.Fatalf("tenant progress must not import the legacy file, got %#v", loaded)
+	}
+
+	if err := acme.ProgressStore.Save(sampleSyncProgressState(2)); err != nil {
+		t.Fatalf("save acme progress failed: %v", err)
+	}
+	if err := acme.SyncStateStore.Save(sampleSyncState(100)); err != nil {
+		t.Fatalf("save acme sync state failed: %v", err)
+	}
+	if err := globex.SyncStateStore.Save(sampleSyncState(200)); err != nil {
+		t.Fatalf("save globex sync state failed: %v", err)
+	}
+	checkpointKey := filepath.Join(dir, "checkpoint.json")
+	if err := acme.CheckpointStoreFactory.ForKey(checkpointKey).Save(sampleCheckpoint([]int64{1}, 1, 1)); err != nil {
+		t.Fatalf("save acme checkpoint failed: %v", err)
+	}
+
+	if state, err := globex.SyncStateStore.Load(); err != nil || state.LastSyncTime != 200 {
+		t.Fatalf("unexpected globex sync state: %#v err=%v", state, err)
+	}
+	if state, err := acme.SyncStateStore.Load(); err != nil || state.LastSyncTime != 100 {
+		t.Fatalf("unexpected acme sync state: %#v err=%v", state, err)
+	}
+	if checkpoint, err := globex.CheckpointStoreFactory.ForKey(checkpointKey).Load(); err != nil || checkpoint != nil {
+		t.Fatalf("globex must not see acme checkpoint: %#v err=%v", checkpoint, err)
+	}
+	if checkpoint, err := stores.CheckpointStoreFactory.ForKey(checkpointKey).Load(); err != nil || checkpoint != nil {
+		t.Fatalf("default store must not see acme checkpoint: %#v err=%v", checkpoint, err)
+	}
+	if progress, err := stores.ProgressStore.Load(); err != nil || progress.Roots[0] != 1 {
+		t.Fatalf("default progress should still come from the legacy file: %#v err=%v", progress, err)
+	}
+}
+
+func TestSQLiteStateStores_ForTenantIsolatesHistoryAndLogs(t *testing.T) {
+	stores, err := NewSQLiteStateStores(SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "sync-state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer stores.DB.Close()
+
+	acme := stores.ForTenant("acme")
+	globex := stores.ForTenant("globex")
+
+	run := &models.SyncRun{Mode: models.SyncModeFull, Status: "done", StartedAt: 1}
+	if err := acme.SyncRunStore.Create(run); err != nil {
+		t.Fatalf("create acme run failed: %v", err)
+	}
+	if runs, err := globex.SyncRunStore.List(SyncRunFilter{}); err != nil || len(runs) != 0 {
+		t.Fatalf("globex must not list acme runs: %#v err=%v", runs, err)
+	}
+	if got, err := stores.SyncRunStore.Get(run.ID); err != nil || got != nil {
+		t.Fatalf("default tenant must not read acme run: %#v err=%v", got, err)
+	}
+	if got, err := acme.SyncRunStore.Get(run.ID); err != nil || got == nil {
+		t.Fatalf("acme should read its own run: %#v err=%v", got, err)
+	}
+
+	letter := &models.DeadLetter{RootFolderID: 100, Documents: []models.IndexDocument{{DocID: "file_1"}}, CreatedAt: 1}
+	if err := acme.DeadLetterStore.Add(letter); err != nil {
+		t.Fatalf("add acme dead letter failed: %v", err)
+	}
+	if count, err := globex.DeadLetterStore.Count(); err != nil || count != 0 {
+		t.Fatalf("globex must not count acme dead letters: %d err=%v", count, err)
+	}
+	if deleted, err := globex.DeadLetterStore.Delete(letter.ID); err != nil || deleted {
+		t.Fatalf("globex must not delete acme dead letters: %v err=%v", deleted, err)
+	}
+
+	schedule := &models.SyncSchedule{Name: "nightly", CronExpr: "0 2 * * *", Mode: models.SyncModeIncremental}
+	if err := acme.ScheduleStore.Create(schedule); err != nil {
+		t.Fatalf("create acme schedule failed: %v", err)
+	}
+	if schedules, err := stores.ScheduleStore.List(); err != nil || len(schedules) != 0 {
+		t.Fatalf("default tenant must not list acme schedules: %#v err=%v", schedules, err)
+	}
+
+	report := &models.ReconciliationReport{Status: "completed", StartedAt: 1}
+	if err := acme.ReconciliationStore.Create(report); err != nil {
+		t.Fatalf("create acme report failed: %v", err)
+	}
+	if latest, err := globex.ReconciliationStore.Latest(); err != nil || latest != nil {
+		t.Fatalf("globex must not see acme reports: %#v err=%v", latest, err)
+	}
+	if removed, err := globex.ReconciliationStore.PruneKeepLatest(1); err != nil || removed != 0 {
+		t.Fatalf("globex prune must not touch acme reports: %d err=%v", removed, err)
+	}
+
+	if err := acme.IndexChangeStore.Append([]models.IndexChange{
+		{Op: models.IndexChangeDelete, DocID: "file_1", OccurredAt: 1_000},
+	}); err != nil {
+		t.Fatalf("append acme change failed: %v", err)
+	}
+	if err := globex.IndexChangeStore.Append([]models.IndexChange{
+		{Op: models.IndexChangeDelete, DocID: "file_2", OccurredAt: 1_000},
+	}); err != nil {
+		t.Fatalf("append globex change failed: %v", err)
+	}
+	if changes, err := globex.IndexChangeStore.ListAfter(0, 10); err != nil || len(changes) != 1 || changes[0].DocID != "file_2" {
+		t.Fatalf("globex should only list its own changes: %#v err=%v", changes, err)
+	}
+	if _, err := acme.IndexChangeStore.PruneBefore(2_000); err != nil {
+		t.Fatalf("prune acme changes failed: %v", err)
+	}
+	if pruned, latest, err := acme.IndexChangeStore.Bounds(); err != nil || pruned != 1 || latest != 2 {
+		t.Fatalf("unexpected acme bounds: %d %d err=%v", pruned, latest, err)
+	}
+	if pruned, _, err := globex.IndexChangeStore.Bounds(); err != nil || pruned != 0 {
+		t.Fatalf("acme prune must not move the globex watermark: %d err=%v", pruned, err)
+	}
+}
+
+func TestNewSQLiteStateStores_MigratesLegacyRowsToDefaultTenant(t *testing.T) {
+	dbFile := filepath.Join(t.TempDir(), "sync-state.sqlite")
+	legacy, err := sql.Open(sqliteDriverName, dbFile)
+	if err != nil {
+		t.Fatalf("open legacy db failed: %v", err)
+	}
+	for _, stmt := range []string{
+		`CREATE TABLE index_changes (
+  seq INTEGER PRIMARY KEY AUTOINCREMENT,
+  op TEXT NOT NULL,
+  doc_id TEXT NOT NULL DEFAULT '',
+  document_json TEXT NOT NULL DEFAULT '',
+  root_folder_id INTEGER NOT NULL DEFAULT 0,
+  run_id INTEGER NOT NULL DEFAULT 0,
+  removed INTEGER NOT NULL DEFAULT 0,
+  occurred_at_ms INTEGER NOT NULL
+)`,
+		`INSERT INTO index_changes(op, doc_id, occurred_at_ms) VALUES ('delete', 'file_1', 1), ('delete', 'file_2', 2), ('delete', 'file_3', 3)`,
+		`DELETE FROM index_changes WHERE seq = 1`,
+		`CREATE TABLE sync_runs (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  mode TEXT NOT NULL,
+  status TEXT NOT NULL,
+  roots_json TEXT NOT NULL DEFAULT '[]',
+  started_at_ms INTEGER NOT NULL,
+  ended_at_ms INTEGER NOT NULL DEFAULT 0,
+  stats_json TEXT NOT NULL DEFAULT '{}',
+  incremental_stats_json TEXT NOT NULL DEFAULT '',
+  verification_json TEXT NOT NULL DEFAULT '',
+  error TEXT NOT NULL DEFAULT ''
+)`,
+		`INSERT INTO sync_runs(mode, status, started_at_ms) VALUES ('full', 'done', 1)`,
+	} {
+		if _, err := legacy.Exec(stmt); err != nil {
+			t.Fatalf("prepare legacy db failed: %v", err)
+		}
+	}
+	if err := legacy.Close(); err != nil {
+		t.Fatalf("close legacy db failed: %v", err)
+	}
+
+	stores, err := NewSQLiteStateStores(SQLiteStateStoresConfig{StateDBFile: dbFile})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer stores.DB.Close()
+
+	if runs, err := stores.SyncRunStore.List(SyncRunFilter{}); err != nil || len(runs) != 1 {
+		t.Fatalf("legacy runs should belong to the default tenant: %#v err=%v", runs, err)
+	}
+	if pruned, latest, err := stores.IndexChangeStore.Bounds(); err != nil || pruned != 1 || latest != 3 {
+		t.Fatalf("legacy prune watermark should come from the oldest retained change: %d %d err=%v", pruned, latest, err)
+	}
+	if changes, err := stores.IndexChangeStore.ListAfter(0, 10); err != nil || len(changes) != 2 {
+		t.Fatalf("legacy changes should belong to the default tenant: %#v err=%v", changes, err)
+	}
+	if pruned, _, err := stores.ForTenant("acme").IndexChangeStore.Bounds(); err != nil || pruned != 0 {
+		t.Fatalf("other tenants start without a prune watermark: %d err=%v", pruned, err)
+	}
+}
+
 func sampleSyncProgressState(rootID int64) *models.SyncProgressState {
 	queueLen := int64(4)
 	currentFolderID := int64(rootID + 10)
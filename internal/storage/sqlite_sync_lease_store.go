@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"npan/internal/models"
+)
+
+// SyncLeaseStore 持久化跨进程的同步租约。同一个 key 同一时间只有一个持有者，
+// 持有者需要在过期前续约，过期的租约可被其他进程直接接管。
+type SyncLeaseStore interface {
+	// Acquire 在租约空闲、已过期或本就由 ownerID 持有时取得租约，返回 acquired=true；
+	// 否则返回当前持有者。
+	Acquire(key string, ownerID string, owner string, ttl time.Duration) (lease *models.SyncLease, acquired bool, err error)
+	// Renew 延长 ownerID 持有的租约，租约已被释放或被他人接管时返回 false。
+	Renew(key string, ownerID string, ttl time.Duration) (bool, error)
+	// Release 释放 ownerID 持有的租约，已不再持有时不做任何事。
+	Release(key string, ownerID string) error
+	// Get 返回当前租约（可能已过期），没有记录时返回 nil。
+	Get(key string) (*models.SyncLease, error)
+	// ForceRelease 无视持有者删除租约，返回被删除的租约，没有记录时返回 nil。
+	ForceRelease(key string) (*models.SyncLease, error)
+}
+
+// SyncLeaseKey 返回租户的租约键，与该租户的进度、断点使用同一个键。
+func SyncLeaseKey(tenantID string) string {
+	if tenantID == "" {
+		return stateDefaultKey
+	}
+	return tenantStateKey(tenantID)
+}
+
+type SQLiteSyncLeaseStore struct {
+	db *sql.DB
+}
+
+func (s *SQLiteSyncLeaseStore) Acquire(key string, ownerID string, owner string, ttl time.Duration) (*models.SyncLease, bool, error) {
+	now := time.Now().UnixMilli()
+	// 单条语句完成判断与写入，多个进程同时抢占时由 SQLite 的写锁保证只有一个成功。
+	result, err := s.db.Exec(
+		`INSERT INTO sync_leases(key, owner_id, owner, acquired_at_ms, heartbeat_at_ms, expires_at_ms)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT(key) DO UPDATE SET
+  acquired_at_ms = CASE WHEN sync_leases.owner_id = excluded.owner_id THEN sync_leases.acquired_at_ms ELSE excluded.acquired_at_ms END,
+  owner_id = excluded.owner_id,
+  owner = excluded.owner,
+  heartbeat_at_ms = excluded.heartbeat_at_ms,
+  expires_at_ms = excluded.expires_at_ms
+WHERE sync_leases.owner_id = excluded.owner_id OR sync_leases.expires_at_ms <= excluded.heartbeat_at_ms`,
+		key,
+		ownerID,
+		owner,
+		now,
+		now,
+		now+ttl.Milliseconds(),
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, err
+	}
+
+	lease, err := s.Get(key)
+	if err != nil {
+		return nil, false, err
+	}
+	if lease == nil {
+		// 刚写入就被强制释放，按未取得处理，由调用方重试。
+		return nil, false, nil
+	}
+	return lease, affected > 0 && lease.OwnerID == ownerID, nil
+}
+
+func (s *SQLiteSyncLeaseStore) Renew(key string, ownerID string, ttl time.Duration) (bool, error) {
+	now := time.Now().UnixMilli()
+	result, err := s.db.Exec(
+		`UPDATE sync_leases SET heartbeat_at_ms = ?, expires_at_ms = ? WHERE key = ? AND owner_id = ?`,
+		now,
+		now+ttl.Milliseconds(),
+		key,
+		ownerID,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (s *SQLiteSyncLeaseStore) Release(key string, ownerID string) error {
+	_, err := s.db.Exec(`DELETE FROM sync_leases WHERE key = ? AND owner_id = ?`, key, ownerID)
+	return err
+}
+
+func (s *SQLiteSyncLeaseStore) Get(key string) (*models.SyncLease, error) {
+	lease := models.SyncLease{Key: key}
+	err := s.db.QueryRow(
+		`SELECT owner_id, owner, acquired_at_ms, heartbeat_at_ms, expires_at_ms FROM sync_leases WHERE key = ?`,
+		key,
+	).Scan(&lease.OwnerID, &lease.Owner, &lease.AcquiredAt, &lease.HeartbeatAt, &lease.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &lease, nil
+}
+
+func (s *SQLiteSyncLeaseStore) ForceRelease(key string) (*models.SyncLease, error) {
+	lease, err := s.Get(key)
+	if err != nil || lease == nil {
+		return nil, err
+	}
+	if _, err := s.db.Exec(`DELETE FROM sync_leases WHERE key = ? AND owner_id = ?`, key, lease.OwnerID); err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
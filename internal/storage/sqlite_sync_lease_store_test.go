@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSQLiteSyncLeaseStore_ExclusiveAcrossConnectionsUntilExpiry(t *testing.T) {
+	dbFile := filepath.Join(t.TempDir(), "sync-state.sqlite")
+	server, err := NewSQLiteStateStores(SQLiteStateStoresConfig{StateDBFile: dbFile})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer server.DB.Close()
+	cli, err := NewSQLiteStateStores(SQLiteStateStoresConfig{StateDBFile: dbFile})
+	if err != nil {
+		t.Fatalf("create second sqlite stores failed: %v", err)
+	}
+	defer cli.DB.Close()
+
+	key := SyncLeaseKey("")
+	lease, acquired, err := server.SyncLeaseStore.Acquire(key, "server-1", "server@a", time.Minute)
+	if err != nil || !acquired || lease.OwnerID != "server-1" {
+		t.Fatalf("expected server to acquire lease, got %#v %v %v", lease, acquired, err)
+	}
+
+	holder, acquired, err := cli.SyncLeaseStore.Acquire(key, "cli-1", "cli@b", time.Minute)
+	if err != nil || acquired {
+		t.Fatalf("expected cli to be refused, got %#v %v %v", holder, acquired, err)
+	}
+	if holder == nil || holder.OwnerID != "server-1" || holder.Owner != "server@a" {
+		t.Fatalf("expected holder to be server, got %#v", holder)
+	}
+	if ok, err := cli.SyncLeaseStore.Renew(key, "cli-1", time.Minute); err != nil || ok {
+		t.Fatalf("expected cli renew to fail, got %v %v", ok, err)
+	}
+	if ok, err := server.SyncLeaseStore.Renew(key, "server-1", time.Minute); err != nil || !ok {
+		t.Fatalf("expected server renew to succeed, got %v %v", ok, err)
+	}
+
+	// 续约时间为负，模拟心跳中断后的过期租约。
+	if ok, err := server.SyncLeaseStore.Renew(key, "server-1", -time.Second); err != nil || !ok {
+		t.Fatalf("expire server lease failed: %v %v", ok, err)
+	}
+	lease, acquired, err = cli.SyncLeaseStore.Acquire(key, "cli-1", "cli@b", time.Minute)
+	if err != nil || !acquired || lease.Owner != "cli@b" {
+		t.Fatalf("expected cli to take over expired lease, got %#v %v %v", lease, acquired, err)
+	}
+	if ok, err := server.SyncLeaseStore.Renew(key, "server-1", time.Minute); err != nil || ok {
+		t.Fatalf("expected server to have lost the lease, got %v %v", ok, err)
+	}
+	if err := server.SyncLeaseStore.Release(key, "server-1"); err != nil {
+		t.Fatalf("release by former owner failed: %v", err)
+	}
+	if lease, err := cli.SyncLeaseStore.Get(key); err != nil || lease == nil || lease.OwnerID != "cli-1" {
+		t.Fatalf("expected release by former owner to keep cli lease, got %#v %v", lease, err)
+	}
+
+	released, err := server.SyncLeaseStore.ForceRelease(key)
+	if err != nil || released == nil || released.OwnerID != "cli-1" {
+		t.Fatalf("expected force release to return cli lease, got %#v %v", released, err)
+	}
+	if lease, err := cli.SyncLeaseStore.Get(key); err != nil || lease != nil {
+		t.Fatalf("expected no lease after force release, got %#v %v", lease, err)
+	}
+	if released, err := server.SyncLeaseStore.ForceRelease(key); err != nil || released != nil {
+		t.Fatalf("expected force release of missing lease to be a no-op, got %#v %v", released, err)
+	}
+}
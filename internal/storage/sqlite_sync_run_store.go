@@ -0,0 +1,214 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"npan/internal/models"
+)
+
+// SyncRunStore 持久化每次同步运行的历史记录。
+type SyncRunStore interface {
+	Create(run *models.SyncRun) error
+	Update(run *models.SyncRun) error
+	Get(id int64) (*models.SyncRun, error)
+	List(filter SyncRunFilter) ([]models.SyncRun, error)
+}
+
+// SyncRunFilter 按 ID 倒序分页：BeforeID 大于 0 时只返回更早的记录。
+type SyncRunFilter struct {
+	Mode     models.SyncMode
+	Limit    int
+	BeforeID int64
+}
+
+// SQLiteSyncRunStore 只读写 tenantID 对应租户的运行记录，默认租户为空字符串。
+type SQLiteSyncRunStore struct {
+	db       *sql.DB
+	tenantID string
+}
+
+const defaultSyncRunListLimit = 50
+
+const syncRunColumns = `id, mode, status, roots_json, started_at_ms, ended_at_ms,
+  stats_json, incremental_stats_json, verification_json, error`
+
+func scanSyncRun(row rowScanner) (models.SyncRun, error) {
+	var run models.SyncRun
+	var mode, rootsJSON, statsJSON, incrementalJSON, verificationJSON string
+	err := row.Scan(
+		&run.ID,
+		&mode,
+		&run.Status,
+		&rootsJSON,
+		&run.StartedAt,
+		&run.EndedAt,
+		&statsJSON,
+		&incrementalJSON,
+		&verificationJSON,
+		&run.Error,
+	)
+	if err != nil {
+		return run, err
+	}
+	run.Mode = models.SyncMode(mode)
+	if err := json.Unmarshal([]byte(rootsJSON), &run.Roots); err != nil {
+		return run, err
+	}
+	if err := json.Unmarshal([]byte(statsJSON), &run.Stats); err != nil {
+		return run, err
+	}
+	if incrementalJSON != "" {
+		run.IncrementalStats = &models.IncrementalSyncStats{}
+		if err := json.Unmarshal([]byte(incrementalJSON), run.IncrementalStats); err != nil {
+			return run, err
+		}
+	}
+	if verificationJSON != "" {
+		run.Verification = &models.SyncVerification{}
+		if err := json.Unmarshal([]byte(verificationJSON), run.Verification); err != nil {
+			return run, err
+		}
+	}
+	return run, nil
+}
+
+// syncRunPayload 把运行记录中的结构化字段编码为 JSON 列，空指针编码为空字符串。
+func syncRunPayload(run *models.SyncRun) (roots string, stats string, incremental string, verification string, err error) {
+	rootIDs := run.Roots
+	if rootIDs == nil {
+		rootIDs = []int64{}
+	}
+	encoded, err := json.Marshal(rootIDs)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	roots = string(encoded)
+	if encoded, err = json.Marshal(run.Stats); err != nil {
+		return "", "", "", "", err
+	}
+	stats = string(encoded)
+	if run.IncrementalStats != nil {
+		if encoded, err = json.Marshal(run.IncrementalStats); err != nil {
+			return "", "", "", "", err
+		}
+		incremental = string(encoded)
+	}
+	if run.Verification != nil {
+		if encoded, err = json.Marshal(run.Verification); err != nil {
+			return "", "", "", "", err
+		}
+		verification = string(encoded)
+	}
+	return roots, stats, incremental, verification, nil
+}
+
+// Create 插入运行记录，并把生成的 ID 回写到 run。
+func (s *SQLiteSyncRunStore) Create(run *models.SyncRun) error {
+	roots, stats, incremental, verification, err := syncRunPayload(run)
+	if err != nil {
+		return err
+	}
+	result, err := s.db.Exec(
+		`INSERT INTO sync_runs(tenant_id, mode, status, roots_json, started_at_ms, ended_at_ms,
+  stats_json, incremental_stats_json, verification_json, error)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		s.tenantID,
+		string(run.Mode),
+		run.Status,
+		roots,
+		run.StartedAt,
+		run.EndedAt,
+		stats,
+		incremental,
+		verification,
+		run.Error,
+	)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	run.ID = id
+	return nil
+}
+
+func (s *SQLiteSyncRunStore) Update(run *models.SyncRun) error {
+	roots, stats, incremental, verification, err := syncRunPayload(run)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(
+		`UPDATE sync_runs SET
+  mode = ?, status = ?, roots_json = ?, started_at_ms = ?, ended_at_ms = ?,
+  stats_json = ?, incremental_stats_json = ?, verification_json = ?, error = ?
+WHERE id = ? AND tenant_id = ?`,
+		string(run.Mode),
+		run.Status,
+		roots,
+		run.StartedAt,
+		run.EndedAt,
+		stats,
+		incremental,
+		verification,
+		run.Error,
+		run.ID,
+		s.tenantID,
+	)
+	return err
+}
+
+func (s *SQLiteSyncRunStore) Get(id int64) (*models.SyncRun, error) {
+	run, err := scanSyncRun(s.db.QueryRow(
+		`SELECT `+syncRunColumns+` FROM sync_runs WHERE id = ? AND tenant_id = ?`,
+		id,
+		s.tenantID,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// List 按 ID 倒序返回运行记录，最新的在前。
+func (s *SQLiteSyncRunStore) List(filter SyncRunFilter) ([]models.SyncRun, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultSyncRunListLimit
+	}
+
+	query := `SELECT ` + syncRunColumns + ` FROM sync_runs WHERE tenant_id = ?`
+	args := []any{s.tenantID}
+	if filter.Mode != "" {
+		query += ` AND mode = ?`
+		args = append(args, string(filter.Mode))
+	}
+	if filter.BeforeID > 0 {
+		query += ` AND id < ?`
+		args = append(args, filter.BeforeID)
+	}
+	query += ` ORDER BY id DESC LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := make([]models.SyncRun, 0)
+	for rows.Next() {
+		run, err := scanSyncRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
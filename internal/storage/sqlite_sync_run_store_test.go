@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"npan/internal/models"
+)
+
+func TestSQLiteSyncRunStore_RecordsAndPagesRuns(t *testing.T) {
+	stores, err := NewSQLiteStateStores(SQLiteStateStoresConfig{
+		StateDBFile: filepath.Join(t.TempDir(), "sync-state.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("create sqlite stores failed: %v", err)
+	}
+	defer stores.DB.Close()
+
+	store := stores.SyncRunStore
+	full := &models.SyncRun{Mode: models.SyncModeFull, Status: "running", Roots: []int64{100, 200}, StartedAt: 1_710_000_000_000}
+	if err := store.Create(full); err != nil {
+		t.Fatalf("create full run failed: %v", err)
+	}
+	full.Status = "done"
+	full.EndedAt = 1_710_000_060_000
+	full.Stats = models.CrawlStats{FilesIndexed: 42, PagesFetched: 3}
+	full.Verification = &models.SyncVerification{MeiliDocCount: 42, CrawledDocCount: 42, Verified: true}
+	if err := store.Update(full); err != nil {
+		t.Fatalf("update full run failed: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		run := &models.SyncRun{
+			Mode:             models.SyncModeIncremental,
+			Status:           "error",
+			StartedAt:        1_710_000_100_000 + int64(i),
+			IncrementalStats: &models.IncrementalSyncStats{Upserted: int64(i)},
+			Error:            "boom",
+		}
+		if err := store.Create(run); err != nil {
+			t.Fatalf("create incremental run failed: %v", err)
+		}
+	}
+
+	got, err := store.Get(full.ID)
+	if err != nil {
+		t.Fatalf("get run failed: %v", err)
+	}
+	if got == nil || got.Status != "done" || got.EndedAt != 1_710_000_060_000 || got.Stats.FilesIndexed != 42 || len(got.Roots) != 2 {
+		t.Fatalf("unexpected full run: %#v", got)
+	}
+	if got.Verification == nil || !got.Verification.Verified || got.IncrementalStats != nil {
+		t.Fatalf("unexpected full run details: %#v", got)
+	}
+	if missing, err := store.Get(9999); err != nil || missing != nil {
+		t.Fatalf("expected missing run to return nil,nil, got %#v %v", missing, err)
+	}
+
+	page, err := store.List(SyncRunFilter{Limit: 2})
+	if err != nil {
+		t.Fatalf("list runs failed: %v", err)
+	}
+	if len(page) != 2 || page[0].ID <= page[1].ID || page[0].IncrementalStats == nil || page[0].IncrementalStats.Upserted != 2 {
+		t.Fatalf("expected newest runs first, got %#v", page)
+	}
+	next, err := store.List(SyncRunFilter{Limit: 2, BeforeID: page[1].ID})
+	if err != nil {
+		t.Fatalf("list next page failed: %v", err)
+	}
+	if len(next) != 2 || next[1].ID != full.ID {
+		t.Fatalf("expected second page to end with the full run, got %#v", next)
+	}
+
+	fullOnly, err := store.List(SyncRunFilter{Mode: models.SyncModeFull})
+	if err != nil {
+		t.Fatalf("list full runs failed: %v", err)
+	}
+	if len(fullOnly) != 1 || fullOnly[0].ID != full.ID {
+		t.Fatalf("expected only the full run, got %#v", fullOnly)
+	}
+}
@@ -588,7 +588,9 @@ export function AdminSyncPage() {
   const loading = startSyncMutation.isPending || cancelSyncMutation.isPending
   const inspectLoading = inspectRootsMutation.isPending
   const initialLoading = hasAuth && progressQuery.isPending && progress === null
-  const isRunning = progress?.status === 'running'
+  // 暂停的同步与进行中的演练仍占用同步任务，不能再启动新的同步，但可以取消。
+  const isRunning =
+    progress?.status === 'running' || progress?.status === 'paused' || progress?.dryRun?.status === 'running'
   const indexState: IndexState = useMemo(() => {
     if (!hasAuth || indexStatsQuery.isPending) {
       return 'checking'
@@ -79,6 +79,18 @@ describe('FileCard', () => {
     expect(iconEl).toBeInTheDocument()
   })
 
+  it('renders folder breadcrumb from path_text', () => {
+    const doc = { ...baseDoc, path_text: '项目资料/设计/report.pdf' }
+    render(<FileCard doc={doc} downloadStatus="idle" onDownload={() => {}} />)
+    expect(screen.getByText('项目资料 / 设计')).toBeInTheDocument()
+  })
+
+  it('omits breadcrumb for top-level items', () => {
+    const doc = { ...baseDoc, path_text: 'report.pdf' }
+    const { container } = render(<FileCard doc={doc} downloadStatus="idle" onDownload={() => {}} />)
+    expect(container.querySelectorAll('p')).toHaveLength(1)
+  })
+
   it('renders download button', () => {
     render(<FileCard doc={baseDoc} downloadStatus="idle" onDownload={() => {}} />)
     expect(screen.getByRole('button', { name: /下载/ })).toBeInTheDocument()
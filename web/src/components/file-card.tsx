@@ -5,6 +5,12 @@ import { getFileIcon } from '@/lib/file-icon'
 import { FileIcon } from './file-icon'
 import { DownloadButton } from './download-button'
 
+// 面包屑只展示所在目录，去掉末尾的文件名本身。
+function folderBreadcrumb(pathText: string): string {
+  const segments = pathText.split('/').filter((segment) => segment !== '')
+  return segments.slice(0, -1).join(' / ')
+}
+
 type DownloadStatus = 'idle' | 'loading' | 'success' | 'error'
 
 interface FileCardProps {
@@ -18,6 +24,7 @@ export const FileCard = memo(function FileCard({ doc, downloadStatus, onDownload
   const size = formatBytes(doc.size)
   const date = formatTime(doc.modified_at)
   const icon = getFileIcon(doc.name)
+  const breadcrumb = folderBreadcrumb(doc.path_text)
 
   return (
     <article className="group frost-panel rounded-2xl px-4 py-4 sm:px-5">
@@ -41,6 +48,11 @@ export const FileCard = memo(function FileCard({ doc, downloadStatus, onDownload
                 {displayName}
               </h3>
             )}
+            {breadcrumb && (
+              <p className="mt-0.5 truncate text-[12px] text-slate-500" title={breadcrumb}>
+                {breadcrumb}
+              </p>
+            )}
             <p className="mt-1 truncate text-[13px] text-slate-600">
               {size}
               <span className="mx-1.5 text-slate-300">&middot;</span>
@@ -13,6 +13,7 @@ interface SyncProgressDisplayProps {
 const statusConfig: Record<string, { label: string; color: string; badgeBg: string }> = {
   idle: { label: '空闲', color: 'text-slate-600', badgeBg: 'bg-slate-100' },
   running: { label: '运行中', color: 'text-blue-600', badgeBg: 'bg-blue-100' },
+  paused: { label: '已暂停', color: 'text-amber-600', badgeBg: 'bg-amber-100' },
   done: { label: '已完成', color: 'text-sky-700', badgeBg: 'bg-sky-100' },
   error: { label: '出错', color: 'text-rose-600', badgeBg: 'bg-rose-100' },
   interrupted: { label: '已中断', color: 'text-amber-600', badgeBg: 'bg-amber-100' },
@@ -93,6 +94,27 @@ export function SyncProgressDisplay({ progress, rootSelection }: SyncProgressDis
           {progress.incrementalStats.skippedDeletes > 0 && (
             <StatCard label="跳过删除" value={progress.incrementalStats.skippedDeletes} skipped />
           )}
+          {(progress.incrementalStats.pathsRewritten ?? 0) > 0 && (
+            <StatCard label="路径改写" value={progress.incrementalStats.pathsRewritten ?? 0} />
+          )}
+          {(progress.incrementalStats.pathRewritesPending ?? 0) > 0 && (
+            <StatCard label="待改写目录" value={progress.incrementalStats.pathRewritesPending ?? 0} />
+          )}
+          {(progress.incrementalStats.cascadeDeleted ?? 0) > 0 && (
+            <StatCard label="级联删除" value={progress.incrementalStats.cascadeDeleted ?? 0} />
+          )}
+          {(progress.incrementalStats.restoredDocs ?? 0) > 0 && (
+            <StatCard label="恢复重爬" value={progress.incrementalStats.restoredDocs ?? 0} />
+          )}
+          {(progress.incrementalStats.recrawlsPending ?? 0) > 0 && (
+            <StatCard label="待重爬目录" value={progress.incrementalStats.recrawlsPending ?? 0} />
+          )}
+          {(progress.incrementalStats.windowsSplit ?? 0) > 0 && (
+            <StatCard label="窗口二分" value={progress.incrementalStats.windowsSplit ?? 0} />
+          )}
+          {(progress.incrementalStats.windowsPending ?? 0) > 0 && (
+            <StatCard label="待拉取窗口" value={progress.incrementalStats.windowsPending ?? 0} />
+          )}
         </div>
       ) : (
         <div className="grid grid-cols-2 gap-3 sm:grid-cols-3">
@@ -108,6 +130,62 @@ export function SyncProgressDisplay({ progress, rootSelection }: SyncProgressDis
           {stats.failedRequests > 0 && (
             <StatCard label="失败请求" value={stats.failedRequests} error />
           )}
+          {(progress.staleRemoved ?? 0) > 0 && (
+            <StatCard label="已清理过期" value={progress.staleRemoved ?? 0} />
+          )}
+        </div>
+      )}
+
+      {/* Blue/green rebuild */}
+      {progress.rebuild != null && (
+        <div className="rounded-xl border border-slate-200 bg-slate-50/85 p-3">
+          <p className="text-sm font-medium text-slate-700">
+            {progress.rebuild.status === 'building'
+              ? '蓝绿重建中'
+              : progress.rebuild.status === 'swapped'
+                ? '蓝绿重建已切换'
+                : '蓝绿重建已回滚'}
+          </p>
+          <p className="mt-1 text-xs text-slate-600">
+            线上索引: {progress.rebuild.liveIndex} · 影子索引: {progress.rebuild.shadowIndex}
+          </p>
+          {progress.rebuild.lastError && (
+            <p className="mt-1 text-xs text-rose-600">{progress.rebuild.lastError}</p>
+          )}
+        </div>
+      )}
+
+      {/* Dry run diff */}
+      {progress.dryRun != null && (
+        <div className="rounded-xl border border-slate-200 bg-slate-50/85 p-3">
+          <p className="text-sm font-medium text-slate-700">
+            {progress.dryRun.status === 'running' ? '演练中' : '演练结果'}（未写入索引）: 新增{' '}
+            {progress.dryRun.adds.toLocaleString()} · 更新 {progress.dryRun.updates.toLocaleString()} · 删除{' '}
+            {progress.dryRun.deletes.toLocaleString()}
+          </p>
+          {progress.dryRun.lastError && (
+            <p className="mt-1 text-xs text-rose-600">{progress.dryRun.lastError}</p>
+          )}
+          {progress.dryRun.roots.map((root) => (
+            <p key={root.rootFolderId} className="mt-1 text-xs text-slate-600">
+              {root.rootName || root.rootFolderId}: +{root.adds} ~{root.updates} -{root.deletes}
+              {root.sampleDeletes.length > 0 && ` · 将删除 ${root.sampleDeletes[0].path || root.sampleDeletes[0].docId} 等`}
+            </p>
+          ))}
+        </div>
+      )}
+
+      {/* Adaptive rate control */}
+      {progress.rateControl != null && progress.rateControl.throttleEvents > 0 && (
+        <div className="rounded-xl border border-amber-200 bg-amber-50/85 p-3">
+          <p className="text-sm font-medium text-amber-700">
+            {progress.rateControl.backoff ? '上游限流，已自动降速' : '上游限流已恢复'}
+          </p>
+          <p className="mt-1 text-xs text-amber-600">
+            当前速率: {progress.rateControl.effectiveRate > 0 ? `${progress.rateControl.effectiveRate} 次/秒` : '不限速'} · 限流次数:{' '}
+            {progress.rateControl.throttleEvents.toLocaleString()}
+            {progress.rateControl.lastThrottleStatus > 0 && ` · 最近状态码 ${progress.rateControl.lastThrottleStatus}`}
+          </p>
         </div>
       )}
 
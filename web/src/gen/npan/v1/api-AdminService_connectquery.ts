@@ -28,3 +28,123 @@ export const getSyncProgress = AdminService.method.getSyncProgress;
  * @generated from rpc npan.v1.AdminService.CancelSync
  */
 export const cancelSync = AdminService.method.cancelSync;
+
+/**
+ * @generated from rpc npan.v1.AdminService.PauseSync
+ */
+export const pauseSync = AdminService.method.pauseSync;
+
+/**
+ * @generated from rpc npan.v1.AdminService.ResumeSync
+ */
+export const resumeSync = AdminService.method.resumeSync;
+
+/**
+ * @generated from rpc npan.v1.AdminService.ResyncFolder
+ */
+export const resyncFolder = AdminService.method.resyncFolder;
+
+/**
+ * @generated from rpc npan.v1.AdminService.GetSyncLease
+ */
+export const getSyncLease = AdminService.method.getSyncLease;
+
+/**
+ * @generated from rpc npan.v1.AdminService.ForceReleaseSyncLease
+ */
+export const forceReleaseSyncLease = AdminService.method.forceReleaseSyncLease;
+
+/**
+ * @generated from rpc npan.v1.AdminService.GetFolderResyncProgress
+ */
+export const getFolderResyncProgress = AdminService.method.getFolderResyncProgress;
+
+/**
+ * @generated from rpc npan.v1.AdminService.CancelFolderResync
+ */
+export const cancelFolderResync = AdminService.method.cancelFolderResync;
+
+/**
+ * @generated from rpc npan.v1.AdminService.RollbackIndexRebuild
+ */
+export const rollbackIndexRebuild = AdminService.method.rollbackIndexRebuild;
+
+/**
+ * @generated from rpc npan.v1.AdminService.ListSyncRuns
+ */
+export const listSyncRuns = AdminService.method.listSyncRuns;
+
+/**
+ * @generated from rpc npan.v1.AdminService.GetSyncRun
+ */
+export const getSyncRun = AdminService.method.getSyncRun;
+
+/**
+ * @generated from rpc npan.v1.AdminService.ListDeadLetters
+ */
+export const listDeadLetters = AdminService.method.listDeadLetters;
+
+/**
+ * @generated from rpc npan.v1.AdminService.ReplayDeadLetters
+ */
+export const replayDeadLetters = AdminService.method.replayDeadLetters;
+
+/**
+ * @generated from rpc npan.v1.AdminService.DiscardDeadLetters
+ */
+export const discardDeadLetters = AdminService.method.discardDeadLetters;
+
+/**
+ * @generated from rpc npan.v1.AdminService.FindDuplicates
+ */
+export const findDuplicates = AdminService.method.findDuplicates;
+
+/**
+ * @generated from rpc npan.v1.AdminService.StartReconciliation
+ */
+export const startReconciliation = AdminService.method.startReconciliation;
+
+/**
+ * @generated from rpc npan.v1.AdminService.GetReconciliationReport
+ */
+export const getReconciliationReport = AdminService.method.getReconciliationReport;
+
+/**
+ * @generated from rpc npan.v1.AdminService.ExportReconciliationReport
+ */
+export const exportReconciliationReport = AdminService.method.exportReconciliationReport;
+
+/**
+ * @generated from rpc npan.v1.AdminService.CancelReconciliation
+ */
+export const cancelReconciliation = AdminService.method.cancelReconciliation;
+
+/**
+ * @generated from rpc npan.v1.AdminService.ListSyncSchedules
+ */
+export const listSyncSchedules = AdminService.method.listSyncSchedules;
+
+/**
+ * @generated from rpc npan.v1.AdminService.CreateSyncSchedule
+ */
+export const createSyncSchedule = AdminService.method.createSyncSchedule;
+
+/**
+ * @generated from rpc npan.v1.AdminService.PauseSyncSchedule
+ */
+export const pauseSyncSchedule = AdminService.method.pauseSyncSchedule;
+
+/**
+ * @generated from rpc npan.v1.AdminService.ResumeSyncSchedule
+ */
+export const resumeSyncSchedule = AdminService.method.resumeSyncSchedule;
+
+/**
+ * @generated from rpc npan.v1.AdminService.DeleteSyncSchedule
+ */
+export const deleteSyncSchedule = AdminService.method.deleteSyncSchedule;
+
+/**
+ * @generated from rpc npan.v1.AdminService.TestNotification
+ */
+export const testNotification = AdminService.method.testNotification;
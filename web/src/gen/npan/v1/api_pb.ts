@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file npan/v1/api.proto.
  */
 export const file_npan_v1_api: GenFile = /*@__PURE__*/
-  fileDesc("ChFucGFuL3YxL2FwaS5wcm90bxIHbnBhbi52MSKmAgoNSW5kZXhEb2N1bWVudBIOCgZkb2NfaWQYASABKAkSEQoJc291cmNlX2lkGAIgASgDEh8KBHR5cGUYAyABKA4yES5ucGFuLnYxLkl0ZW1UeXBlEgwKBG5hbWUYBCABKAkSEQoJcGF0aF90ZXh0GAUgASgJEhEKCXBhcmVudF9pZBgGIAEoAxITCgttb2RpZmllZF9hdBgHIAEoAxISCgpjcmVhdGVkX2F0GAggASgDEgwKBHNpemUYCSABKAMSDAoEc2hhMRgKIAEoCRIQCghpbl90cmFzaBgLIAEoCBISCgppc19kZWxldGVkGAwgASgIEh0KEGhpZ2hsaWdodGVkX25hbWUYDSABKAlIAIgBAUITChFfaGlnaGxpZ2h0ZWRfbmFtZSJDCgtRdWVyeVJlc3VsdBIlCgVpdGVtcxgBIAMoCzIWLm5wYW4udjEuSW5kZXhEb2N1bWVudBINCgV0b3RhbBgCIAEoAyKnAgoKQ3Jhd2xTdGF0cxIXCg9mb2xkZXJzX3Zpc2l0ZWQYASABKAMSFQoNZmlsZXNfaW5kZXhlZBgCIAEoAxIYChBmaWxlc19kaXNjb3ZlcmVkGAMgASgDEhUKDXNraXBwZWRfZmlsZXMYBCABKAMSFQoNcGFnZXNfZmV0Y2hlZBgFIAEoAxIXCg9mYWlsZWRfcmVxdWVzdHMYBiABKAMSEgoKc3RhcnRlZF9hdBgHIAEoAxIQCghlbmRlZF9hdBgIIAEoAxIxCg1zdGFydGVkX2F0X3RzGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgtlbmRlZF9hdF90cxgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiywMKEFJvb3RTeW5jUHJvZ3Jlc3MSFgoOcm9vdF9mb2xkZXJfaWQYASABKAMSDgoGc3RhdHVzGAIgASgJEiEKFGVzdGltYXRlZF90b3RhbF9kb2NzGAMgASgDSACIAQESIgoFc3RhdHMYBCABKAsyEy5ucGFuLnYxLkNyYXdsU3RhdHMSEgoKdXBkYXRlZF9hdBgFIAEoAxIxCg11cGRhdGVkX2F0X3RzGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIeChFjdXJyZW50X2ZvbGRlcl9pZBgHIAEoA0gBiAEBEhwKD2N1cnJlbnRfcGFnZV9pZBgIIAEoA0gCiAEBEh8KEmN1cnJlbnRfcGFnZV9jb3VudBgJIAEoA0gDiAEBEhkKDHF1ZXVlX2xlbmd0aBgKIAEoA0gEiAEBEhIKBWVycm9yGAsgASgJSAWIAQFCFwoVX2VzdGltYXRlZF90b3RhbF9kb2NzQhQKEl9jdXJyZW50X2ZvbGRlcl9pZEISChBfY3VycmVudF9wYWdlX2lkQhUKE19jdXJyZW50X3BhZ2VfY291bnRCDwoNX3F1ZXVlX2xlbmd0aEIICgZfZXJyb3IisQEKFEluY3JlbWVudGFsU3luY1N0YXRzEhcKD2NoYW5nZXNfZmV0Y2hlZBgBIAEoAxIQCgh1cHNlcnRlZBgCIAEoAxIPCgdkZWxldGVkGAMgASgDEhcKD3NraXBwZWRfdXBzZXJ0cxgEIAEoAxIXCg9za2lwcGVkX2RlbGV0ZXMYBSABKAMSFQoNY3Vyc29yX2JlZm9yZRgGIAEoAxIUCgxjdXJzb3JfYWZ0ZXIYByABKAMinwEKEFN5bmNWZXJpZmljYXRpb24SFwoPbWVpbGlfZG9jX2NvdW50GAEgASgDEhkKEWNyYXdsZWRfZG9jX2NvdW50GAIgASgDEhwKFGRpc2NvdmVyZWRfZG9jX2NvdW50GAMgASgDEhUKDXNraXBwZWRfY291bnQYBCABKAMSEAoIdmVyaWZpZWQYBSABKAgSEAoId2FybmluZ3MYBiADKAkiiAkKEVN5bmNQcm9ncmVzc1N0YXRlEiMKBnN0YXR1cxgBIAEoDjITLm5wYW4udjEuU3luY1N0YXR1cxIkCgRtb2RlGAIgASgOMhEubnBhbi52MS5TeW5jTW9kZUgAiAEBEhIKCnN0YXJ0ZWRfYXQYAyABKAMSEgoKdXBkYXRlZF9hdBgEIAEoAxINCgVyb290cxgFIAMoAxI9Cgpyb290X25hbWVzGAYgAygLMikubnBhbi52MS5TeW5jUHJvZ3Jlc3NTdGF0ZS5Sb290TmFtZXNFbnRyeRIXCg9jb21wbGV0ZWRfcm9vdHMYByADKAMSGAoLYWN0aXZlX3Jvb3QYCCABKANIAYgBARIsCg9hZ2dyZWdhdGVfc3RhdHMYCSABKAsyEy5ucGFuLnYxLkNyYXdsU3RhdHMSQwoNcm9vdF9wcm9ncmVzcxgKIAMoCzIsLm5wYW4udjEuU3luY1Byb2dyZXNzU3RhdGUuUm9vdFByb2dyZXNzRW50cnkSFQoNY2F0YWxvZ19yb290cxgLIAMoAxJMChJjYXRhbG9nX3Jvb3RfbmFtZXMYDCADKAsyMC5ucGFuLnYxLlN5bmNQcm9ncmVzc1N0YXRlLkNhdGFsb2dSb290TmFtZXNFbnRyeRJSChVjYXRhbG9nX3Jvb3RfcHJvZ3Jlc3MYDSADKAsyMy5ucGFuLnYxLlN5bmNQcm9ncmVzc1N0YXRlLkNhdGFsb2dSb290UHJvZ3Jlc3NFbnRyeRI9ChFpbmNyZW1lbnRhbF9zdGF0cxgOIAEoCzIdLm5wYW4udjEuSW5jcmVtZW50YWxTeW5jU3RhdHNIAogBARIXCgpsYXN0X2Vycm9yGA8gASgJSAOIAQESNAoMdmVyaWZpY2F0aW9uGBAgASgLMhkubnBhbi52MS5TeW5jVmVyaWZpY2F0aW9uSASIAQESMQoNc3RhcnRlZF9hdF90cxgRIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMQoNdXBkYXRlZF9hdF90cxgSIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaMAoOUm9vdE5hbWVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARpOChFSb290UHJvZ3Jlc3NFbnRyeRILCgNrZXkYASABKAkSKAoFdmFsdWUYAiABKAsyGS5ucGFuLnYxLlJvb3RTeW5jUHJvZ3Jlc3M6AjgBGjcKFUNhdGFsb2dSb290TmFtZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGlUKGENhdGFsb2dSb290UHJvZ3Jlc3NFbnRyeRILCgNrZXkYASABKAkSKAoFdmFsdWUYAiABKAsyGS5ucGFuLnYxLlJvb3RTeW5jUHJvZ3Jlc3M6AjgBQgcKBV9tb2RlQg4KDF9hY3RpdmVfcm9vdEIUChJfaW5jcmVtZW50YWxfc3RhdHNCDQoLX2xhc3RfZXJyb3JCDwoNX3ZlcmlmaWNhdGlvbiJqCg1FcnJvclJlc3BvbnNlEiAKBGNvZGUYASABKA4yEi5ucGFuLnYxLkVycm9yQ29kZRIPCgdtZXNzYWdlGAIgASgJEhcKCnJlcXVlc3RfaWQYAyABKAlIAIgBAUINCgtfcmVxdWVzdF9pZCI6ChFEb3dubG9hZFVSTFJlc3VsdBIPCgdmaWxlX2lkGAEgASgDEhQKDGRvd25sb2FkX3VybBgCIAEoCSI6ChBSZW1vdGVTZWFyY2hJdGVtEgoKAmlkGAEgASgDEgwKBG5hbWUYAiABKAkSDAoEdHlwZRgDIAEoCSK9AQoUUmVtb3RlU2VhcmNoUmVzcG9uc2USKAoFZmlsZXMYASADKAsyGS5ucGFuLnYxLlJlbW90ZVNlYXJjaEl0ZW0SKgoHZm9sZGVycxgCIAMoCzIZLm5wYW4udjEuUmVtb3RlU2VhcmNoSXRlbRITCgt0b3RhbF9jb3VudBgDIAEoAxIPCgdwYWdlX2lkGAQgASgDEhUKDXBhZ2VfY2FwYWNpdHkYBSABKAMSEgoKcGFnZV9jb3VudBgGIAEoAyJkCg9JbnNwZWN0Um9vdEl0ZW0SEQoJZm9sZGVyX2lkGAEgASgDEgwKBG5hbWUYAiABKAkSEgoKaXRlbV9jb3VudBgDIAEoAxIcChRlc3RpbWF0ZWRfdG90YWxfZG9jcxgEIAEoAyI2ChBJbnNwZWN0Um9vdEVycm9yEhEKCWZvbGRlcl9pZBgBIAEoAxIPCgdtZXNzYWdlGAIgASgJIg8KDUhlYWx0aFJlcXVlc3QiNgoOSGVhbHRoUmVzcG9uc2USDgoGc3RhdHVzGAEgASgJEhQKDHJ1bm5pbmdfc3luYxgCIAEoCCIPCg1SZWFkeXpSZXF1ZXN0IlQKDlJlYWR5elJlc3BvbnNlEiQKBnN0YXR1cxgBIAEoDjIULm5wYW4udjEuUmVhZHlTdGF0dXMSEgoFbWVpbGkYAiABKAlIAIgBAUIICgZfbWVpbGkiGAoWR2V0U2VhcmNoQ29uZmlnUmVxdWVzdCKEAQoXR2V0U2VhcmNoQ29uZmlnUmVzcG9uc2USDAoEaG9zdBgBIAEoCRISCgppbmRleF9uYW1lGAIgASgJEhYKDnNlYXJjaF9hcGlfa2V5GAMgASgJEh0KFWluc3RhbnRzZWFyY2hfZW5hYmxlZBgEIAEoCBIQCghwcm92aWRlchgFIAEoCSJ3ChBBcHBTZWFyY2hSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEhoKBHBhZ2UYAiABKANCB7pIBCICIABIAIgBARIhCglwYWdlX3NpemUYAyABKANCCbpIBiIEGGQgAEgBiAEBQgcKBV9wYWdlQgwKCl9wYWdlX3NpemUiOQoRQXBwU2VhcmNoUmVzcG9uc2USJAoGcmVzdWx0GAEgASgLMhQubnBhbi52MS5RdWVyeVJlc3VsdCJUChVBcHBEb3dubG9hZFVSTFJlcXVlc3QSDwoHZmlsZV9pZBgBIAEoAxIZCgx2YWxpZF9wZXJpb2QYAiABKANIAIgBAUIPCg1fdmFsaWRfcGVyaW9kIkQKFkFwcERvd25sb2FkVVJMUmVzcG9uc2USKgoGcmVzdWx0GAEgASgLMhoubnBhbi52MS5Eb3dubG9hZFVSTFJlc3VsdCLyAQoSQ3JlYXRlVG9rZW5SZXF1ZXN0EhIKBXRva2VuGAEgASgJSACIAQESFgoJY2xpZW50X2lkGAIgASgJSAGIAQESGgoNY2xpZW50X3NlY3JldBgDIAEoCUgCiAEBEhMKBnN1Yl9pZBgEIAEoA0gDiAEBEhUKCHN1Yl90eXBlGAUgASgJSASIAQESFwoKb2F1dGhfaG9zdBgGIAEoCUgFiAEBQggKBl90b2tlbkIMCgpfY2xpZW50X2lkQhAKDl9jbGllbnRfc2VjcmV0QgkKB19zdWJfaWRCCwoJX3N1Yl90eXBlQg0KC19vYXV0aF9ob3N0IiQKE0NyZWF0ZVRva2VuUmVzcG9uc2USDQoFdG9rZW4YASABKAki+gEKE1JlbW90ZVNlYXJjaFJlcXVlc3QSDQoFcXVlcnkYASABKAkSEQoEdHlwZRgCIAEoCUgAiAEBEhQKB3BhZ2VfaWQYAyABKANIAYgBARIZCgxxdWVyeV9maWx0ZXIYBCABKAlIAogBARIdChBzZWFyY2hfaW5fZm9sZGVyGAUgASgDSAOIAQESHwoSdXBkYXRlZF90aW1lX3JhbmdlGAYgASgJSASIAQFCBwoFX3R5cGVCCgoIX3BhZ2VfaWRCDwoNX3F1ZXJ5X2ZpbHRlckITChFfc2VhcmNoX2luX2ZvbGRlckIVChNfdXBkYXRlZF90aW1lX3JhbmdlIssCChJMb2NhbFNlYXJjaFJlcXVlc3QSDQoFcXVlcnkYASABKAkSGgoEcGFnZRgCIAEoA0IHukgEIgIgAEgAiAEBEiEKCXBhZ2Vfc2l6ZRgDIAEoA0IJukgGIgQYZCAASAGIAQESEQoEdHlwZRgEIAEoCUgCiAEBEhYKCXBhcmVudF9pZBgFIAEoA0gDiAEBEhoKDXVwZGF0ZWRfYWZ0ZXIYBiABKANIBIgBARIbCg51cGRhdGVkX2JlZm9yZRgHIAEoA0gFiAEBEhwKD2luY2x1ZGVfZGVsZXRlZBgIIAEoCEgGiAEBQgcKBV9wYWdlQgwKCl9wYWdlX3NpemVCBwoFX3R5cGVCDAoKX3BhcmVudF9pZEIQCg5fdXBkYXRlZF9hZnRlckIRCg9fdXBkYXRlZF9iZWZvcmVCEgoQX2luY2x1ZGVfZGVsZXRlZCI7ChNMb2NhbFNlYXJjaFJlc3BvbnNlEiQKBnJlc3VsdBgBIAEoCzIULm5wYW4udjEuUXVlcnlSZXN1bHQiUQoSRG93bmxvYWRVUkxSZXF1ZXN0Eg8KB2ZpbGVfaWQYASABKAMSGQoMdmFsaWRfcGVyaW9kGAIgASgDSACIAQFCDwoNX3ZhbGlkX3BlcmlvZCJBChNEb3dubG9hZFVSTFJlc3BvbnNlEioKBnJlc3VsdBgBIAEoCzIaLm5wYW4udjEuRG93bmxvYWRVUkxSZXN1bHQigwUKEFN0YXJ0U3luY1JlcXVlc3QSJAoEbW9kZRgBIAEoDjIRLm5wYW4udjEuU3luY01vZGVIAIgBARIlCg9yb290X2ZvbGRlcl9pZHMYAiADKANCDLpICZIBBiIEIgIgABIgChNpbmNsdWRlX2RlcGFydG1lbnRzGAMgASgISAGIAQESIgoVcHJlc2VydmVfcm9vdF9jYXRhbG9nGAQgASgISAKIAQESJAoOZGVwYXJ0bWVudF9pZHMYBSADKANCDLpICZIBBiIEIgIgABIcCg9yZXN1bWVfcHJvZ3Jlc3MYBiABKAhIA4gBARIaCg1mb3JjZV9yZWJ1aWxkGAcgASgISASIAQESIgoMcm9vdF93b3JrZXJzGAggASgDQge6SAQiAiAASAWIAQESJAoOcHJvZ3Jlc3NfZXZlcnkYCSABKANCB7pIBCICIABIBogBARIgChNjaGVja3BvaW50X3RlbXBsYXRlGAogASgJSAeIAQESJwoRd2luZG93X292ZXJsYXBfbXMYCyABKANCB7pIBCICKABICIgBARIeChFpbmNyZW1lbnRhbF9xdWVyeRgMIAEoCUgJiAEBQgcKBV9tb2RlQhYKFF9pbmNsdWRlX2RlcGFydG1lbnRzQhgKFl9wcmVzZXJ2ZV9yb290X2NhdGFsb2dCEgoQX3Jlc3VtZV9wcm9ncmVzc0IQCg5fZm9yY2VfcmVidWlsZEIPCg1fcm9vdF93b3JrZXJzQhEKD19wcm9ncmVzc19ldmVyeUIWChRfY2hlY2twb2ludF90ZW1wbGF0ZUIUChJfd2luZG93X292ZXJsYXBfbXNCFAoSX2luY3JlbWVudGFsX3F1ZXJ5IiQKEVN0YXJ0U3luY1Jlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiOQoTSW5zcGVjdFJvb3RzUmVxdWVzdBIiCgpmb2xkZXJfaWRzGAEgAygDQg66SAuSAQgIASIEIgIgACJqChRJbnNwZWN0Um9vdHNSZXNwb25zZRInCgVpdGVtcxgBIAMoCzIYLm5wYW4udjEuSW5zcGVjdFJvb3RJdGVtEikKBmVycm9ycxgCIAMoCzIZLm5wYW4udjEuSW5zcGVjdFJvb3RFcnJvciIWChRHZXRJbmRleFN0YXRzUmVxdWVzdCIvChVHZXRJbmRleFN0YXRzUmVzcG9uc2USFgoOZG9jdW1lbnRfY291bnQYASABKAMiGAoWR2V0U3luY1Byb2dyZXNzUmVxdWVzdCJEChdHZXRTeW5jUHJvZ3Jlc3NSZXNwb25zZRIpCgVzdGF0ZRgBIAEoCzIaLm5wYW4udjEuU3luY1Byb2dyZXNzU3RhdGUiGgoYV2F0Y2hTeW5jUHJvZ3Jlc3NSZXF1ZXN0IkYKGVdhdGNoU3luY1Byb2dyZXNzUmVzcG9uc2USKQoFc3RhdGUYASABKAsyGi5ucGFuLnYxLlN5bmNQcm9ncmVzc1N0YXRlIhMKEUNhbmNlbFN5bmNSZXF1ZXN0IiUKEkNhbmNlbFN5bmNSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJKk8KCEl0ZW1UeXBlEhkKFUlURU1fVFlQRV9VTlNQRUNJRklFRBAAEhIKDklURU1fVFlQRV9GSUxFEAESFAoQSVRFTV9UWVBFX0ZPTERFUhACKr0BCgpTeW5jU3RhdHVzEhsKF1NZTkNfU1RBVFVTX1VOU1BFQ0lGSUVEEAASFAoQU1lOQ19TVEFUVVNfSURMRRABEhcKE1NZTkNfU1RBVFVTX1JVTk5JTkcQAhIUChBTWU5DX1NUQVRVU19ET05FEAMSFQoRU1lOQ19TVEFUVVNfRVJST1IQBBIZChVTWU5DX1NUQVRVU19DQU5DRUxMRUQQBRIbChdTWU5DX1NUQVRVU19JTlRFUlJVUFRFRBAGKmoKCFN5bmNNb2RlEhkKFVNZTkNfTU9ERV9VTlNQRUNJRklFRBAAEhIKDlNZTkNfTU9ERV9GVUxMEAISGQoVU1lOQ19NT0RFX0lOQ1JFTUVOVEFMEAMiBAgBEAEqDlNZTkNfTU9ERV9BVVRPKs8BCglFcnJvckNvZGUSGgoWRVJST1JfQ09ERV9VTlNQRUNJRklFRBAAEhsKF0VSUk9SX0NPREVfVU5BVVRIT1JJWkVEEAESGgoWRVJST1JfQ09ERV9CQURfUkVRVUVTVBACEhgKFEVSUk9SX0NPREVfTk9UX0ZPVU5EEAMSFwoTRVJST1JfQ09ERV9DT05GTElDVBAEEhsKF0VSUk9SX0NPREVfUkFURV9MSU1JVEVEEAUSHQoZRVJST1JfQ09ERV9JTlRFUk5BTF9FUlJPUhAGKl8KC1JlYWR5U3RhdHVzEhwKGFJFQURZX1NUQVRVU19VTlNQRUNJRklFRBAAEhYKElJFQURZX1NUQVRVU19SRUFEWRABEhoKFlJFQURZX1NUQVRVU19OT1RfUkVBRFkQAjKFAQoNSGVhbHRoU2VydmljZRI5CgZIZWFsdGgSFi5ucGFuLnYxLkhlYWx0aFJlcXVlc3QaFy5ucGFuLnYxLkhlYWx0aFJlc3BvbnNlEjkKBlJlYWR5ehIWLm5wYW4udjEuUmVhZHl6UmVxdWVzdBoXLm5wYW4udjEuUmVhZHl6UmVzcG9uc2Uy+QEKCkFwcFNlcnZpY2USVAoPR2V0U2VhcmNoQ29uZmlnEh8ubnBhbi52MS5HZXRTZWFyY2hDb25maWdSZXF1ZXN0GiAubnBhbi52MS5HZXRTZWFyY2hDb25maWdSZXNwb25zZRJCCglBcHBTZWFyY2gSGS5ucGFuLnYxLkFwcFNlYXJjaFJlcXVlc3QaGi5ucGFuLnYxLkFwcFNlYXJjaFJlc3BvbnNlElEKDkFwcERvd25sb2FkVVJMEh4ubnBhbi52MS5BcHBEb3dubG9hZFVSTFJlcXVlc3QaHy5ucGFuLnYxLkFwcERvd25sb2FkVVJMUmVzcG9uc2UyVwoLQXV0aFNlcnZpY2USSAoLQ3JlYXRlVG9rZW4SGy5ucGFuLnYxLkNyZWF0ZVRva2VuUmVxdWVzdBocLm5wYW4udjEuQ3JlYXRlVG9rZW5SZXNwb25zZTLwAQoNU2VhcmNoU2VydmljZRJLCgxSZW1vdGVTZWFyY2gSHC5ucGFuLnYxLlJlbW90ZVNlYXJjaFJlcXVlc3QaHS5ucGFuLnYxLlJlbW90ZVNlYXJjaFJlc3BvbnNlEkgKC0xvY2FsU2VhcmNoEhsubnBhbi52MS5Mb2NhbFNlYXJjaFJlcXVlc3QaHC5ucGFuLnYxLkxvY2FsU2VhcmNoUmVzcG9uc2USSAoLRG93bmxvYWRVUkwSGy5ucGFuLnYxLkRvd25sb2FkVVJMUmVxdWVzdBocLm5wYW4udjEuRG93bmxvYWRVUkxSZXNwb25zZTLqAwoMQWRtaW5TZXJ2aWNlEkIKCVN0YXJ0U3luYxIZLm5wYW4udjEuU3RhcnRTeW5jUmVxdWVzdBoaLm5wYW4udjEuU3RhcnRTeW5jUmVzcG9uc2USSwoMSW5zcGVjdFJvb3RzEhwubnBhbi52MS5JbnNwZWN0Um9vdHNSZXF1ZXN0Gh0ubnBhbi52MS5JbnNwZWN0Um9vdHNSZXNwb25zZRJOCg1HZXRJbmRleFN0YXRzEh0ubnBhbi52MS5HZXRJbmRleFN0YXRzUmVxdWVzdBoeLm5wYW4udjEuR2V0SW5kZXhTdGF0c1Jlc3BvbnNlElQKD0dldFN5bmNQcm9ncmVzcxIfLm5wYW4udjEuR2V0U3luY1Byb2dyZXNzUmVxdWVzdBogLm5wYW4udjEuR2V0U3luY1Byb2dyZXNzUmVzcG9uc2USXAoRV2F0Y2hTeW5jUHJvZ3Jlc3MSIS5ucGFuLnYxLldhdGNoU3luY1Byb2dyZXNzUmVxdWVzdBoiLm5wYW4udjEuV2F0Y2hTeW5jUHJvZ3Jlc3NSZXNwb25zZTABEkUKCkNhbmNlbFN5bmMSGi5ucGFuLnYxLkNhbmNlbFN5bmNSZXF1ZXN0GhsubnBhbi52MS5DYW5jZWxTeW5jUmVzcG9uc2VCHFoabnBhbi9nZW4vZ28vbnBhbi92MTtucGFudjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+  fileDesc("ChFucGFuL3YxL2FwaS5wcm90bxIHbnBhbi52MSLiAgoNSW5kZXhEb2N1bWVudBIOCgZkb2NfaWQYASABKAkSEQoJc291cmNlX2lkGAIgASgDEh8KBHR5cGUYAyABKA4yES5ucGFuLnYxLkl0ZW1UeXBlEgwKBG5hbWUYBCABKAkSEQoJcGF0aF90ZXh0GAUgASgJEhEKCXBhcmVudF9pZBgGIAEoAxITCgttb2RpZmllZF9hdBgHIAEoAxISCgpjcmVhdGVkX2F0GAggASgDEgwKBHNpemUYCSABKAMSDAoEc2hhMRgKIAEoCRIQCghpbl90cmFzaBgLIAEoCBISCgppc19kZWxldGVkGAwgASgIEh0KEGhpZ2hsaWdodGVkX25hbWUYDSABKAlIAIgBARIUCgxhbmNlc3Rvcl9pZHMYDiADKAMSFgoJdGVuYW50X2lkGA8gASgJSAGIAQFCEwoRX2hpZ2hsaWdodGVkX25hbWVCDAoKX3RlbmFudF9pZCJDCgtRdWVyeVJlc3VsdBIlCgVpdGVtcxgBIAMoCzIWLm5wYW4udjEuSW5kZXhEb2N1bWVudBINCgV0b3RhbBgCIAEoAyKnAgoKQ3Jhd2xTdGF0cxIXCg9mb2xkZXJzX3Zpc2l0ZWQYASABKAMSFQoNZmlsZXNfaW5kZXhlZBgCIAEoAxIYChBmaWxlc19kaXNjb3ZlcmVkGAMgASgDEhUKDXNraXBwZWRfZmlsZXMYBCABKAMSFQoNcGFnZXNfZmV0Y2hlZBgFIAEoAxIXCg9mYWlsZWRfcmVxdWVzdHMYBiABKAMSEgoKc3RhcnRlZF9hdBgHIAEoAxIQCghlbmRlZF9hdBgIIAEoAxIxCg1zdGFydGVkX2F0X3RzGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgtlbmRlZF9hdF90cxgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi4gMKEFJvb3RTeW5jUHJvZ3Jlc3MSFgoOcm9vdF9mb2xkZXJfaWQYASABKAMSDgoGc3RhdHVzGAIgASgJEiEKFGVzdGltYXRlZF90b3RhbF9kb2NzGAMgASgDSACIAQESIgoFc3RhdHMYBCABKAsyEy5ucGFuLnYxLkNyYXdsU3RhdHMSEgoKdXBkYXRlZF9hdBgFIAEoAxIxCg11cGRhdGVkX2F0X3RzGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIeChFjdXJyZW50X2ZvbGRlcl9pZBgHIAEoA0gBiAEBEhwKD2N1cnJlbnRfcGFnZV9pZBgIIAEoA0gCiAEBEh8KEmN1cnJlbnRfcGFnZV9jb3VudBgJIAEoA0gDiAEBEhkKDHF1ZXVlX2xlbmd0aBgKIAEoA0gEiAEBEhIKBWVycm9yGAsgASgJSAWIAQESFQoNc3RhbGVfcmVtb3ZlZBgMIAEoA0IXChVfZXN0aW1hdGVkX3RvdGFsX2RvY3NCFAoSX2N1cnJlbnRfZm9sZGVyX2lkQhIKEF9jdXJyZW50X3BhZ2VfaWRCFQoTX2N1cnJlbnRfcGFnZV9jb3VudEIPCg1fcXVldWVfbGVuZ3RoQggKBl9lcnJvciKtAwoUSW5jcmVtZW50YWxTeW5jU3RhdHMSFwoPY2hhbmdlc19mZXRjaGVkGAEgASgDEhAKCHVwc2VydGVkGAIgASgDEg8KB2RlbGV0ZWQYAyABKAMSFwoPc2tpcHBlZF91cHNlcnRzGAQgASgDEhcKD3NraXBwZWRfZGVsZXRlcxgFIAEoAxIVCg1jdXJzb3JfYmVmb3JlGAYgASgDEhQKDGN1cnNvcl9hZnRlchgHIAEoAxIVCg1mb2xkZXJzX21vdmVkGAggASgDEhcKD3BhdGhzX3Jld3JpdHRlbhgJIAEoAxIdChVwYXRoX3Jld3JpdGVzX3BlbmRpbmcYCiABKAMSFwoPY2FzY2FkZV9kZWxldGVkGAsgASgDEhgKEGZvbGRlcnNfcmVzdG9yZWQYDCABKAMSFQoNcmVzdG9yZWRfZG9jcxgNIAEoAxIYChByZWNyYXdsc19wZW5kaW5nGA4gASgDEhcKD3dpbmRvd3NfZmV0Y2hlZBgPIAEoAxIVCg13aW5kb3dzX3NwbGl0GBAgASgDEhcKD3dpbmRvd3NfcGVuZGluZxgRIAEoAyLRAQoQU3luY1ZlcmlmaWNhdGlvbhIXCg9tZWlsaV9kb2NfY291bnQYASABKAMSGQoRY3Jhd2xlZF9kb2NfY291bnQYAiABKAMSHAoUZGlzY292ZXJlZF9kb2NfY291bnQYAyABKAMSFQoNc2tpcHBlZF9jb3VudBgEIAEoAxIQCgh2ZXJpZmllZBgFIAEoCBIQCgh3YXJuaW5ncxgGIAMoCRIVCg1zdGFsZV9yZW1vdmVkGAcgASgDEhkKEWRlYWRfbGV0dGVyX2NvdW50GAggASgDIoMLChFTeW5jUHJvZ3Jlc3NTdGF0ZRIjCgZzdGF0dXMYASABKA4yEy5ucGFuLnYxLlN5bmNTdGF0dXMSJAoEbW9kZRgCIAEoDjIRLm5wYW4udjEuU3luY01vZGVIAIgBARISCgpzdGFydGVkX2F0GAMgASgDEhIKCnVwZGF0ZWRfYXQYBCABKAMSDQoFcm9vdHMYBSADKAMSPQoKcm9vdF9uYW1lcxgGIAMoCzIpLm5wYW4udjEuU3luY1Byb2dyZXNzU3RhdGUuUm9vdE5hbWVzRW50cnkSFwoPY29tcGxldGVkX3Jvb3RzGAcgAygDEhgKC2FjdGl2ZV9yb290GAggASgDSAGIAQESLAoPYWdncmVnYXRlX3N0YXRzGAkgASgLMhMubnBhbi52MS5DcmF3bFN0YXRzEkMKDXJvb3RfcHJvZ3Jlc3MYCiADKAsyLC5ucGFuLnYxLlN5bmNQcm9ncmVzc1N0YXRlLlJvb3RQcm9ncmVzc0VudHJ5EhUKDWNhdGFsb2dfcm9vdHMYCyADKAMSTAoSY2F0YWxvZ19yb290X25hbWVzGAwgAygLMjAubnBhbi52MS5TeW5jUHJvZ3Jlc3NTdGF0ZS5DYXRhbG9nUm9vdE5hbWVzRW50cnkSUgoVY2F0YWxvZ19yb290X3Byb2dyZXNzGA0gAygLMjMubnBhbi52MS5TeW5jUHJvZ3Jlc3NTdGF0ZS5DYXRhbG9nUm9vdFByb2dyZXNzRW50cnkSPQoRaW5jcmVtZW50YWxfc3RhdHMYDiABKAsyHS5ucGFuLnYxLkluY3JlbWVudGFsU3luY1N0YXRzSAKIAQESFwoKbGFzdF9lcnJvchgPIAEoCUgDiAEBEjQKDHZlcmlmaWNhdGlvbhgQIAEoCzIZLm5wYW4udjEuU3luY1ZlcmlmaWNhdGlvbkgEiAEBEjEKDXN0YXJ0ZWRfYXRfdHMYESABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjEKDXVwZGF0ZWRfYXRfdHMYEiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDXN0YWxlX3JlbW92ZWQYEyABKAMSMAoHcmVidWlsZBgUIAEoCzIaLm5wYW4udjEuSW5kZXhSZWJ1aWxkU3RhdGVIBYgBARIrCgdkcnlfcnVuGBUgASgLMhUubnBhbi52MS5EcnlSdW5SZXBvcnRIBogBARI0CgxyYXRlX2NvbnRyb2wYFiABKAsyGS5ucGFuLnYxLlJhdGVDb250cm9sU3RhdGVIB4gBARIWCglwYXVzZWRfYXQYFyABKANICIgBARowCg5Sb290TmFtZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGk4KEVJvb3RQcm9ncmVzc0VudHJ5EgsKA2tleRgBIAEoCRIoCgV2YWx1ZRgCIAEoCzIZLm5wYW4udjEuUm9vdFN5bmNQcm9ncmVzczoCOAEaNwoVQ2F0YWxvZ1Jvb3ROYW1lc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEaVQoYQ2F0YWxvZ1Jvb3RQcm9ncmVzc0VudHJ5EgsKA2tleRgBIAEoCRIoCgV2YWx1ZRgCIAEoCzIZLm5wYW4udjEuUm9vdFN5bmNQcm9ncmVzczoCOAFCBwoFX21vZGVCDgoMX2FjdGl2ZV9yb290QhQKEl9pbmNyZW1lbnRhbF9zdGF0c0INCgtfbGFzdF9lcnJvckIPCg1fdmVyaWZpY2F0aW9uQgoKCF9yZWJ1aWxkQgoKCF9kcnlfcnVuQg8KDV9yYXRlX2NvbnRyb2xCDAoKX3BhdXNlZF9hdCK1AQoQUmF0ZUNvbnRyb2xTdGF0ZRIRCgliYXNlX3JhdGUYASABKAESFgoOZWZmZWN0aXZlX3JhdGUYAiABKAESDwoHYmFja29mZhgDIAEoCBIUCgxwYXVzZWRfdW50aWwYBCABKAMSFwoPdGhyb3R0bGVfZXZlbnRzGAUgASgDEhgKEGxhc3RfdGhyb3R0bGVfYXQYBiABKAMSHAoUbGFzdF90aHJvdHRsZV9zdGF0dXMYByABKAUieAoMRHJ5UnVuU2FtcGxlEg4KBmRvY19pZBgBIAEoCRIRCglzb3VyY2VfaWQYAiABKAMSHwoEdHlwZRgDIAEoDjIRLm5wYW4udjEuSXRlbVR5cGUSDAoEcGF0aBgEIAEoCRIWCg5jaGFuZ2VkX2ZpZWxkcxgFIAMoCSKIAgoORHJ5UnVuUm9vdERpZmYSFgoOcm9vdF9mb2xkZXJfaWQYASABKAMSEQoJcm9vdF9uYW1lGAIgASgJEgwKBGFkZHMYAyABKAMSDwoHdXBkYXRlcxgEIAEoAxIPCgdkZWxldGVzGAUgASgDEhEKCXVuY2hhbmdlZBgGIAEoAxIqCgtzYW1wbGVfYWRkcxgHIAMoCzIVLm5wYW4udjEuRHJ5UnVuU2FtcGxlEi0KDnNhbXBsZV91cGRhdGVzGAggAygLMhUubnBhbi52MS5EcnlSdW5TYW1wbGUSLQoOc2FtcGxlX2RlbGV0ZXMYCSADKAsyFS5ucGFuLnYxLkRyeVJ1blNhbXBsZSLKAgoMRHJ5UnVuUmVwb3J0EiQKBG1vZGUYASABKA4yES5ucGFuLnYxLlN5bmNNb2RlSACIAQESEgoKc3RhcnRlZF9hdBgCIAEoAxIYCgtmaW5pc2hlZF9hdBgDIAEoA0gBiAEBEiYKBXJvb3RzGAQgAygLMhcubnBhbi52MS5EcnlSdW5Sb290RGlmZhIMCgRhZGRzGAUgASgDEg8KB3VwZGF0ZXMYBiABKAMSDwoHZGVsZXRlcxgHIAEoAxIjCgZzdGF0dXMYCCABKA4yEy5ucGFuLnYxLlN5bmNTdGF0dXMSFwoKbGFzdF9lcnJvchgJIAEoCUgCiAEBEhgKC2FjdGl2ZV9yb290GAogASgDSAOIAQFCBwoFX21vZGVCDgoMX2ZpbmlzaGVkX2F0Qg0KC19sYXN0X2Vycm9yQg4KDF9hY3RpdmVfcm9vdCL+AQoRSW5kZXhSZWJ1aWxkU3RhdGUSKwoGc3RhdHVzGAEgASgOMhsubnBhbi52MS5JbmRleFJlYnVpbGRTdGF0dXMSEgoKbGl2ZV9pbmRleBgCIAEoCRIUCgxzaGFkb3dfaW5kZXgYAyABKAkSEgoKc3RhcnRlZF9hdBgEIAEoAxIXCgpzd2FwcGVkX2F0GAUgASgDSACIAQESGwoOcm9sbGVkX2JhY2tfYXQYBiABKANIAYgBARIXCgpsYXN0X2Vycm9yGAcgASgJSAKIAQFCDQoLX3N3YXBwZWRfYXRCEQoPX3JvbGxlZF9iYWNrX2F0Qg0KC19sYXN0X2Vycm9yImoKDUVycm9yUmVzcG9uc2USIAoEY29kZRgBIAEoDjISLm5wYW4udjEuRXJyb3JDb2RlEg8KB21lc3NhZ2UYAiABKAkSFwoKcmVxdWVzdF9pZBgDIAEoCUgAiAEBQg0KC19yZXF1ZXN0X2lkIjoKEURvd25sb2FkVVJMUmVzdWx0Eg8KB2ZpbGVfaWQYASABKAMSFAoMZG93bmxvYWRfdXJsGAIgASgJIjoKEFJlbW90ZVNlYXJjaEl0ZW0SCgoCaWQYASABKAMSDAoEbmFtZRgCIAEoCRIMCgR0eXBlGAMgASgJIr0BChRSZW1vdGVTZWFyY2hSZXNwb25zZRIoCgVmaWxlcxgBIAMoCzIZLm5wYW4udjEuUmVtb3RlU2VhcmNoSXRlbRIqCgdmb2xkZXJzGAIgAygLMhkubnBhbi52MS5SZW1vdGVTZWFyY2hJdGVtEhMKC3RvdGFsX2NvdW50GAMgASgDEg8KB3BhZ2VfaWQYBCABKAMSFQoNcGFnZV9jYXBhY2l0eRgFIAEoAxISCgpwYWdlX2NvdW50GAYgASgDImQKD0luc3BlY3RSb290SXRlbRIRCglmb2xkZXJfaWQYASABKAMSDAoEbmFtZRgCIAEoCRISCgppdGVtX2NvdW50GAMgASgDEhwKFGVzdGltYXRlZF90b3RhbF9kb2NzGAQgASgDIjYKEEluc3BlY3RSb290RXJyb3ISEQoJZm9sZGVyX2lkGAEgASgDEg8KB21lc3NhZ2UYAiABKAkiDwoNSGVhbHRoUmVxdWVzdCI2Cg5IZWFsdGhSZXNwb25zZRIOCgZzdGF0dXMYASABKAkSFAoMcnVubmluZ19zeW5jGAIgASgIIg8KDVJlYWR5elJlcXVlc3QioAEKDlJlYWR5elJlc3BvbnNlEiQKBnN0YXR1cxgBIAEoDjIULm5wYW4udjEuUmVhZHlTdGF0dXMSEgoFbWVpbGkYAiABKAlIAIgBARIVCghucGFuX2FwaRgDIAEoCUgBiAEBEhcKCm5wYW5fdG9rZW4YBCABKAlIAogBAUIICgZfbWVpbGlCCwoJX25wYW5fYXBpQg0KC19ucGFuX3Rva2VuIj4KFkdldFNlYXJjaENvbmZpZ1JlcXVlc3QSFgoJdGVuYW50X2lkGAEgASgJSACIAQFCDAoKX3RlbmFudF9pZCKXAQoXR2V0U2VhcmNoQ29uZmlnUmVzcG9uc2USDAoEaG9zdBgBIAEoCRISCgppbmRleF9uYW1lGAIgASgJEhYKDnNlYXJjaF9hcGlfa2V5GAMgASgJEh0KFWluc3RhbnRzZWFyY2hfZW5hYmxlZBgEIAEoCBIQCghwcm92aWRlchgFIAEoCRIRCgl0ZW5hbnRfaWQYBiABKAki2gEKEEFwcFNlYXJjaFJlcXVlc3QSDQoFcXVlcnkYASABKAkSGgoEcGFnZRgCIAEoA0IHukgEIgIgAEgAiAEBEiEKCXBhZ2Vfc2l6ZRgDIAEoA0IJukgGIgQYZCAASAGIAQESJgoQd2l0aGluX2ZvbGRlcl9pZBgEIAEoA0IHukgEIgIoAEgCiAEBEhYKCXRlbmFudF9pZBgFIAEoCUgDiAEBQgcKBV9wYWdlQgwKCl9wYWdlX3NpemVCEwoRX3dpdGhpbl9mb2xkZXJfaWRCDAoKX3RlbmFudF9pZCI5ChFBcHBTZWFyY2hSZXNwb25zZRIkCgZyZXN1bHQYASABKAsyFC5ucGFuLnYxLlF1ZXJ5UmVzdWx0InoKFUFwcERvd25sb2FkVVJMUmVxdWVzdBIPCgdmaWxlX2lkGAEgASgDEhkKDHZhbGlkX3BlcmlvZBgCIAEoA0gAiAEBEhYKCXRlbmFudF9pZBgDIAEoCUgBiAEBQg8KDV92YWxpZF9wZXJpb2RCDAoKX3RlbmFudF9pZCJEChZBcHBEb3dubG9hZFVSTFJlc3BvbnNlEioKBnJlc3VsdBgBIAEoCzIaLm5wYW4udjEuRG93bmxvYWRVUkxSZXN1bHQi8gEKEkNyZWF0ZVRva2VuUmVxdWVzdBISCgV0b2tlbhgBIAEoCUgAiAEBEhYKCWNsaWVudF9pZBgCIAEoCUgBiAEBEhoKDWNsaWVudF9zZWNyZXQYAyABKAlIAogBARITCgZzdWJfaWQYBCABKANIA4gBARIVCghzdWJfdHlwZRgFIAEoCUgEiAEBEhcKCm9hdXRoX2hvc3QYBiABKAlIBYgBAUIICgZfdG9rZW5CDAoKX2NsaWVudF9pZEIQCg5fY2xpZW50X3NlY3JldEIJCgdfc3ViX2lkQgsKCV9zdWJfdHlwZUINCgtfb2F1dGhfaG9zdCIkChNDcmVhdGVUb2tlblJlc3BvbnNlEg0KBXRva2VuGAEgASgJIvoBChNSZW1vdGVTZWFyY2hSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEhEKBHR5cGUYAiABKAlIAIgBARIUCgdwYWdlX2lkGAMgASgDSAGIAQESGQoMcXVlcnlfZmlsdGVyGAQgASgJSAKIAQESHQoQc2VhcmNoX2luX2ZvbGRlchgFIAEoA0gDiAEBEh8KEnVwZGF0ZWRfdGltZV9yYW5nZRgGIAEoCUgEiAEBQgcKBV90eXBlQgoKCF9wYWdlX2lkQg8KDV9xdWVyeV9maWx0ZXJCEwoRX3NlYXJjaF9pbl9mb2xkZXJCFQoTX3VwZGF0ZWRfdGltZV9yYW5nZSKuAwoSTG9jYWxTZWFyY2hSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEhoKBHBhZ2UYAiABKANCB7pIBCICIABIAIgBARIhCglwYWdlX3NpemUYAyABKANCCbpIBiIEGGQgAEgBiAEBEhEKBHR5cGUYBCABKAlIAogBARIWCglwYXJlbnRfaWQYBSABKANIA4gBARIaCg11cGRhdGVkX2FmdGVyGAYgASgDSASIAQESGwoOdXBkYXRlZF9iZWZvcmUYByABKANIBYgBARIcCg9pbmNsdWRlX2RlbGV0ZWQYCCABKAhIBogBARImChB3aXRoaW5fZm9sZGVyX2lkGAkgASgDQge6SAQiAigASAeIAQESFgoJdGVuYW50X2lkGAogASgJSAiIAQFCBwoFX3BhZ2VCDAoKX3BhZ2Vfc2l6ZUIHCgVfdHlwZUIMCgpfcGFyZW50X2lkQhAKDl91cGRhdGVkX2FmdGVyQhEKD191cGRhdGVkX2JlZm9yZUISChBfaW5jbHVkZV9kZWxldGVkQhMKEV93aXRoaW5fZm9sZGVyX2lkQgwKCl90ZW5hbnRfaWQiOwoTTG9jYWxTZWFyY2hSZXNwb25zZRIkCgZyZXN1bHQYASABKAsyFC5ucGFuLnYxLlF1ZXJ5UmVzdWx0IncKEkRvd25sb2FkVVJMUmVxdWVzdBIPCgdmaWxlX2lkGAEgASgDEhkKDHZhbGlkX3BlcmlvZBgCIAEoA0gAiAEBEhYKCXRlbmFudF9pZBgDIAEoCUgBiAEBQg8KDV92YWxpZF9wZXJpb2RCDAoKX3RlbmFudF9pZCJBChNEb3dubG9hZFVSTFJlc3BvbnNlEioKBnJlc3VsdBgBIAEoCzIaLm5wYW4udjEuRG93bmxvYWRVUkxSZXN1bHQitAYKEFN0YXJ0U3luY1JlcXVlc3QSJAoEbW9kZRgBIAEoDjIRLm5wYW4udjEuU3luY01vZGVIAIgBARIlCg9yb290X2ZvbGRlcl9pZHMYAiADKANCDLpICZIBBiIEIgIgABIgChNpbmNsdWRlX2RlcGFydG1lbnRzGAMgASgISAGIAQESIgoVcHJlc2VydmVfcm9vdF9jYXRhbG9nGAQgASgISAKIAQESJAoOZGVwYXJ0bWVudF9pZHMYBSADKANCDLpICZIBBiIEIgIgABIcCg9yZXN1bWVfcHJvZ3Jlc3MYBiABKAhIA4gBARIaCg1mb3JjZV9yZWJ1aWxkGAcgASgISASIAQESIgoMcm9vdF93b3JrZXJzGAggASgDQge6SAQiAiAASAWIAQESJAoOcHJvZ3Jlc3NfZXZlcnkYCSABKANCB7pIBCICIABIBogBARIgChNjaGVja3BvaW50X3RlbXBsYXRlGAogASgJSAeIAQESJwoRd2luZG93X292ZXJsYXBfbXMYCyABKANCB7pIBCICKABICIgBARIeChFpbmNyZW1lbnRhbF9xdWVyeRgMIAEoCUgJiAEBEiQKDmZvbGRlcl93b3JrZXJzGA0gASgDQge6SAQiAiAASAqIAQESGwoOc2hhZG93X3JlYnVpbGQYDiABKAhIC4gBARIUCgdkcnlfcnVuGA8gASgISAyIAQESFgoJdGVuYW50X2lkGBAgASgJSA2IAQFCBwoFX21vZGVCFgoUX2luY2x1ZGVfZGVwYXJ0bWVudHNCGAoWX3ByZXNlcnZlX3Jvb3RfY2F0YWxvZ0ISChBfcmVzdW1lX3Byb2dyZXNzQhAKDl9mb3JjZV9yZWJ1aWxkQg8KDV9yb290X3dvcmtlcnNCEQoPX3Byb2dyZXNzX2V2ZXJ5QhYKFF9jaGVja3BvaW50X3RlbXBsYXRlQhQKEl93aW5kb3dfb3ZlcmxhcF9tc0IUChJfaW5jcmVtZW50YWxfcXVlcnlCEQoPX2ZvbGRlcl93b3JrZXJzQhEKD19zaGFkb3dfcmVidWlsZEIKCghfZHJ5X3J1bkIMCgpfdGVuYW50X2lkIiQKEVN0YXJ0U3luY1Jlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiOQoTSW5zcGVjdFJvb3RzUmVxdWVzdBIiCgpmb2xkZXJfaWRzGAEgAygDQg66SAuSAQgIASIEIgIgACJqChRJbnNwZWN0Um9vdHNSZXNwb25zZRInCgVpdGVtcxgBIAMoCzIYLm5wYW4udjEuSW5zcGVjdFJvb3RJdGVtEikKBmVycm9ycxgCIAMoCzIZLm5wYW4udjEuSW5zcGVjdFJvb3RFcnJvciI8ChRHZXRJbmRleFN0YXRzUmVxdWVzdBIWCgl0ZW5hbnRfaWQYASABKAlIAIgBAUIMCgpfdGVuYW50X2lkImgKFUdldEluZGV4U3RhdHNSZXNwb25zZRIWCg5kb2N1bWVudF9jb3VudBgBIAEoAxItCgV0b2tlbhgCIAEoCzIZLm5wYW4udjEuT0F1dGhUb2tlblN0YXR1c0gAiAEBQggKBl90b2tlbiKdAQoQT0F1dGhUb2tlblN0YXR1cxINCgVzdGF0ZRgBIAEoCRISCgpleHBpcmVzX2F0GAIgASgDEhQKDHJlZnJlc2hlZF9hdBgDIAEoAxIOCgZzb3VyY2UYBCABKAkSFQoNcmVmcmVzaF9jb3VudBgFIAEoAxISCgpsYXN0X2Vycm9yGAYgASgJEhUKDWxhc3RfZXJyb3JfYXQYByABKAMiPgoWR2V0U3luY1Byb2dyZXNzUmVxdWVzdBIWCgl0ZW5hbnRfaWQYASABKAlIAIgBAUIMCgpfdGVuYW50X2lkIkQKF0dldFN5bmNQcm9ncmVzc1Jlc3BvbnNlEikKBXN0YXRlGAEgASgLMhoubnBhbi52MS5TeW5jUHJvZ3Jlc3NTdGF0ZSJAChhXYXRjaFN5bmNQcm9ncmVzc1JlcXVlc3QSFgoJdGVuYW50X2lkGAEgASgJSACIAQFCDAoKX3RlbmFudF9pZCJGChlXYXRjaFN5bmNQcm9ncmVzc1Jlc3BvbnNlEikKBXN0YXRlGAEgASgLMhoubnBhbi52MS5TeW5jUHJvZ3Jlc3NTdGF0ZSI5ChFDYW5jZWxTeW5jUmVxdWVzdBIWCgl0ZW5hbnRfaWQYASABKAlIAIgBAUIMCgpfdGVuYW50X2lkIiUKEkNhbmNlbFN5bmNSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIjgKEFBhdXNlU3luY1JlcXVlc3QSFgoJdGVuYW50X2lkGAEgASgJSACIAQFCDAoKX3RlbmFudF9pZCIkChFQYXVzZVN5bmNSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIjkKEVJlc3VtZVN5bmNSZXF1ZXN0EhYKCXRlbmFudF9pZBgBIAEoCUgAiAEBQgwKCl90ZW5hbnRfaWQiJQoSUmVzdW1lU3luY1Jlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkifAoJU3luY0xlYXNlEhAKCG93bmVyX2lkGAEgASgJEg0KBW93bmVyGAIgASgJEhMKC2FjcXVpcmVkX2F0GAMgASgDEhQKDGhlYXJ0YmVhdF9hdBgEIAEoAxISCgpleHBpcmVzX2F0GAUgASgDEg8KB2V4cGlyZWQYBiABKAgiOwoTR2V0U3luY0xlYXNlUmVxdWVzdBIWCgl0ZW5hbnRfaWQYASABKAlIAIgBAUIMCgpfdGVuYW50X2lkIkgKFEdldFN5bmNMZWFzZVJlc3BvbnNlEiYKBWxlYXNlGAEgASgLMhIubnBhbi52MS5TeW5jTGVhc2VIAIgBAUIICgZfbGVhc2UiRAocRm9yY2VSZWxlYXNlU3luY0xlYXNlUmVxdWVzdBIWCgl0ZW5hbnRfaWQYASABKAlIAIgBAUIMCgpfdGVuYW50X2lkImgKHUZvcmNlUmVsZWFzZVN5bmNMZWFzZVJlc3BvbnNlEikKCHJlbGVhc2VkGAEgASgLMhIubnBhbi52MS5TeW5jTGVhc2VIAIgBARIPCgdtZXNzYWdlGAIgASgJQgsKCV9yZWxlYXNlZCKBAwoURm9sZGVyUmVzeW5jUHJvZ3Jlc3MSEQoJZm9sZGVyX2lkGAEgASgDEhMKC2ZvbGRlcl9uYW1lGAIgASgJEicKBG1vZGUYAyABKA4yGS5ucGFuLnYxLkZvbGRlclJlc3luY01vZGUSIwoGc3RhdHVzGAQgASgOMhMubnBhbi52MS5TeW5jU3RhdHVzEhIKCnN0YXJ0ZWRfYXQYBSABKAMSEgoKdXBkYXRlZF9hdBgGIAEoAxIYCgtmaW5pc2hlZF9hdBgHIAEoA0gAiAEBEhQKDGRvY3NfZGVsZXRlZBgIIAEoAxIUCgxkb2NzX3dyaXR0ZW4YCSABKAMSFwoPZm9sZGVyc192aXNpdGVkGAogASgDEh4KEWN1cnJlbnRfZm9sZGVyX2lkGAsgASgDSAGIAQESFwoKbGFzdF9lcnJvchgMIAEoCUgCiAEBQg4KDF9maW5pc2hlZF9hdEIUChJfY3VycmVudF9mb2xkZXJfaWRCDQoLX2xhc3RfZXJyb3IijgEKE1Jlc3luY0ZvbGRlclJlcXVlc3QSGgoJZm9sZGVyX2lkGAEgASgDQge6SAQiAiAAEiwKBG1vZGUYAiABKA4yGS5ucGFuLnYxLkZvbGRlclJlc3luY01vZGVIAIgBARIWCgl0ZW5hbnRfaWQYAyABKAlIAYgBAUIHCgVfbW9kZUIMCgpfdGVuYW50X2lkIicKFFJlc3luY0ZvbGRlclJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiRgoeR2V0Rm9sZGVyUmVzeW5jUHJvZ3Jlc3NSZXF1ZXN0EhYKCXRlbmFudF9pZBgBIAEoCUgAiAEBQgwKCl90ZW5hbnRfaWQiUgofR2V0Rm9sZGVyUmVzeW5jUHJvZ3Jlc3NSZXNwb25zZRIvCghwcm9ncmVzcxgBIAEoCzIdLm5wYW4udjEuRm9sZGVyUmVzeW5jUHJvZ3Jlc3MiQQoZQ2FuY2VsRm9sZGVyUmVzeW5jUmVxdWVzdBIWCgl0ZW5hbnRfaWQYASABKAlIAIgBAUIMCgpfdGVuYW50X2lkIi0KGkNhbmNlbEZvbGRlclJlc3luY1Jlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiQwobUm9sbGJhY2tJbmRleFJlYnVpbGRSZXF1ZXN0EhYKCXRlbmFudF9pZBgBIAEoCUgAiAEBQgwKCl90ZW5hbnRfaWQiSwocUm9sbGJhY2tJbmRleFJlYnVpbGRSZXNwb25zZRIrCgdyZWJ1aWxkGAEgASgLMhoubnBhbi52MS5JbmRleFJlYnVpbGRTdGF0ZSLnAwoHU3luY1J1bhIKCgJpZBgBIAEoAxIfCgRtb2RlGAIgASgOMhEubnBhbi52MS5TeW5jTW9kZRIjCgZzdGF0dXMYAyABKA4yEy5ucGFuLnYxLlN5bmNTdGF0dXMSDQoFcm9vdHMYBCADKAMSEgoKc3RhcnRlZF9hdBgFIAEoAxIxCg1zdGFydGVkX2F0X3RzGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghlbmRlZF9hdBgHIAEoAxIvCgtlbmRlZF9hdF90cxgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEwoLZHVyYXRpb25fbXMYCSABKAMSIgoFc3RhdHMYCiABKAsyEy5ucGFuLnYxLkNyYXdsU3RhdHMSPQoRaW5jcmVtZW50YWxfc3RhdHMYCyABKAsyHS5ucGFuLnYxLkluY3JlbWVudGFsU3luY1N0YXRzSACIAQESNAoMdmVyaWZpY2F0aW9uGAwgASgLMhkubnBhbi52MS5TeW5jVmVyaWZpY2F0aW9uSAGIAQESEgoFZXJyb3IYDSABKAlIAogBAUIUChJfaW5jcmVtZW50YWxfc3RhdHNCDwoNX3ZlcmlmaWNhdGlvbkIICgZfZXJyb3IiwwEKE0xpc3RTeW5jUnVuc1JlcXVlc3QSJAoEbW9kZRgBIAEoDjIRLm5wYW4udjEuU3luY01vZGVIAIgBARIeCgVsaW1pdBgCIAEoA0IKukgHIgUYyAEgAEgBiAEBEh8KCWJlZm9yZV9pZBgDIAEoA0IHukgEIgIgAEgCiAEBEhYKCXRlbmFudF9pZBgEIAEoCUgDiAEBQgcKBV9tb2RlQggKBl9saW1pdEIMCgpfYmVmb3JlX2lkQgwKCl90ZW5hbnRfaWQiZgoUTGlzdFN5bmNSdW5zUmVzcG9uc2USHgoEcnVucxgBIAMoCzIQLm5wYW4udjEuU3luY1J1bhIbCg5uZXh0X2JlZm9yZV9pZBgCIAEoA0gAiAEBQhEKD19uZXh0X2JlZm9yZV9pZCJOChFHZXRTeW5jUnVuUmVxdWVzdBITCgJpZBgBIAEoA0IHukgEIgIgABIWCgl0ZW5hbnRfaWQYAiABKAlIAIgBAUIMCgpfdGVuYW50X2lkIjMKEkdldFN5bmNSdW5SZXNwb25zZRIdCgNydW4YASABKAsyEC5ucGFuLnYxLlN5bmNSdW4ikwIKCkRlYWRMZXR0ZXISCgoCaWQYASABKAMSDgoGcnVuX2lkGAIgASgDEhYKDnJvb3RfZm9sZGVyX2lkGAMgASgDEhEKCWRvY19jb3VudBgEIAEoAxIPCgdkb2NfaWRzGAUgAygJEg0KBWVycm9yGAYgASgJEhAKCGF0dGVtcHRzGAcgASgDEhIKCmNyZWF0ZWRfYXQYCCABKAMSMQoNY3JlYXRlZF9hdF90cxgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKdXBkYXRlZF9hdBgKIAEoAxIxCg11cGRhdGVkX2F0X3RzGAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLQAQoWTGlzdERlYWRMZXR0ZXJzUmVxdWVzdBIkCg5yb290X2ZvbGRlcl9pZBgBIAEoA0IHukgEIgIgAEgAiAEBEh4KBWxpbWl0GAIgASgDQgq6SAciBRjIASAASAGIAQESHwoJYmVmb3JlX2lkGAMgASgDQge6SAQiAiAASAKIAQESFgoJdGVuYW50X2lkGAQgASgJSAOIAQFCEQoPX3Jvb3RfZm9sZGVyX2lkQggKBl9saW1pdEIMCgpfYmVmb3JlX2lkQgwKCl90ZW5hbnRfaWQigwEKF0xpc3REZWFkTGV0dGVyc1Jlc3BvbnNlEikKDGRlYWRfbGV0dGVycxgBIAMoCzITLm5wYW4udjEuRGVhZExldHRlchIbCg5uZXh0X2JlZm9yZV9pZBgCIAEoA0gAiAEBEg0KBXRvdGFsGAMgASgDQhEKD19uZXh0X2JlZm9yZV9pZCJrChhSZXBsYXlEZWFkTGV0dGVyc1JlcXVlc3QSHAoDaWRzGAEgAygDQg+6SAySAQkQ9AMiBCICIAASCwoDYWxsGAIgASgIEhYKCXRlbmFudF9pZBgDIAEoCUgAiAEBQgwKCl90ZW5hbnRfaWQicAoZUmVwbGF5RGVhZExldHRlcnNSZXNwb25zZRIUCgxyZXBsYXllZF9pZHMYASADKAMSEgoKZmFpbGVkX2lkcxgCIAMoAxIRCglyZW1haW5pbmcYAyABKAMSFgoOc3VwZXJzZWRlZF9pZHMYBCADKAMibAoZRGlzY2FyZERlYWRMZXR0ZXJzUmVxdWVzdBIcCgNpZHMYASADKANCD7pIDJIBCRD0AyIEIgIgABILCgNhbGwYAiABKAgSFgoJdGVuYW50X2lkGAMgASgJSACIAQFCDAoKX3RlbmFudF9pZCJCChpEaXNjYXJkRGVhZExldHRlcnNSZXNwb25zZRIRCglkaXNjYXJkZWQYASABKAMSEQoJcmVtYWluaW5nGAIgASgDIlwKDUR1cGxpY2F0ZUZpbGUSDgoGZG9jX2lkGAEgASgJEhEKCXNvdXJjZV9pZBgCIAEoAxIMCgRuYW1lGAMgASgJEgwKBHBhdGgYBCABKAkSDAoEc2l6ZRgFIAEoAyJ5Cg5EdXBsaWNhdGVHcm91cBIMCgRzaGExGAEgASgJEgwKBHNpemUYAiABKAMSDgoGY29waWVzGAMgASgDEhQKDHdhc3RlZF9ieXRlcxgEIAEoAxIlCgVmaWxlcxgFIAMoCzIWLm5wYW4udjEuRHVwbGljYXRlRmlsZSL9AQoVRmluZER1cGxpY2F0ZXNSZXF1ZXN0EiQKDnJvb3RfZm9sZGVyX2lkGAEgASgDQge6SAQiAiAASACIAQESGQoIbWluX3NpemUYAiABKANCB7pIBCICKAASHgoFbGltaXQYAyABKANCCrpIByIFGOgHIABIAYgBARIuCg1leHBvcnRfZm9ybWF0GAQgASgJQhK6SA9yDVIDY3N2UgZuZGpzb25IAogBARIWCgl0ZW5hbnRfaWQYBSABKAlIA4gBAUIRCg9fcm9vdF9mb2xkZXJfaWRCCAoGX2xpbWl0QhAKDl9leHBvcnRfZm9ybWF0QgwKCl90ZW5hbnRfaWQikAEKFkZpbmREdXBsaWNhdGVzUmVzcG9uc2USJwoGZ3JvdXBzGAEgAygLMhcubnBhbi52MS5EdXBsaWNhdGVHcm91cBIUCgx3YXN0ZWRfYnl0ZXMYAiABKAMSDgoGZXhwb3J0GAMgASgJEhQKDHRvdGFsX2dyb3VwcxgEIAEoAxIRCgl0cnVuY2F0ZWQYBSABKAgiqAEKEVJlY29uY2lsaWF0aW9uUm93EhYKDnJvb3RfZm9sZGVyX2lkGAEgASgDEhEKCWZvbGRlcl9pZBgCIAEoAxIMCgRwYXRoGAMgASgJEhYKDnVwc3RyZWFtX2l0ZW1zGAQgASgDEhUKDWluZGV4ZWRfaXRlbXMYBSABKAMSDQoFZHJpZnQYBiABKAMSEgoFZXJyb3IYByABKAlIAIgBAUIICgZfZXJyb3Ii+QEKFFJlY29uY2lsaWF0aW9uUmVwb3J0EgoKAmlkGAEgASgDEiMKBnN0YXR1cxgCIAEoDjITLm5wYW4udjEuU3luY1N0YXR1cxINCgVyb290cxgDIAMoAxITCgtzYW1wbGVfc2l6ZRgEIAEoAxISCgpzdGFydGVkX2F0GAUgASgDEhgKC2ZpbmlzaGVkX2F0GAYgASgDSACIAQESFwoPZm9sZGVyc19jaGVja2VkGAcgASgDEhcKD2ZvbGRlcnNfZHJpZnRlZBgIIAEoAxISCgVlcnJvchgJIAEoCUgBiAEBQg4KDF9maW5pc2hlZF9hdEIICgZfZXJyb3IinAEKGlN0YXJ0UmVjb25jaWxpYXRpb25SZXF1ZXN0EiUKD3Jvb3RfZm9sZGVyX2lkcxgBIAMoA0IMukgJkgEGIgQiAiAAEiEKC3NhbXBsZV9zaXplGAIgASgDQge6SAQiAiAASACIAQESFgoJdGVuYW50X2lkGAMgASgJSAGIAQFCDgoMX3NhbXBsZV9zaXplQgwKCl90ZW5hbnRfaWQiTAobU3RhcnRSZWNvbmNpbGlhdGlvblJlc3BvbnNlEi0KBnJlcG9ydBgBIAEoCzIdLm5wYW4udjEuUmVjb25jaWxpYXRpb25SZXBvcnQixwEKHkdldFJlY29uY2lsaWF0aW9uUmVwb3J0UmVxdWVzdBIfCglyZXBvcnRfaWQYASABKANCB7pIBCICIABIAIgBARIXCgpvbmx5X2RyaWZ0GAIgASgISAGIAQESHgoFbGltaXQYAyABKANCCrpIByIFGOgHIABIAogBARIWCgl0ZW5hbnRfaWQYBCABKAlIA4gBAUIMCgpfcmVwb3J0X2lkQg0KC19vbmx5X2RyaWZ0QggKBl9saW1pdEIMCgpfdGVuYW50X2lkInoKH0dldFJlY29uY2lsaWF0aW9uUmVwb3J0UmVzcG9uc2USLQoGcmVwb3J0GAEgASgLMh0ubnBhbi52MS5SZWNvbmNpbGlhdGlvblJlcG9ydBIoCgRyb3dzGAIgAygLMhoubnBhbi52MS5SZWNvbmNpbGlhdGlvblJvdyLCAQohRXhwb3J0UmVjb25jaWxpYXRpb25SZXBvcnRSZXF1ZXN0Eh8KCXJlcG9ydF9pZBgBIAEoA0IHukgEIgIgAEgAiAEBEiAKBmZvcm1hdBgCIAEoCUIQukgNcgtSA2NzdlIEanNvbhIXCgpvbmx5X2RyaWZ0GAMgASgISAGIAQESFgoJdGVuYW50X2lkGAQgASgJSAKIAQFCDAoKX3JlcG9ydF9pZEINCgtfb25seV9kcmlmdEIMCgpfdGVuYW50X2lkIkYKIkV4cG9ydFJlY29uY2lsaWF0aW9uUmVwb3J0UmVzcG9uc2USEAoIZmlsZW5hbWUYASABKAkSDgoGZXhwb3J0GAIgASgJIkMKG0NhbmNlbFJlY29uY2lsaWF0aW9uUmVxdWVzdBIWCgl0ZW5hbnRfaWQYASABKAlIAIgBAUIMCgpfdGVuYW50X2lkIi8KHENhbmNlbFJlY29uY2lsaWF0aW9uUmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSKYAwoMU3luY1NjaGVkdWxlEgoKAmlkGAEgASgDEgwKBG5hbWUYAiABKAkSEQoJY3Jvbl9leHByGAMgASgJEh8KBG1vZGUYBCABKA4yES5ucGFuLnYxLlN5bmNNb2RlEhYKDmppdHRlcl9zZWNvbmRzGAUgASgDEg4KBnBhdXNlZBgGIAEoCBITCgtuZXh0X3J1bl9hdBgHIAEoAxIyCg5uZXh0X3J1bl9hdF90cxgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEwoLbGFzdF9ydW5fYXQYCSABKAMSMgoObGFzdF9ydW5fYXRfdHMYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhwKD2xhc3RfcnVuX3N0YXR1cxgLIAEoCUgAiAEBEhcKCmxhc3RfZXJyb3IYDCABKAlIAYgBARISCgpjcmVhdGVkX2F0GA0gASgDEhIKCnVwZGF0ZWRfYXQYDiABKANCEgoQX2xhc3RfcnVuX3N0YXR1c0INCgtfbGFzdF9lcnJvciJAChhMaXN0U3luY1NjaGVkdWxlc1JlcXVlc3QSFgoJdGVuYW50X2lkGAEgASgJSACIAQFCDAoKX3RlbmFudF9pZCJFChlMaXN0U3luY1NjaGVkdWxlc1Jlc3BvbnNlEigKCXNjaGVkdWxlcxgBIAMoCzIVLm5wYW4udjEuU3luY1NjaGVkdWxlIv8BChlDcmVhdGVTeW5jU2NoZWR1bGVSZXF1ZXN0EhUKBG5hbWUYASABKAlCB7pIBHICEAESGgoJY3Jvbl9leHByGAIgASgJQge6SARyAhABEiQKBG1vZGUYAyABKA4yES5ucGFuLnYxLlN5bmNNb2RlSACIAQESJwoOaml0dGVyX3NlY29uZHMYBCABKANCCrpIByIFGJAcKABIAYgBARITCgZwYXVzZWQYBSABKAhIAogBARIWCgl0ZW5hbnRfaWQYBiABKAlIA4gBAUIHCgVfbW9kZUIRCg9faml0dGVyX3NlY29uZHNCCQoHX3BhdXNlZEIMCgpfdGVuYW50X2lkIkUKGkNyZWF0ZVN5bmNTY2hlZHVsZVJlc3BvbnNlEicKCHNjaGVkdWxlGAEgASgLMhUubnBhbi52MS5TeW5jU2NoZWR1bGUiVQoYUGF1c2VTeW5jU2NoZWR1bGVSZXF1ZXN0EhMKAmlkGAEgASgDQge6SAQiAiAAEhYKCXRlbmFudF9pZBgCIAEoCUgAiAEBQgwKCl90ZW5hbnRfaWQiRAoZUGF1c2VTeW5jU2NoZWR1bGVSZXNwb25zZRInCghzY2hlZHVsZRgBIAEoCzIVLm5wYW4udjEuU3luY1NjaGVkdWxlIlYKGVJlc3VtZVN5bmNTY2hlZHVsZVJlcXVlc3QSEwoCaWQYASABKANCB7pIBCICIAASFgoJdGVuYW50X2lkGAIgASgJSACIAQFCDAoKX3RlbmFudF9pZCJFChpSZXN1bWVTeW5jU2NoZWR1bGVSZXNwb25zZRInCghzY2hlZHVsZRgBIAEoCzIVLm5wYW4udjEuU3luY1NjaGVkdWxlIlYKGURlbGV0ZVN5bmNTY2hlZHVsZVJlcXVlc3QSEwoCaWQYASABKANCB7pIBCICIAASFgoJdGVuYW50X2lkGAIgASgJSACIAQFCDAoKX3RlbmFudF9pZCItChpEZWxldGVTeW5jU2NoZWR1bGVSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIlEKF1Rlc3ROb3RpZmljYXRpb25SZXF1ZXN0Ei0KBHNpbmsYASABKAlCGrpIF3IVUgd3ZWJob29rUgRzbXRwUgRmaWxlSACIAQFCBwoFX3NpbmsiUAoWTm90aWZpY2F0aW9uU2lua1Jlc3VsdBIMCgRzaW5rGAEgASgJEgoKAm9rGAIgASgIEhIKBWVycm9yGAMgASgJSACIAQFCCAoGX2Vycm9yIkwKGFRlc3ROb3RpZmljYXRpb25SZXNwb25zZRIwCgdyZXN1bHRzGAEgAygLMh8ubnBhbi52MS5Ob3RpZmljYXRpb25TaW5rUmVzdWx0ItgBCgtJbmRleENoYW5nZRILCgNzZXEYASABKAMSIgoCb3AYAiABKA4yFi5ucGFuLnYxLkluZGV4Q2hhbmdlT3ASDgoGZG9jX2lkGAMgASgJEi0KCGRvY3VtZW50GAQgASgLMhYubnBhbi52MS5JbmRleERvY3VtZW50SACIAQESFgoOcm9vdF9mb2xkZXJfaWQYBSABKAMSDgoGcnVuX2lkGAYgASgDEg8KB3JlbW92ZWQYByABKAMSEwoLb2NjdXJyZWRfYXQYCCABKANCCwoJX2RvY3VtZW50IoYBChhXYXRjaEluZGV4Q2hhbmdlc1JlcXVlc3QSGgoJYWZ0ZXJfc2VxGAEgASgDQge6SAQiAigAEhgKC2Zyb21fbGF0ZXN0GAIgASgISACIAQESFgoJdGVuYW50X2lkGAMgASgJSAGIAQFCDgoMX2Zyb21fbGF0ZXN0QgwKCl90ZW5hbnRfaWQiVgoZV2F0Y2hJbmRleENoYW5nZXNSZXNwb25zZRIlCgdjaGFuZ2VzGAEgAygLMhQubnBhbi52MS5JbmRleENoYW5nZRISCgpsYXRlc3Rfc2VxGAIgASgDKk8KCEl0ZW1UeXBlEhkKFUlURU1fVFlQRV9VTlNQRUNJRklFRBAAEhIKDklURU1fVFlQRV9GSUxFEAESFAoQSVRFTV9UWVBFX0ZPTERFUhACKtUBCgpTeW5jU3RhdHVzEhsKF1NZTkNfU1RBVFVTX1VOU1BFQ0lGSUVEEAASFAoQU1lOQ19TVEFUVVNfSURMRRABEhcKE1NZTkNfU1RBVFVTX1JVTk5JTkcQAhIUChBTWU5DX1NUQVRVU19ET05FEAMSFQoRU1lOQ19TVEFUVVNfRVJST1IQBBIZChVTWU5DX1NUQVRVU19DQU5DRUxMRUQQBRIbChdTWU5DX1NUQVRVU19JTlRFUlJVUFRFRBAGEhYKElNZTkNfU1RBVFVTX1BBVVNFRBAHKmoKCFN5bmNNb2RlEhkKFVNZTkNfTU9ERV9VTlNQRUNJRklFRBAAEhIKDlNZTkNfTU9ERV9GVUxMEAISGQoVU1lOQ19NT0RFX0lOQ1JFTUVOVEFMEAMiBAgBEAEqDlNZTkNfTU9ERV9BVVRPKs8BCglFcnJvckNvZGUSGgoWRVJST1JfQ09ERV9VTlNQRUNJRklFRBAAEhsKF0VSUk9SX0NPREVfVU5BVVRIT1JJWkVEEAESGgoWRVJST1JfQ09ERV9CQURfUkVRVUVTVBACEhgKFEVSUk9SX0NPREVfTk9UX0ZPVU5EEAMSFwoTRVJST1JfQ09ERV9DT05GTElDVBAEEhsKF0VSUk9SX0NPREVfUkFURV9MSU1JVEVEEAUSHQoZRVJST1JfQ09ERV9JTlRFUk5BTF9FUlJPUhAGKl8KC1JlYWR5U3RhdHVzEhwKGFJFQURZX1NUQVRVU19VTlNQRUNJRklFRBAAEhYKElJFQURZX1NUQVRVU19SRUFEWRABEhoKFlJFQURZX1NUQVRVU19OT1RfUkVBRFkQAiqlAQoSSW5kZXhSZWJ1aWxkU3RhdHVzEiQKIElOREVYX1JFQlVJTERfU1RBVFVTX1VOU1BFQ0lGSUVEEAASIQodSU5ERVhfUkVCVUlMRF9TVEFUVVNfQlVJTERJTkcQARIgChxJTkRFWF9SRUJVSUxEX1NUQVRVU19TV0FQUEVEEAISJAogSU5ERVhfUkVCVUlMRF9TVEFUVVNfUk9MTEVEX0JBQ0sQAyp0ChBGb2xkZXJSZXN5bmNNb2RlEiIKHkZPTERFUl9SRVNZTkNfTU9ERV9VTlNQRUNJRklFRBAAEhwKGEZPTERFUl9SRVNZTkNfTU9ERV9NRVJHRRABEh4KGkZPTERFUl9SRVNZTkNfTU9ERV9SRUJVSUxEEAIqngEKDUluZGV4Q2hhbmdlT3ASHwobSU5ERVhfQ0hBTkdFX09QX1VOU1BFQ0lGSUVEEAASGgoWSU5ERVhfQ0hBTkdFX09QX1VQU0VSVBABEhoKFklOREVYX0NIQU5HRV9PUF9ERUxFVEUQAhIZChVJTkRFWF9DSEFOR0VfT1BfU1dFRVAQAxIZChVJTkRFWF9DSEFOR0VfT1BfUkVTRVQQBDKFAQoNSGVhbHRoU2VydmljZRI5CgZIZWFsdGgSFi5ucGFuLnYxLkhlYWx0aFJlcXVlc3QaFy5ucGFuLnYxLkhlYWx0aFJlc3BvbnNlEjkKBlJlYWR5ehIWLm5wYW4udjEuUmVhZHl6UmVxdWVzdBoXLm5wYW4udjEuUmVhZHl6UmVzcG9uc2Uy+QEKCkFwcFNlcnZpY2USVAoPR2V0U2VhcmNoQ29uZmlnEh8ubnBhbi52MS5HZXRTZWFyY2hDb25maWdSZXF1ZXN0GiAubnBhbi52MS5HZXRTZWFyY2hDb25maWdSZXNwb25zZRJCCglBcHBTZWFyY2gSGS5ucGFuLnYxLkFwcFNlYXJjaFJlcXVlc3QaGi5ucGFuLnYxLkFwcFNlYXJjaFJlc3BvbnNlElEKDkFwcERvd25sb2FkVVJMEh4ubnBhbi52MS5BcHBEb3dubG9hZFVSTFJlcXVlc3QaHy5ucGFuLnYxLkFwcERvd25sb2FkVVJMUmVzcG9uc2UyVwoLQXV0aFNlcnZpY2USSAoLQ3JlYXRlVG9rZW4SGy5ucGFuLnYxLkNyZWF0ZVRva2VuUmVxdWVzdBocLm5wYW4udjEuQ3JlYXRlVG9rZW5SZXNwb25zZTLwAQoNU2VhcmNoU2VydmljZRJLCgxSZW1vdGVTZWFyY2gSHC5ucGFuLnYxLlJlbW90ZVNlYXJjaFJlcXVlc3QaHS5ucGFuLnYxLlJlbW90ZVNlYXJjaFJlc3BvbnNlEkgKC0xvY2FsU2VhcmNoEhsubnBhbi52MS5Mb2NhbFNlYXJjaFJlcXVlc3QaHC5ucGFuLnYxLkxvY2FsU2VhcmNoUmVzcG9uc2USSAoLRG93bmxvYWRVUkwSGy5ucGFuLnYxLkRvd25sb2FkVVJMUmVxdWVzdBocLm5wYW4udjEuRG93bmxvYWRVUkxSZXNwb25zZTLZFQoMQWRtaW5TZXJ2aWNlEkIKCVN0YXJ0U3luYxIZLm5wYW4udjEuU3RhcnRTeW5jUmVxdWVzdBoaLm5wYW4udjEuU3RhcnRTeW5jUmVzcG9uc2USSwoMSW5zcGVjdFJvb3RzEhwubnBhbi52MS5JbnNwZWN0Um9vdHNSZXF1ZXN0Gh0ubnBhbi52MS5JbnNwZWN0Um9vdHNSZXNwb25zZRJOCg1HZXRJbmRleFN0YXRzEh0ubnBhbi52MS5HZXRJbmRleFN0YXRzUmVxdWVzdBoeLm5wYW4udjEuR2V0SW5kZXhTdGF0c1Jlc3BvbnNlElQKD0dldFN5bmNQcm9ncmVzcxIfLm5wYW4udjEuR2V0U3luY1Byb2dyZXNzUmVxdWVzdBogLm5wYW4udjEuR2V0U3luY1Byb2dyZXNzUmVzcG9uc2USXAoRV2F0Y2hTeW5jUHJvZ3Jlc3MSIS5ucGFuLnYxLldhdGNoU3luY1Byb2dyZXNzUmVxdWVzdBoiLm5wYW4udjEuV2F0Y2hTeW5jUHJvZ3Jlc3NSZXNwb25zZTABEkUKCkNhbmNlbFN5bmMSGi5ucGFuLnYxLkNhbmNlbFN5bmNSZXF1ZXN0GhsubnBhbi52MS5DYW5jZWxTeW5jUmVzcG9uc2USQgoJUGF1c2VTeW5jEhkubnBhbi52MS5QYXVzZVN5bmNSZXF1ZXN0GhoubnBhbi52MS5QYXVzZVN5bmNSZXNwb25zZRJFCgpSZXN1bWVTeW5jEhoubnBhbi52MS5SZXN1bWVTeW5jUmVxdWVzdBobLm5wYW4udjEuUmVzdW1lU3luY1Jlc3BvbnNlEksKDFJlc3luY0ZvbGRlchIcLm5wYW4udjEuUmVzeW5jRm9sZGVyUmVxdWVzdBodLm5wYW4udjEuUmVzeW5jRm9sZGVyUmVzcG9uc2USSwoMR2V0U3luY0xlYXNlEhwubnBhbi52MS5HZXRTeW5jTGVhc2VSZXF1ZXN0Gh0ubnBhbi52MS5HZXRTeW5jTGVhc2VSZXNwb25zZRJmChVGb3JjZVJlbGVhc2VTeW5jTGVhc2USJS5ucGFuLnYxLkZvcmNlUmVsZWFzZVN5bmNMZWFzZVJlcXVlc3QaJi5ucGFuLnYxLkZvcmNlUmVsZWFzZVN5bmNMZWFzZVJlc3BvbnNlEmwKF0dldEZvbGRlclJlc3luY1Byb2dyZXNzEicubnBhbi52MS5HZXRGb2xkZXJSZXN5bmNQcm9ncmVzc1JlcXVlc3QaKC5ucGFuLnYxLkdldEZvbGRlclJlc3luY1Byb2dyZXNzUmVzcG9uc2USXQoSQ2FuY2VsRm9sZGVyUmVzeW5jEiIubnBhbi52MS5DYW5jZWxGb2xkZXJSZXN5bmNSZXF1ZXN0GiMubnBhbi52MS5DYW5jZWxGb2xkZXJSZXN5bmNSZXNwb25zZRJjChRSb2xsYmFja0luZGV4UmVidWlsZBIkLm5wYW4udjEuUm9sbGJhY2tJbmRleFJlYnVpbGRSZXF1ZXN0GiUubnBhbi52MS5Sb2xsYmFja0luZGV4UmVidWlsZFJlc3BvbnNlEksKDExpc3RTeW5jUnVucxIcLm5wYW4udjEuTGlzdFN5bmNSdW5zUmVxdWVzdBodLm5wYW4udjEuTGlzdFN5bmNSdW5zUmVzcG9uc2USRQoKR2V0U3luY1J1bhIaLm5wYW4udjEuR2V0U3luY1J1blJlcXVlc3QaGy5ucGFuLnYxLkdldFN5bmNSdW5SZXNwb25zZRJUCg9MaXN0RGVhZExldHRlcnMSHy5ucGFuLnYxLkxpc3REZWFkTGV0dGVyc1JlcXVlc3QaIC5ucGFuLnYxLkxpc3REZWFkTGV0dGVyc1Jlc3BvbnNlEloKEVJlcGxheURlYWRMZXR0ZXJzEiEubnBhbi52MS5SZXBsYXlEZWFkTGV0dGVyc1JlcXVlc3QaIi5ucGFuLnYxLlJlcGxheURlYWRMZXR0ZXJzUmVzcG9uc2USXQoSRGlzY2FyZERlYWRMZXR0ZXJzEiIubnBhbi52MS5EaXNjYXJkRGVhZExldHRlcnNSZXF1ZXN0GiMubnBhbi52MS5EaXNjYXJkRGVhZExldHRlcnNSZXNwb25zZRJRCg5GaW5kRHVwbGljYXRlcxIeLm5wYW4udjEuRmluZER1cGxpY2F0ZXNSZXF1ZXN0Gh8ubnBhbi52MS5GaW5kRHVwbGljYXRlc1Jlc3BvbnNlEmAKE1N0YXJ0UmVjb25jaWxpYXRpb24SIy5ucGFuLnYxLlN0YXJ0UmVjb25jaWxpYXRpb25SZXF1ZXN0GiQubnBhbi52MS5TdGFydFJlY29uY2lsaWF0aW9uUmVzcG9uc2USbAoXR2V0UmVjb25jaWxpYXRpb25SZXBvcnQSJy5ucGFuLnYxLkdldFJlY29uY2lsaWF0aW9uUmVwb3J0UmVxdWVzdBooLm5wYW4udjEuR2V0UmVjb25jaWxpYXRpb25SZXBvcnRSZXNwb25zZRJ1ChpFeHBvcnRSZWNvbmNpbGlhdGlvblJlcG9ydBIqLm5wYW4udjEuRXhwb3J0UmVjb25jaWxpYXRpb25SZXBvcnRSZXF1ZXN0GisubnBhbi52MS5FeHBvcnRSZWNvbmNpbGlhdGlvblJlcG9ydFJlc3BvbnNlEmMKFENhbmNlbFJlY29uY2lsaWF0aW9uEiQubnBhbi52MS5DYW5jZWxSZWNvbmNpbGlhdGlvblJlcXVlc3QaJS5ucGFuLnYxLkNhbmNlbFJlY29uY2lsaWF0aW9uUmVzcG9uc2USWgoRTGlzdFN5bmNTY2hlZHVsZXMSIS5ucGFuLnYxLkxpc3RTeW5jU2NoZWR1bGVzUmVxdWVzdBoiLm5wYW4udjEuTGlzdFN5bmNTY2hlZHVsZXNSZXNwb25zZRJdChJDcmVhdGVTeW5jU2NoZWR1bGUSIi5ucGFuLnYxLkNyZWF0ZVN5bmNTY2hlZHVsZVJlcXVlc3QaIy5ucGFuLnYxLkNyZWF0ZVN5bmNTY2hlZHVsZVJlc3BvbnNlEloKEVBhdXNlU3luY1NjaGVkdWxlEiEubnBhbi52MS5QYXVzZVN5bmNTY2hlZHVsZVJlcXVlc3QaIi5ucGFuLnYxLlBhdXNlU3luY1NjaGVkdWxlUmVzcG9uc2USXQoSUmVzdW1lU3luY1NjaGVkdWxlEiIubnBhbi52MS5SZXN1bWVTeW5jU2NoZWR1bGVSZXF1ZXN0GiMubnBhbi52MS5SZXN1bWVTeW5jU2NoZWR1bGVSZXNwb25zZRJdChJEZWxldGVTeW5jU2NoZWR1bGUSIi5ucGFuLnYxLkRlbGV0ZVN5bmNTY2hlZHVsZVJlcXVlc3QaIy5ucGFuLnYxLkRlbGV0ZVN5bmNTY2hlZHVsZVJlc3BvbnNlElcKEFRlc3ROb3RpZmljYXRpb24SIC5ucGFuLnYxLlRlc3ROb3RpZmljYXRpb25SZXF1ZXN0GiEubnBhbi52MS5UZXN0Tm90aWZpY2F0aW9uUmVzcG9uc2USXAoRV2F0Y2hJbmRleENoYW5nZXMSIS5ucGFuLnYxLldhdGNoSW5kZXhDaGFuZ2VzUmVxdWVzdBoiLm5wYW4udjEuV2F0Y2hJbmRleENoYW5nZXNSZXNwb25zZTABQhxaGm5wYW4vZ2VuL2dvL25wYW4vdjE7bnBhbnYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message npan.v1.IndexDocument
@@ -83,6 +83,16 @@ export type IndexDocument = Message<"npan.v1.IndexDocument"> & {
    * @generated from field: optional string highlighted_name = 13;
    */
   highlightedName?: string;
+
+  /**
+   * @generated from field: repeated int64 ancestor_ids = 14;
+   */
+  ancestorIds: bigint[];
+
+  /**
+   * @generated from field: optional string tenant_id = 15;
+   */
+  tenantId?: string;
 };
 
 /**
@@ -234,6 +244,11 @@ export type RootSyncProgress = Message<"npan.v1.RootSyncProgress"> & {
    * @generated from field: optional string error = 11;
    */
   error?: string;
+
+  /**
+   * @generated from field: int64 stale_removed = 12;
+   */
+  staleRemoved: bigint;
 };
 
 /**
@@ -281,6 +296,56 @@ export type IncrementalSyncStats = Message<"npan.v1.IncrementalSyncStats"> & {
    * @generated from field: int64 cursor_after = 7;
    */
   cursorAfter: bigint;
+
+  /**
+   * @generated from field: int64 folders_moved = 8;
+   */
+  foldersMoved: bigint;
+
+  /**
+   * @generated from field: int64 paths_rewritten = 9;
+   */
+  pathsRewritten: bigint;
+
+  /**
+   * @generated from field: int64 path_rewrites_pending = 10;
+   */
+  pathRewritesPending: bigint;
+
+  /**
+   * @generated from field: int64 cascade_deleted = 11;
+   */
+  cascadeDeleted: bigint;
+
+  /**
+   * @generated from field: int64 folders_restored = 12;
+   */
+  foldersRestored: bigint;
+
+  /**
+   * @generated from field: int64 restored_docs = 13;
+   */
+  restoredDocs: bigint;
+
+  /**
+   * @generated from field: int64 recrawls_pending = 14;
+   */
+  recrawlsPending: bigint;
+
+  /**
+   * @generated from field: int64 windows_fetched = 15;
+   */
+  windowsFetched: bigint;
+
+  /**
+   * @generated from field: int64 windows_split = 16;
+   */
+  windowsSplit: bigint;
+
+  /**
+   * @generated from field: int64 windows_pending = 17;
+   */
+  windowsPending: bigint;
 };
 
 /**
@@ -323,6 +388,16 @@ export type SyncVerification = Message<"npan.v1.SyncVerification"> & {
    * @generated from field: repeated string warnings = 6;
    */
   warnings: string[];
+
+  /**
+   * @generated from field: int64 stale_removed = 7;
+   */
+  staleRemoved: bigint;
+
+  /**
+   * @generated from field: int64 dead_letter_count = 8;
+   */
+  deadLetterCount: bigint;
 };
 
 /**
@@ -425,6 +500,31 @@ export type SyncProgressState = Message<"npan.v1.SyncProgressState"> & {
    * @generated from field: google.protobuf.Timestamp updated_at_ts = 18;
    */
   updatedAtTs?: Timestamp;
+
+  /**
+   * @generated from field: int64 stale_removed = 19;
+   */
+  staleRemoved: bigint;
+
+  /**
+   * @generated from field: optional npan.v1.IndexRebuildState rebuild = 20;
+   */
+  rebuild?: IndexRebuildState;
+
+  /**
+   * @generated from field: optional npan.v1.DryRunReport dry_run = 21;
+   */
+  dryRun?: DryRunReport;
+
+  /**
+   * @generated from field: optional npan.v1.RateControlState rate_control = 22;
+   */
+  rateControl?: RateControlState;
+
+  /**
+   * @generated from field: optional int64 paused_at = 23;
+   */
+  pausedAt?: bigint;
 };
 
 /**
@@ -434,6 +534,256 @@ export type SyncProgressState = Message<"npan.v1.SyncProgressState"> & {
 export const SyncProgressStateSchema: GenMessage<SyncProgressState> = /*@__PURE__*/
   messageDesc(file_npan_v1_api, 6);
 
+/**
+ * @generated from message npan.v1.RateControlState
+ */
+export type RateControlState = Message<"npan.v1.RateControlState"> & {
+  /**
+   * @generated from field: double base_rate = 1;
+   */
+  baseRate: number;
+
+  /**
+   * @generated from field: double effective_rate = 2;
+   */
+  effectiveRate: number;
+
+  /**
+   * @generated from field: bool backoff = 3;
+   */
+  backoff: boolean;
+
+  /**
+   * @generated from field: int64 paused_until = 4;
+   */
+  pausedUntil: bigint;
+
+  /**
+   * @generated from field: int64 throttle_events = 5;
+   */
+  throttleEvents: bigint;
+
+  /**
+   * @generated from field: int64 last_throttle_at = 6;
+   */
+  lastThrottleAt: bigint;
+
+  /**
+   * @generated from field: int32 last_throttle_status = 7;
+   */
+  lastThrottleStatus: number;
+};
+
+/**
+ * Describes the message npan.v1.RateControlState.
+ * Use `create(RateControlStateSchema)` to create a new message.
+ */
+export const RateControlStateSchema: GenMessage<RateControlState> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 7);
+
+/**
+ * @generated from message npan.v1.DryRunSample
+ */
+export type DryRunSample = Message<"npan.v1.DryRunSample"> & {
+  /**
+   * @generated from field: string doc_id = 1;
+   */
+  docId: string;
+
+  /**
+   * @generated from field: int64 source_id = 2;
+   */
+  sourceId: bigint;
+
+  /**
+   * @generated from field: npan.v1.ItemType type = 3;
+   */
+  type: ItemType;
+
+  /**
+   * @generated from field: string path = 4;
+   */
+  path: string;
+
+  /**
+   * @generated from field: repeated string changed_fields = 5;
+   */
+  changedFields: string[];
+};
+
+/**
+ * Describes the message npan.v1.DryRunSample.
+ * Use `create(DryRunSampleSchema)` to create a new message.
+ */
+export const DryRunSampleSchema: GenMessage<DryRunSample> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 8);
+
+/**
+ * @generated from message npan.v1.DryRunRootDiff
+ */
+export type DryRunRootDiff = Message<"npan.v1.DryRunRootDiff"> & {
+  /**
+   * @generated from field: int64 root_folder_id = 1;
+   */
+  rootFolderId: bigint;
+
+  /**
+   * @generated from field: string root_name = 2;
+   */
+  rootName: string;
+
+  /**
+   * @generated from field: int64 adds = 3;
+   */
+  adds: bigint;
+
+  /**
+   * @generated from field: int64 updates = 4;
+   */
+  updates: bigint;
+
+  /**
+   * @generated from field: int64 deletes = 5;
+   */
+  deletes: bigint;
+
+  /**
+   * @generated from field: int64 unchanged = 6;
+   */
+  unchanged: bigint;
+
+  /**
+   * @generated from field: repeated npan.v1.DryRunSample sample_adds = 7;
+   */
+  sampleAdds: DryRunSample[];
+
+  /**
+   * @generated from field: repeated npan.v1.DryRunSample sample_updates = 8;
+   */
+  sampleUpdates: DryRunSample[];
+
+  /**
+   * @generated from field: repeated npan.v1.DryRunSample sample_deletes = 9;
+   */
+  sampleDeletes: DryRunSample[];
+};
+
+/**
+ * Describes the message npan.v1.DryRunRootDiff.
+ * Use `create(DryRunRootDiffSchema)` to create a new message.
+ */
+export const DryRunRootDiffSchema: GenMessage<DryRunRootDiff> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 9);
+
+/**
+ * @generated from message npan.v1.DryRunReport
+ */
+export type DryRunReport = Message<"npan.v1.DryRunReport"> & {
+  /**
+   * @generated from field: optional npan.v1.SyncMode mode = 1;
+   */
+  mode?: SyncMode;
+
+  /**
+   * @generated from field: int64 started_at = 2;
+   */
+  startedAt: bigint;
+
+  /**
+   * @generated from field: optional int64 finished_at = 3;
+   */
+  finishedAt?: bigint;
+
+  /**
+   * @generated from field: repeated npan.v1.DryRunRootDiff roots = 4;
+   */
+  roots: DryRunRootDiff[];
+
+  /**
+   * @generated from field: int64 adds = 5;
+   */
+  adds: bigint;
+
+  /**
+   * @generated from field: int64 updates = 6;
+   */
+  updates: bigint;
+
+  /**
+   * @generated from field: int64 deletes = 7;
+   */
+  deletes: bigint;
+
+  /**
+   * @generated from field: npan.v1.SyncStatus status = 8;
+   */
+  status: SyncStatus;
+
+  /**
+   * @generated from field: optional string last_error = 9;
+   */
+  lastError?: string;
+
+  /**
+   * @generated from field: optional int64 active_root = 10;
+   */
+  activeRoot?: bigint;
+};
+
+/**
+ * Describes the message npan.v1.DryRunReport.
+ * Use `create(DryRunReportSchema)` to create a new message.
+ */
+export const DryRunReportSchema: GenMessage<DryRunReport> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 10);
+
+/**
+ * @generated from message npan.v1.IndexRebuildState
+ */
+export type IndexRebuildState = Message<"npan.v1.IndexRebuildState"> & {
+  /**
+   * @generated from field: npan.v1.IndexRebuildStatus status = 1;
+   */
+  status: IndexRebuildStatus;
+
+  /**
+   * @generated from field: string live_index = 2;
+   */
+  liveIndex: string;
+
+  /**
+   * @generated from field: string shadow_index = 3;
+   */
+  shadowIndex: string;
+
+  /**
+   * @generated from field: int64 started_at = 4;
+   */
+  startedAt: bigint;
+
+  /**
+   * @generated from field: optional int64 swapped_at = 5;
+   */
+  swappedAt?: bigint;
+
+  /**
+   * @generated from field: optional int64 rolled_back_at = 6;
+   */
+  rolledBackAt?: bigint;
+
+  /**
+   * @generated from field: optional string last_error = 7;
+   */
+  lastError?: string;
+};
+
+/**
+ * Describes the message npan.v1.IndexRebuildState.
+ * Use `create(IndexRebuildStateSchema)` to create a new message.
+ */
+export const IndexRebuildStateSchema: GenMessage<IndexRebuildState> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 11);
+
 /**
  * @generated from message npan.v1.ErrorResponse
  */
@@ -459,7 +809,7 @@ export type ErrorResponse = Message<"npan.v1.ErrorResponse"> & {
  * Use `create(ErrorResponseSchema)` to create a new message.
  */
 export const ErrorResponseSchema: GenMessage<ErrorResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 7);
+  messageDesc(file_npan_v1_api, 12);
 
 /**
  * @generated from message npan.v1.DownloadURLResult
@@ -481,7 +831,7 @@ export type DownloadURLResult = Message<"npan.v1.DownloadURLResult"> & {
  * Use `create(DownloadURLResultSchema)` to create a new message.
  */
 export const DownloadURLResultSchema: GenMessage<DownloadURLResult> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 8);
+  messageDesc(file_npan_v1_api, 13);
 
 /**
  * @generated from message npan.v1.RemoteSearchItem
@@ -508,7 +858,7 @@ export type RemoteSearchItem = Message<"npan.v1.RemoteSearchItem"> & {
  * Use `create(RemoteSearchItemSchema)` to create a new message.
  */
 export const RemoteSearchItemSchema: GenMessage<RemoteSearchItem> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 9);
+  messageDesc(file_npan_v1_api, 14);
 
 /**
  * @generated from message npan.v1.RemoteSearchResponse
@@ -550,7 +900,7 @@ export type RemoteSearchResponse = Message<"npan.v1.RemoteSearchResponse"> & {
  * Use `create(RemoteSearchResponseSchema)` to create a new message.
  */
 export const RemoteSearchResponseSchema: GenMessage<RemoteSearchResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 10);
+  messageDesc(file_npan_v1_api, 15);
 
 /**
  * @generated from message npan.v1.InspectRootItem
@@ -582,7 +932,7 @@ export type InspectRootItem = Message<"npan.v1.InspectRootItem"> & {
  * Use `create(InspectRootItemSchema)` to create a new message.
  */
 export const InspectRootItemSchema: GenMessage<InspectRootItem> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 11);
+  messageDesc(file_npan_v1_api, 16);
 
 /**
  * @generated from message npan.v1.InspectRootError
@@ -604,7 +954,7 @@ export type InspectRootError = Message<"npan.v1.InspectRootError"> & {
  * Use `create(InspectRootErrorSchema)` to create a new message.
  */
 export const InspectRootErrorSchema: GenMessage<InspectRootError> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 12);
+  messageDesc(file_npan_v1_api, 17);
 
 /**
  * @generated from message npan.v1.HealthRequest
@@ -617,7 +967,7 @@ export type HealthRequest = Message<"npan.v1.HealthRequest"> & {
  * Use `create(HealthRequestSchema)` to create a new message.
  */
 export const HealthRequestSchema: GenMessage<HealthRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 13);
+  messageDesc(file_npan_v1_api, 18);
 
 /**
  * @generated from message npan.v1.HealthResponse
@@ -639,7 +989,7 @@ export type HealthResponse = Message<"npan.v1.HealthResponse"> & {
  * Use `create(HealthResponseSchema)` to create a new message.
  */
 export const HealthResponseSchema: GenMessage<HealthResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 14);
+  messageDesc(file_npan_v1_api, 19);
 
 /**
  * @generated from message npan.v1.ReadyzRequest
@@ -652,7 +1002,7 @@ export type ReadyzRequest = Message<"npan.v1.ReadyzRequest"> & {
  * Use `create(ReadyzRequestSchema)` to create a new message.
  */
 export const ReadyzRequestSchema: GenMessage<ReadyzRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 15);
+  messageDesc(file_npan_v1_api, 20);
 
 /**
  * @generated from message npan.v1.ReadyzResponse
@@ -667,6 +1017,16 @@ export type ReadyzResponse = Message<"npan.v1.ReadyzResponse"> & {
    * @generated from field: optional string meili = 2;
    */
   meili?: string;
+
+  /**
+   * @generated from field: optional string npan_api = 3;
+   */
+  npanApi?: string;
+
+  /**
+   * @generated from field: optional string npan_token = 4;
+   */
+  npanToken?: string;
 };
 
 /**
@@ -674,12 +1034,16 @@ export type ReadyzResponse = Message<"npan.v1.ReadyzResponse"> & {
  * Use `create(ReadyzResponseSchema)` to create a new message.
  */
 export const ReadyzResponseSchema: GenMessage<ReadyzResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 16);
+  messageDesc(file_npan_v1_api, 21);
 
 /**
  * @generated from message npan.v1.GetSearchConfigRequest
  */
 export type GetSearchConfigRequest = Message<"npan.v1.GetSearchConfigRequest"> & {
+  /**
+   * @generated from field: optional string tenant_id = 1;
+   */
+  tenantId?: string;
 };
 
 /**
@@ -687,7 +1051,7 @@ export type GetSearchConfigRequest = Message<"npan.v1.GetSearchConfigRequest"> &
  * Use `create(GetSearchConfigRequestSchema)` to create a new message.
  */
 export const GetSearchConfigRequestSchema: GenMessage<GetSearchConfigRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 17);
+  messageDesc(file_npan_v1_api, 22);
 
 /**
  * @generated from message npan.v1.GetSearchConfigResponse
@@ -717,14 +1081,19 @@ export type GetSearchConfigResponse = Message<"npan.v1.GetSearchConfigResponse">
    * @generated from field: string provider = 5;
    */
   provider: string;
-};
+
+  /**
+   * @generated from field: string tenant_id = 6;
+   */
+  tenantId: string;
+};
 
 /**
  * Describes the message npan.v1.GetSearchConfigResponse.
  * Use `create(GetSearchConfigResponseSchema)` to create a new message.
  */
 export const GetSearchConfigResponseSchema: GenMessage<GetSearchConfigResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 18);
+  messageDesc(file_npan_v1_api, 23);
 
 /**
  * @generated from message npan.v1.AppSearchRequest
@@ -744,6 +1113,16 @@ export type AppSearchRequest = Message<"npan.v1.AppSearchRequest"> & {
    * @generated from field: optional int64 page_size = 3;
    */
   pageSize?: bigint;
+
+  /**
+   * @generated from field: optional int64 within_folder_id = 4;
+   */
+  withinFolderId?: bigint;
+
+  /**
+   * @generated from field: optional string tenant_id = 5;
+   */
+  tenantId?: string;
 };
 
 /**
@@ -751,7 +1130,7 @@ export type AppSearchRequest = Message<"npan.v1.AppSearchRequest"> & {
  * Use `create(AppSearchRequestSchema)` to create a new message.
  */
 export const AppSearchRequestSchema: GenMessage<AppSearchRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 19);
+  messageDesc(file_npan_v1_api, 24);
 
 /**
  * @generated from message npan.v1.AppSearchResponse
@@ -768,7 +1147,7 @@ export type AppSearchResponse = Message<"npan.v1.AppSearchResponse"> & {
  * Use `create(AppSearchResponseSchema)` to create a new message.
  */
 export const AppSearchResponseSchema: GenMessage<AppSearchResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 20);
+  messageDesc(file_npan_v1_api, 25);
 
 /**
  * @generated from message npan.v1.AppDownloadURLRequest
@@ -783,6 +1162,11 @@ export type AppDownloadURLRequest = Message<"npan.v1.AppDownloadURLRequest"> & {
    * @generated from field: optional int64 valid_period = 2;
    */
   validPeriod?: bigint;
+
+  /**
+   * @generated from field: optional string tenant_id = 3;
+   */
+  tenantId?: string;
 };
 
 /**
@@ -790,7 +1174,7 @@ export type AppDownloadURLRequest = Message<"npan.v1.AppDownloadURLRequest"> & {
  * Use `create(AppDownloadURLRequestSchema)` to create a new message.
  */
 export const AppDownloadURLRequestSchema: GenMessage<AppDownloadURLRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 21);
+  messageDesc(file_npan_v1_api, 26);
 
 /**
  * @generated from message npan.v1.AppDownloadURLResponse
@@ -807,7 +1191,7 @@ export type AppDownloadURLResponse = Message<"npan.v1.AppDownloadURLResponse"> &
  * Use `create(AppDownloadURLResponseSchema)` to create a new message.
  */
 export const AppDownloadURLResponseSchema: GenMessage<AppDownloadURLResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 22);
+  messageDesc(file_npan_v1_api, 27);
 
 /**
  * @generated from message npan.v1.CreateTokenRequest
@@ -849,7 +1233,7 @@ export type CreateTokenRequest = Message<"npan.v1.CreateTokenRequest"> & {
  * Use `create(CreateTokenRequestSchema)` to create a new message.
  */
 export const CreateTokenRequestSchema: GenMessage<CreateTokenRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 23);
+  messageDesc(file_npan_v1_api, 28);
 
 /**
  * @generated from message npan.v1.CreateTokenResponse
@@ -866,7 +1250,7 @@ export type CreateTokenResponse = Message<"npan.v1.CreateTokenResponse"> & {
  * Use `create(CreateTokenResponseSchema)` to create a new message.
  */
 export const CreateTokenResponseSchema: GenMessage<CreateTokenResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 24);
+  messageDesc(file_npan_v1_api, 29);
 
 /**
  * @generated from message npan.v1.RemoteSearchRequest
@@ -908,7 +1292,7 @@ export type RemoteSearchRequest = Message<"npan.v1.RemoteSearchRequest"> & {
  * Use `create(RemoteSearchRequestSchema)` to create a new message.
  */
 export const RemoteSearchRequestSchema: GenMessage<RemoteSearchRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 25);
+  messageDesc(file_npan_v1_api, 30);
 
 /**
  * @generated from message npan.v1.LocalSearchRequest
@@ -953,6 +1337,16 @@ export type LocalSearchRequest = Message<"npan.v1.LocalSearchRequest"> & {
    * @generated from field: optional bool include_deleted = 8;
    */
   includeDeleted?: boolean;
+
+  /**
+   * @generated from field: optional int64 within_folder_id = 9;
+   */
+  withinFolderId?: bigint;
+
+  /**
+   * @generated from field: optional string tenant_id = 10;
+   */
+  tenantId?: string;
 };
 
 /**
@@ -960,7 +1354,7 @@ export type LocalSearchRequest = Message<"npan.v1.LocalSearchRequest"> & {
  * Use `create(LocalSearchRequestSchema)` to create a new message.
  */
 export const LocalSearchRequestSchema: GenMessage<LocalSearchRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 26);
+  messageDesc(file_npan_v1_api, 31);
 
 /**
  * @generated from message npan.v1.LocalSearchResponse
@@ -977,7 +1371,7 @@ export type LocalSearchResponse = Message<"npan.v1.LocalSearchResponse"> & {
  * Use `create(LocalSearchResponseSchema)` to create a new message.
  */
 export const LocalSearchResponseSchema: GenMessage<LocalSearchResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 27);
+  messageDesc(file_npan_v1_api, 32);
 
 /**
  * @generated from message npan.v1.DownloadURLRequest
@@ -992,6 +1386,11 @@ export type DownloadURLRequest = Message<"npan.v1.DownloadURLRequest"> & {
    * @generated from field: optional int64 valid_period = 2;
    */
   validPeriod?: bigint;
+
+  /**
+   * @generated from field: optional string tenant_id = 3;
+   */
+  tenantId?: string;
 };
 
 /**
@@ -999,7 +1398,7 @@ export type DownloadURLRequest = Message<"npan.v1.DownloadURLRequest"> & {
  * Use `create(DownloadURLRequestSchema)` to create a new message.
  */
 export const DownloadURLRequestSchema: GenMessage<DownloadURLRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 28);
+  messageDesc(file_npan_v1_api, 33);
 
 /**
  * @generated from message npan.v1.DownloadURLResponse
@@ -1016,7 +1415,7 @@ export type DownloadURLResponse = Message<"npan.v1.DownloadURLResponse"> & {
  * Use `create(DownloadURLResponseSchema)` to create a new message.
  */
 export const DownloadURLResponseSchema: GenMessage<DownloadURLResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 29);
+  messageDesc(file_npan_v1_api, 34);
 
 /**
  * @generated from message npan.v1.StartSyncRequest
@@ -1081,6 +1480,26 @@ export type StartSyncRequest = Message<"npan.v1.StartSyncRequest"> & {
    * @generated from field: optional string incremental_query = 12;
    */
   incrementalQuery?: string;
+
+  /**
+   * @generated from field: optional int64 folder_workers = 13;
+   */
+  folderWorkers?: bigint;
+
+  /**
+   * @generated from field: optional bool shadow_rebuild = 14;
+   */
+  shadowRebuild?: boolean;
+
+  /**
+   * @generated from field: optional bool dry_run = 15;
+   */
+  dryRun?: boolean;
+
+  /**
+   * @generated from field: optional string tenant_id = 16;
+   */
+  tenantId?: string;
 };
 
 /**
@@ -1088,7 +1507,7 @@ export type StartSyncRequest = Message<"npan.v1.StartSyncRequest"> & {
  * Use `create(StartSyncRequestSchema)` to create a new message.
  */
 export const StartSyncRequestSchema: GenMessage<StartSyncRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 30);
+  messageDesc(file_npan_v1_api, 35);
 
 /**
  * @generated from message npan.v1.StartSyncResponse
@@ -1105,7 +1524,7 @@ export type StartSyncResponse = Message<"npan.v1.StartSyncResponse"> & {
  * Use `create(StartSyncResponseSchema)` to create a new message.
  */
 export const StartSyncResponseSchema: GenMessage<StartSyncResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 31);
+  messageDesc(file_npan_v1_api, 36);
 
 /**
  * @generated from message npan.v1.InspectRootsRequest
@@ -1122,7 +1541,7 @@ export type InspectRootsRequest = Message<"npan.v1.InspectRootsRequest"> & {
  * Use `create(InspectRootsRequestSchema)` to create a new message.
  */
 export const InspectRootsRequestSchema: GenMessage<InspectRootsRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 32);
+  messageDesc(file_npan_v1_api, 37);
 
 /**
  * @generated from message npan.v1.InspectRootsResponse
@@ -1144,12 +1563,16 @@ export type InspectRootsResponse = Message<"npan.v1.InspectRootsResponse"> & {
  * Use `create(InspectRootsResponseSchema)` to create a new message.
  */
 export const InspectRootsResponseSchema: GenMessage<InspectRootsResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 33);
+  messageDesc(file_npan_v1_api, 38);
 
 /**
  * @generated from message npan.v1.GetIndexStatsRequest
  */
 export type GetIndexStatsRequest = Message<"npan.v1.GetIndexStatsRequest"> & {
+  /**
+   * @generated from field: optional string tenant_id = 1;
+   */
+  tenantId?: string;
 };
 
 /**
@@ -1157,7 +1580,7 @@ export type GetIndexStatsRequest = Message<"npan.v1.GetIndexStatsRequest"> & {
  * Use `create(GetIndexStatsRequestSchema)` to create a new message.
  */
 export const GetIndexStatsRequestSchema: GenMessage<GetIndexStatsRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 34);
+  messageDesc(file_npan_v1_api, 39);
 
 /**
  * @generated from message npan.v1.GetIndexStatsResponse
@@ -1167,6 +1590,11 @@ export type GetIndexStatsResponse = Message<"npan.v1.GetIndexStatsResponse"> & {
    * @generated from field: int64 document_count = 1;
    */
   documentCount: bigint;
+
+  /**
+   * @generated from field: optional npan.v1.OAuthTokenStatus token = 2;
+   */
+  token?: OAuthTokenStatus;
 };
 
 /**
@@ -1174,12 +1602,63 @@ export type GetIndexStatsResponse = Message<"npan.v1.GetIndexStatsResponse"> & {
  * Use `create(GetIndexStatsResponseSchema)` to create a new message.
  */
 export const GetIndexStatsResponseSchema: GenMessage<GetIndexStatsResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 35);
+  messageDesc(file_npan_v1_api, 40);
+
+/**
+ * @generated from message npan.v1.OAuthTokenStatus
+ */
+export type OAuthTokenStatus = Message<"npan.v1.OAuthTokenStatus"> & {
+  /**
+   * @generated from field: string state = 1;
+   */
+  state: string;
+
+  /**
+   * @generated from field: int64 expires_at = 2;
+   */
+  expiresAt: bigint;
+
+  /**
+   * @generated from field: int64 refreshed_at = 3;
+   */
+  refreshedAt: bigint;
+
+  /**
+   * @generated from field: string source = 4;
+   */
+  source: string;
+
+  /**
+   * @generated from field: int64 refresh_count = 5;
+   */
+  refreshCount: bigint;
+
+  /**
+   * @generated from field: string last_error = 6;
+   */
+  lastError: string;
+
+  /**
+   * @generated from field: int64 last_error_at = 7;
+   */
+  lastErrorAt: bigint;
+};
+
+/**
+ * Describes the message npan.v1.OAuthTokenStatus.
+ * Use `create(OAuthTokenStatusSchema)` to create a new message.
+ */
+export const OAuthTokenStatusSchema: GenMessage<OAuthTokenStatus> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 41);
 
 /**
  * @generated from message npan.v1.GetSyncProgressRequest
  */
 export type GetSyncProgressRequest = Message<"npan.v1.GetSyncProgressRequest"> & {
+  /**
+   * @generated from field: optional string tenant_id = 1;
+   */
+  tenantId?: string;
 };
 
 /**
@@ -1187,7 +1666,7 @@ export type GetSyncProgressRequest = Message<"npan.v1.GetSyncProgressRequest"> &
  * Use `create(GetSyncProgressRequestSchema)` to create a new message.
  */
 export const GetSyncProgressRequestSchema: GenMessage<GetSyncProgressRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 36);
+  messageDesc(file_npan_v1_api, 42);
 
 /**
  * @generated from message npan.v1.GetSyncProgressResponse
@@ -1204,12 +1683,16 @@ export type GetSyncProgressResponse = Message<"npan.v1.GetSyncProgressResponse">
  * Use `create(GetSyncProgressResponseSchema)` to create a new message.
  */
 export const GetSyncProgressResponseSchema: GenMessage<GetSyncProgressResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 37);
+  messageDesc(file_npan_v1_api, 43);
 
 /**
  * @generated from message npan.v1.WatchSyncProgressRequest
  */
 export type WatchSyncProgressRequest = Message<"npan.v1.WatchSyncProgressRequest"> & {
+  /**
+   * @generated from field: optional string tenant_id = 1;
+   */
+  tenantId?: string;
 };
 
 /**
@@ -1217,7 +1700,7 @@ export type WatchSyncProgressRequest = Message<"npan.v1.WatchSyncProgressRequest
  * Use `create(WatchSyncProgressRequestSchema)` to create a new message.
  */
 export const WatchSyncProgressRequestSchema: GenMessage<WatchSyncProgressRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 38);
+  messageDesc(file_npan_v1_api, 44);
 
 /**
  * @generated from message npan.v1.WatchSyncProgressResponse
@@ -1234,12 +1717,16 @@ export type WatchSyncProgressResponse = Message<"npan.v1.WatchSyncProgressRespon
  * Use `create(WatchSyncProgressResponseSchema)` to create a new message.
  */
 export const WatchSyncProgressResponseSchema: GenMessage<WatchSyncProgressResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 39);
+  messageDesc(file_npan_v1_api, 45);
 
 /**
  * @generated from message npan.v1.CancelSyncRequest
  */
 export type CancelSyncRequest = Message<"npan.v1.CancelSyncRequest"> & {
+  /**
+   * @generated from field: optional string tenant_id = 1;
+   */
+  tenantId?: string;
 };
 
 /**
@@ -1247,7 +1734,7 @@ export type CancelSyncRequest = Message<"npan.v1.CancelSyncRequest"> & {
  * Use `create(CancelSyncRequestSchema)` to create a new message.
  */
 export const CancelSyncRequestSchema: GenMessage<CancelSyncRequest> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 40);
+  messageDesc(file_npan_v1_api, 46);
 
 /**
  * @generated from message npan.v1.CancelSyncResponse
@@ -1264,186 +1751,1981 @@ export type CancelSyncResponse = Message<"npan.v1.CancelSyncResponse"> & {
  * Use `create(CancelSyncResponseSchema)` to create a new message.
  */
 export const CancelSyncResponseSchema: GenMessage<CancelSyncResponse> = /*@__PURE__*/
-  messageDesc(file_npan_v1_api, 41);
+  messageDesc(file_npan_v1_api, 47);
 
 /**
- * @generated from enum npan.v1.ItemType
+ * @generated from message npan.v1.PauseSyncRequest
  */
-export enum ItemType {
+export type PauseSyncRequest = Message<"npan.v1.PauseSyncRequest"> & {
   /**
-   * @generated from enum value: ITEM_TYPE_UNSPECIFIED = 0;
+   * @generated from field: optional string tenant_id = 1;
    */
-  UNSPECIFIED = 0,
+  tenantId?: string;
+};
 
+/**
+ * Describes the message npan.v1.PauseSyncRequest.
+ * Use `create(PauseSyncRequestSchema)` to create a new message.
+ */
+export const PauseSyncRequestSchema: GenMessage<PauseSyncRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 48);
+
+/**
+ * @generated from message npan.v1.PauseSyncResponse
+ */
+export type PauseSyncResponse = Message<"npan.v1.PauseSyncResponse"> & {
   /**
-   * @generated from enum value: ITEM_TYPE_FILE = 1;
+   * @generated from field: string message = 1;
    */
-  FILE = 1,
+  message: string;
+};
+
+/**
+ * Describes the message npan.v1.PauseSyncResponse.
+ * Use `create(PauseSyncResponseSchema)` to create a new message.
+ */
+export const PauseSyncResponseSchema: GenMessage<PauseSyncResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 49);
 
+/**
+ * @generated from message npan.v1.ResumeSyncRequest
+ */
+export type ResumeSyncRequest = Message<"npan.v1.ResumeSyncRequest"> & {
   /**
-   * @generated from enum value: ITEM_TYPE_FOLDER = 2;
+   * @generated from field: optional string tenant_id = 1;
    */
-  FOLDER = 2,
-}
+  tenantId?: string;
+};
 
 /**
- * Describes the enum npan.v1.ItemType.
+ * Describes the message npan.v1.ResumeSyncRequest.
+ * Use `create(ResumeSyncRequestSchema)` to create a new message.
  */
-export const ItemTypeSchema: GenEnum<ItemType> = /*@__PURE__*/
-  enumDesc(file_npan_v1_api, 0);
+export const ResumeSyncRequestSchema: GenMessage<ResumeSyncRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 50);
 
 /**
- * @generated from enum npan.v1.SyncStatus
+ * @generated from message npan.v1.ResumeSyncResponse
  */
-export enum SyncStatus {
+export type ResumeSyncResponse = Message<"npan.v1.ResumeSyncResponse"> & {
   /**
-   * @generated from enum value: SYNC_STATUS_UNSPECIFIED = 0;
+   * @generated from field: string message = 1;
    */
-  UNSPECIFIED = 0,
+  message: string;
+};
+
+/**
+ * Describes the message npan.v1.ResumeSyncResponse.
+ * Use `create(ResumeSyncResponseSchema)` to create a new message.
+ */
+export const ResumeSyncResponseSchema: GenMessage<ResumeSyncResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 51);
 
+/**
+ * @generated from message npan.v1.SyncLease
+ */
+export type SyncLease = Message<"npan.v1.SyncLease"> & {
   /**
-   * @generated from enum value: SYNC_STATUS_IDLE = 1;
+   * @generated from field: string owner_id = 1;
    */
-  IDLE = 1,
+  ownerId: string;
 
   /**
-   * @generated from enum value: SYNC_STATUS_RUNNING = 2;
+   * @generated from field: string owner = 2;
    */
-  RUNNING = 2,
+  owner: string;
 
   /**
-   * @generated from enum value: SYNC_STATUS_DONE = 3;
+   * @generated from field: int64 acquired_at = 3;
    */
-  DONE = 3,
+  acquiredAt: bigint;
 
   /**
-   * @generated from enum value: SYNC_STATUS_ERROR = 4;
+   * @generated from field: int64 heartbeat_at = 4;
    */
-  ERROR = 4,
+  heartbeatAt: bigint;
 
   /**
-   * @generated from enum value: SYNC_STATUS_CANCELLED = 5;
+   * @generated from field: int64 expires_at = 5;
    */
-  CANCELLED = 5,
+  expiresAt: bigint;
 
   /**
-   * @generated from enum value: SYNC_STATUS_INTERRUPTED = 6;
+   * @generated from field: bool expired = 6;
    */
-  INTERRUPTED = 6,
-}
+  expired: boolean;
+};
 
 /**
- * Describes the enum npan.v1.SyncStatus.
+ * Describes the message npan.v1.SyncLease.
+ * Use `create(SyncLeaseSchema)` to create a new message.
  */
-export const SyncStatusSchema: GenEnum<SyncStatus> = /*@__PURE__*/
-  enumDesc(file_npan_v1_api, 1);
+export const SyncLeaseSchema: GenMessage<SyncLease> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 52);
 
 /**
- * @generated from enum npan.v1.SyncMode
+ * @generated from message npan.v1.GetSyncLeaseRequest
  */
-export enum SyncMode {
+export type GetSyncLeaseRequest = Message<"npan.v1.GetSyncLeaseRequest"> & {
   /**
-   * @generated from enum value: SYNC_MODE_UNSPECIFIED = 0;
+   * @generated from field: optional string tenant_id = 1;
    */
-  UNSPECIFIED = 0,
+  tenantId?: string;
+};
 
+/**
+ * Describes the message npan.v1.GetSyncLeaseRequest.
+ * Use `create(GetSyncLeaseRequestSchema)` to create a new message.
+ */
+export const GetSyncLeaseRequestSchema: GenMessage<GetSyncLeaseRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 53);
+
+/**
+ * @generated from message npan.v1.GetSyncLeaseResponse
+ */
+export type GetSyncLeaseResponse = Message<"npan.v1.GetSyncLeaseResponse"> & {
   /**
-   * @generated from enum value: SYNC_MODE_FULL = 2;
+   * @generated from field: optional npan.v1.SyncLease lease = 1;
    */
-  FULL = 2,
+  lease?: SyncLease;
+};
 
+/**
+ * Describes the message npan.v1.GetSyncLeaseResponse.
+ * Use `create(GetSyncLeaseResponseSchema)` to create a new message.
+ */
+export const GetSyncLeaseResponseSchema: GenMessage<GetSyncLeaseResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 54);
+
+/**
+ * @generated from message npan.v1.ForceReleaseSyncLeaseRequest
+ */
+export type ForceReleaseSyncLeaseRequest = Message<"npan.v1.ForceReleaseSyncLeaseRequest"> & {
   /**
-   * @generated from enum value: SYNC_MODE_INCREMENTAL = 3;
+   * @generated from field: optional string tenant_id = 1;
    */
-  INCREMENTAL = 3,
-}
+  tenantId?: string;
+};
 
 /**
- * Describes the enum npan.v1.SyncMode.
+ * Describes the message npan.v1.ForceReleaseSyncLeaseRequest.
+ * Use `create(ForceReleaseSyncLeaseRequestSchema)` to create a new message.
  */
-export const SyncModeSchema: GenEnum<SyncMode> = /*@__PURE__*/
-  enumDesc(file_npan_v1_api, 2);
+export const ForceReleaseSyncLeaseRequestSchema: GenMessage<ForceReleaseSyncLeaseRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 55);
 
 /**
- * @generated from enum npan.v1.ErrorCode
+ * @generated from message npan.v1.ForceReleaseSyncLeaseResponse
  */
-export enum ErrorCode {
+export type ForceReleaseSyncLeaseResponse = Message<"npan.v1.ForceReleaseSyncLeaseResponse"> & {
   /**
-   * @generated from enum value: ERROR_CODE_UNSPECIFIED = 0;
+   * @generated from field: optional npan.v1.SyncLease released = 1;
    */
-  UNSPECIFIED = 0,
+  released?: SyncLease;
 
   /**
-   * @generated from enum value: ERROR_CODE_UNAUTHORIZED = 1;
+   * @generated from field: string message = 2;
    */
-  UNAUTHORIZED = 1,
+  message: string;
+};
+
+/**
+ * Describes the message npan.v1.ForceReleaseSyncLeaseResponse.
+ * Use `create(ForceReleaseSyncLeaseResponseSchema)` to create a new message.
+ */
+export const ForceReleaseSyncLeaseResponseSchema: GenMessage<ForceReleaseSyncLeaseResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 56);
 
+/**
+ * @generated from message npan.v1.FolderResyncProgress
+ */
+export type FolderResyncProgress = Message<"npan.v1.FolderResyncProgress"> & {
   /**
-   * @generated from enum value: ERROR_CODE_BAD_REQUEST = 2;
+   * @generated from field: int64 folder_id = 1;
    */
-  BAD_REQUEST = 2,
+  folderId: bigint;
 
   /**
-   * @generated from enum value: ERROR_CODE_NOT_FOUND = 3;
+   * @generated from field: string folder_name = 2;
    */
-  NOT_FOUND = 3,
+  folderName: string;
 
   /**
-   * @generated from enum value: ERROR_CODE_CONFLICT = 4;
+   * @generated from field: npan.v1.FolderResyncMode mode = 3;
    */
-  CONFLICT = 4,
+  mode: FolderResyncMode;
 
   /**
-   * @generated from enum value: ERROR_CODE_RATE_LIMITED = 5;
+   * @generated from field: npan.v1.SyncStatus status = 4;
    */
-  RATE_LIMITED = 5,
+  status: SyncStatus;
 
   /**
-   * @generated from enum value: ERROR_CODE_INTERNAL_ERROR = 6;
+   * @generated from field: int64 started_at = 5;
    */
-  INTERNAL_ERROR = 6,
-}
-
-/**
- * Describes the enum npan.v1.ErrorCode.
- */
-export const ErrorCodeSchema: GenEnum<ErrorCode> = /*@__PURE__*/
-  enumDesc(file_npan_v1_api, 3);
+  startedAt: bigint;
 
-/**
- * @generated from enum npan.v1.ReadyStatus
- */
-export enum ReadyStatus {
   /**
-   * @generated from enum value: READY_STATUS_UNSPECIFIED = 0;
+   * @generated from field: int64 updated_at = 6;
    */
-  UNSPECIFIED = 0,
+  updatedAt: bigint;
 
   /**
-   * @generated from enum value: READY_STATUS_READY = 1;
+   * @generated from field: optional int64 finished_at = 7;
    */
-  READY = 1,
+  finishedAt?: bigint;
 
   /**
-   * @generated from enum value: READY_STATUS_NOT_READY = 2;
+   * @generated from field: int64 docs_deleted = 8;
    */
-  NOT_READY = 2,
-}
+  docsDeleted: bigint;
 
-/**
- * Describes the enum npan.v1.ReadyStatus.
- */
-export const ReadyStatusSchema: GenEnum<ReadyStatus> = /*@__PURE__*/
-  enumDesc(file_npan_v1_api, 4);
+  /**
+   * @generated from field: int64 docs_written = 9;
+   */
+  docsWritten: bigint;
 
-/**
- * @generated from service npan.v1.HealthService
- */
-export const HealthService: GenService<{
   /**
-   * @generated from rpc npan.v1.HealthService.Health
+   * @generated from field: int64 folders_visited = 10;
    */
-  health: {
+  foldersVisited: bigint;
+
+  /**
+   * @generated from field: optional int64 current_folder_id = 11;
+   */
+  currentFolderId?: bigint;
+
+  /**
+   * @generated from field: optional string last_error = 12;
+   */
+  lastError?: string;
+};
+
+/**
+ * Describes the message npan.v1.FolderResyncProgress.
+ * Use `create(FolderResyncProgressSchema)` to create a new message.
+ */
+export const FolderResyncProgressSchema: GenMessage<FolderResyncProgress> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 57);
+
+/**
+ * @generated from message npan.v1.ResyncFolderRequest
+ */
+export type ResyncFolderRequest = Message<"npan.v1.ResyncFolderRequest"> & {
+  /**
+   * @generated from field: int64 folder_id = 1;
+   */
+  folderId: bigint;
+
+  /**
+   * @generated from field: optional npan.v1.FolderResyncMode mode = 2;
+   */
+  mode?: FolderResyncMode;
+
+  /**
+   * @generated from field: optional string tenant_id = 3;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.ResyncFolderRequest.
+ * Use `create(ResyncFolderRequestSchema)` to create a new message.
+ */
+export const ResyncFolderRequestSchema: GenMessage<ResyncFolderRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 58);
+
+/**
+ * @generated from message npan.v1.ResyncFolderResponse
+ */
+export type ResyncFolderResponse = Message<"npan.v1.ResyncFolderResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message npan.v1.ResyncFolderResponse.
+ * Use `create(ResyncFolderResponseSchema)` to create a new message.
+ */
+export const ResyncFolderResponseSchema: GenMessage<ResyncFolderResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 59);
+
+/**
+ * @generated from message npan.v1.GetFolderResyncProgressRequest
+ */
+export type GetFolderResyncProgressRequest = Message<"npan.v1.GetFolderResyncProgressRequest"> & {
+  /**
+   * @generated from field: optional string tenant_id = 1;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.GetFolderResyncProgressRequest.
+ * Use `create(GetFolderResyncProgressRequestSchema)` to create a new message.
+ */
+export const GetFolderResyncProgressRequestSchema: GenMessage<GetFolderResyncProgressRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 60);
+
+/**
+ * @generated from message npan.v1.GetFolderResyncProgressResponse
+ */
+export type GetFolderResyncProgressResponse = Message<"npan.v1.GetFolderResyncProgressResponse"> & {
+  /**
+   * @generated from field: npan.v1.FolderResyncProgress progress = 1;
+   */
+  progress?: FolderResyncProgress;
+};
+
+/**
+ * Describes the message npan.v1.GetFolderResyncProgressResponse.
+ * Use `create(GetFolderResyncProgressResponseSchema)` to create a new message.
+ */
+export const GetFolderResyncProgressResponseSchema: GenMessage<GetFolderResyncProgressResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 61);
+
+/**
+ * @generated from message npan.v1.CancelFolderResyncRequest
+ */
+export type CancelFolderResyncRequest = Message<"npan.v1.CancelFolderResyncRequest"> & {
+  /**
+   * @generated from field: optional string tenant_id = 1;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.CancelFolderResyncRequest.
+ * Use `create(CancelFolderResyncRequestSchema)` to create a new message.
+ */
+export const CancelFolderResyncRequestSchema: GenMessage<CancelFolderResyncRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 62);
+
+/**
+ * @generated from message npan.v1.CancelFolderResyncResponse
+ */
+export type CancelFolderResyncResponse = Message<"npan.v1.CancelFolderResyncResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message npan.v1.CancelFolderResyncResponse.
+ * Use `create(CancelFolderResyncResponseSchema)` to create a new message.
+ */
+export const CancelFolderResyncResponseSchema: GenMessage<CancelFolderResyncResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 63);
+
+/**
+ * @generated from message npan.v1.RollbackIndexRebuildRequest
+ */
+export type RollbackIndexRebuildRequest = Message<"npan.v1.RollbackIndexRebuildRequest"> & {
+  /**
+   * @generated from field: optional string tenant_id = 1;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.RollbackIndexRebuildRequest.
+ * Use `create(RollbackIndexRebuildRequestSchema)` to create a new message.
+ */
+export const RollbackIndexRebuildRequestSchema: GenMessage<RollbackIndexRebuildRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 64);
+
+/**
+ * @generated from message npan.v1.RollbackIndexRebuildResponse
+ */
+export type RollbackIndexRebuildResponse = Message<"npan.v1.RollbackIndexRebuildResponse"> & {
+  /**
+   * @generated from field: npan.v1.IndexRebuildState rebuild = 1;
+   */
+  rebuild?: IndexRebuildState;
+};
+
+/**
+ * Describes the message npan.v1.RollbackIndexRebuildResponse.
+ * Use `create(RollbackIndexRebuildResponseSchema)` to create a new message.
+ */
+export const RollbackIndexRebuildResponseSchema: GenMessage<RollbackIndexRebuildResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 65);
+
+/**
+ * @generated from message npan.v1.SyncRun
+ */
+export type SyncRun = Message<"npan.v1.SyncRun"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: npan.v1.SyncMode mode = 2;
+   */
+  mode: SyncMode;
+
+  /**
+   * @generated from field: npan.v1.SyncStatus status = 3;
+   */
+  status: SyncStatus;
+
+  /**
+   * @generated from field: repeated int64 roots = 4;
+   */
+  roots: bigint[];
+
+  /**
+   * @generated from field: int64 started_at = 5;
+   */
+  startedAt: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at_ts = 6;
+   */
+  startedAtTs?: Timestamp;
+
+  /**
+   * @generated from field: int64 ended_at = 7;
+   */
+  endedAt: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp ended_at_ts = 8;
+   */
+  endedAtTs?: Timestamp;
+
+  /**
+   * @generated from field: int64 duration_ms = 9;
+   */
+  durationMs: bigint;
+
+  /**
+   * @generated from field: npan.v1.CrawlStats stats = 10;
+   */
+  stats?: CrawlStats;
+
+  /**
+   * @generated from field: optional npan.v1.IncrementalSyncStats incremental_stats = 11;
+   */
+  incrementalStats?: IncrementalSyncStats;
+
+  /**
+   * @generated from field: optional npan.v1.SyncVerification verification = 12;
+   */
+  verification?: SyncVerification;
+
+  /**
+   * @generated from field: optional string error = 13;
+   */
+  error?: string;
+};
+
+/**
+ * Describes the message npan.v1.SyncRun.
+ * Use `create(SyncRunSchema)` to create a new message.
+ */
+export const SyncRunSchema: GenMessage<SyncRun> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 66);
+
+/**
+ * @generated from message npan.v1.ListSyncRunsRequest
+ */
+export type ListSyncRunsRequest = Message<"npan.v1.ListSyncRunsRequest"> & {
+  /**
+   * @generated from field: optional npan.v1.SyncMode mode = 1;
+   */
+  mode?: SyncMode;
+
+  /**
+   * @generated from field: optional int64 limit = 2;
+   */
+  limit?: bigint;
+
+  /**
+   * @generated from field: optional int64 before_id = 3;
+   */
+  beforeId?: bigint;
+
+  /**
+   * @generated from field: optional string tenant_id = 4;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.ListSyncRunsRequest.
+ * Use `create(ListSyncRunsRequestSchema)` to create a new message.
+ */
+export const ListSyncRunsRequestSchema: GenMessage<ListSyncRunsRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 67);
+
+/**
+ * @generated from message npan.v1.ListSyncRunsResponse
+ */
+export type ListSyncRunsResponse = Message<"npan.v1.ListSyncRunsResponse"> & {
+  /**
+   * @generated from field: repeated npan.v1.SyncRun runs = 1;
+   */
+  runs: SyncRun[];
+
+  /**
+   * @generated from field: optional int64 next_before_id = 2;
+   */
+  nextBeforeId?: bigint;
+};
+
+/**
+ * Describes the message npan.v1.ListSyncRunsResponse.
+ * Use `create(ListSyncRunsResponseSchema)` to create a new message.
+ */
+export const ListSyncRunsResponseSchema: GenMessage<ListSyncRunsResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 68);
+
+/**
+ * @generated from message npan.v1.GetSyncRunRequest
+ */
+export type GetSyncRunRequest = Message<"npan.v1.GetSyncRunRequest"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: optional string tenant_id = 2;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.GetSyncRunRequest.
+ * Use `create(GetSyncRunRequestSchema)` to create a new message.
+ */
+export const GetSyncRunRequestSchema: GenMessage<GetSyncRunRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 69);
+
+/**
+ * @generated from message npan.v1.GetSyncRunResponse
+ */
+export type GetSyncRunResponse = Message<"npan.v1.GetSyncRunResponse"> & {
+  /**
+   * @generated from field: npan.v1.SyncRun run = 1;
+   */
+  run?: SyncRun;
+};
+
+/**
+ * Describes the message npan.v1.GetSyncRunResponse.
+ * Use `create(GetSyncRunResponseSchema)` to create a new message.
+ */
+export const GetSyncRunResponseSchema: GenMessage<GetSyncRunResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 70);
+
+/**
+ * @generated from message npan.v1.DeadLetter
+ */
+export type DeadLetter = Message<"npan.v1.DeadLetter"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: int64 run_id = 2;
+   */
+  runId: bigint;
+
+  /**
+   * @generated from field: int64 root_folder_id = 3;
+   */
+  rootFolderId: bigint;
+
+  /**
+   * @generated from field: int64 doc_count = 4;
+   */
+  docCount: bigint;
+
+  /**
+   * @generated from field: repeated string doc_ids = 5;
+   */
+  docIds: string[];
+
+  /**
+   * @generated from field: string error = 6;
+   */
+  error: string;
+
+  /**
+   * @generated from field: int64 attempts = 7;
+   */
+  attempts: bigint;
+
+  /**
+   * @generated from field: int64 created_at = 8;
+   */
+  createdAt: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at_ts = 9;
+   */
+  createdAtTs?: Timestamp;
+
+  /**
+   * @generated from field: int64 updated_at = 10;
+   */
+  updatedAt: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at_ts = 11;
+   */
+  updatedAtTs?: Timestamp;
+};
+
+/**
+ * Describes the message npan.v1.DeadLetter.
+ * Use `create(DeadLetterSchema)` to create a new message.
+ */
+export const DeadLetterSchema: GenMessage<DeadLetter> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 71);
+
+/**
+ * @generated from message npan.v1.ListDeadLettersRequest
+ */
+export type ListDeadLettersRequest = Message<"npan.v1.ListDeadLettersRequest"> & {
+  /**
+   * @generated from field: optional int64 root_folder_id = 1;
+   */
+  rootFolderId?: bigint;
+
+  /**
+   * @generated from field: optional int64 limit = 2;
+   */
+  limit?: bigint;
+
+  /**
+   * @generated from field: optional int64 before_id = 3;
+   */
+  beforeId?: bigint;
+
+  /**
+   * @generated from field: optional string tenant_id = 4;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.ListDeadLettersRequest.
+ * Use `create(ListDeadLettersRequestSchema)` to create a new message.
+ */
+export const ListDeadLettersRequestSchema: GenMessage<ListDeadLettersRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 72);
+
+/**
+ * @generated from message npan.v1.ListDeadLettersResponse
+ */
+export type ListDeadLettersResponse = Message<"npan.v1.ListDeadLettersResponse"> & {
+  /**
+   * @generated from field: repeated npan.v1.DeadLetter dead_letters = 1;
+   */
+  deadLetters: DeadLetter[];
+
+  /**
+   * @generated from field: optional int64 next_before_id = 2;
+   */
+  nextBeforeId?: bigint;
+
+  /**
+   * @generated from field: int64 total = 3;
+   */
+  total: bigint;
+};
+
+/**
+ * Describes the message npan.v1.ListDeadLettersResponse.
+ * Use `create(ListDeadLettersResponseSchema)` to create a new message.
+ */
+export const ListDeadLettersResponseSchema: GenMessage<ListDeadLettersResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 73);
+
+/**
+ * @generated from message npan.v1.ReplayDeadLettersRequest
+ */
+export type ReplayDeadLettersRequest = Message<"npan.v1.ReplayDeadLettersRequest"> & {
+  /**
+   * @generated from field: repeated int64 ids = 1;
+   */
+  ids: bigint[];
+
+  /**
+   * @generated from field: bool all = 2;
+   */
+  all: boolean;
+
+  /**
+   * @generated from field: optional string tenant_id = 3;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.ReplayDeadLettersRequest.
+ * Use `create(ReplayDeadLettersRequestSchema)` to create a new message.
+ */
+export const ReplayDeadLettersRequestSchema: GenMessage<ReplayDeadLettersRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 74);
+
+/**
+ * @generated from message npan.v1.ReplayDeadLettersResponse
+ */
+export type ReplayDeadLettersResponse = Message<"npan.v1.ReplayDeadLettersResponse"> & {
+  /**
+   * @generated from field: repeated int64 replayed_ids = 1;
+   */
+  replayedIds: bigint[];
+
+  /**
+   * @generated from field: repeated int64 failed_ids = 2;
+   */
+  failedIds: bigint[];
+
+  /**
+   * @generated from field: int64 remaining = 3;
+   */
+  remaining: bigint;
+
+  /**
+   * @generated from field: repeated int64 superseded_ids = 4;
+   */
+  supersededIds: bigint[];
+};
+
+/**
+ * Describes the message npan.v1.ReplayDeadLettersResponse.
+ * Use `create(ReplayDeadLettersResponseSchema)` to create a new message.
+ */
+export const ReplayDeadLettersResponseSchema: GenMessage<ReplayDeadLettersResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 75);
+
+/**
+ * @generated from message npan.v1.DiscardDeadLettersRequest
+ */
+export type DiscardDeadLettersRequest = Message<"npan.v1.DiscardDeadLettersRequest"> & {
+  /**
+   * @generated from field: repeated int64 ids = 1;
+   */
+  ids: bigint[];
+
+  /**
+   * @generated from field: bool all = 2;
+   */
+  all: boolean;
+
+  /**
+   * @generated from field: optional string tenant_id = 3;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.DiscardDeadLettersRequest.
+ * Use `create(DiscardDeadLettersRequestSchema)` to create a new message.
+ */
+export const DiscardDeadLettersRequestSchema: GenMessage<DiscardDeadLettersRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 76);
+
+/**
+ * @generated from message npan.v1.DiscardDeadLettersResponse
+ */
+export type DiscardDeadLettersResponse = Message<"npan.v1.DiscardDeadLettersResponse"> & {
+  /**
+   * @generated from field: int64 discarded = 1;
+   */
+  discarded: bigint;
+
+  /**
+   * @generated from field: int64 remaining = 2;
+   */
+  remaining: bigint;
+};
+
+/**
+ * Describes the message npan.v1.DiscardDeadLettersResponse.
+ * Use `create(DiscardDeadLettersResponseSchema)` to create a new message.
+ */
+export const DiscardDeadLettersResponseSchema: GenMessage<DiscardDeadLettersResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 77);
+
+/**
+ * @generated from message npan.v1.DuplicateFile
+ */
+export type DuplicateFile = Message<"npan.v1.DuplicateFile"> & {
+  /**
+   * @generated from field: string doc_id = 1;
+   */
+  docId: string;
+
+  /**
+   * @generated from field: int64 source_id = 2;
+   */
+  sourceId: bigint;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string path = 4;
+   */
+  path: string;
+
+  /**
+   * @generated from field: int64 size = 5;
+   */
+  size: bigint;
+};
+
+/**
+ * Describes the message npan.v1.DuplicateFile.
+ * Use `create(DuplicateFileSchema)` to create a new message.
+ */
+export const DuplicateFileSchema: GenMessage<DuplicateFile> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 78);
+
+/**
+ * @generated from message npan.v1.DuplicateGroup
+ */
+export type DuplicateGroup = Message<"npan.v1.DuplicateGroup"> & {
+  /**
+   * @generated from field: string sha1 = 1;
+   */
+  sha1: string;
+
+  /**
+   * @generated from field: int64 size = 2;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: int64 copies = 3;
+   */
+  copies: bigint;
+
+  /**
+   * @generated from field: int64 wasted_bytes = 4;
+   */
+  wastedBytes: bigint;
+
+  /**
+   * @generated from field: repeated npan.v1.DuplicateFile files = 5;
+   */
+  files: DuplicateFile[];
+};
+
+/**
+ * Describes the message npan.v1.DuplicateGroup.
+ * Use `create(DuplicateGroupSchema)` to create a new message.
+ */
+export const DuplicateGroupSchema: GenMessage<DuplicateGroup> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 79);
+
+/**
+ * @generated from message npan.v1.FindDuplicatesRequest
+ */
+export type FindDuplicatesRequest = Message<"npan.v1.FindDuplicatesRequest"> & {
+  /**
+   * @generated from field: optional int64 root_folder_id = 1;
+   */
+  rootFolderId?: bigint;
+
+  /**
+   * @generated from field: int64 min_size = 2;
+   */
+  minSize: bigint;
+
+  /**
+   * @generated from field: optional int64 limit = 3;
+   */
+  limit?: bigint;
+
+  /**
+   * @generated from field: optional string export_format = 4;
+   */
+  exportFormat?: string;
+
+  /**
+   * @generated from field: optional string tenant_id = 5;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.FindDuplicatesRequest.
+ * Use `create(FindDuplicatesRequestSchema)` to create a new message.
+ */
+export const FindDuplicatesRequestSchema: GenMessage<FindDuplicatesRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 80);
+
+/**
+ * @generated from message npan.v1.FindDuplicatesResponse
+ */
+export type FindDuplicatesResponse = Message<"npan.v1.FindDuplicatesResponse"> & {
+  /**
+   * @generated from field: repeated npan.v1.DuplicateGroup groups = 1;
+   */
+  groups: DuplicateGroup[];
+
+  /**
+   * @generated from field: int64 wasted_bytes = 2;
+   */
+  wastedBytes: bigint;
+
+  /**
+   * @generated from field: string export = 3;
+   */
+  export: string;
+
+  /**
+   * @generated from field: int64 total_groups = 4;
+   */
+  totalGroups: bigint;
+
+  /**
+   * @generated from field: bool truncated = 5;
+   */
+  truncated: boolean;
+};
+
+/**
+ * Describes the message npan.v1.FindDuplicatesResponse.
+ * Use `create(FindDuplicatesResponseSchema)` to create a new message.
+ */
+export const FindDuplicatesResponseSchema: GenMessage<FindDuplicatesResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 81);
+
+/**
+ * @generated from message npan.v1.ReconciliationRow
+ */
+export type ReconciliationRow = Message<"npan.v1.ReconciliationRow"> & {
+  /**
+   * @generated from field: int64 root_folder_id = 1;
+   */
+  rootFolderId: bigint;
+
+  /**
+   * @generated from field: int64 folder_id = 2;
+   */
+  folderId: bigint;
+
+  /**
+   * @generated from field: string path = 3;
+   */
+  path: string;
+
+  /**
+   * @generated from field: int64 upstream_items = 4;
+   */
+  upstreamItems: bigint;
+
+  /**
+   * @generated from field: int64 indexed_items = 5;
+   */
+  indexedItems: bigint;
+
+  /**
+   * @generated from field: int64 drift = 6;
+   */
+  drift: bigint;
+
+  /**
+   * @generated from field: optional string error = 7;
+   */
+  error?: string;
+};
+
+/**
+ * Describes the message npan.v1.ReconciliationRow.
+ * Use `create(ReconciliationRowSchema)` to create a new message.
+ */
+export const ReconciliationRowSchema: GenMessage<ReconciliationRow> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 82);
+
+/**
+ * @generated from message npan.v1.ReconciliationReport
+ */
+export type ReconciliationReport = Message<"npan.v1.ReconciliationReport"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: npan.v1.SyncStatus status = 2;
+   */
+  status: SyncStatus;
+
+  /**
+   * @generated from field: repeated int64 roots = 3;
+   */
+  roots: bigint[];
+
+  /**
+   * @generated from field: int64 sample_size = 4;
+   */
+  sampleSize: bigint;
+
+  /**
+   * @generated from field: int64 started_at = 5;
+   */
+  startedAt: bigint;
+
+  /**
+   * @generated from field: optional int64 finished_at = 6;
+   */
+  finishedAt?: bigint;
+
+  /**
+   * @generated from field: int64 folders_checked = 7;
+   */
+  foldersChecked: bigint;
+
+  /**
+   * @generated from field: int64 folders_drifted = 8;
+   */
+  foldersDrifted: bigint;
+
+  /**
+   * @generated from field: optional string error = 9;
+   */
+  error?: string;
+};
+
+/**
+ * Describes the message npan.v1.ReconciliationReport.
+ * Use `create(ReconciliationReportSchema)` to create a new message.
+ */
+export const ReconciliationReportSchema: GenMessage<ReconciliationReport> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 83);
+
+/**
+ * @generated from message npan.v1.StartReconciliationRequest
+ */
+export type StartReconciliationRequest = Message<"npan.v1.StartReconciliationRequest"> & {
+  /**
+   * @generated from field: repeated int64 root_folder_ids = 1;
+   */
+  rootFolderIds: bigint[];
+
+  /**
+   * @generated from field: optional int64 sample_size = 2;
+   */
+  sampleSize?: bigint;
+
+  /**
+   * @generated from field: optional string tenant_id = 3;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.StartReconciliationRequest.
+ * Use `create(StartReconciliationRequestSchema)` to create a new message.
+ */
+export const StartReconciliationRequestSchema: GenMessage<StartReconciliationRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 84);
+
+/**
+ * @generated from message npan.v1.StartReconciliationResponse
+ */
+export type StartReconciliationResponse = Message<"npan.v1.StartReconciliationResponse"> & {
+  /**
+   * @generated from field: npan.v1.ReconciliationReport report = 1;
+   */
+  report?: ReconciliationReport;
+};
+
+/**
+ * Describes the message npan.v1.StartReconciliationResponse.
+ * Use `create(StartReconciliationResponseSchema)` to create a new message.
+ */
+export const StartReconciliationResponseSchema: GenMessage<StartReconciliationResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 85);
+
+/**
+ * @generated from message npan.v1.GetReconciliationReportRequest
+ */
+export type GetReconciliationReportRequest = Message<"npan.v1.GetReconciliationReportRequest"> & {
+  /**
+   * @generated from field: optional int64 report_id = 1;
+   */
+  reportId?: bigint;
+
+  /**
+   * @generated from field: optional bool only_drift = 2;
+   */
+  onlyDrift?: boolean;
+
+  /**
+   * @generated from field: optional int64 limit = 3;
+   */
+  limit?: bigint;
+
+  /**
+   * @generated from field: optional string tenant_id = 4;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.GetReconciliationReportRequest.
+ * Use `create(GetReconciliationReportRequestSchema)` to create a new message.
+ */
+export const GetReconciliationReportRequestSchema: GenMessage<GetReconciliationReportRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 86);
+
+/**
+ * @generated from message npan.v1.GetReconciliationReportResponse
+ */
+export type GetReconciliationReportResponse = Message<"npan.v1.GetReconciliationReportResponse"> & {
+  /**
+   * @generated from field: npan.v1.ReconciliationReport report = 1;
+   */
+  report?: ReconciliationReport;
+
+  /**
+   * @generated from field: repeated npan.v1.ReconciliationRow rows = 2;
+   */
+  rows: ReconciliationRow[];
+};
+
+/**
+ * Describes the message npan.v1.GetReconciliationReportResponse.
+ * Use `create(GetReconciliationReportResponseSchema)` to create a new message.
+ */
+export const GetReconciliationReportResponseSchema: GenMessage<GetReconciliationReportResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 87);
+
+/**
+ * @generated from message npan.v1.ExportReconciliationReportRequest
+ */
+export type ExportReconciliationReportRequest = Message<"npan.v1.ExportReconciliationReportRequest"> & {
+  /**
+   * @generated from field: optional int64 report_id = 1;
+   */
+  reportId?: bigint;
+
+  /**
+   * @generated from field: string format = 2;
+   */
+  format: string;
+
+  /**
+   * @generated from field: optional bool only_drift = 3;
+   */
+  onlyDrift?: boolean;
+
+  /**
+   * @generated from field: optional string tenant_id = 4;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.ExportReconciliationReportRequest.
+ * Use `create(ExportReconciliationReportRequestSchema)` to create a new message.
+ */
+export const ExportReconciliationReportRequestSchema: GenMessage<ExportReconciliationReportRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 88);
+
+/**
+ * @generated from message npan.v1.ExportReconciliationReportResponse
+ */
+export type ExportReconciliationReportResponse = Message<"npan.v1.ExportReconciliationReportResponse"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: string export = 2;
+   */
+  export: string;
+};
+
+/**
+ * Describes the message npan.v1.ExportReconciliationReportResponse.
+ * Use `create(ExportReconciliationReportResponseSchema)` to create a new message.
+ */
+export const ExportReconciliationReportResponseSchema: GenMessage<ExportReconciliationReportResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 89);
+
+/**
+ * @generated from message npan.v1.CancelReconciliationRequest
+ */
+export type CancelReconciliationRequest = Message<"npan.v1.CancelReconciliationRequest"> & {
+  /**
+   * @generated from field: optional string tenant_id = 1;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.CancelReconciliationRequest.
+ * Use `create(CancelReconciliationRequestSchema)` to create a new message.
+ */
+export const CancelReconciliationRequestSchema: GenMessage<CancelReconciliationRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 90);
+
+/**
+ * @generated from message npan.v1.CancelReconciliationResponse
+ */
+export type CancelReconciliationResponse = Message<"npan.v1.CancelReconciliationResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message npan.v1.CancelReconciliationResponse.
+ * Use `create(CancelReconciliationResponseSchema)` to create a new message.
+ */
+export const CancelReconciliationResponseSchema: GenMessage<CancelReconciliationResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 91);
+
+/**
+ * @generated from message npan.v1.SyncSchedule
+ */
+export type SyncSchedule = Message<"npan.v1.SyncSchedule"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string cron_expr = 3;
+   */
+  cronExpr: string;
+
+  /**
+   * @generated from field: npan.v1.SyncMode mode = 4;
+   */
+  mode: SyncMode;
+
+  /**
+   * @generated from field: int64 jitter_seconds = 5;
+   */
+  jitterSeconds: bigint;
+
+  /**
+   * @generated from field: bool paused = 6;
+   */
+  paused: boolean;
+
+  /**
+   * @generated from field: int64 next_run_at = 7;
+   */
+  nextRunAt: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp next_run_at_ts = 8;
+   */
+  nextRunAtTs?: Timestamp;
+
+  /**
+   * @generated from field: int64 last_run_at = 9;
+   */
+  lastRunAt: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_run_at_ts = 10;
+   */
+  lastRunAtTs?: Timestamp;
+
+  /**
+   * @generated from field: optional string last_run_status = 11;
+   */
+  lastRunStatus?: string;
+
+  /**
+   * @generated from field: optional string last_error = 12;
+   */
+  lastError?: string;
+
+  /**
+   * @generated from field: int64 created_at = 13;
+   */
+  createdAt: bigint;
+
+  /**
+   * @generated from field: int64 updated_at = 14;
+   */
+  updatedAt: bigint;
+};
+
+/**
+ * Describes the message npan.v1.SyncSchedule.
+ * Use `create(SyncScheduleSchema)` to create a new message.
+ */
+export const SyncScheduleSchema: GenMessage<SyncSchedule> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 92);
+
+/**
+ * @generated from message npan.v1.ListSyncSchedulesRequest
+ */
+export type ListSyncSchedulesRequest = Message<"npan.v1.ListSyncSchedulesRequest"> & {
+  /**
+   * @generated from field: optional string tenant_id = 1;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.ListSyncSchedulesRequest.
+ * Use `create(ListSyncSchedulesRequestSchema)` to create a new message.
+ */
+export const ListSyncSchedulesRequestSchema: GenMessage<ListSyncSchedulesRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 93);
+
+/**
+ * @generated from message npan.v1.ListSyncSchedulesResponse
+ */
+export type ListSyncSchedulesResponse = Message<"npan.v1.ListSyncSchedulesResponse"> & {
+  /**
+   * @generated from field: repeated npan.v1.SyncSchedule schedules = 1;
+   */
+  schedules: SyncSchedule[];
+};
+
+/**
+ * Describes the message npan.v1.ListSyncSchedulesResponse.
+ * Use `create(ListSyncSchedulesResponseSchema)` to create a new message.
+ */
+export const ListSyncSchedulesResponseSchema: GenMessage<ListSyncSchedulesResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 94);
+
+/**
+ * @generated from message npan.v1.CreateSyncScheduleRequest
+ */
+export type CreateSyncScheduleRequest = Message<"npan.v1.CreateSyncScheduleRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string cron_expr = 2;
+   */
+  cronExpr: string;
+
+  /**
+   * @generated from field: optional npan.v1.SyncMode mode = 3;
+   */
+  mode?: SyncMode;
+
+  /**
+   * @generated from field: optional int64 jitter_seconds = 4;
+   */
+  jitterSeconds?: bigint;
+
+  /**
+   * @generated from field: optional bool paused = 5;
+   */
+  paused?: boolean;
+
+  /**
+   * @generated from field: optional string tenant_id = 6;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.CreateSyncScheduleRequest.
+ * Use `create(CreateSyncScheduleRequestSchema)` to create a new message.
+ */
+export const CreateSyncScheduleRequestSchema: GenMessage<CreateSyncScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 95);
+
+/**
+ * @generated from message npan.v1.CreateSyncScheduleResponse
+ */
+export type CreateSyncScheduleResponse = Message<"npan.v1.CreateSyncScheduleResponse"> & {
+  /**
+   * @generated from field: npan.v1.SyncSchedule schedule = 1;
+   */
+  schedule?: SyncSchedule;
+};
+
+/**
+ * Describes the message npan.v1.CreateSyncScheduleResponse.
+ * Use `create(CreateSyncScheduleResponseSchema)` to create a new message.
+ */
+export const CreateSyncScheduleResponseSchema: GenMessage<CreateSyncScheduleResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 96);
+
+/**
+ * @generated from message npan.v1.PauseSyncScheduleRequest
+ */
+export type PauseSyncScheduleRequest = Message<"npan.v1.PauseSyncScheduleRequest"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: optional string tenant_id = 2;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.PauseSyncScheduleRequest.
+ * Use `create(PauseSyncScheduleRequestSchema)` to create a new message.
+ */
+export const PauseSyncScheduleRequestSchema: GenMessage<PauseSyncScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 97);
+
+/**
+ * @generated from message npan.v1.PauseSyncScheduleResponse
+ */
+export type PauseSyncScheduleResponse = Message<"npan.v1.PauseSyncScheduleResponse"> & {
+  /**
+   * @generated from field: npan.v1.SyncSchedule schedule = 1;
+   */
+  schedule?: SyncSchedule;
+};
+
+/**
+ * Describes the message npan.v1.PauseSyncScheduleResponse.
+ * Use `create(PauseSyncScheduleResponseSchema)` to create a new message.
+ */
+export const PauseSyncScheduleResponseSchema: GenMessage<PauseSyncScheduleResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 98);
+
+/**
+ * @generated from message npan.v1.ResumeSyncScheduleRequest
+ */
+export type ResumeSyncScheduleRequest = Message<"npan.v1.ResumeSyncScheduleRequest"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: optional string tenant_id = 2;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.ResumeSyncScheduleRequest.
+ * Use `create(ResumeSyncScheduleRequestSchema)` to create a new message.
+ */
+export const ResumeSyncScheduleRequestSchema: GenMessage<ResumeSyncScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 99);
+
+/**
+ * @generated from message npan.v1.ResumeSyncScheduleResponse
+ */
+export type ResumeSyncScheduleResponse = Message<"npan.v1.ResumeSyncScheduleResponse"> & {
+  /**
+   * @generated from field: npan.v1.SyncSchedule schedule = 1;
+   */
+  schedule?: SyncSchedule;
+};
+
+/**
+ * Describes the message npan.v1.ResumeSyncScheduleResponse.
+ * Use `create(ResumeSyncScheduleResponseSchema)` to create a new message.
+ */
+export const ResumeSyncScheduleResponseSchema: GenMessage<ResumeSyncScheduleResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 100);
+
+/**
+ * @generated from message npan.v1.DeleteSyncScheduleRequest
+ */
+export type DeleteSyncScheduleRequest = Message<"npan.v1.DeleteSyncScheduleRequest"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: optional string tenant_id = 2;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.DeleteSyncScheduleRequest.
+ * Use `create(DeleteSyncScheduleRequestSchema)` to create a new message.
+ */
+export const DeleteSyncScheduleRequestSchema: GenMessage<DeleteSyncScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 101);
+
+/**
+ * @generated from message npan.v1.DeleteSyncScheduleResponse
+ */
+export type DeleteSyncScheduleResponse = Message<"npan.v1.DeleteSyncScheduleResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message npan.v1.DeleteSyncScheduleResponse.
+ * Use `create(DeleteSyncScheduleResponseSchema)` to create a new message.
+ */
+export const DeleteSyncScheduleResponseSchema: GenMessage<DeleteSyncScheduleResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 102);
+
+/**
+ * @generated from message npan.v1.TestNotificationRequest
+ */
+export type TestNotificationRequest = Message<"npan.v1.TestNotificationRequest"> & {
+  /**
+   * @generated from field: optional string sink = 1;
+   */
+  sink?: string;
+};
+
+/**
+ * Describes the message npan.v1.TestNotificationRequest.
+ * Use `create(TestNotificationRequestSchema)` to create a new message.
+ */
+export const TestNotificationRequestSchema: GenMessage<TestNotificationRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 103);
+
+/**
+ * @generated from message npan.v1.NotificationSinkResult
+ */
+export type NotificationSinkResult = Message<"npan.v1.NotificationSinkResult"> & {
+  /**
+   * @generated from field: string sink = 1;
+   */
+  sink: string;
+
+  /**
+   * @generated from field: bool ok = 2;
+   */
+  ok: boolean;
+
+  /**
+   * @generated from field: optional string error = 3;
+   */
+  error?: string;
+};
+
+/**
+ * Describes the message npan.v1.NotificationSinkResult.
+ * Use `create(NotificationSinkResultSchema)` to create a new message.
+ */
+export const NotificationSinkResultSchema: GenMessage<NotificationSinkResult> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 104);
+
+/**
+ * @generated from message npan.v1.TestNotificationResponse
+ */
+export type TestNotificationResponse = Message<"npan.v1.TestNotificationResponse"> & {
+  /**
+   * @generated from field: repeated npan.v1.NotificationSinkResult results = 1;
+   */
+  results: NotificationSinkResult[];
+};
+
+/**
+ * Describes the message npan.v1.TestNotificationResponse.
+ * Use `create(TestNotificationResponseSchema)` to create a new message.
+ */
+export const TestNotificationResponseSchema: GenMessage<TestNotificationResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 105);
+
+/**
+ * @generated from message npan.v1.IndexChange
+ */
+export type IndexChange = Message<"npan.v1.IndexChange"> & {
+  /**
+   * @generated from field: int64 seq = 1;
+   */
+  seq: bigint;
+
+  /**
+   * @generated from field: npan.v1.IndexChangeOp op = 2;
+   */
+  op: IndexChangeOp;
+
+  /**
+   * @generated from field: string doc_id = 3;
+   */
+  docId: string;
+
+  /**
+   * @generated from field: optional npan.v1.IndexDocument document = 4;
+   */
+  document?: IndexDocument;
+
+  /**
+   * @generated from field: int64 root_folder_id = 5;
+   */
+  rootFolderId: bigint;
+
+  /**
+   * @generated from field: int64 run_id = 6;
+   */
+  runId: bigint;
+
+  /**
+   * @generated from field: int64 removed = 7;
+   */
+  removed: bigint;
+
+  /**
+   * @generated from field: int64 occurred_at = 8;
+   */
+  occurredAt: bigint;
+};
+
+/**
+ * Describes the message npan.v1.IndexChange.
+ * Use `create(IndexChangeSchema)` to create a new message.
+ */
+export const IndexChangeSchema: GenMessage<IndexChange> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 106);
+
+/**
+ * @generated from message npan.v1.WatchIndexChangesRequest
+ */
+export type WatchIndexChangesRequest = Message<"npan.v1.WatchIndexChangesRequest"> & {
+  /**
+   * @generated from field: int64 after_seq = 1;
+   */
+  afterSeq: bigint;
+
+  /**
+   * @generated from field: optional bool from_latest = 2;
+   */
+  fromLatest?: boolean;
+
+  /**
+   * @generated from field: optional string tenant_id = 3;
+   */
+  tenantId?: string;
+};
+
+/**
+ * Describes the message npan.v1.WatchIndexChangesRequest.
+ * Use `create(WatchIndexChangesRequestSchema)` to create a new message.
+ */
+export const WatchIndexChangesRequestSchema: GenMessage<WatchIndexChangesRequest> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 107);
+
+/**
+ * @generated from message npan.v1.WatchIndexChangesResponse
+ */
+export type WatchIndexChangesResponse = Message<"npan.v1.WatchIndexChangesResponse"> & {
+  /**
+   * @generated from field: repeated npan.v1.IndexChange changes = 1;
+   */
+  changes: IndexChange[];
+
+  /**
+   * @generated from field: int64 latest_seq = 2;
+   */
+  latestSeq: bigint;
+};
+
+/**
+ * Describes the message npan.v1.WatchIndexChangesResponse.
+ * Use `create(WatchIndexChangesResponseSchema)` to create a new message.
+ */
+export const WatchIndexChangesResponseSchema: GenMessage<WatchIndexChangesResponse> = /*@__PURE__*/
+  messageDesc(file_npan_v1_api, 108);
+
+/**
+ * @generated from enum npan.v1.ItemType
+ */
+export enum ItemType {
+  /**
+   * @generated from enum value: ITEM_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ITEM_TYPE_FILE = 1;
+   */
+  FILE = 1,
+
+  /**
+   * @generated from enum value: ITEM_TYPE_FOLDER = 2;
+   */
+  FOLDER = 2,
+}
+
+/**
+ * Describes the enum npan.v1.ItemType.
+ */
+export const ItemTypeSchema: GenEnum<ItemType> = /*@__PURE__*/
+  enumDesc(file_npan_v1_api, 0);
+
+/**
+ * @generated from enum npan.v1.SyncStatus
+ */
+export enum SyncStatus {
+  /**
+   * @generated from enum value: SYNC_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SYNC_STATUS_IDLE = 1;
+   */
+  IDLE = 1,
+
+  /**
+   * @generated from enum value: SYNC_STATUS_RUNNING = 2;
+   */
+  RUNNING = 2,
+
+  /**
+   * @generated from enum value: SYNC_STATUS_DONE = 3;
+   */
+  DONE = 3,
+
+  /**
+   * @generated from enum value: SYNC_STATUS_ERROR = 4;
+   */
+  ERROR = 4,
+
+  /**
+   * @generated from enum value: SYNC_STATUS_CANCELLED = 5;
+   */
+  CANCELLED = 5,
+
+  /**
+   * @generated from enum value: SYNC_STATUS_INTERRUPTED = 6;
+   */
+  INTERRUPTED = 6,
+
+  /**
+   * @generated from enum value: SYNC_STATUS_PAUSED = 7;
+   */
+  PAUSED = 7,
+}
+
+/**
+ * Describes the enum npan.v1.SyncStatus.
+ */
+export const SyncStatusSchema: GenEnum<SyncStatus> = /*@__PURE__*/
+  enumDesc(file_npan_v1_api, 1);
+
+/**
+ * @generated from enum npan.v1.SyncMode
+ */
+export enum SyncMode {
+  /**
+   * @generated from enum value: SYNC_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SYNC_MODE_FULL = 2;
+   */
+  FULL = 2,
+
+  /**
+   * @generated from enum value: SYNC_MODE_INCREMENTAL = 3;
+   */
+  INCREMENTAL = 3,
+}
+
+/**
+ * Describes the enum npan.v1.SyncMode.
+ */
+export const SyncModeSchema: GenEnum<SyncMode> = /*@__PURE__*/
+  enumDesc(file_npan_v1_api, 2);
+
+/**
+ * @generated from enum npan.v1.ErrorCode
+ */
+export enum ErrorCode {
+  /**
+   * @generated from enum value: ERROR_CODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ERROR_CODE_UNAUTHORIZED = 1;
+   */
+  UNAUTHORIZED = 1,
+
+  /**
+   * @generated from enum value: ERROR_CODE_BAD_REQUEST = 2;
+   */
+  BAD_REQUEST = 2,
+
+  /**
+   * @generated from enum value: ERROR_CODE_NOT_FOUND = 3;
+   */
+  NOT_FOUND = 3,
+
+  /**
+   * @generated from enum value: ERROR_CODE_CONFLICT = 4;
+   */
+  CONFLICT = 4,
+
+  /**
+   * @generated from enum value: ERROR_CODE_RATE_LIMITED = 5;
+   */
+  RATE_LIMITED = 5,
+
+  /**
+   * @generated from enum value: ERROR_CODE_INTERNAL_ERROR = 6;
+   */
+  INTERNAL_ERROR = 6,
+}
+
+/**
+ * Describes the enum npan.v1.ErrorCode.
+ */
+export const ErrorCodeSchema: GenEnum<ErrorCode> = /*@__PURE__*/
+  enumDesc(file_npan_v1_api, 3);
+
+/**
+ * @generated from enum npan.v1.ReadyStatus
+ */
+export enum ReadyStatus {
+  /**
+   * @generated from enum value: READY_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: READY_STATUS_READY = 1;
+   */
+  READY = 1,
+
+  /**
+   * @generated from enum value: READY_STATUS_NOT_READY = 2;
+   */
+  NOT_READY = 2,
+}
+
+/**
+ * Describes the enum npan.v1.ReadyStatus.
+ */
+export const ReadyStatusSchema: GenEnum<ReadyStatus> = /*@__PURE__*/
+  enumDesc(file_npan_v1_api, 4);
+
+/**
+ * @generated from enum npan.v1.IndexRebuildStatus
+ */
+export enum IndexRebuildStatus {
+  /**
+   * @generated from enum value: INDEX_REBUILD_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: INDEX_REBUILD_STATUS_BUILDING = 1;
+   */
+  BUILDING = 1,
+
+  /**
+   * @generated from enum value: INDEX_REBUILD_STATUS_SWAPPED = 2;
+   */
+  SWAPPED = 2,
+
+  /**
+   * @generated from enum value: INDEX_REBUILD_STATUS_ROLLED_BACK = 3;
+   */
+  ROLLED_BACK = 3,
+}
+
+/**
+ * Describes the enum npan.v1.IndexRebuildStatus.
+ */
+export const IndexRebuildStatusSchema: GenEnum<IndexRebuildStatus> = /*@__PURE__*/
+  enumDesc(file_npan_v1_api, 5);
+
+/**
+ * @generated from enum npan.v1.FolderResyncMode
+ */
+export enum FolderResyncMode {
+  /**
+   * @generated from enum value: FOLDER_RESYNC_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: FOLDER_RESYNC_MODE_MERGE = 1;
+   */
+  MERGE = 1,
+
+  /**
+   * @generated from enum value: FOLDER_RESYNC_MODE_REBUILD = 2;
+   */
+  REBUILD = 2,
+}
+
+/**
+ * Describes the enum npan.v1.FolderResyncMode.
+ */
+export const FolderResyncModeSchema: GenEnum<FolderResyncMode> = /*@__PURE__*/
+  enumDesc(file_npan_v1_api, 6);
+
+/**
+ * @generated from enum npan.v1.IndexChangeOp
+ */
+export enum IndexChangeOp {
+  /**
+   * @generated from enum value: INDEX_CHANGE_OP_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: INDEX_CHANGE_OP_UPSERT = 1;
+   */
+  UPSERT = 1,
+
+  /**
+   * @generated from enum value: INDEX_CHANGE_OP_DELETE = 2;
+   */
+  DELETE = 2,
+
+  /**
+   * @generated from enum value: INDEX_CHANGE_OP_SWEEP = 3;
+   */
+  SWEEP = 3,
+
+  /**
+   * @generated from enum value: INDEX_CHANGE_OP_RESET = 4;
+   */
+  RESET = 4,
+}
+
+/**
+ * Describes the enum npan.v1.IndexChangeOp.
+ */
+export const IndexChangeOpSchema: GenEnum<IndexChangeOp> = /*@__PURE__*/
+  enumDesc(file_npan_v1_api, 7);
+
+/**
+ * @generated from service npan.v1.HealthService
+ */
+export const HealthService: GenService<{
+  /**
+   * @generated from rpc npan.v1.HealthService.Health
+   */
+  health: {
     methodKind: "unary";
     input: typeof HealthRequestSchema;
     output: typeof HealthResponseSchema;
@@ -1588,6 +3870,206 @@ export const AdminService: GenService<{
     input: typeof CancelSyncRequestSchema;
     output: typeof CancelSyncResponseSchema;
   },
+  /**
+   * @generated from rpc npan.v1.AdminService.PauseSync
+   */
+  pauseSync: {
+    methodKind: "unary";
+    input: typeof PauseSyncRequestSchema;
+    output: typeof PauseSyncResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.ResumeSync
+   */
+  resumeSync: {
+    methodKind: "unary";
+    input: typeof ResumeSyncRequestSchema;
+    output: typeof ResumeSyncResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.ResyncFolder
+   */
+  resyncFolder: {
+    methodKind: "unary";
+    input: typeof ResyncFolderRequestSchema;
+    output: typeof ResyncFolderResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.GetSyncLease
+   */
+  getSyncLease: {
+    methodKind: "unary";
+    input: typeof GetSyncLeaseRequestSchema;
+    output: typeof GetSyncLeaseResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.ForceReleaseSyncLease
+   */
+  forceReleaseSyncLease: {
+    methodKind: "unary";
+    input: typeof ForceReleaseSyncLeaseRequestSchema;
+    output: typeof ForceReleaseSyncLeaseResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.GetFolderResyncProgress
+   */
+  getFolderResyncProgress: {
+    methodKind: "unary";
+    input: typeof GetFolderResyncProgressRequestSchema;
+    output: typeof GetFolderResyncProgressResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.CancelFolderResync
+   */
+  cancelFolderResync: {
+    methodKind: "unary";
+    input: typeof CancelFolderResyncRequestSchema;
+    output: typeof CancelFolderResyncResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.RollbackIndexRebuild
+   */
+  rollbackIndexRebuild: {
+    methodKind: "unary";
+    input: typeof RollbackIndexRebuildRequestSchema;
+    output: typeof RollbackIndexRebuildResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.ListSyncRuns
+   */
+  listSyncRuns: {
+    methodKind: "unary";
+    input: typeof ListSyncRunsRequestSchema;
+    output: typeof ListSyncRunsResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.GetSyncRun
+   */
+  getSyncRun: {
+    methodKind: "unary";
+    input: typeof GetSyncRunRequestSchema;
+    output: typeof GetSyncRunResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.ListDeadLetters
+   */
+  listDeadLetters: {
+    methodKind: "unary";
+    input: typeof ListDeadLettersRequestSchema;
+    output: typeof ListDeadLettersResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.ReplayDeadLetters
+   */
+  replayDeadLetters: {
+    methodKind: "unary";
+    input: typeof ReplayDeadLettersRequestSchema;
+    output: typeof ReplayDeadLettersResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.DiscardDeadLetters
+   */
+  discardDeadLetters: {
+    methodKind: "unary";
+    input: typeof DiscardDeadLettersRequestSchema;
+    output: typeof DiscardDeadLettersResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.FindDuplicates
+   */
+  findDuplicates: {
+    methodKind: "unary";
+    input: typeof FindDuplicatesRequestSchema;
+    output: typeof FindDuplicatesResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.StartReconciliation
+   */
+  startReconciliation: {
+    methodKind: "unary";
+    input: typeof StartReconciliationRequestSchema;
+    output: typeof StartReconciliationResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.GetReconciliationReport
+   */
+  getReconciliationReport: {
+    methodKind: "unary";
+    input: typeof GetReconciliationReportRequestSchema;
+    output: typeof GetReconciliationReportResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.ExportReconciliationReport
+   */
+  exportReconciliationReport: {
+    methodKind: "unary";
+    input: typeof ExportReconciliationReportRequestSchema;
+    output: typeof ExportReconciliationReportResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.CancelReconciliation
+   */
+  cancelReconciliation: {
+    methodKind: "unary";
+    input: typeof CancelReconciliationRequestSchema;
+    output: typeof CancelReconciliationResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.ListSyncSchedules
+   */
+  listSyncSchedules: {
+    methodKind: "unary";
+    input: typeof ListSyncSchedulesRequestSchema;
+    output: typeof ListSyncSchedulesResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.CreateSyncSchedule
+   */
+  createSyncSchedule: {
+    methodKind: "unary";
+    input: typeof CreateSyncScheduleRequestSchema;
+    output: typeof CreateSyncScheduleResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.PauseSyncSchedule
+   */
+  pauseSyncSchedule: {
+    methodKind: "unary";
+    input: typeof PauseSyncScheduleRequestSchema;
+    output: typeof PauseSyncScheduleResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.ResumeSyncSchedule
+   */
+  resumeSyncSchedule: {
+    methodKind: "unary";
+    input: typeof ResumeSyncScheduleRequestSchema;
+    output: typeof ResumeSyncScheduleResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.DeleteSyncSchedule
+   */
+  deleteSyncSchedule: {
+    methodKind: "unary";
+    input: typeof DeleteSyncScheduleRequestSchema;
+    output: typeof DeleteSyncScheduleResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.TestNotification
+   */
+  testNotification: {
+    methodKind: "unary";
+    input: typeof TestNotificationRequestSchema;
+    output: typeof TestNotificationResponseSchema;
+  },
+  /**
+   * @generated from rpc npan.v1.AdminService.WatchIndexChanges
+   */
+  watchIndexChanges: {
+    methodKind: "server_streaming";
+    input: typeof WatchIndexChangesRequestSchema;
+    output: typeof WatchIndexChangesResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_npan_v1_api, 4);
 
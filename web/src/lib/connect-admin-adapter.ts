@@ -1,16 +1,24 @@
 import type { Timestamp } from '@bufbuild/protobuf/wkt'
 import type {
   CrawlStats as ProtoCrawlStats,
+  DryRunReport as ProtoDryRunReport,
+  DryRunSample as ProtoDryRunSample,
   GetIndexStatsResponse,
   GetSyncProgressResponse,
+  IndexRebuildState as ProtoIndexRebuildState,
   InspectRootsResponse as ProtoInspectRootsResponse,
+  RateControlState as ProtoRateControlState,
   RootSyncProgress as ProtoRootSyncProgress,
   SyncProgressState as ProtoSyncProgressState,
 } from '@/gen/npan/v1/api_pb'
-import { SyncMode, SyncStatus } from '@/gen/npan/v1/api_pb'
+import { IndexRebuildStatus, SyncMode, SyncStatus } from '@/gen/npan/v1/api_pb'
 import type {
   CrawlStats,
+  DryRunReport,
+  DryRunSample,
+  IndexRebuildState,
   InspectRootsResponse,
+  RateControlState,
   RootProgress,
   SyncProgress,
 } from '@/lib/sync-schemas'
@@ -44,6 +52,8 @@ function mapSyncStatus(status: SyncStatus): SyncProgress['status'] {
       return 'cancelled'
     case SyncStatus.INTERRUPTED:
       return 'interrupted'
+    case SyncStatus.PAUSED:
+      return 'paused'
     case SyncStatus.IDLE:
     case SyncStatus.UNSPECIFIED:
     default:
@@ -62,6 +72,98 @@ function mapSyncMode(mode: SyncMode | undefined): SyncProgress['mode'] {
   }
 }
 
+function mapRebuildStatus(
+  status: IndexRebuildStatus,
+): IndexRebuildState['status'] {
+  switch (status) {
+    case IndexRebuildStatus.BUILDING:
+      return 'building'
+    case IndexRebuildStatus.SWAPPED:
+      return 'swapped'
+    case IndexRebuildStatus.ROLLED_BACK:
+      return 'rolled_back'
+    default:
+      return undefined
+  }
+}
+
+function mapRebuildState(
+  state: ProtoIndexRebuildState | undefined,
+): IndexRebuildState | undefined {
+  if (!state) {
+    return undefined
+  }
+  return {
+    status: mapRebuildStatus(state.status),
+    liveIndex: state.liveIndex,
+    shadowIndex: state.shadowIndex,
+    startedAt: int64ToNumber(state.startedAt),
+    swappedAt:
+      state.swappedAt != null ? int64ToNumber(state.swappedAt) : undefined,
+    rolledBackAt:
+      state.rolledBackAt != null ? int64ToNumber(state.rolledBackAt) : undefined,
+    lastError: state.lastError,
+  }
+}
+
+function mapDryRunSamples(samples: ProtoDryRunSample[]): DryRunSample[] {
+  return samples.map((sample) => ({
+    docId: sample.docId,
+    sourceId: int64ToNumber(sample.sourceId),
+    path: sample.path,
+    changedFields: sample.changedFields,
+  }))
+}
+
+function mapDryRunReport(
+  report: ProtoDryRunReport | undefined,
+): DryRunReport | undefined {
+  if (!report) {
+    return undefined
+  }
+  return {
+    mode: mapSyncMode(report.mode),
+    status: mapSyncStatus(report.status),
+    lastError: report.lastError,
+    activeRoot:
+      report.activeRoot != null ? int64ToNumber(report.activeRoot) : undefined,
+    startedAt: int64ToNumber(report.startedAt),
+    finishedAt:
+      report.finishedAt != null ? int64ToNumber(report.finishedAt) : undefined,
+    roots: report.roots.map((root) => ({
+      rootFolderId: int64ToNumber(root.rootFolderId),
+      rootName: root.rootName || undefined,
+      adds: int64ToNumber(root.adds),
+      updates: int64ToNumber(root.updates),
+      deletes: int64ToNumber(root.deletes),
+      unchanged: int64ToNumber(root.unchanged),
+      sampleAdds: mapDryRunSamples(root.sampleAdds),
+      sampleUpdates: mapDryRunSamples(root.sampleUpdates),
+      sampleDeletes: mapDryRunSamples(root.sampleDeletes),
+    })),
+    adds: int64ToNumber(report.adds),
+    updates: int64ToNumber(report.updates),
+    deletes: int64ToNumber(report.deletes),
+  }
+}
+
+function mapRateControl(
+  state: ProtoRateControlState | undefined,
+): RateControlState | undefined {
+  if (!state) {
+    return undefined
+  }
+  return {
+    baseRate: state.baseRate,
+    effectiveRate: state.effectiveRate,
+    backoff: state.backoff,
+    pausedUntil: int64ToNumber(state.pausedUntil),
+    throttleEvents: int64ToNumber(state.throttleEvents),
+    lastThrottleAt: int64ToNumber(state.lastThrottleAt),
+    lastThrottleStatus: state.lastThrottleStatus,
+  }
+}
+
 function mapCrawlStats(stats: ProtoCrawlStats | undefined): CrawlStats {
   return {
     foldersVisited: int64ToNumber(stats?.foldersVisited),
@@ -139,9 +241,24 @@ function mapProgressState(state: ProtoSyncProgressState): SyncProgress {
           skippedDeletes: int64ToNumber(state.incrementalStats.skippedDeletes),
           cursorBefore: int64ToNumber(state.incrementalStats.cursorBefore),
           cursorAfter: int64ToNumber(state.incrementalStats.cursorAfter),
+          foldersMoved: int64ToNumber(state.incrementalStats.foldersMoved),
+          pathsRewritten: int64ToNumber(state.incrementalStats.pathsRewritten),
+          pathRewritesPending: int64ToNumber(
+            state.incrementalStats.pathRewritesPending,
+          ),
+          cascadeDeleted: int64ToNumber(state.incrementalStats.cascadeDeleted),
+          foldersRestored: int64ToNumber(state.incrementalStats.foldersRestored),
+          restoredDocs: int64ToNumber(state.incrementalStats.restoredDocs),
+          recrawlsPending: int64ToNumber(state.incrementalStats.recrawlsPending),
+          windowsSplit: int64ToNumber(state.incrementalStats.windowsSplit),
+          windowsPending: int64ToNumber(state.incrementalStats.windowsPending),
         }
       : undefined,
     lastError: state.lastError,
+    staleRemoved: int64ToNumber(state.staleRemoved),
+    rebuild: mapRebuildState(state.rebuild),
+    dryRun: mapDryRunReport(state.dryRun),
+    rateControl: mapRateControl(state.rateControl),
     verification: state.verification
       ? {
           meiliDocCount: int64ToNumber(state.verification.meiliDocCount),
@@ -150,8 +267,10 @@ function mapProgressState(state: ProtoSyncProgressState): SyncProgress {
             state.verification.discoveredDocCount,
           ),
           skippedCount: int64ToNumber(state.verification.skippedCount),
+          staleRemoved: int64ToNumber(state.verification.staleRemoved),
           verified: state.verification.verified,
           warnings: state.verification.warnings,
+          deadLetterCount: int64ToNumber(state.verification.deadLetterCount),
         }
       : undefined,
     startedAtTs: timestampToProtoLike(state.startedAtTs),
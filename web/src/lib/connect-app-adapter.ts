@@ -28,6 +28,7 @@ function mapIndexDocument(item: ProtoIndexDocument): IndexDocument {
     type: mapItemType(item.type),
     name: item.name,
     path_text: item.pathText,
+    ancestor_ids: item.ancestorIds.map(int64ToNumber),
     parent_id: int64ToNumber(item.parentId),
     modified_at: int64ToNumber(item.modifiedAt),
     created_at: int64ToNumber(item.createdAt),
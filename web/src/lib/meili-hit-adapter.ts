@@ -22,6 +22,7 @@ export interface MeiliHit {
   type: 'file' | 'folder' | string
   name: string
   path_text: string
+  ancestor_ids?: Array<number | string>
   parent_id: number | string
   modified_at: number | string
   created_at: number | string
@@ -70,6 +71,7 @@ export function fromMeiliHit(hit: MeiliHit): IndexDocument {
     type: toItemType(hit.type),
     name: hit.name,
     path_text: hit.path_text,
+    ancestor_ids: hit.ancestor_ids?.map(toNumber),
     parent_id: toNumber(hit.parent_id),
     modified_at: toNumber(hit.modified_at),
     created_at: toNumber(hit.created_at),
@@ -9,6 +9,7 @@ export const IndexDocumentSchema = z.object({
   type: z.enum(['file', 'folder']),
   name: z.string(),
   path_text: z.string(),
+  ancestor_ids: z.array(z.number().int()).optional(),
   parent_id: z.number().int(),
   modified_at: z.number().int(),
   created_at: z.number().int(),
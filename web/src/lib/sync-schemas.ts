@@ -49,6 +49,15 @@ export const IncrementalSyncStatsSchema = z.object({
   skippedDeletes: z.number().int(),
   cursorBefore: z.number().int(),
   cursorAfter: z.number().int(),
+  foldersMoved: z.number().int().optional(),
+  pathsRewritten: z.number().int().optional(),
+  pathRewritesPending: z.number().int().optional(),
+  cascadeDeleted: z.number().int().optional(),
+  foldersRestored: z.number().int().optional(),
+  restoredDocs: z.number().int().optional(),
+  recrawlsPending: z.number().int().optional(),
+  windowsSplit: z.number().int().optional(),
+  windowsPending: z.number().int().optional(),
 })
 export type IncrementalSyncStats = z.infer<typeof IncrementalSyncStatsSchema>
 
@@ -57,12 +66,70 @@ const SyncVerificationSchema = z.object({
   crawledDocCount: z.number().int(),
   discoveredDocCount: z.number().int(),
   skippedCount: z.number().int(),
+  staleRemoved: z.number().int().optional(),
   verified: z.boolean(),
   warnings: z.array(z.string()).optional(),
+  deadLetterCount: z.number().int().optional(),
 })
 
+const IndexRebuildStateSchema = z.object({
+  status: z.enum(['building', 'swapped', 'rolled_back']).optional(),
+  liveIndex: z.string(),
+  shadowIndex: z.string(),
+  startedAt: z.number().int(),
+  swappedAt: z.number().int().optional(),
+  rolledBackAt: z.number().int().optional(),
+  lastError: z.string().optional(),
+})
+export type IndexRebuildState = z.infer<typeof IndexRebuildStateSchema>
+
+const DryRunSampleSchema = z.object({
+  docId: z.string(),
+  sourceId: z.number().int(),
+  path: z.string(),
+  changedFields: z.array(z.string()).optional(),
+})
+
+const DryRunRootDiffSchema = z.object({
+  rootFolderId: z.number().int(),
+  rootName: z.string().optional(),
+  adds: z.number().int(),
+  updates: z.number().int(),
+  deletes: z.number().int(),
+  unchanged: z.number().int(),
+  sampleAdds: z.array(DryRunSampleSchema),
+  sampleUpdates: z.array(DryRunSampleSchema),
+  sampleDeletes: z.array(DryRunSampleSchema),
+})
+
+const DryRunReportSchema = z.object({
+  mode: z.enum(['full', 'incremental']).optional(),
+  status: z.enum(['idle', 'running', 'paused', 'done', 'error', 'cancelled', 'interrupted']),
+  lastError: z.string().optional(),
+  activeRoot: z.number().int().optional(),
+  startedAt: z.number().int(),
+  finishedAt: z.number().int().optional(),
+  roots: z.array(DryRunRootDiffSchema),
+  adds: z.number().int(),
+  updates: z.number().int(),
+  deletes: z.number().int(),
+})
+export type DryRunReport = z.infer<typeof DryRunReportSchema>
+export type DryRunSample = z.infer<typeof DryRunSampleSchema>
+
+const RateControlStateSchema = z.object({
+  baseRate: z.number(),
+  effectiveRate: z.number(),
+  backoff: z.boolean(),
+  pausedUntil: z.number().int(),
+  throttleEvents: z.number().int(),
+  lastThrottleAt: z.number().int(),
+  lastThrottleStatus: z.number().int(),
+})
+export type RateControlState = z.infer<typeof RateControlStateSchema>
+
 export const SyncProgressSchema = z.object({
-  status: z.enum(['idle', 'running', 'done', 'error', 'cancelled', 'interrupted']),
+  status: z.enum(['idle', 'running', 'paused', 'done', 'error', 'cancelled', 'interrupted']),
   mode: z.enum(['full', 'incremental']).optional(),
   startedAt: z.number().int(),
   updatedAt: z.number().int(),
@@ -75,6 +142,10 @@ export const SyncProgressSchema = z.object({
   lastError: z.string().optional(),
   verification: SyncVerificationSchema.nullable().optional(),
   incrementalStats: IncrementalSyncStatsSchema.optional(),
+  staleRemoved: z.number().int().optional(),
+  rebuild: IndexRebuildStateSchema.optional(),
+  dryRun: DryRunReportSchema.optional(),
+  rateControl: RateControlStateSchema.optional(),
 }).extend({
   startedAtTs: ProtoTimestampSchema.optional(),
   updatedAtTs: ProtoTimestampSchema.optional(),